@@ -0,0 +1,129 @@
+package main
+
+import (
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// balanceInfo is the JSON description of a balance, in atoms.
+type balanceInfo struct {
+	Total          int64 `json:"total"`
+	Spendable      int64 `json:"spendable"`
+	ImmatureReward int64 `json:"immature_reward"`
+	Locked         int64 `json:"locked"`
+
+	// DCR only fields
+	ImmatureStakeGeneration int64 `json:"immature_stake_generation,omitempty"`
+	LockedByTickets         int64 `json:"locked_by_tickets,omitempty"`
+	VotingAuthority         int64 `json:"voting_authority,omitempty"`
+	UnConfirmed             int64 `json:"unconfirmed,omitempty"`
+}
+
+func newBalanceInfo(balance *sharedW.Balance) *balanceInfo {
+	atoms := func(amount sharedW.AssetAmount) int64 {
+		if amount == nil {
+			return 0
+		}
+		return amount.ToInt()
+	}
+	return &balanceInfo{
+		Total:                   atoms(balance.Total),
+		Spendable:               atoms(balance.Spendable),
+		ImmatureReward:          atoms(balance.ImmatureReward),
+		Locked:                  atoms(balance.Locked),
+		ImmatureStakeGeneration: atoms(balance.ImmatureStakeGeneration),
+		LockedByTickets:         atoms(balance.LockedByTickets),
+		VotingAuthority:         atoms(balance.VotingAuthority),
+		UnConfirmed:             atoms(balance.UnConfirmed),
+	}
+}
+
+// toAtoms converts a coin amount of the asset to atoms.
+func toAtoms(assetType libutils.AssetType, amount float64) int64 {
+	switch assetType {
+	case libutils.BTCWalletAsset:
+		return btc.AmountSatoshi(amount)
+	case libutils.LTCWalletAsset:
+		return ltc.AmountLitoshi(amount)
+	}
+	return dcr.AmountAtom(amount)
+}
+
+type accountInfo struct {
+	Number  int32        `json:"number"`
+	Name    string       `json:"name"`
+	Balance *balanceInfo `json:"balance"`
+}
+
+type balanceCommand struct {
+	walletOption
+}
+
+func (c *balanceCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts, err := wallet.GetAccountsRaw()
+		if err != nil {
+			return nil, err
+		}
+		walletBalance, err := wallet.GetWalletBalance()
+		if err != nil {
+			return nil, err
+		}
+
+		result := struct {
+			Wallet   *walletInfo    `json:"wallet"`
+			Balance  *balanceInfo   `json:"balance"`
+			Accounts []*accountInfo `json:"accounts"`
+		}{
+			Wallet:  newWalletInfo(wallet),
+			Balance: newBalanceInfo(walletBalance),
+		}
+		for _, account := range accounts.Accounts {
+			result.Accounts = append(result.Accounts, &accountInfo{
+				Number:  account.Number,
+				Name:    account.Name,
+				Balance: newBalanceInfo(account.Balance),
+			})
+		}
+		return result, nil
+	})
+}
+
+type receiveCommand struct {
+	walletOption
+	Account int32 `long:"account" description:"Number of the account to receive to"`
+	New     bool  `long:"new" description:"Generate a new address instead of the current one"`
+}
+
+func (c *receiveCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+
+		var address string
+		if c.New {
+			address, err = wallet.NextAddress(c.Account)
+		} else {
+			address, err = wallet.CurrentAddress(c.Account)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			Account int32  `json:"account"`
+			Address string `json:"address"`
+		}{c.Account, address}, nil
+	})
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestToAtoms(t *testing.T) {
+	tests := []struct {
+		assetType libutils.AssetType
+		amount    float64
+		want      int64
+	}{
+		{libutils.DCRWalletAsset, 1.5, 150000000},
+		{libutils.DCRWalletAsset, 0.00000001, 1},
+		{libutils.DCRWalletAsset, 0.1 + 0.2, 30000000},
+		{libutils.BTCWalletAsset, 0.00012345, 12345},
+		{libutils.BTCWalletAsset, 21, 2100000000},
+		{libutils.LTCWalletAsset, 2.5, 250000000},
+		{libutils.LTCWalletAsset, 0, 0},
+	}
+	for _, test := range tests {
+		if got := toAtoms(test.assetType, test.amount); got != test.want {
+			t.Errorf("%s %v: expected %d atoms, got %d", test.assetType, test.amount, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/balancehistory"
+	"github.com/crypto-power/cryptopower/libwallet/dexorders"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/libwallet/portfolio"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/logger"
+
+	"decred.org/dcrwallet/v4/p2p"
+	"decred.org/dcrwallet/v4/spv"
+	"decred.org/dcrwallet/v4/ticketbuyer"
+	dcrw "decred.org/dcrwallet/v4/wallet"
+	"decred.org/dcrwallet/v4/wallet/udb"
+	"github.com/btcsuite/btclog"
+	btcC "github.com/btcsuite/btcwallet/chain"
+	btcw "github.com/btcsuite/btcwallet/wallet"
+	btcWtx "github.com/btcsuite/btcwallet/wtxmgr"
+	ltcC "github.com/dcrlabs/ltcwallet/chain"
+	ltcw "github.com/dcrlabs/ltcwallet/spv"
+	ltcWtx "github.com/dcrlabs/ltcwallet/wtxmgr"
+	"github.com/decred/dcrd/addrmgr/v2"
+	"github.com/decred/dcrd/connmgr/v3"
+	"github.com/decred/slog"
+	"github.com/jrick/logrotate/rotator"
+	btcN "github.com/lightninglabs/neutrino"
+)
+
+// logFilename is the file the cli logs to. Unlike the app, the cli never
+// logs to standard output which is reserved for the JSON results.
+const logFilename = "cryptopower-cli.log"
+
+// logWriter implements an io.Writer that outputs to the log rotator.
+type logWriter struct{}
+
+// Write writes the data in p to the log rotator, if it is initialized.
+func (logWriter) Write(p []byte) (n int, err error) {
+	if logRotator == nil {
+		return len(p), nil
+	}
+	return logRotator.Write(p)
+}
+
+// Loggers per subsystem. A single backend logger is created and all subsytem
+// loggers created from it will write to the backend.
+var (
+	backendLog    = slog.NewBackend(logWriter{})
+	btcBackendLog = btclog.NewBackend(logWriter{})
+
+	// logRotator is the logging output, it is initialized by
+	// initLogRotator.
+	logRotator *rotator.Rotator
+
+	log          = backendLog.Logger("CLI")
+	sharedWLog   = backendLog.Logger("SHWL")
+	dlwlLog      = backendLog.Logger("DLWL")
+	extLog       = backendLog.Logger("EXT")
+	dcrLog       = backendLog.Logger("DCR")
+	syncLog      = backendLog.Logger("SYNC")
+	tkbyLog      = backendLog.Logger("TKBY")
+	dcrWalletLog = backendLog.Logger("WLLT")
+	dcrSpv       = backendLog.Logger("DCR-S")
+	btcNtrn      = btcBackendLog.Logger("B-NTR")
+	btcLog       = btcBackendLog.Logger("BTC")
+	ltcLog       = btcBackendLog.Logger("LTC")
+)
+
+// Initialize package-global logger variables.
+func init() {
+	sharedW.UseLogger(sharedWLog)
+	libwallet.UseLogger(dlwlLog)
+	dcr.UseLogger(dcrLog)
+	btc.UseLogger(btcLog)
+	ltc.UseLogger(ltcLog)
+	ext.UseLogger(extLog)
+	addrmgr.UseLogger(dcrLog)
+	connmgr.UseLogger(dcrLog)
+	p2p.UseLogger(syncLog)
+	ticketbuyer.UseLogger(tkbyLog)
+	udb.UseLogger(dcrWalletLog)
+	btcN.UseLogger(btcNtrn)
+	ltcWtx.UseLogger(ltcLog)
+	btcWtx.UseLogger(btcLog)
+	ltcC.UseLogger(ltcLog)
+	btcC.UseLogger(btcLog)
+	btcw.UseLogger(btcLog)
+	ltcw.UseLogger(ltcLog)
+	dcrw.UseLogger(dcrLog)
+	spv.UseLogger(dcrSpv)
+	instantswap.UseLogger(sharedWLog)
+	dexorders.UseLogger(sharedWLog)
+	portfolio.UseLogger(sharedWLog)
+	txexport.UseLogger(sharedWLog)
+	balancehistory.UseLogger(sharedWLog)
+
+	logger.New(subsystemSLoggers, subsystemBLoggers)
+	// Neutrino and dcr spv logs are capped to errors as in the app.
+	btcNtrn.SetLevel(btclog.LevelError)
+	dcrSpv.SetLevel(slog.LevelError)
+}
+
+// subsystemSLoggers maps each subsystem identifier to its associated logger.
+var subsystemSLoggers = map[string]slog.Logger{
+	"CLI":  log,
+	"DLWL": dlwlLog,
+	"DCR":  dcrLog,
+	"EXT":  extLog,
+	"SYNC": syncLog,
+	"TKBY": tkbyLog,
+	"WLLT": dcrWalletLog,
+	"SHWL": sharedWLog,
+}
+
+var subsystemBLoggers = map[string]btclog.Logger{
+	"BTC": btcLog,
+	"LTC": ltcLog,
+}
+
+// initLogRotator initializes the logging rotator to write logs to logDir
+// and create roll files in the same directory. It must be called before the
+// package-global log rotator variable is used.
+func initLogRotator(logDir string, maxRolls int) error {
+	if err := os.MkdirAll(logDir, libutils.UserFilePerm); err != nil {
+		return fmt.Errorf("failed to create log directory: %v", err)
+	}
+
+	r, err := rotator.New(filepath.Join(logDir, logFilename), 32*1024, false, maxRolls)
+	if err != nil {
+		return fmt.Errorf("failed to create file rotator: %v", err)
+	}
+	logRotator = r
+	return nil
+}
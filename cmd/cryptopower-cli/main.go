@@ -0,0 +1,137 @@
+// cryptopower-cli is a headless front-end for libwallet. It manages the
+// wallets of the app data directory without the app UI and prints the result
+// of each command as JSON for scripting.
+//
+// Passphrases and seeds are read one per line from standard input or from
+// the file descriptor set with --passfd, in the order the command needs them:
+// the startup passphrase first if it is set, then the seed when restoring and
+// the spending passphrase of the wallet last.
+//
+// Errors are printed as a JSON object with an "error" field and the command
+// exits with status 1.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/logger"
+	"github.com/decred/dcrd/dcrutil/v4"
+	flags "github.com/jessevdk/go-flags"
+)
+
+const defaultLogDirname = "logs"
+
+type options struct {
+	Network     string `long:"network" default:"mainnet" description:"Network to use (mainnet, testnet, simnet, dextest)"`
+	HomeDir     string `long:"appdata" description:"Directory where the app configuration file and wallet data is stored"`
+	LogDir      string `long:"logdir" description:"Directory to log output"`
+	MaxLogZips  int    `long:"max-log-zips" default:"8" description:"The number of zipped log files created by the log rotator to be retained. Setting to 0 will keep all."`
+	DebugLevel  string `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical, off}"`
+	DEXTestAddr string `long:"dextestaddr" description:"If using the dextest network, set an address for the dex harness to be used as a persistant peer for all new wallets."`
+	PassFD      int    `long:"passfd" default:"-1" description:"File descriptor to read passphrases and seeds from instead of stdin"`
+
+	Wallet  walletCommand  `command:"wallet" description:"Create, restore and list wallets"`
+	Sync    syncCommand    `command:"sync" description:"Synchronize wallets with the network"`
+	Balance balanceCommand `command:"balance" description:"Show the balance of the accounts of a wallet"`
+	Receive receiveCommand `command:"receive" description:"Show an address to receive funds to"`
+	Send    sendCommand    `command:"send" description:"Send funds to an address"`
+	Txs     txsCommand     `command:"txs" description:"List the transactions of a wallet"`
+	Tickets ticketsCommand `command:"buytickets" description:"Purchase DCR tickets through a VSP"`
+	Mixer   mixerCommand   `command:"mixer" description:"Start or stop the DCR account mixer"`
+	Swap    swapCommand    `command:"swap" description:"Create and list instant swap orders"`
+}
+
+// cfg holds the global options, it is set before the commands are executed.
+var cfg = options{
+	HomeDir: dcrutil.AppDataDir("cryptopower", false),
+}
+
+func main() {
+	// The errors are printed as JSON instead of by the parser.
+	parser := flags.NewParser(&cfg, flags.HelpFlag|flags.PassDoubleDash)
+	if _, err := parser.Parse(); err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			fmt.Fprintln(os.Stdout, e.Message)
+			os.Exit(0)
+		}
+		_ = printJSON(errorResult{Error: err.Error()})
+		os.Exit(1)
+	}
+}
+
+// errorResult is printed in place of the result of a command that failed.
+type errorResult struct {
+	Error string `json:"error"`
+}
+
+// loadAssetsManager creates the assets manager of the configured network and
+// opens its wallets. The startup passphrase is read if it is set.
+func loadAssetsManager() (*libwallet.AssetsManager, error) {
+	netType := libutils.ToNetworkType(cfg.Network)
+	if netType == libutils.Unknown {
+		return nil, fmt.Errorf("unknown network %q", cfg.Network)
+	}
+
+	logDir := cfg.LogDir
+	if logDir == "" {
+		logDir = filepath.Join(cfg.HomeDir, defaultLogDirname)
+	}
+	logDir = filepath.Join(logDir, string(netType))
+	if err := initLogRotator(logDir, cfg.MaxLogZips); err != nil {
+		return nil, err
+	}
+	if cfg.DebugLevel == "" {
+		_ = logger.SetLogLevels(libutils.DefaultLogLevel)
+	} else {
+		_ = logger.SetLogLevels(cfg.DebugLevel)
+	}
+
+	mgr, err := libwallet.NewAssetsManager(cfg.HomeDir, logDir, netType, cfg.DEXTestAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var startupPassphrase string
+	if mgr.IsStartupSecuritySet() {
+		startupPassphrase, err = readSecret("startup passphrase")
+		if err != nil {
+			mgr.Shutdown()
+			return nil, err
+		}
+	}
+
+	if err := mgr.OpenWallets(startupPassphrase); err != nil {
+		mgr.Shutdown()
+		return nil, err
+	}
+
+	return mgr, nil
+}
+
+// run loads the assets manager, runs fn with it and prints the result as
+// JSON. The assets manager is shut down before run returns.
+func run(fn func(mgr *libwallet.AssetsManager) (interface{}, error)) error {
+	mgr, err := loadAssetsManager()
+	if err != nil {
+		return err
+	}
+	defer mgr.Shutdown()
+
+	result, err := fn(mgr)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+// printJSON writes v to the standard output as indented JSON.
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// secrets reads the passphrases and seeds, one per line. It is opened on the
+// first read.
+var secrets *bufio.Reader
+
+// readSecret reads the next line of the secrets input. name describes the
+// secret in errors.
+func readSecret(name string) (string, error) {
+	if secrets == nil {
+		input := os.Stdin
+		if cfg.PassFD >= 0 {
+			input = os.NewFile(uintptr(cfg.PassFD), "passfd")
+			if input == nil {
+				return "", fmt.Errorf("invalid passphrase file descriptor %d", cfg.PassFD)
+			}
+		}
+		secrets = bufio.NewReader(input)
+	}
+
+	line, err := secrets.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", fmt.Errorf("unable to read the %s: %v", name, err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestReadSecret(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	// The startup passphrase, the seed and the spending passphrase, the last
+	// line has no line break.
+	if _, err := w.WriteString("startup\r\nword1 word2 word3\n\nspending"); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	defer func(passFD int) {
+		cfg.PassFD = passFD
+		secrets = nil
+	}(cfg.PassFD)
+	cfg.PassFD = int(r.Fd())
+	secrets = nil
+
+	for _, want := range []string{"startup", "word1 word2 word3", "", "spending"} {
+		got, err := readSecret("secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	}
+
+	if _, err := readSecret("secret"); err == nil {
+		t.Fatal("expected an error once the secrets are read")
+	}
+}
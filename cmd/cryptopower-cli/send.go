@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+type sendCommand struct {
+	walletOption
+	Account int32   `long:"account" description:"Number of the account to send from"`
+	Address string  `long:"address" required:"true" description:"Address to send to"`
+	Amount  float64 `long:"amount" description:"Amount to send, in coins"`
+	SendMax bool    `long:"sendmax" description:"Send the whole spendable balance of the account"`
+	Label   string  `long:"label" description:"Label of the transaction"`
+}
+
+func (c *sendCommand) Execute(_ []string) error {
+	if c.Amount <= 0 && !c.SendMax {
+		return errors.New("either a positive --amount or --sendmax is required")
+	}
+
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+		if !wallet.IsAddressValid(c.Address) {
+			return nil, fmt.Errorf("invalid %s address %s", wallet.GetAssetType(), c.Address)
+		}
+
+		passphrase, err := readSecret("spending passphrase")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := syncWallet(ctx, wallet); err != nil {
+			return nil, err
+		}
+
+		if err := wallet.NewUnsignedTx(c.Account, nil); err != nil {
+			return nil, err
+		}
+		amount := toAtoms(wallet.GetAssetType(), c.Amount)
+		if err := wallet.AddSendDestination(0, c.Address, amount, c.SendMax); err != nil {
+			return nil, err
+		}
+		feeAndSize, err := wallet.EstimateFeeAndSize()
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := wallet.Broadcast(passphrase, c.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			Hash    string `json:"hash"`
+			Fee     int64  `json:"fee"`
+			FeeRate int64  `json:"fee_rate"`
+			Size    int    `json:"size"`
+		}{txHash, feeAndSize.Fee.UnitValue, feeAndSize.FeeRate, feeAndSize.EstimatedSignedSize}, nil
+	})
+}
+
+// txFilters maps the names accepted by the txs command to the tx filters.
+var txFilters = map[string]int32{
+	"all":         libutils.TxFilterAll,
+	"sent":        libutils.TxFilterSent,
+	"received":    libutils.TxFilterReceived,
+	"transferred": libutils.TxFilterTransferred,
+	"staking":     libutils.TxFilterStaking,
+	"coinbase":    libutils.TxFilterCoinBase,
+	"regular":     libutils.TxFilterRegular,
+	"mixed":       libutils.TxFilterMixed,
+	"voted":       libutils.TxFilterVoted,
+	"revoked":     libutils.TxFilterRevoked,
+	"immature":    libutils.TxFilterImmature,
+	"live":        libutils.TxFilterLive,
+	"unmined":     libutils.TxFilterUnmined,
+	"expired":     libutils.TxFilterExpired,
+	"tickets":     libutils.TxFilterTickets,
+}
+
+type txsCommand struct {
+	walletOption
+	Filter string `long:"filter" default:"all" description:"Transactions to list (all, sent, received, transferred, staking, coinbase, regular, mixed, voted, revoked, immature, live, unmined, expired, tickets)"`
+	Offset int32  `long:"offset" description:"Number of transactions to skip"`
+	Limit  int32  `long:"limit" default:"50" description:"Maximum number of transactions to list"`
+	Oldest bool   `long:"oldest" description:"List the oldest transactions first"`
+	Hash   string `long:"hash" description:"Only list the transactions whose hash contains the value"`
+}
+
+func (c *txsCommand) Execute(_ []string) error {
+	txFilter, ok := txFilters[strings.ToLower(c.Filter)]
+	if !ok {
+		return fmt.Errorf("unknown transaction filter %q", c.Filter)
+	}
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+
+		txs, err := wallet.GetTransactionsRaw(c.Offset, c.Limit, txFilter, !c.Oldest, c.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if txs == nil {
+			txs = []*sharedW.Transaction{}
+		}
+		return txs, nil
+	})
+}
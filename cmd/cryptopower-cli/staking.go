@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+type ticketsCommand struct {
+	walletOption
+	Account int32  `long:"account" description:"Number of the account to purchase the tickets with"`
+	Count   int32  `long:"count" default:"1" description:"Number of tickets to purchase"`
+	VSP     string `long:"vsp" description:"Host of the VSP, e.g. https://vsp.example.com. Defaults to the last used VSP."`
+}
+
+func (c *ticketsCommand) Execute(_ []string) error {
+	if c.Count < 1 {
+		return errors.New("at least one ticket must be purchased")
+	}
+
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.dcrWallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+
+		host := strings.TrimSuffix(c.VSP, "/")
+		if host == "" {
+			host = wallet.LastUsedVSP()
+		}
+		if host == "" {
+			return nil, errors.New("no VSP was used before, --vsp is required")
+		}
+		vsp, err := findVSP(ctx, wallet, host)
+		if err != nil {
+			return nil, err
+		}
+
+		passphrase, err := readSecret("spending passphrase")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := syncWallet(ctx, wallet); err != nil {
+			return nil, err
+		}
+
+		ticketPrice, err := wallet.TicketPrice()
+		if err != nil {
+			return nil, err
+		}
+
+		hashes, err := wallet.PurchaseTickets(c.Account, c.Count, vsp.Host, passphrase, vsp.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		wallet.SaveLastUsedVSP(vsp.Host)
+
+		tickets := make([]string, 0, len(hashes))
+		for _, hash := range hashes {
+			tickets = append(tickets, hash.String())
+		}
+		return struct {
+			VSP         string   `json:"vsp"`
+			TicketPrice int64    `json:"ticket_price"`
+			Tickets     []string `json:"tickets"`
+		}{vsp.Host, ticketPrice.TicketPrice, tickets}, nil
+	})
+}
+
+// findVSP returns the known VSP with the host. The VSP is saved if it is not
+// known yet.
+func findVSP(ctx context.Context, wallet *dcr.Asset, host string) (*dcr.VSP, error) {
+	known := func() *dcr.VSP {
+		for _, vsp := range wallet.KnownVSPs() {
+			if vsp.Host == host {
+				return vsp
+			}
+		}
+		return nil
+	}
+
+	wallet.ReloadVSPList(ctx)
+	if vsp := known(); vsp != nil {
+		return vsp, nil
+	}
+
+	if err := wallet.SaveVSP(host); err != nil {
+		return nil, fmt.Errorf("unable to add VSP %s: %v", host, err)
+	}
+	if vsp := known(); vsp != nil {
+		return vsp, nil
+	}
+	return nil, fmt.Errorf("VSP %s not found", host)
+}
+
+type mixerCommand struct {
+	Start mixerStartCommand `command:"start" description:"Start the account mixer and keep it running until the process is interrupted or mixer stop is run, the spending passphrase is read from the secrets input"`
+	Stop  mixerStopCommand  `command:"stop" description:"Stop the account mixer started by mixer start"`
+}
+
+// mixerStopCheckInterval is how often the process running the mixer checks
+// if mixer stop was run.
+const mixerStopCheckInterval = time.Second
+
+// mixerPIDFile returns the file the process running the mixer of the wallet
+// writes its pid to. The mixer is stopped once the file is removed, which
+// unlike signals works on every platform.
+func mixerPIDFile(walletID int) string {
+	netType := libutils.ToNetworkType(cfg.Network)
+	return filepath.Join(cfg.HomeDir, fmt.Sprintf("mixer-%s-%d.pid", netType, walletID))
+}
+
+type mixerStartCommand struct {
+	walletOption
+}
+
+func (c *mixerStartCommand) Execute(_ []string) error {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallet, err := c.dcrWallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+		if !wallet.AccountMixerConfigIsSet() {
+			return nil, errors.New("the mixed and unmixed accounts of the wallet are not set up")
+		}
+
+		passphrase, err := readSecret("spending passphrase")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := syncWallet(ctx, wallet); err != nil {
+			return nil, err
+		}
+
+		pidFile := mixerPIDFile(c.WalletID)
+		if err := os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), libutils.UserFilePerm); err != nil {
+			return nil, err
+		}
+		defer os.Remove(pidFile)
+
+		if err := wallet.StartAccountMixer(passphrase); err != nil {
+			return nil, err
+		}
+		startedAt := time.Now()
+		log.Infof("Account mixer of wallet %s started", wallet.GetWalletName())
+
+		waitMixerStop(ctx, pidFile)
+		if err := wallet.StopAccountMixer(); err != nil {
+			return nil, err
+		}
+
+		return struct {
+			Wallet   int    `json:"wallet"`
+			Duration string `json:"duration"`
+		}{c.WalletID, time.Since(startedAt).Round(time.Second).String()}, nil
+	})
+}
+
+// waitMixerStop returns once ctx is done or pidFile is removed by mixer stop.
+func waitMixerStop(ctx context.Context, pidFile string) {
+	ticker := time.NewTicker(mixerStopCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := os.Stat(pidFile); os.IsNotExist(err) {
+				return
+			}
+		}
+	}
+}
+
+type mixerStopCommand struct {
+	walletOption
+}
+
+func (c *mixerStopCommand) Execute(_ []string) error {
+	pidFile := mixerPIDFile(c.WalletID)
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("the account mixer of wallet %d is not running", c.WalletID)
+		}
+		return err
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("invalid pid file %s: %v", pidFile, err)
+	}
+	if err := os.Remove(pidFile); err != nil {
+		return fmt.Errorf("unable to stop the account mixer process %d: %v", pid, err)
+	}
+
+	return printJSON(struct {
+		Wallet int `json:"wallet"`
+		PID    int `json:"pid"`
+	}{c.WalletID, pid})
+}
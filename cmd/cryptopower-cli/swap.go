@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	api "github.com/crypto-power/instantswap/instantswap"
+)
+
+type swapCommand struct {
+	Servers swapServersCommand `command:"servers" description:"List the exchange servers"`
+	Create  swapCreateCommand  `command:"create" description:"Create an instant swap order between two wallets"`
+	List    swapListCommand    `command:"list" description:"List the instant swap orders"`
+	Info    swapInfoCommand    `command:"info" description:"Update an order from its exchange server and show it"`
+}
+
+// exchangeServer returns the exchange server with the name.
+func exchangeServer(mgr *libwallet.AssetsManager, name string) (instantswap.ExchangeServer, error) {
+	var names []string
+	for _, server := range mgr.InstantSwap.ExchangeServers() {
+		if strings.EqualFold(string(server.Server), name) {
+			return server, nil
+		}
+		names = append(names, string(server.Server))
+	}
+	sort.Strings(names)
+	return instantswap.ExchangeServer{}, fmt.Errorf("unknown exchange server %q, use one of %s", name, strings.Join(names, ", "))
+}
+
+type swapServersCommand struct{}
+
+func (c *swapServersCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		var servers []string
+		for _, server := range mgr.InstantSwap.ExchangeServers() {
+			servers = append(servers, string(server.Server))
+		}
+		sort.Strings(servers)
+		return servers, nil
+	})
+}
+
+type swapCreateCommand struct {
+	Server      string  `long:"server" required:"true" description:"Exchange server of the order, as listed by swap servers"`
+	FromWallet  int     `long:"fromwallet" required:"true" description:"ID of the wallet to swap from"`
+	FromAccount int32   `long:"fromaccount" description:"Number of the account to swap from, refunds are sent to it"`
+	ToWallet    int     `long:"towallet" required:"true" description:"ID of the wallet to swap to"`
+	ToAccount   int32   `long:"toaccount" description:"Number of the account to receive the swapped funds in"`
+	Amount      float64 `long:"amount" required:"true" description:"Amount to swap, in coins of the source wallet"`
+	FromNetwork string  `long:"fromnetwork" description:"Network of the source currency on the exchange server, if it has several"`
+	ToNetwork   string  `long:"tonetwork" description:"Network of the destination currency on the exchange server, if it has several"`
+	Fund        bool    `long:"fund" description:"Send the amount to the deposit address of the order, the spending passphrase of the source wallet is read from the secrets input"`
+	FeeRate     int64   `long:"feerate" description:"Fee rate of the BTC and LTC deposit in atoms/kvB, the wallet fee rate is used if it is not set"`
+}
+
+func (c *swapCreateCommand) Execute(_ []string) error {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		server, err := exchangeServer(mgr, c.Server)
+		if err != nil {
+			return nil, err
+		}
+		sourceWallet, err := walletOption{c.FromWallet}.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+		destinationWallet, err := walletOption{c.ToWallet}.wallet(mgr)
+		if err != nil {
+			return nil, err
+		}
+
+		var passphrase string
+		if c.Fund {
+			passphrase, err = readSecret("spending passphrase")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		exchangeObject, err := mgr.InstantSwap.NewExchangeServer(server)
+		if err != nil {
+			return nil, err
+		}
+
+		params := api.ExchangeRateRequest{
+			From:        sourceWallet.GetAssetType().String(),
+			FromNetwork: c.FromNetwork,
+			To:          destinationWallet.GetAssetType().String(),
+			ToNetwork:   c.ToNetwork,
+			Amount:      c.Amount,
+		}
+		rateInfo, err := mgr.InstantSwap.GetExchangeRateInfo(exchangeObject, params)
+		if err != nil {
+			return nil, err
+		}
+		if c.Amount < rateInfo.Min || (rateInfo.Max > 0 && c.Amount > rateInfo.Max) {
+			return nil, fmt.Errorf("amount %f %s is outside the exchange server limits of %f to %f", c.Amount, params.From, rateInfo.Min, rateInfo.Max)
+		}
+
+		refundAddress, err := sourceWallet.CurrentAddress(c.FromAccount)
+		if err != nil {
+			return nil, err
+		}
+		destinationAddress, err := destinationWallet.CurrentAddress(c.ToAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		order, err := mgr.InstantSwap.CreateOrder(exchangeObject, instantswap.Order{
+			ExchangeServer:           server,
+			SourceWalletID:           c.FromWallet,
+			SourceAccountNumber:      c.FromAccount,
+			DestinationWalletID:      c.ToWallet,
+			DestinationAccountNumber: c.ToAccount,
+
+			InvoicedAmount: c.Amount,
+			FromCurrency:   params.From,
+			ToCurrency:     params.To,
+			FromNetwork:    params.FromNetwork,
+			ToNetwork:      params.ToNetwork,
+			Provider:       rateInfo.Provider,
+			Signature:      rateInfo.Signature,
+
+			RefundAddress:      refundAddress,
+			DestinationAddress: destinationAddress,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Fund {
+			if err := syncWallet(ctx, sourceWallet); err != nil {
+				return nil, err
+			}
+			if _, err := mgr.FundInstantSwapOrder(order, passphrase, c.FeeRate); err != nil {
+				return nil, fmt.Errorf("order %s was created but not funded: %v", order.UUID, err)
+			}
+		}
+
+		return order, nil
+	})
+}
+
+type swapListCommand struct {
+	Server string `long:"server" description:"Only list the orders of the exchange server"`
+	TxID   string `long:"txid" description:"Only list the order funded by the transaction"`
+	Offset int32  `long:"offset" description:"Number of orders to skip"`
+	Limit  int32  `long:"limit" default:"50" description:"Maximum number of orders to list"`
+	Oldest bool   `long:"oldest" description:"List the oldest orders first"`
+}
+
+func (c *swapListCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		orders, err := mgr.InstantSwap.GetOrdersRaw(c.Offset, c.Limit, !c.Oldest, c.Server, c.TxID)
+		if err != nil {
+			return nil, err
+		}
+		if orders == nil {
+			orders = []*instantswap.Order{}
+		}
+		return orders, nil
+	})
+}
+
+type swapInfoCommand struct {
+	UUID string `long:"uuid" required:"true" description:"UUID of the order"`
+}
+
+func (c *swapInfoCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		order, err := mgr.InstantSwap.GetOrderByUUIDRaw(c.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		exchangeObject, err := mgr.InstantSwap.NewExchangeServer(order.ExchangeServer)
+		if err != nil {
+			return nil, err
+		}
+		return mgr.InstantSwap.GetOrderInfo(exchangeObject, c.UUID)
+	})
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+)
+
+const syncListenerID = "cryptopower-cli"
+
+// interruptContext returns a context that is canceled when the process is
+// interrupted or terminated.
+func interruptContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// syncWallet synchronizes the wallet with the network and returns once it is
+// synced. The sync is canceled if ctx is done first.
+func syncWallet(ctx context.Context, wallet sharedW.Asset) error {
+	if wallet.IsSynced() {
+		return nil
+	}
+
+	done := make(chan error, 1)
+	finish := func(err error) {
+		select {
+		case done <- err:
+		default:
+		}
+	}
+	listener := &sharedW.SyncProgressListener{
+		OnSyncCompleted: func() { finish(nil) },
+		OnSyncCanceled: func(willRestart bool) {
+			if !willRestart {
+				finish(errors.New("sync canceled"))
+			}
+		},
+		OnSyncEndedWithError: finish,
+	}
+	if err := wallet.AddSyncProgressListener(listener, syncListenerID); err != nil {
+		return err
+	}
+	defer wallet.RemoveSyncProgressListener(syncListenerID)
+
+	if !wallet.IsSyncing() {
+		log.Infof("Syncing wallet %s", wallet.GetWalletName())
+		if err := wallet.SpvSync(); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		wallet.CancelSync()
+		return ctx.Err()
+	}
+}
+
+type syncCommand struct {
+	WalletID int `long:"wallet" description:"ID of the wallet to sync, all wallets are synced if it is not set"`
+}
+
+func (c *syncCommand) Execute(_ []string) error {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallets := mgr.AllWallets()
+		if c.WalletID != 0 {
+			wallet, err := walletOption{c.WalletID}.wallet(mgr)
+			if err != nil {
+				return nil, err
+			}
+			wallets = []sharedW.Asset{wallet}
+		}
+
+		errCh := make(chan error, len(wallets))
+		for _, wallet := range wallets {
+			go func(wallet sharedW.Asset) {
+				errCh <- syncWallet(ctx, wallet)
+			}(wallet)
+		}
+		for range wallets {
+			if err := <-errCh; err != nil {
+				return nil, err
+			}
+		}
+
+		infos := make([]*walletInfo, 0, len(wallets))
+		for _, wallet := range wallets {
+			infos = append(infos, newWalletInfo(wallet))
+		}
+		return infos, nil
+	})
+}
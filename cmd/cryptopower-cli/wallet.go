@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+type walletCommand struct {
+	Create  walletCreateCommand  `command:"create" description:"Create a new wallet, the spending passphrase is read from the secrets input"`
+	Restore walletRestoreCommand `command:"restore" description:"Restore a wallet from its seed, the seed and the spending passphrase are read from the secrets input"`
+	List    walletListCommand    `command:"list" description:"List the wallets"`
+}
+
+// walletInfo is the JSON description of a wallet.
+type walletInfo struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Asset        string `json:"asset"`
+	WatchingOnly bool   `json:"watching_only"`
+	Synced       bool   `json:"synced"`
+	BestBlock    int32  `json:"best_block"`
+	// Seed is only set when a wallet is created with --showseed.
+	Seed string `json:"seed,omitempty"`
+}
+
+func newWalletInfo(wallet sharedW.Asset) *walletInfo {
+	return &walletInfo{
+		ID:           wallet.GetWalletID(),
+		Name:         wallet.GetWalletName(),
+		Asset:        wallet.GetAssetType().String(),
+		WatchingOnly: wallet.IsWatchingOnlyWallet(),
+		Synced:       wallet.IsSynced(),
+		BestBlock:    wallet.GetBestBlockHeight(),
+	}
+}
+
+// walletOption selects the wallet a command applies to.
+type walletOption struct {
+	WalletID int `long:"wallet" required:"true" description:"ID of the wallet, as listed by the wallet list command"`
+}
+
+func (opt walletOption) wallet(mgr *libwallet.AssetsManager) (sharedW.Asset, error) {
+	wallet := mgr.WalletWithID(opt.WalletID)
+	if wallet == nil {
+		return nil, fmt.Errorf("wallet with id %d not found", opt.WalletID)
+	}
+	return wallet, nil
+}
+
+// dcrWallet returns the DCR wallet selected by the option.
+func (opt walletOption) dcrWallet(mgr *libwallet.AssetsManager) (*dcr.Asset, error) {
+	wallet, err := opt.wallet(mgr)
+	if err != nil {
+		return nil, err
+	}
+	dcrAsset, ok := wallet.(*dcr.Asset)
+	if !ok {
+		return nil, fmt.Errorf("wallet %d is not a DCR wallet", opt.WalletID)
+	}
+	return dcrAsset, nil
+}
+
+// toAssetType maps the asset names accepted by the commands to asset types.
+func toAssetType(asset string) (libutils.AssetType, error) {
+	switch strings.ToUpper(asset) {
+	case libutils.DCRWalletAsset.String():
+		return libutils.DCRWalletAsset, nil
+	case libutils.BTCWalletAsset.String():
+		return libutils.BTCWalletAsset, nil
+	case libutils.LTCWalletAsset.String():
+		return libutils.LTCWalletAsset, nil
+	}
+	return libutils.NilAsset, fmt.Errorf("unknown asset %q", asset)
+}
+
+// toWordSeedType validates the number of words of a seed.
+func toWordSeedType(words int) (sharedW.WordSeedType, error) {
+	switch seedType := sharedW.WordSeedType(words); seedType {
+	case sharedW.WordSeed12, sharedW.WordSeed24, sharedW.WordSeed33:
+		return seedType, nil
+	}
+	return sharedW.NoneWordSeed, fmt.Errorf("invalid number of seed words %d", words)
+}
+
+type walletCreateCommand struct {
+	Asset    string `long:"asset" required:"true" description:"Asset of the wallet (dcr, btc, ltc)"`
+	Name     string `long:"name" required:"true" description:"Name of the wallet"`
+	Words    int    `long:"words" default:"33" description:"Number of words of the seed (12, 24 or 33)"`
+	ShowSeed bool   `long:"showseed" description:"Include the seed of the new wallet in the output"`
+}
+
+func (c *walletCreateCommand) Execute(_ []string) error {
+	assetType, err := toAssetType(c.Asset)
+	if err != nil {
+		return err
+	}
+	wordSeedType, err := toWordSeedType(c.Words)
+	if err != nil {
+		return err
+	}
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		passphrase, err := readSecret("spending passphrase")
+		if err != nil {
+			return nil, err
+		}
+
+		var wallet sharedW.Asset
+		switch assetType {
+		case libutils.DCRWalletAsset:
+			wallet, err = mgr.CreateNewDCRWallet(c.Name, passphrase, sharedW.PassphraseTypePass, wordSeedType)
+		case libutils.BTCWalletAsset:
+			wallet, err = mgr.CreateNewBTCWallet(c.Name, passphrase, sharedW.PassphraseTypePass, wordSeedType)
+		case libutils.LTCWalletAsset:
+			wallet, err = mgr.CreateNewLTCWallet(c.Name, passphrase, sharedW.PassphraseTypePass, wordSeedType)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		info := newWalletInfo(wallet)
+		if c.ShowSeed {
+			info.Seed, err = wallet.DecryptSeed(passphrase)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return info, nil
+	})
+}
+
+type walletRestoreCommand struct {
+	Asset string `long:"asset" required:"true" description:"Asset of the wallet (dcr, btc, ltc)"`
+	Name  string `long:"name" required:"true" description:"Name of the wallet"`
+}
+
+func (c *walletRestoreCommand) Execute(_ []string) error {
+	assetType, err := toAssetType(c.Asset)
+	if err != nil {
+		return err
+	}
+
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		seed, err := readSecret("seed")
+		if err != nil {
+			return nil, err
+		}
+		passphrase, err := readSecret("spending passphrase")
+		if err != nil {
+			return nil, err
+		}
+
+		wordSeedType := sharedW.SeedTypeFromMnemonic(seed, assetType)
+		wallet, err := mgr.RestoreWallet(assetType, c.Name, seed, passphrase, sharedW.PassphraseTypePass, wordSeedType)
+		if err != nil {
+			return nil, err
+		}
+		return newWalletInfo(wallet), nil
+	})
+}
+
+type walletListCommand struct{}
+
+func (c *walletListCommand) Execute(_ []string) error {
+	return run(func(mgr *libwallet.AssetsManager) (interface{}, error) {
+		wallets := make([]*walletInfo, 0, mgr.LoadedWalletsCount())
+		for _, wallet := range mgr.AllWallets() {
+			wallets = append(wallets, newWalletInfo(wallet))
+		}
+		return wallets, nil
+	})
+}
@@ -35,6 +35,7 @@ type config struct {
 	Quiet            bool   `short:"q" long:"quiet" description:"Easy way to set debuglevel to error"`
 	SpendUnconfirmed bool   `long:"spendunconfirmed" description:"Allow the assetsManager to use transactions that have not been confirmed"`
 	Profile          int    `long:"profile" description:"Runs local web server for profiling"`
+	AuditSemantics   bool   `long:"auditsemantics" description:"Log the controls of each page that screen readers can't describe"`
 	DEXTestAddr      string `long:"dextestaddr" description:"If using the dextest network, set an address for the dex harness to be used as a persistant peer for all new wallets."`
 
 	net libutils.NetworkType
@@ -0,0 +1,103 @@
+package dexc
+
+import (
+	"sync"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex/candles"
+)
+
+// MarketCandles holds the candlestick data of a DEX market at each of the
+// bin sizes served by the DEX server. The candle caches are fetched from the
+// server and kept up to date with the candle updates received on the
+// market's book feed.
+type MarketCandles struct {
+	host     string
+	marketID string
+	binSizes []string
+
+	mtx    sync.RWMutex
+	caches map[string]*candles.Cache
+}
+
+// NewMarketCandles returns a *MarketCandles for the market on the DEX host.
+// binSizes are the candle durations served by the host, e.g. "5m", "1h".
+func NewMarketCandles(host, marketID string, binSizes []string) *MarketCandles {
+	return &MarketCandles{
+		host:     host,
+		marketID: marketID,
+		binSizes: binSizes,
+		caches:   make(map[string]*candles.Cache, len(binSizes)),
+	}
+}
+
+// BinSizes returns the candle durations of the market.
+func (mc *MarketCandles) BinSizes() []string {
+	return mc.binSizes
+}
+
+// Subscribe requests the candle caches of every bin size from the DEX server.
+// The candles are sent over the feed and must be passed to HandleBookUpdate.
+// Subscribe blocks until the server responds to all requests.
+func (mc *MarketCandles) Subscribe(feed core.BookFeed) error {
+	for _, binSize := range mc.binSizes {
+		if err := feed.Candles(binSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleBookUpdate updates the candle caches with the update received on the
+// market's book feed. It returns true if the update changed the candles.
+func (mc *MarketCandles) HandleBookUpdate(update *core.BookUpdate) bool {
+	if update.Host != mc.host || update.MarketID != mc.marketID {
+		return false
+	}
+
+	mc.mtx.Lock()
+	defer mc.mtx.Unlock()
+
+	switch update.Action {
+	case core.FreshCandlesAction:
+		payload, ok := update.Payload.(*core.CandlesPayload)
+		if !ok {
+			return false
+		}
+		cache := candles.NewCache(candles.CacheSize, payload.DurMilliSecs)
+		for i := range payload.Candles {
+			cache.Add(&payload.Candles[i])
+		}
+		mc.caches[payload.Dur] = cache
+		return true
+
+	case core.CandleUpdateAction:
+		payload, ok := update.Payload.(core.CandleUpdate)
+		if !ok || payload.Candle == nil {
+			return false
+		}
+		// Updates received before the cache is fetched are ignored, the
+		// fetched cache includes them.
+		cache, ok := mc.caches[payload.Dur]
+		if !ok {
+			return false
+		}
+		cache.Add(payload.Candle)
+		return true
+	}
+
+	return false
+}
+
+// Candles returns a copy of the candles of the bin size, oldest first. Nil is
+// returned if the candles of the bin size have not been fetched.
+func (mc *MarketCandles) Candles(binSize string) []candles.Candle {
+	mc.mtx.RLock()
+	defer mc.mtx.RUnlock()
+
+	cache, ok := mc.caches[binSize]
+	if !ok {
+		return nil
+	}
+	return cache.CandlesCopy()
+}
@@ -12,6 +12,7 @@ import (
 	"decred.org/dcrdex/client/asset"
 	"decred.org/dcrdex/client/core"
 	"decred.org/dcrdex/dex"
+	"decred.org/dcrdex/dex/order"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/values/localizable"
 )
@@ -264,6 +265,20 @@ func parseDEXNet(net libutils.NetworkType) (dex.Network, error) {
 	}
 }
 
+// SwapLockTime returns the duration from the swap of a match to the time it
+// can be refunded, for the maker or the taker side of the match on the
+// network.
+func SwapLockTime(net libutils.NetworkType, side order.MatchSide) (time.Duration, error) {
+	dexNet, err := parseDEXNet(net)
+	if err != nil {
+		return 0, err
+	}
+	if side == order.Maker {
+		return dex.LockTimeMaker(dexNet), nil
+	}
+	return dex.LockTimeTaker(dexNet), nil
+}
+
 // validDEXLang checks that the provided lang is supported by the DEX client. An
 // empty string is returned for an invalid lang to allow DEX core use it's
 // default language.
@@ -0,0 +1,77 @@
+package activity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"decred.org/dcrwallet/v4/errors"
+)
+
+// Cursor is the position of an item in the feed. Items are ordered by
+// timestamp and then by ID, so the position of an item doesn't change when
+// newer items are added.
+type Cursor struct {
+	Timestamp int64
+	ID        string
+}
+
+// String encodes the cursor for Page.Next.
+func (c *Cursor) String() string {
+	return fmt.Sprintf("%d:%s", c.Timestamp, c.ID)
+}
+
+// ParseCursor decodes a cursor returned by Cursor.String. An empty string
+// returns a nil cursor, which is the position before the newest item.
+func ParseCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	timestamp, id, ok := strings.Cut(s, ":")
+	if !ok || id == "" {
+		return nil, errors.New(ErrInvalidCursor)
+	}
+	t, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, errors.New(ErrInvalidCursor)
+	}
+	return &Cursor{Timestamp: t, ID: id}, nil
+}
+
+// After is true if the item comes after the cursor in the feed, that is if
+// it is older. Every item comes after a nil cursor.
+func (c *Cursor) After(item *Item) bool {
+	if c == nil {
+		return true
+	}
+	if item.Timestamp != c.Timestamp {
+		return item.Timestamp < c.Timestamp
+	}
+	return item.ID < c.ID
+}
+
+// NewPage returns the first limit items of the feed after the cursor. The
+// items may come in any order and include items before the cursor.
+func NewPage(items []*Item, cursor *Cursor, limit int) *Page {
+	page := &Page{Items: make([]*Item, 0, limit)}
+	for _, item := range items {
+		if cursor.After(item) {
+			page.Items = append(page.Items, item)
+		}
+	}
+
+	sort.Slice(page.Items, func(i, j int) bool {
+		if page.Items[i].Timestamp != page.Items[j].Timestamp {
+			return page.Items[i].Timestamp > page.Items[j].Timestamp
+		}
+		return page.Items[i].ID > page.Items[j].ID
+	})
+
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		page.Next = (&Cursor{Timestamp: last.Timestamp, ID: last.ID}).String()
+	}
+	return page
+}
@@ -0,0 +1,58 @@
+package activity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewPage(t *testing.T) {
+	// Items 3 and 4 have the same timestamp and are ordered by ID.
+	items := []*Item{
+		{ID: "1", Timestamp: 10},
+		{ID: "4", Timestamp: 30},
+		{ID: "2", Timestamp: 20},
+		{ID: "3", Timestamp: 30},
+		{ID: "5", Timestamp: 40},
+	}
+	ids := func(page *Page) []string {
+		ids := make([]string, 0, len(page.Items))
+		for _, item := range page.Items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		cursor   string
+		limit    int
+		wantIDs  []string
+		wantNext string
+	}{
+		{name: "first page", limit: 2, wantIDs: []string{"5", "4"}, wantNext: "30:4"},
+		{name: "page after an item of the same timestamp", cursor: "30:4", limit: 2, wantIDs: []string{"3", "2"}, wantNext: "20:2"},
+		{name: "last page", cursor: "20:2", limit: 2, wantIDs: []string{"1"}},
+		{name: "page of the limit size", cursor: "30:3", limit: 2, wantIDs: []string{"2", "1"}},
+		{name: "cursor of a removed item", cursor: "35:x", limit: 5, wantIDs: []string{"4", "3", "2", "1"}},
+		{name: "all the items", limit: 10, wantIDs: []string{"5", "4", "3", "2", "1"}},
+	}
+	for _, test := range tests {
+		cursor, err := ParseCursor(test.cursor)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		page := NewPage(items, cursor, test.limit)
+		if got := ids(page); !reflect.DeepEqual(got, test.wantIDs) {
+			t.Errorf("%s: expected items %v, got %v", test.name, test.wantIDs, got)
+		}
+		if page.Next != test.wantNext {
+			t.Errorf("%s: expected next cursor %q, got %q", test.name, test.wantNext, page.Next)
+		}
+	}
+
+	for _, cursor := range []string{"30", "30:", "x:4"} {
+		if _, err := ParseCursor(cursor); err == nil {
+			t.Errorf("expected cursor %q to be invalid", cursor)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package activity
+
+const (
+	ErrListenerAlreadyExist = "listener_already_exist"
+	ErrInvalidCursor        = "invalid_cursor"
+)
@@ -0,0 +1,61 @@
+package activity
+
+import (
+	"decred.org/dcrdex/client/core"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Kind is the kind of an activity item.
+type Kind string
+
+const (
+	// Transaction items are regular and coinbase wallet transactions.
+	Transaction Kind = "transaction"
+	// Ticket items are DCR ticket purchases, votes and revocations.
+	Ticket Kind = "ticket"
+	// Mixer items are the DCR transactions created by the account mixer
+	// runs.
+	Mixer Kind = "mixer"
+	// InstantSwap items are instant swap orders.
+	InstantSwap Kind = "instantswap"
+	// DEXMatch items are the matches of DEX orders.
+	DEXMatch Kind = "dexmatch"
+)
+
+// Item is an activity of one of the wallets.
+type Item struct {
+	// ID identifies the item among the items of all the kinds. It doesn't
+	// change when the item is updated.
+	ID        string
+	Kind      Kind
+	Timestamp int64
+
+	// WalletID, Asset and Tx are set for the Transaction, Ticket and Mixer
+	// items.
+	WalletID int
+	Asset    utils.AssetType
+	Tx       *sharedW.Transaction
+
+	// Order is set for the InstantSwap items.
+	Order *instantswap.Order
+
+	// DEXOrder and Match are set for the DEXMatch items.
+	DEXOrder *core.Order
+	Match    *core.Match
+}
+
+// Page is a page of the activity feed, newest first.
+type Page struct {
+	Items []*Item
+	// Next is the cursor of the following page, it is empty if there are no
+	// older items.
+	Next string
+}
+
+// FeedListener receives the items added to or updated in the feed.
+type FeedListener struct {
+	OnActivity func(item *Item)
+}
@@ -0,0 +1,324 @@
+package libwallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrwallet/v4/errors"
+
+	"github.com/crypto-power/cryptopower/libwallet/activity"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// activityListenerID identifies the listeners the activity feed adds to the
+// wallets and the instant swap orders.
+const activityListenerID = "activity_feed"
+
+// activityFeed tracks the listeners of the activity feed. The feed listens to
+// the wallets, the instant swap orders and the DEX while it has listeners.
+type activityFeed struct {
+	mtx       sync.Mutex
+	listeners map[string]*activity.FeedListener
+	// stopDEX stops listening to the DEX notifications.
+	stopDEX context.CancelFunc
+}
+
+// ActivityFeed returns the page of the activity of all the wallets that
+// follows the cursor, newest first. An empty cursor returns the newest
+// items. The cursor of an item doesn't change when newer items are added, so
+// pages can be fetched while the feed is updated.
+func (mgr *AssetsManager) ActivityFeed(cursor string, limit int) (*activity.Page, error) {
+	const op errors.Op = "mgr.ActivityFeed"
+
+	after, err := activity.ParseCursor(cursor)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var items []*activity.Item
+	for _, wallet := range mgr.AllWallets() {
+		walletItems, err := walletActivity(wallet, after, limit)
+		if err != nil {
+			// Wallets that are not loaded yet are left out.
+			log.Errorf("Error getting %s activity: %v", wallet.GetWalletName(), err)
+			continue
+		}
+		items = append(items, walletItems...)
+	}
+	items = append(items, mgr.instantSwapActivity()...)
+	items = append(items, mgr.dexActivity()...)
+
+	return activity.NewPage(items, after, limit), nil
+}
+
+// walletActivity returns at least the limit transactions of the wallet that
+// follow the cursor in the feed, if there are that many.
+func walletActivity(wallet sharedW.Asset, after *activity.Cursor, limit int) ([]*activity.Item, error) {
+	filters := []int32{utils.TxFilterAll}
+	if wallet.GetAssetType() == utils.DCRWalletAsset {
+		filters = append(filters, utils.TxFilterStaking)
+	}
+
+	var queries []*sharedW.TxQuery
+	for _, filter := range filters {
+		if after == nil {
+			// One more transaction tells if there is a following page.
+			queries = append(queries, &sharedW.TxQuery{TxFilter: filter, Limit: int32(limit + 1), NewestFirst: true})
+			continue
+		}
+
+		// The transactions with the timestamp of the cursor are all read
+		// since the ones before the cursor are skipped by activity.NewPage.
+		queries = append(queries, &sharedW.TxQuery{TxFilter: filter, From: after.Timestamp, To: after.Timestamp})
+		if after.Timestamp > 1 {
+			queries = append(queries, &sharedW.TxQuery{TxFilter: filter, To: after.Timestamp - 1, Limit: int32(limit + 1), NewestFirst: true})
+		}
+	}
+
+	var items []*activity.Item
+	for _, query := range queries {
+		txs, err := wallet.QueryTransactions(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs {
+			items = append(items, txActivity(wallet, tx))
+		}
+	}
+	return items, nil
+}
+
+func txActivity(wallet sharedW.Asset, tx *sharedW.Transaction) *activity.Item {
+	kind := activity.Transaction
+	switch tx.Type {
+	case txhelper.TxTypeTicketPurchase, txhelper.TxTypeVote, txhelper.TxTypeRevocation:
+		kind = activity.Ticket
+	case txhelper.TxTypeMixed:
+		kind = activity.Mixer
+	}
+
+	return &activity.Item{
+		ID:        fmt.Sprintf("tx:%d:%s", wallet.GetWalletID(), tx.Hash),
+		Kind:      kind,
+		Timestamp: tx.Timestamp,
+		WalletID:  wallet.GetWalletID(),
+		Asset:     wallet.GetAssetType(),
+		Tx:        tx,
+	}
+}
+
+// instantSwapActivity returns all the instant swap orders.
+func (mgr *AssetsManager) instantSwapActivity() []*activity.Item {
+	orders, err := mgr.InstantSwap.GetOrdersRaw(0, 0, true, "", "")
+	if err != nil {
+		log.Errorf("Error loading instant swap orders: %v", err)
+		return nil
+	}
+
+	items := make([]*activity.Item, 0, len(orders))
+	for _, order := range orders {
+		items = append(items, swapActivity(order))
+	}
+	return items
+}
+
+func swapActivity(order *instantswap.Order) *activity.Item {
+	return &activity.Item{
+		ID:        "swap:" + order.UUID,
+		Kind:      activity.InstantSwap,
+		Timestamp: order.CreatedAt,
+		Order:     order,
+	}
+}
+
+// dexActivity returns the matches of the last dexOrdersLimit DEX orders.
+func (mgr *AssetsManager) dexActivity() []*activity.Item {
+	dexClient := mgr.DexClient()
+	if dexClient == nil || !dexClient.IsInitialized() {
+		return nil
+	}
+
+	orders, err := dexClient.Orders(&core.OrderFilter{N: dexOrdersLimit})
+	if err != nil {
+		log.Errorf("Error loading DEX orders: %v", err)
+		return nil
+	}
+
+	var items []*activity.Item
+	for _, order := range orders {
+		for _, match := range order.Matches {
+			if match.IsCancel {
+				continue
+			}
+			items = append(items, matchActivity(order, match))
+		}
+	}
+	return items
+}
+
+func matchActivity(order *core.Order, match *core.Match) *activity.Item {
+	return &activity.Item{
+		ID:        "dex:" + match.MatchID.String(),
+		Kind:      activity.DEXMatch,
+		Timestamp: int64(match.Stamp / 1000),
+		DEXOrder:  order,
+		Match:     match,
+	}
+}
+
+// AddActivityListener adds a listener of the items added to or updated in
+// the activity feed. The items are published from the wallet, instant swap
+// and DEX notifications.
+func (mgr *AssetsManager) AddActivityListener(listener *activity.FeedListener, uniqueIdentifier string) error {
+	mgr.activity.mtx.Lock()
+	defer mgr.activity.mtx.Unlock()
+
+	if _, ok := mgr.activity.listeners[uniqueIdentifier]; ok {
+		return errors.New(activity.ErrListenerAlreadyExist)
+	}
+	if len(mgr.activity.listeners) == 0 {
+		mgr.startActivityNotifications()
+	}
+	mgr.activity.listeners[uniqueIdentifier] = listener
+	return nil
+}
+
+// RemoveActivityListener removes the listener, the feed stops listening to
+// the notifications once it has no listeners.
+func (mgr *AssetsManager) RemoveActivityListener(uniqueIdentifier string) {
+	mgr.activity.mtx.Lock()
+	defer mgr.activity.mtx.Unlock()
+
+	if _, ok := mgr.activity.listeners[uniqueIdentifier]; !ok {
+		return
+	}
+	delete(mgr.activity.listeners, uniqueIdentifier)
+	if len(mgr.activity.listeners) == 0 {
+		mgr.stopActivityNotifications()
+	}
+}
+
+// startActivityNotifications listens to the notifications the feed items are
+// published from. The activity mutex MUST be held.
+func (mgr *AssetsManager) startActivityNotifications() {
+	for _, wallet := range mgr.AllWallets() {
+		mgr.addWalletActivityListener(wallet)
+	}
+
+	swapListener := &instantswap.OrderNotificationListener{
+		OnOrderCreated: func(order *instantswap.Order) {
+			go mgr.publishActivity(swapActivity(order))
+		},
+		OnOrderUpdated: func(order *instantswap.Order) {
+			go mgr.publishActivity(swapActivity(order))
+		},
+	}
+	if err := mgr.InstantSwap.AddNotificationListener(swapListener, activityListenerID); err != nil {
+		log.Errorf("Error adding instant swap activity listener: %v", err)
+	}
+
+	dexClient := mgr.DexClient()
+	if dexClient != nil && dexClient.IsInitialized() {
+		ctx, cancel := context.WithCancel(context.Background())
+		mgr.activity.stopDEX = cancel
+		go mgr.listenForDEXMatches(ctx, dexClient)
+	}
+}
+
+// addWalletActivityListener publishes the transactions of the wallet. The
+// activity mutex MUST be held.
+func (mgr *AssetsManager) addWalletActivityListener(wallet sharedW.Asset) {
+	// The notification handlers can't access the wallet until they return,
+	// so the items are published from goroutines.
+	listener := &sharedW.TxAndBlockNotificationListener{
+		OnTransaction: func(_ int, tx *sharedW.Transaction) {
+			go mgr.publishActivity(txActivity(wallet, tx))
+		},
+		OnTransactionConfirmed: func(_ int, hash string, _ int32) {
+			go mgr.publishWalletTx(wallet, hash)
+		},
+	}
+	if err := wallet.AddTxAndBlockNotificationListener(listener, activityListenerID); err != nil {
+		log.Errorf("Error adding %s activity listener: %v", wallet.GetWalletName(), err)
+	}
+}
+
+// watchWalletActivity adds the activity listener to a wallet created or
+// restored while the feed has listeners.
+func (mgr *AssetsManager) watchWalletActivity(wallet sharedW.Asset) {
+	mgr.activity.mtx.Lock()
+	defer mgr.activity.mtx.Unlock()
+
+	if len(mgr.activity.listeners) > 0 {
+		mgr.addWalletActivityListener(wallet)
+	}
+}
+
+// stopActivityNotifications stops listening to the notifications of the
+// feed. The activity mutex MUST be held.
+func (mgr *AssetsManager) stopActivityNotifications() {
+	for _, wallet := range mgr.AllWallets() {
+		wallet.RemoveTxAndBlockNotificationListener(activityListenerID)
+	}
+	mgr.InstantSwap.RemoveNotificationListener(activityListenerID)
+	if mgr.activity.stopDEX != nil {
+		mgr.activity.stopDEX()
+		mgr.activity.stopDEX = nil
+	}
+}
+
+// listenForDEXMatches publishes the DEX matches that are added or updated
+// until ctx is canceled. It MUST be called from a goroutine.
+func (mgr *AssetsManager) listenForDEXMatches(ctx context.Context, dexClient DEXClient) {
+	noteFeed := dexClient.NotificationFeed()
+	defer noteFeed.ReturnFeed()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-noteFeed.C:
+			if n == nil {
+				return
+			}
+
+			note, ok := n.(*core.MatchNote)
+			if !ok || note.Match == nil || note.Match.IsCancel {
+				continue
+			}
+			// The note doesn't include the order of the match.
+			order, err := dexClient.Order(note.OrderID)
+			if err != nil {
+				log.Errorf("Error loading DEX order %s: %v", note.OrderID, err)
+				continue
+			}
+			mgr.publishActivity(matchActivity(order, note.Match))
+		}
+	}
+}
+
+// publishWalletTx publishes the wallet transaction with the hash.
+func (mgr *AssetsManager) publishWalletTx(wallet sharedW.Asset, hash string) {
+	tx, err := wallet.GetTransactionRaw(hash)
+	if err != nil || tx == nil {
+		log.Errorf("Error loading transaction %s: %v", hash, err)
+		return
+	}
+	mgr.publishActivity(txActivity(wallet, tx))
+}
+
+func (mgr *AssetsManager) publishActivity(item *activity.Item) {
+	mgr.activity.mtx.Lock()
+	defer mgr.activity.mtx.Unlock()
+
+	for _, listener := range mgr.activity.listeners {
+		if listener.OnActivity != nil {
+			listener.OnActivity(item)
+		}
+	}
+}
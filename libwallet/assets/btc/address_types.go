@@ -0,0 +1,218 @@
+package btc
+
+import (
+	"fmt"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet/txsizes"
+	"github.com/btcsuite/btcwallet/walletdb"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Asset confirms that BTC accounts support multiple address types.
+var _ sharedW.AddressTypesAsset = (*Asset)(nil)
+
+// addressKeyScopes are the key scopes receive addresses can be derived from,
+// the wallet's default scope first.
+var addressKeyScopes = []waddrmgr.KeyScope{
+	GetScope(),
+	waddrmgr.KeyScopeBIP0086,
+	waddrmgr.KeyScopeBIP0049Plus,
+	waddrmgr.KeyScopeBIP0044,
+}
+
+func toSharedScope(scope waddrmgr.KeyScope) sharedW.KeyScope {
+	return sharedW.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin}
+}
+
+func fromSharedScope(scope sharedW.KeyScope) waddrmgr.KeyScope {
+	return waddrmgr.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin}
+}
+
+// AccountAddressKeyScopes returns the key scopes the account can derive
+// receive addresses from. Accounts created before the other address types
+// were supported may only exist in the default scope.
+func (asset *Asset) AccountAddressKeyScopes(account int32) ([]sharedW.KeyScope, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	scopes := make([]sharedW.KeyScope, 0, len(addressKeyScopes))
+	for _, scope := range addressKeyScopes {
+		if _, err := asset.Internal().BTC.AccountName(scope, uint32(account)); err == nil {
+			scopes = append(scopes, toSharedScope(scope))
+		}
+	}
+	return scopes, nil
+}
+
+// AccountAddressKeyScope returns the key scope the receive addresses of the
+// account are derived from by default.
+func (asset *Asset) AccountAddressKeyScope(account int32) sharedW.KeyScope {
+	scope := toSharedScope(asset.accountScope())
+	key := fmt.Sprintf("%s_%d", sharedW.AccountAddressKeyScopeConfigKey, account)
+	_ = asset.ReadUserConfigValue(key, &scope)
+	return scope
+}
+
+// SetAccountAddressKeyScope sets the key scope the receive addresses of the
+// account are derived from by default.
+func (asset *Asset) SetAccountAddressKeyScope(account int32, scope sharedW.KeyScope) error {
+	if err := asset.validateAccountScope(account, scope); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s_%d", sharedW.AccountAddressKeyScopeConfigKey, account)
+	asset.SaveUserConfigValue(key, scope)
+	return nil
+}
+
+// CurrentAddressForScope gets the most recently requested payment address
+// of the account in the provided key scope. If that address has already been
+// used to receive funds, the next chained address is returned.
+func (asset *Asset) CurrentAddressForScope(account int32, scope sharedW.KeyScope) (string, error) {
+	if asset.IsRestored && !asset.ContainsDiscoveredAccounts() {
+		return "", errors.E(utils.ErrAddressDiscoveryNotDone)
+	}
+
+	if err := asset.validateAccountScope(account, scope); err != nil {
+		return "", err
+	}
+
+	addr, err := asset.Internal().BTC.CurrentAddress(uint32(account), fromSharedScope(scope))
+	if err != nil {
+		log.Errorf("CurrentAddress error: %v", err)
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// NextAddressForScope returns the address immediately following the last
+// requested payment address of the account in the provided key scope.
+func (asset *Asset) NextAddressForScope(account int32, scope sharedW.KeyScope) (string, error) {
+	if asset.IsRestored && !asset.ContainsDiscoveredAccounts() {
+		return "", errors.E(utils.ErrAddressDiscoveryNotDone)
+	}
+
+	if err := asset.validateAccountScope(account, scope); err != nil {
+		return "", err
+	}
+
+	address, err := asset.Internal().BTC.NewAddress(uint32(account), fromSharedScope(scope))
+	if err != nil {
+		log.Errorf("NewExternalAddress error: %w", err)
+		return "", err
+	}
+	return address.String(), nil
+}
+
+func (asset *Asset) validateAccountScope(account int32, scope sharedW.KeyScope) error {
+	scopes, err := asset.AccountAddressKeyScopes(account)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range scopes {
+		if s == scope {
+			return nil
+		}
+	}
+	return errors.E(utils.ErrInvalid, fmt.Sprintf("account %d has no addresses in %v", account, scope))
+}
+
+// lastAccount returns the number of the last account created in the scope.
+func (asset *Asset) lastAccount(scopedMgr *waddrmgr.ScopedKeyManager) (uint32, error) {
+	var lastAccount uint32
+	err := walletdb.View(asset.Internal().BTC.Database(), func(dbtx walletdb.ReadTx) error {
+		var err error
+		lastAccount, err = scopedMgr.LastAccount(dbtx.ReadBucket(wAddrMgrBkt))
+		return err
+	})
+	return lastAccount, err
+}
+
+// UnlockWallet unlocks the wallet and creates the accounts missing from the
+// other address scopes, which is the case for accounts created before the
+// other address types were supported.
+func (asset *Asset) UnlockWallet(privPass string) error {
+	if err := asset.Wallet.UnlockWallet(privPass); err != nil {
+		return err
+	}
+
+	asset.addAccountsToAddressScopes()
+	return nil
+}
+
+// addAccountsToAddressScopes creates all the accounts of the default scope in
+// the other address scopes. The wallet must be unlocked.
+func (asset *Asset) addAccountsToAddressScopes() {
+	scopedMgr, err := asset.Internal().BTC.Manager.FetchScopedKeyManager(GetScope())
+	if err != nil {
+		log.Errorf("(%v) Default scope not found: %v", asset.GetWalletName(), err)
+		return
+	}
+
+	lastAccount, err := asset.lastAccount(scopedMgr)
+	if err != nil {
+		log.Errorf("(%v) Reading the last account failed: %v", asset.GetWalletName(), err)
+		return
+	}
+
+	asset.addAccountToAddressScopes(lastAccount)
+}
+
+// addAccountToAddressScopes creates the account in the other address scopes
+// so that all the address types can be used with it. Account numbers are
+// sequential within a scope, any missing lower account is created too using
+// the name it has in the default scope. The wallet must be unlocked.
+func (asset *Asset) addAccountToAddressScopes(account uint32) {
+	w := asset.Internal().BTC
+	for _, scope := range addressKeyScopes[1:] {
+		scopedMgr, err := w.Manager.FetchScopedKeyManager(scope)
+		if err != nil {
+			log.Warnf("(%v) %v scope not found: %v", asset.GetWalletName(), scope, err)
+			continue
+		}
+
+		lastAccount, err := asset.lastAccount(scopedMgr)
+		if err != nil {
+			log.Errorf("(%v) Reading the last %v account failed: %v", asset.GetWalletName(), scope, err)
+			continue
+		}
+
+		for number := lastAccount + 1; number <= account; number++ {
+			name, err := w.AccountName(GetScope(), number)
+			if err != nil {
+				name = fmt.Sprintf("account-%d", number)
+			}
+
+			if _, err = w.NextAccount(scope, name); err != nil {
+				log.Errorf("(%v) Adding account %d to %v failed: %v", asset.GetWalletName(), number, scope, err)
+				break
+			}
+		}
+	}
+}
+
+// estimateVirtualSize returns the worst case virtual size of a tx spending
+// the provided output scripts. Each input is sized according to its type.
+func estimateVirtualSize(prevScripts [][]byte, txOuts []*wire.TxOut, changeScriptSize int) int {
+	var p2pkh, p2tr, p2wpkh, nestedP2wpkh int
+	for _, pkScript := range prevScripts {
+		switch {
+		case txscript.IsPayToScriptHash(pkScript):
+			nestedP2wpkh++
+		case txscript.IsPayToWitnessPubKeyHash(pkScript):
+			p2wpkh++
+		case txscript.IsPayToTaproot(pkScript):
+			p2tr++
+		default:
+			p2pkh++
+		}
+	}
+	return txsizes.EstimateVirtualSize(p2pkh, p2tr, p2wpkh, nestedP2wpkh, txOuts, changeScriptSize)
+}
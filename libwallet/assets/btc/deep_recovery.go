@@ -0,0 +1,474 @@
+package btc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// deepRecoveryProgressInterval is the number of blocks scanned between two
+// deep recovery progress reports.
+const deepRecoveryProgressInterval = 500
+
+// recoveredAddress is the derivation path of an address watched during a
+// deep recovery.
+type recoveredAddress struct {
+	account uint32
+	branch  uint32
+	index   uint32
+}
+
+// DeepRecoveryKeyScopes returns the key scopes that can be scanned during a
+// deep recovery. Other wallet software derive testnet keys using the
+// network's SLIP0044 coin type, those scopes are included when it differs
+// from the coin type of the wallet's default scopes.
+func (asset *Asset) DeepRecoveryKeyScopes() []sharedW.KeyScope {
+	defaultScopes := []waddrmgr.KeyScope{
+		waddrmgr.KeyScopeBIP0044,
+		waddrmgr.KeyScopeBIP0049Plus,
+		waddrmgr.KeyScopeBIP0084,
+		waddrmgr.KeyScopeBIP0086,
+	}
+
+	scopes := make([]sharedW.KeyScope, 0, len(defaultScopes)*2)
+	for _, scope := range defaultScopes {
+		scopes = append(scopes, sharedW.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin})
+	}
+
+	if coin := asset.chainParams.HDCoinType; coin != waddrmgr.KeyScopeBIP0084.Coin {
+		for _, scope := range defaultScopes {
+			scopes = append(scopes, sharedW.KeyScope{Purpose: scope.Purpose, Coin: coin})
+		}
+	}
+	return scopes
+}
+
+// IsDeepRecovering returns true if a deep recovery is in progress.
+func (asset *Asset) IsDeepRecovering() bool {
+	asset.syncData.mu.RLock()
+	defer asset.syncData.mu.RUnlock()
+
+	return asset.syncData.isDeepRecovery
+}
+
+// DeepRecovery scans the chain from the wallet's birthday block for the
+// addresses of the provided key scopes using the gap limits in params. Used
+// accounts and addresses are then added to the wallet and their txs are
+// recovered with a rescan. The scan runs in the background, its progress is
+// reported through the sync progress listeners.
+func (asset *Asset) DeepRecovery(privatePassphrase string, params *sharedW.DeepRecoveryParams) error {
+	if !asset.WalletOpened() {
+		return utils.ErrBTCNotInitialized
+	}
+
+	if asset.IsWatchingOnlyWallet() {
+		return errors.E(utils.ErrWalletIsWatchOnly)
+	}
+
+	if !asset.IsSynced() {
+		return errors.E(utils.ErrNotSynced)
+	}
+
+	if asset.IsRescanning() || asset.IsDeepRecovering() {
+		return errors.E(utils.ErrSyncAlreadyInProgress)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	seedMnemonic, err := asset.DecryptSeed(privatePassphrase)
+	if err != nil {
+		return err
+	}
+
+	seedType := sharedW.SeedTypeFromMnemonic(seedMnemonic, asset.Type)
+	seed, err := sharedW.DecodeSeedMnemonic(seedMnemonic, asset.Type, seedType)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for i := range seed {
+			seed[i] = 0
+		}
+	}()
+
+	masterNode, err := hdkeychain.NewMaster(seed, asset.chainParams)
+	if err != nil {
+		return err
+	}
+
+	startHeight, _, err := asset.getBirthdayBlock()
+	if err != nil {
+		masterNode.Zero()
+		return err
+	}
+
+	// The wallet needs to be unlocked to add the recovered accounts. It is
+	// only locked again if it was locked before.
+	wasLocked := asset.IsLocked()
+	if wasLocked {
+		if err = asset.UnlockWallet(privatePassphrase); err != nil {
+			masterNode.Zero()
+			return err
+		}
+	}
+
+	asset.syncData.mu.Lock()
+	asset.syncData.isDeepRecovery = true
+	asset.syncData.mu.Unlock()
+
+	go func() {
+		defer func() {
+			masterNode.Zero()
+			if wasLocked {
+				asset.LockWallet()
+			}
+
+			asset.syncData.mu.Lock()
+			asset.syncData.isDeepRecovery = false
+			asset.syncData.mu.Unlock()
+		}()
+
+		ctx, _ := asset.ShutdownContextWithCancel()
+		if err := asset.deepRecovery(ctx, masterNode, params, startHeight); err != nil {
+			log.Errorf("(%v) Deep recovery failed: %v", asset.GetWalletName(), err)
+		}
+	}()
+
+	return nil
+}
+
+func (asset *Asset) deepRecovery(ctx context.Context, masterNode *hdkeychain.ExtendedKey,
+	params *sharedW.DeepRecoveryParams, startHeight int32,
+) error {
+	startTime := time.Now()
+	discoveredAccounts := int32(0)
+	var addrs []btcutil.Address
+
+	for i, scope := range params.KeyScopes {
+		state := sharedW.NewScopeRecoveryState(scope, params)
+		err := asset.scanKeyScope(ctx, masterNode, state, startHeight, int32(i), int32(len(params.KeyScopes)))
+		if err != nil {
+			return fmt.Errorf("scanning %v: %w", scope, err)
+		}
+
+		if !state.IsUsed() {
+			continue
+		}
+
+		scopeAddrs, err := asset.importRecoveredScope(state)
+		if err != nil {
+			return fmt.Errorf("importing %v: %w", scope, err)
+		}
+
+		addrs = append(addrs, scopeAddrs...)
+		discoveredAccounts += int32(state.LastUsedAccount() + 1)
+	}
+
+	log.Infof("(%v) Deep recovery found %d account(s) in %v", asset.GetWalletName(),
+		discoveredAccounts, time.Since(startTime).Round(time.Second))
+
+	asset.publishDeepRecoveryProgress(&sharedW.AddressDiscoveryProgressReport{
+		AddressDiscoveryProgress: 100,
+		TotalDiscoveryTimeSpent:  time.Since(startTime),
+		TotalKeyScopes:           int32(len(params.KeyScopes)),
+		CurrentScanHeight:        asset.GetBestBlockHeight(),
+		DiscoveredAccounts:       discoveredAccounts,
+		DeepRecoveryFinished:     true,
+	})
+
+	if len(addrs) == 0 {
+		return nil
+	}
+	return asset.rescanBlocks(startHeight, addrs)
+}
+
+// scanKeyScope matches the addresses of the scope against the block filters
+// from startHeight to the chain tip, extending the derivation horizon every
+// time a used address is found.
+func (asset *Asset) scanKeyScope(ctx context.Context, masterNode *hdkeychain.ExtendedKey,
+	state *sharedW.ScopeRecoveryState, startHeight, scopeIndex, totalScopes int32,
+) error {
+	addrSchema := keyScopeAddrSchema(state.Scope)
+	coinTypeKey, err := deriveHardened(masterNode, state.Scope.Purpose, state.Scope.Coin)
+	if err != nil {
+		return err
+	}
+
+	watched := make(map[string]recoveredAddress)
+	var scripts [][]byte
+	branchKeys := make(map[recoveredAddress]*hdkeychain.ExtendedKey)
+
+	deriveScripts := func() error {
+		for _, r := range state.NextRanges() {
+			branchPath := recoveredAddress{account: r.Account, branch: r.Branch}
+			branchKey, ok := branchKeys[branchPath]
+			if !ok {
+				acctKey, err := coinTypeKey.Derive(hardenedKey(r.Account))
+				if err != nil {
+					return err
+				}
+				if branchKey, err = acctKey.Derive(r.Branch); err != nil {
+					return err
+				}
+				branchKeys[branchPath] = branchKey
+			}
+
+			addrType := addrSchema.ExternalAddrType
+			if r.Branch == sharedW.InternalBranch {
+				addrType = addrSchema.InternalAddrType
+			}
+
+			for index := r.From; index < r.To; index++ {
+				child, err := branchKey.Derive(index)
+				if errors.Is(err, hdkeychain.ErrInvalidChild) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+
+				pubKey, err := child.ECPubKey()
+				if err != nil {
+					return err
+				}
+
+				pkScript, err := asset.scopedPkScript(pubKey, addrType)
+				if err != nil {
+					return err
+				}
+
+				watched[string(pkScript)] = recoveredAddress{r.Account, r.Branch, index}
+				scripts = append(scripts, pkScript)
+			}
+		}
+		return nil
+	}
+
+	if err = deriveScripts(); err != nil {
+		return err
+	}
+
+	scope := state.Scope
+	bestHeight := asset.GetBestBlockHeight()
+	for height := startHeight; height <= bestHeight; height++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if (height-startHeight)%deepRecoveryProgressInterval == 0 {
+			progress := int32(0)
+			if bestHeight > startHeight {
+				progress = (height - startHeight) * 100 / (bestHeight - startHeight)
+			}
+			asset.publishDeepRecoveryProgress(&sharedW.AddressDiscoveryProgressReport{
+				AddressDiscoveryProgress: progress,
+				KeyScope:                 &scope,
+				KeyScopeIndex:            scopeIndex,
+				TotalKeyScopes:           totalScopes,
+				CurrentScanHeight:        height,
+				DiscoveredAccounts:       int32(state.LastUsedAccount() + 1),
+			})
+		}
+
+		hash, err := asset.chainClient.CS.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+
+		filter, err := asset.chainClient.CS.GetCFilter(*hash, wire.GCSFilterRegular)
+		if err != nil {
+			return err
+		}
+		if filter == nil || filter.N() == 0 {
+			continue
+		}
+
+		key := builder.DeriveKey(hash)
+		matched, err := filter.MatchAny(key, scripts)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+
+		block, err := asset.chainClient.CS.GetBlock(*hash)
+		if err != nil {
+			return err
+		}
+
+		// Addresses derived after a used one is found could also be used
+		// in the same block, keep checking until the horizon stops moving.
+		for {
+			extended := false
+			for _, tx := range block.Transactions() {
+				for _, txOut := range tx.MsgTx().TxOut {
+					addr, ok := watched[string(txOut.PkScript)]
+					if ok && state.MarkUsed(addr.account, addr.branch, addr.index) {
+						extended = true
+					}
+				}
+			}
+
+			if !extended {
+				break
+			}
+			if err = deriveScripts(); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Infof("(%v) Deep recovery of %v done, last used account: %d", asset.GetWalletName(),
+		scope, state.LastUsedAccount())
+	return nil
+}
+
+// importRecoveredScope adds the used accounts and addresses of the scope to
+// the wallet. It returns the addresses of the recovered accounts.
+func (asset *Asset) importRecoveredScope(state *sharedW.ScopeRecoveryState) ([]btcutil.Address, error) {
+	w := asset.Internal().BTC
+	scope := waddrmgr.KeyScope{Purpose: state.Scope.Purpose, Coin: state.Scope.Coin}
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		err = walletdb.Update(w.Database(), func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wAddrMgrBkt)
+			scopedMgr, err = w.Manager.NewScopedKeyManager(ns, scope, keyScopeAddrSchema(state.Scope))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lastAccount, err := asset.lastAccount(scopedMgr)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUsedAccount := uint32(state.LastUsedAccount())
+	for account := lastAccount + 1; account <= lastUsedAccount; account++ {
+		if _, err = w.NextAccount(scope, fmt.Sprintf("recovered-%d", account)); err != nil {
+			return nil, err
+		}
+	}
+
+	var addrs []btcutil.Address
+	err = walletdb.Update(w.Database(), func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wAddrMgrBkt)
+		for _, acct := range state.Accounts {
+			if !acct.IsUsed() {
+				continue
+			}
+
+			if lastUsed := acct.LastUsed[sharedW.ExternalBranch]; lastUsed >= 0 {
+				if err := scopedMgr.ExtendExternalAddresses(ns, acct.Account, uint32(lastUsed)); err != nil {
+					return err
+				}
+			}
+			if lastUsed := acct.LastUsed[sharedW.InternalBranch]; lastUsed >= 0 {
+				if err := scopedMgr.ExtendInternalAddresses(ns, acct.Account, uint32(lastUsed)); err != nil {
+					return err
+				}
+			}
+
+			err := scopedMgr.ForEachAccountAddress(ns, acct.Account, func(a waddrmgr.ManagedAddress) error {
+				addrs = append(addrs, a.Address())
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return addrs, err
+}
+
+// scopedPkScript returns the output script paying to the address of the
+// provided type for the public key.
+func (asset *Asset) scopedPkScript(pubKey *btcec.PublicKey, addrType waddrmgr.AddressType) ([]byte, error) {
+	pubKeyHash := btcutil.Hash160(pubKey.SerializeCompressed())
+
+	var addr btcutil.Address
+	var err error
+	switch addrType {
+	case waddrmgr.PubKeyHash:
+		addr, err = btcutil.NewAddressPubKeyHash(pubKeyHash, asset.chainParams)
+	case waddrmgr.NestedWitnessPubKey:
+		var witnessAddr *btcutil.AddressWitnessPubKeyHash
+		witnessAddr, err = btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, asset.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		var witnessScript []byte
+		if witnessScript, err = txscript.PayToAddrScript(witnessAddr); err != nil {
+			return nil, err
+		}
+		addr, err = btcutil.NewAddressScriptHash(witnessScript, asset.chainParams)
+	case waddrmgr.WitnessPubKey:
+		addr, err = btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, asset.chainParams)
+	case waddrmgr.TaprootPubKey:
+		taprootKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+		addr, err = btcutil.NewAddressTaproot(schnorr.SerializePubKey(taprootKey), asset.chainParams)
+	default:
+		return nil, fmt.Errorf("unsupported address type %v", addrType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+func (asset *Asset) publishDeepRecoveryProgress(report *sharedW.AddressDiscoveryProgressReport) {
+	asset.syncData.mu.RLock()
+	defer asset.syncData.mu.RUnlock()
+
+	for _, listener := range asset.syncData.syncProgressListeners {
+		if listener.OnAddressDiscoveryProgress != nil {
+			listener.OnAddressDiscoveryProgress(report)
+		}
+	}
+}
+
+// keyScopeAddrSchema returns the address schema of the key scope. Scopes
+// using other coin types follow the schema of the matching default scope.
+func keyScopeAddrSchema(scope sharedW.KeyScope) waddrmgr.ScopeAddrSchema {
+	if schema, ok := waddrmgr.ScopeAddrMap[waddrmgr.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin}]; ok {
+		return schema
+	}
+	for defaultScope, schema := range waddrmgr.ScopeAddrMap {
+		if defaultScope.Purpose == scope.Purpose {
+			return schema
+		}
+	}
+	return waddrmgr.ScopeAddrMap[waddrmgr.KeyScopeBIP0044]
+}
+
+// deriveHardened derives the child key at the provided hardened path.
+func deriveHardened(key *hdkeychain.ExtendedKey, path ...uint32) (*hdkeychain.ExtendedKey, error) {
+	var err error
+	for _, index := range path {
+		if key, err = key.Derive(hardenedKey(index)); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
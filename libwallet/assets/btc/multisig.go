@@ -0,0 +1,1118 @@
+package btc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
+	"github.com/btcsuite/btcwallet/walletdb"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	btcloader "github.com/crypto-power/cryptopower/libwallet/internal/loader/btc"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Asset confirms that BTC supports multisig wallets.
+var _ sharedW.MultisigAsset = (*Asset)(nil)
+
+const (
+	// p2wshOutputSize is the serialized size of a P2WSH output.
+	p2wshOutputSize = 8 + 1 + 1 + 1 + 32
+)
+
+// multisigAddress is a P2WSH multisig address of the wallet.
+type multisigAddress struct {
+	branch, index uint32
+	address       btcutil.Address
+	witnessScript []byte
+	pkScript      []byte
+	// derivations hold the derivation of each cosigner's key used by the
+	// address, they are included in the PSBTs.
+	derivations []*psbt.Bip32Derivation
+}
+
+// CreateNewMultisigWallet creates a new m-of-n multisig wallet. The local
+// cosigner key is derived from the new wallet's seed, the others are parsed
+// from the provided cosigner keys.
+func CreateNewMultisigWallet(pass *sharedW.AuthInfo, params *sharedW.InitParams, threshold int, cosignerKeys []string) (sharedW.Asset, error) {
+	chainParams, err := utils.BTCChainParams(params.NetType)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local key is added once the wallet's seed exists, it counts
+	// as one of the keys.
+	if err := sharedW.ValidateMultisigParams(threshold, len(cosignerKeys)+1); err != nil {
+		return nil, err
+	}
+
+	cosigners, err := parseCosignerKeys(cosignerKeys, chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := CreateNewWallet(pass, params)
+	if err != nil {
+		return nil, err
+	}
+
+	btcWallet := w.(*Asset)
+	if err := btcWallet.setupMultisig(pass.PrivatePass, threshold, cosigners); err != nil {
+		if delErr := btcWallet.DeleteWallet(pass.PrivatePass); delErr != nil {
+			log.Errorf("(%v) Deleting the incomplete multisig wallet failed: %v", btcWallet.GetWalletName(), delErr)
+		}
+		return nil, err
+	}
+
+	return btcWallet, nil
+}
+
+// parseCosignerKeys parses the xpubs of the cosigners. Keys without an origin
+// are identified by the fingerprint of the xpub itself.
+func parseCosignerKeys(cosignerKeys []string, chainParams *chaincfg.Params) ([]*sharedW.Cosigner, error) {
+	cosigners := make([]*sharedW.Cosigner, 0, len(cosignerKeys))
+	for i, key := range cosignerKeys {
+		cosigner, err := sharedW.ParseCosignerKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		xpub, err := btcloader.ParseExtendedPubKey(cosigner.XPub)
+		if err != nil {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("invalid cosigner %d key: %v", i+1, err))
+		}
+		if !xpub.IsForNet(chainParams) {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("cosigner %d key is not for %s", i+1, chainParams.Name))
+		}
+
+		if cosigner.Fingerprint == "" {
+			pubKey, err := xpub.ECPubKey()
+			if err != nil {
+				return nil, err
+			}
+			cosigner.Fingerprint = keyFingerprint(pubKey.SerializeCompressed())
+			cosigner.Path = "m"
+		}
+		cosigner.Name = fmt.Sprintf("Cosigner %d", i+1)
+		cosigners = append(cosigners, cosigner)
+	}
+	return cosigners, nil
+}
+
+// keyFingerprint returns the hex encoded BIP32 fingerprint of the public key.
+func keyFingerprint(pubKey []byte) string {
+	return hex.EncodeToString(btcutil.Hash160(pubKey)[:4])
+}
+
+// masterExtendedKey returns the master key derived from the wallet's seed.
+// The key must be zeroed once it is no longer needed.
+func (asset *Asset) masterExtendedKey(privatePassphrase string) (*hdkeychain.ExtendedKey, error) {
+	seedMnemonic, err := asset.DecryptSeed(privatePassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	seedType := sharedW.SeedTypeFromMnemonic(seedMnemonic, asset.Type)
+	seed, err := sharedW.DecodeSeedMnemonic(seedMnemonic, asset.Type, seedType)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for i := range seed {
+			seed[i] = 0
+		}
+	}()
+
+	return hdkeychain.NewMaster(seed, asset.chainParams)
+}
+
+// setupMultisig derives the local cosigner key at the BIP48 P2WSH path and
+// starts watching the multisig addresses.
+func (asset *Asset) setupMultisig(privatePassphrase string, threshold int, cosigners []*sharedW.Cosigner) error {
+	masterKey, err := asset.masterExtendedKey(privatePassphrase)
+	if err != nil {
+		return err
+	}
+	defer masterKey.Zero()
+
+	masterPubKey, err := masterKey.ECPubKey()
+	if err != nil {
+		return err
+	}
+
+	path := []uint32{sharedW.MultisigPurpose, asset.chainParams.HDCoinType, 0, sharedW.MultisigP2WSHScriptType}
+	accountKey, err := deriveHardened(masterKey, path...)
+	if err != nil {
+		return err
+	}
+	accountXPub, err := accountKey.Neuter()
+	if err != nil {
+		return err
+	}
+
+	for i := range path {
+		path[i] = hardenedKey(path[i])
+	}
+	local := &sharedW.Cosigner{
+		Name:        asset.GetWalletName(),
+		XPub:        accountXPub.String(),
+		Fingerprint: keyFingerprint(masterPubKey.SerializeCompressed()),
+		Path:        sharedW.FormatDerivationPath(path),
+		IsLocal:     true,
+	}
+
+	cfg := &sharedW.MultisigConfig{
+		Threshold: threshold,
+		Cosigners: append([]*sharedW.Cosigner{local}, cosigners...),
+	}
+	return asset.initMultisig(cfg)
+}
+
+// initMultisig validates the config of a new multisig wallet, starts watching
+// its addresses and saves it.
+func (asset *Asset) initMultisig(cfg *sharedW.MultisigConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if err := asset.watchMultisigAddresses(cfg); err != nil {
+		return err
+	}
+	asset.SaveUserConfigValue(sharedW.MultisigConfigKey, cfg)
+	return nil
+}
+
+// multisigAddress derives the address of the branch at the index. The keys
+// are sorted as per BIP67 in the witness script unless the config is
+// unsorted.
+func (asset *Asset) multisigAddress(cfg *sharedW.MultisigConfig, branch, index uint32) (*multisigAddress, error) {
+	type cosignerKey struct {
+		pubKey     *btcutil.AddressPubKey
+		derivation *psbt.Bip32Derivation
+	}
+
+	keys := make([]*cosignerKey, len(cfg.Cosigners))
+	for i, cosigner := range cfg.Cosigners {
+		xpub, err := hdkeychain.NewKeyFromString(cosigner.XPub)
+		if err != nil {
+			return nil, err
+		}
+		child, err := xpub.Derive(branch)
+		if err != nil {
+			return nil, err
+		}
+		if child, err = child.Derive(index); err != nil {
+			return nil, err
+		}
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		serializedPubKey := pubKey.SerializeCompressed()
+		addrPubKey, err := btcutil.NewAddressPubKey(serializedPubKey, asset.chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		path, err := sharedW.ParseDerivationPath(cosigner.Path)
+		if err != nil {
+			return nil, err
+		}
+		fingerprint, err := sharedW.PSBTFingerprint(cosigner.Fingerprint)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = &cosignerKey{
+			pubKey: addrPubKey,
+			derivation: &psbt.Bip32Derivation{
+				PubKey:               serializedPubKey,
+				MasterKeyFingerprint: fingerprint,
+				Bip32Path:            append(path, branch, index),
+			},
+		}
+	}
+
+	if !cfg.Unsorted {
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].pubKey.ScriptAddress(), keys[j].pubKey.ScriptAddress()) < 0
+		})
+	}
+
+	pubKeys := make([]*btcutil.AddressPubKey, len(keys))
+	derivations := make([]*psbt.Bip32Derivation, len(keys))
+	for i, key := range keys {
+		pubKeys[i], derivations[i] = key.pubKey, key.derivation
+	}
+
+	witnessScript, err := txscript.MultiSigScript(pubKeys, cfg.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptHash := sha256.Sum256(witnessScript)
+	address, err := btcutil.NewAddressWitnessScriptHash(scriptHash[:], asset.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigAddress{
+		branch:        branch,
+		index:         index,
+		address:       address,
+		witnessScript: witnessScript,
+		pkScript:      pkScript,
+		derivations:   derivations,
+	}, nil
+}
+
+// multisigAddresses returns the watched multisig addresses of the wallet
+// keyed by their encoded address.
+func (asset *Asset) multisigAddresses(cfg *sharedW.MultisigConfig) (map[string]*multisigAddress, error) {
+	addresses := make(map[string]*multisigAddress)
+	for _, branch := range []uint32{sharedW.ExternalBranch, sharedW.InternalBranch} {
+		for index := uint32(0); index < cfg.WatchedIndex[branch]; index++ {
+			addr, err := asset.multisigAddress(cfg, branch, index)
+			if err != nil {
+				return nil, err
+			}
+			addresses[addr.address.String()] = addr
+		}
+	}
+	return addresses, nil
+}
+
+// watchMultisigAddresses imports the witness scripts of the addresses
+// following the last returned address of each branch. The scripts are not
+// secret, so the wallet tracks their outputs through the neutrino filters
+// without being unlocked.
+func (asset *Asset) watchMultisigAddresses(cfg *sharedW.MultisigConfig) error {
+	var addresses []*multisigAddress
+	for _, branch := range []uint32{sharedW.ExternalBranch, sharedW.InternalBranch} {
+		lookahead := cfg.NextIndex[branch] + sharedW.MultisigAddressLookahead
+		for index := cfg.WatchedIndex[branch]; index < lookahead; index++ {
+			addr, err := asset.multisigAddress(cfg, branch, index)
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, addr)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	w := asset.Internal().BTC
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(GetScope())
+	if err != nil {
+		return err
+	}
+
+	bs := w.Manager.SyncedTo()
+	err = walletdb.Update(w.Database(), func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wAddrMgrBkt)
+		for _, addr := range addresses {
+			_, err := scopedMgr.ImportWitnessScript(ns, addr.witnessScript, &bs, 0, false)
+			if err != nil && !waddrmgr.IsError(err, waddrmgr.ErrDuplicateAddress) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addresses {
+		if addr.index >= cfg.WatchedIndex[addr.branch] {
+			cfg.WatchedIndex[addr.branch] = addr.index + 1
+		}
+	}
+
+	// A running sync only learns about the new addresses if notified, they
+	// are loaded from the wallet when a sync starts.
+	if asset.IsSynced() && asset.chainClient != nil {
+		watched := make([]btcutil.Address, len(addresses))
+		for i, addr := range addresses {
+			watched[i] = addr.address
+		}
+		if err := asset.chainClient.NotifyReceived(watched); err != nil {
+			log.Errorf("(%v) Watching the multisig addresses failed: %v", asset.GetWalletName(), err)
+		}
+	}
+	return nil
+}
+
+// nextMultisigAddress returns the next address of the branch and updates the
+// config. The caller must hold multisigMu.
+func (asset *Asset) nextMultisigAddress(cfg *sharedW.MultisigConfig, branch uint32) (*multisigAddress, error) {
+	addr, err := asset.multisigAddress(cfg, branch, cfg.NextIndex[branch])
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.NextIndex[branch]++
+	if err := asset.watchMultisigAddresses(cfg); err != nil {
+		return nil, err
+	}
+	asset.SaveUserConfigValue(sharedW.MultisigConfigKey, cfg)
+	return addr, nil
+}
+
+// MultisigCurrentAddress returns the last returned receive address of the
+// multisig wallet.
+func (asset *Asset) MultisigCurrentAddress() (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrBTCNotInitialized
+	}
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return "", err
+	}
+	if cfg.NextIndex[sharedW.ExternalBranch] == 0 {
+		return asset.MultisigNextAddress()
+	}
+
+	addr, err := asset.multisigAddress(cfg, sharedW.ExternalBranch, cfg.NextIndex[sharedW.ExternalBranch]-1)
+	if err != nil {
+		return "", err
+	}
+	return addr.address.String(), nil
+}
+
+// MultisigNextAddress returns a new receive address of the multisig wallet.
+func (asset *Asset) MultisigNextAddress() (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrBTCNotInitialized
+	}
+
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return "", err
+	}
+
+	addr, err := asset.nextMultisigAddress(cfg, sharedW.ExternalBranch)
+	if err != nil {
+		return "", err
+	}
+	return addr.address.String(), nil
+}
+
+// multisigUnspents returns the unspent outputs of the multisig addresses
+// with at least minConf confirmations.
+func (asset *Asset) multisigUnspents(addresses map[string]*multisigAddress, minConf int32) ([]*btcjson.ListUnspentResult, error) {
+	unspents, err := asset.Internal().BTC.ListUnspent(minConf, math.MaxInt32, "")
+	if err != nil {
+		return nil, err
+	}
+
+	multisigUnspents := make([]*btcjson.ListUnspentResult, 0, len(unspents))
+	for _, utxo := range unspents {
+		if _, ok := addresses[utxo.Address]; ok {
+			multisigUnspents = append(multisigUnspents, utxo)
+		}
+	}
+	return multisigUnspents, nil
+}
+
+// MultisigBalance returns the balance held by the multisig addresses.
+func (asset *Asset) MultisigBalance() (*sharedW.Balance, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := asset.multisigAddresses(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	unspents, err := asset.multisigUnspents(addresses, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, spendable btcutil.Amount
+	for _, utxo := range unspents {
+		amount, _ := btcutil.NewAmount(utxo.Amount)
+		total += amount
+		if utxo.Confirmations >= int64(asset.RequiredConfirmations()) {
+			spendable += amount
+		}
+	}
+
+	return &sharedW.Balance{
+		Total:          Amount(total),
+		Spendable:      Amount(spendable),
+		ImmatureReward: Amount(0),
+		Locked:         Amount(0),
+	}, nil
+}
+
+// estimateMultisigTxSize returns the worst case virtual size of a tx
+// spending numInputs multisig outputs.
+func estimateMultisigTxSize(cfg *sharedW.MultisigConfig, numInputs int, txOuts []*wire.TxOut, addChange bool) int {
+	numOutputs := len(txOuts)
+	outputsSize := 0
+	for _, txOut := range txOuts {
+		outputsSize += txOut.SerializeSize()
+	}
+	if addChange {
+		numOutputs++
+		outputsSize += p2wshOutputSize
+	}
+	return sharedW.EstimateMultisigTxSize(cfg, numInputs, numOutputs, outputsSize)
+}
+
+// reservedMultisigOutpoints returns the outpoints spent by the pending txs.
+func reservedMultisigOutpoints(txs map[string]*sharedW.MultisigPendingTx) map[wire.OutPoint]bool {
+	reserved := make(map[wire.OutPoint]bool)
+	for _, tx := range txs {
+		packet, err := decodePSBT([]byte(tx.PSBT))
+		if err != nil {
+			continue
+		}
+		for _, txIn := range packet.UnsignedTx.TxIn {
+			reserved[txIn.PreviousOutPoint] = true
+		}
+	}
+	return reserved
+}
+
+// CreateMultisigTx creates a tx spending the multisig outputs to the
+// destinations. The tx is saved as a pending tx until enough cosigners sign
+// its PSBT. The user fee rate is used if feeRatePerKvB is not positive.
+func (asset *Asset) CreateMultisigTx(destinations []*sharedW.TransactionDestination, feeRatePerKvB int64) (*sharedW.MultisigPendingTx, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+	if len(destinations) == 0 {
+		return nil, errors.E(utils.ErrInvalid, "no destination provided")
+	}
+
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := asset.multisigAddresses(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	sendMaxIndex := -1
+	var sendAmount btcutil.Amount
+	for i, destination := range destinations {
+		address, err := decodeAddress(destination.Address, asset.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if destination.SendMax {
+			if sendMaxIndex >= 0 {
+				return nil, errors.E(utils.ErrInvalid, "only one destination can send the max amount")
+			}
+			sendMaxIndex = i
+		} else {
+			if err := asset.validateSendAmount(false, destination.UnitAmount); err != nil {
+				return nil, err
+			}
+			sendAmount += btcutil.Amount(destination.UnitAmount)
+		}
+		tx.AddTxOut(wire.NewTxOut(destination.UnitAmount, pkScript))
+	}
+
+	pendingTxs := asset.ReadMultisigPendingTxs()
+	reserved := reservedMultisigOutpoints(pendingTxs)
+	unspents, err := asset.multisigUnspents(addresses, asset.RequiredConfirmations())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(unspents, func(i, j int) bool {
+		return unspents[i].Amount > unspents[j].Amount
+	})
+
+	if feeRatePerKvB <= 0 {
+		feeRatePerKvB = asset.GetUserFeeRate().ToInt()
+	}
+	feeRate := btcutil.Amount(feeRatePerKvB)
+	if feeRate <= 0 {
+		feeRate = txrules.DefaultRelayFeePerKb
+	}
+
+	var inputAmount, fee btcutil.Amount
+	var selected []*btcjson.ListUnspentResult
+	for _, utxo := range unspents {
+		if sendMaxIndex < 0 {
+			fee = txrules.FeeForSerializeSize(feeRate, estimateMultisigTxSize(cfg, len(selected), tx.TxOut, true))
+			if len(selected) > 0 && inputAmount >= sendAmount+fee {
+				break
+			}
+		}
+
+		hash, err := chainhash.NewHashFromStr(utxo.TxID)
+		if err != nil {
+			return nil, err
+		}
+		outPoint := wire.NewOutPoint(hash, utxo.Vout)
+		if reserved[*outPoint] {
+			continue
+		}
+
+		amount, _ := btcutil.NewAmount(utxo.Amount)
+		inputAmount += amount
+		selected = append(selected, utxo)
+		tx.AddTxIn(wire.NewTxIn(outPoint, nil, nil))
+	}
+
+	changeIndex := -1
+	var change *multisigAddress
+	if sendMaxIndex >= 0 {
+		fee = txrules.FeeForSerializeSize(feeRate, estimateMultisigTxSize(cfg, len(selected), tx.TxOut, false))
+		tx.TxOut[sendMaxIndex].Value = int64(inputAmount - sendAmount - fee)
+		if len(selected) == 0 || txrules.IsDustOutput(tx.TxOut[sendMaxIndex], txrules.DefaultRelayFeePerKb) {
+			return nil, errors.New(utils.ErrInsufficientBalance)
+		}
+	} else {
+		fee = txrules.FeeForSerializeSize(feeRate, estimateMultisigTxSize(cfg, len(selected), tx.TxOut, true))
+		if len(selected) == 0 || inputAmount < sendAmount+fee {
+			return nil, errors.New(utils.ErrInsufficientBalance)
+		}
+
+		changeAmount := inputAmount - sendAmount - fee
+		change, err = asset.multisigAddress(cfg, sharedW.InternalBranch, cfg.NextIndex[sharedW.InternalBranch])
+		if err != nil {
+			return nil, err
+		}
+		changeOutput := wire.NewTxOut(int64(changeAmount), change.pkScript)
+		if txrules.IsDustOutput(changeOutput, txrules.DefaultRelayFeePerKb) {
+			// Dust change is left to the miners.
+			change = nil
+			fee += changeAmount
+		} else {
+			changeIndex = len(tx.TxOut)
+			tx.AddTxOut(changeOutput)
+		}
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	for i, utxo := range selected {
+		addr := addresses[utxo.Address]
+		amount, _ := btcutil.NewAmount(utxo.Amount)
+		packet.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(amount), addr.pkScript)
+		packet.Inputs[i].WitnessScript = addr.witnessScript
+		packet.Inputs[i].SighashType = txscript.SigHashAll
+		packet.Inputs[i].Bip32Derivation = addr.derivations
+	}
+	if change != nil {
+		// Include the change derivation so the cosigners can verify it.
+		packet.Outputs[changeIndex].WitnessScript = change.witnessScript
+		packet.Outputs[changeIndex].Bip32Derivation = change.derivations
+		if _, err := asset.nextMultisigAddress(cfg, sharedW.InternalBranch); err != nil {
+			return nil, err
+		}
+	}
+
+	encodedPSBT, err := packet.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	var amount int64
+	for i, txOut := range tx.TxOut {
+		if i != changeIndex {
+			amount += txOut.Value
+		}
+	}
+
+	pendingTx := &sharedW.MultisigPendingTx{
+		TxID:      tx.TxHash().String(),
+		PSBT:      encodedPSBT,
+		Amount:    amount,
+		Fee:       int64(fee),
+		CreatedAt: time.Now().Unix(),
+	}
+	pendingTxs[pendingTx.TxID] = pendingTx
+	asset.SaveMultisigPendingTxs(pendingTxs)
+	return pendingTx, nil
+}
+
+// decodePSBT decodes a PSBT either in its binary or in its base64 form.
+func decodePSBT(data []byte) (*psbt.Packet, error) {
+	data = bytes.TrimSpace(data)
+	isBase64 := !bytes.HasPrefix(data, []byte("psbt\xff"))
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(data), isBase64)
+	if err != nil {
+		return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("invalid PSBT: %v", err))
+	}
+	return packet, nil
+}
+
+// multisigPendingTx returns the pending tx and its decoded PSBT.
+func (asset *Asset) multisigPendingTx(txID string) (*sharedW.MultisigPendingTx, *psbt.Packet, error) {
+	pendingTx, ok := asset.ReadMultisigPendingTxs()[txID]
+	if !ok {
+		return nil, nil, errors.E(utils.ErrNotExist, "pending multisig tx not found")
+	}
+
+	packet, err := decodePSBT([]byte(pendingTx.PSBT))
+	if err != nil {
+		return nil, nil, err
+	}
+	// Pending txs saved before the signatures were verified on import may
+	// hold invalid ones.
+	dropInvalidSignatures(packet)
+	return pendingTx, packet, nil
+}
+
+func (asset *Asset) saveMultisigPendingTx(pendingTx *sharedW.MultisigPendingTx, packet *psbt.Packet) error {
+	encodedPSBT, err := packet.B64Encode()
+	if err != nil {
+		return err
+	}
+	pendingTx.PSBT = encodedPSBT
+
+	pendingTxs := asset.ReadMultisigPendingTxs()
+	pendingTxs[pendingTx.TxID] = pendingTx
+	asset.SaveMultisigPendingTxs(pendingTxs)
+	return nil
+}
+
+// witnessScriptPubKeys returns the public keys of the multisig witness
+// script.
+func witnessScriptPubKeys(witnessScript []byte) [][]byte {
+	var pubKeys [][]byte
+	tokenizer := txscript.MakeScriptTokenizer(0, witnessScript)
+	for tokenizer.Next() {
+		if len(tokenizer.Data()) == btcec.PubKeyBytesLenCompressed {
+			pubKeys = append(pubKeys, tokenizer.Data())
+		}
+	}
+	return pubKeys
+}
+
+// verifyPartialSig checks that sig is a SIGHASH_ALL signature of input idx by
+// one of the keys of the input's witness script.
+func verifyPartialSig(packet *psbt.Packet, sigHashes *txscript.TxSigHashes, idx int, sig *psbt.PartialSig) bool {
+	input := packet.Inputs[idx]
+	if input.WitnessUtxo == nil || len(sig.Signature) == 0 ||
+		txscript.SigHashType(sig.Signature[len(sig.Signature)-1]) != txscript.SigHashAll {
+		return false
+	}
+
+	inScript := false
+	for _, pubKey := range witnessScriptPubKeys(input.WitnessScript) {
+		inScript = inScript || bytes.Equal(pubKey, sig.PubKey)
+	}
+	if !inScript {
+		return false
+	}
+
+	pubKey, err := btcec.ParsePubKey(sig.PubKey)
+	if err != nil {
+		return false
+	}
+	signature, err := ecdsa.ParseDERSignature(sig.Signature[:len(sig.Signature)-1])
+	if err != nil {
+		return false
+	}
+	hash, err := txscript.CalcWitnessSigHash(input.WitnessScript, sigHashes, txscript.SigHashAll,
+		packet.UnsignedTx, idx, input.WitnessUtxo.Value)
+	if err != nil {
+		return false
+	}
+	return signature.Verify(hash, pubKey)
+}
+
+// dropInvalidSignatures removes the partial signatures that do not verify
+// against the inputs' witness scripts, and the duplicates. The final scripts
+// are removed too, the wallet finalizes the tx itself from the partial
+// signatures. The witness utxos and scripts of the inputs must be set.
+func dropInvalidSignatures(packet *psbt.Packet) {
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOutFetcher)
+
+	for i := range packet.Inputs {
+		input := &packet.Inputs[i]
+		input.FinalScriptWitness = nil
+		input.FinalScriptSig = nil
+
+		var sigs []*psbt.PartialSig
+	nextSig:
+		for _, sig := range input.PartialSigs {
+			for _, valid := range sigs {
+				if bytes.Equal(valid.PubKey, sig.PubKey) {
+					continue nextSig
+				}
+			}
+			if verifyPartialSig(packet, sigHashes, i, sig) {
+				sigs = append(sigs, sig)
+			}
+		}
+		input.PartialSigs = sigs
+	}
+}
+
+// mergePSBTSignatures adds the signatures of src missing from dst. Both
+// PSBTs must be for the same unsigned tx and the signatures of src must
+// have been verified.
+func mergePSBTSignatures(dst, src *psbt.Packet) {
+	for i := range dst.Inputs {
+	nextSig:
+		for _, sig := range src.Inputs[i].PartialSigs {
+			for _, existing := range dst.Inputs[i].PartialSigs {
+				if bytes.Equal(existing.PubKey, sig.PubKey) {
+					continue nextSig
+				}
+			}
+			dst.Inputs[i].PartialSigs = append(dst.Inputs[i].PartialSigs, sig)
+		}
+	}
+}
+
+// ImportMultisigPSBT imports a PSBT shared by a cosigner. The signatures it
+// holds are added to the matching pending tx, a new pending tx is created if
+// none matches.
+func (asset *Asset) ImportMultisigPSBT(data []byte) (*sharedW.MultisigPendingTx, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	packet, err := decodePSBT(data)
+	if err != nil {
+		return nil, err
+	}
+
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := asset.multisigAddresses(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ownScripts := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		ownScripts[string(addr.pkScript)] = true
+	}
+
+	// The amounts and scripts of the inputs are taken from the wallet's own
+	// outputs, the values set by the cosigner are only checked against
+	// them. A PSBT with understated input amounts would otherwise hide the
+	// fee paid.
+	unspents, err := asset.multisigUnspents(addresses, 0)
+	if err != nil {
+		return nil, err
+	}
+	ownUnspents := make(map[wire.OutPoint]*btcjson.ListUnspentResult, len(unspents))
+	for _, utxo := range unspents {
+		hash, err := chainhash.NewHashFromStr(utxo.TxID)
+		if err != nil {
+			return nil, err
+		}
+		ownUnspents[*wire.NewOutPoint(hash, utxo.Vout)] = utxo
+	}
+
+	var inputAmount int64
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		utxo, ok := ownUnspents[txIn.PreviousOutPoint]
+		if !ok {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("input %d does not spend an unspent multisig output of this wallet", i))
+		}
+		addr := addresses[utxo.Address]
+		amount, err := btcutil.NewAmount(utxo.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		input := &packet.Inputs[i]
+		if input.WitnessUtxo != nil && (input.WitnessUtxo.Value != int64(amount) ||
+			!bytes.Equal(input.WitnessUtxo.PkScript, addr.pkScript)) {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("input %d amount or script does not match the output spent", i))
+		}
+		if input.WitnessScript != nil {
+			if err := sharedW.VerifyMultisigWitnessScript(addr.pkScript, input.WitnessScript, addr.witnessScript); err != nil {
+				return nil, err
+			}
+		}
+
+		input.WitnessUtxo = wire.NewTxOut(int64(amount), addr.pkScript)
+		input.WitnessScript = addr.witnessScript
+		input.Bip32Derivation = addr.derivations
+		input.SighashType = txscript.SigHashAll
+		inputAmount += int64(amount)
+	}
+	dropInvalidSignatures(packet)
+
+	txID := packet.UnsignedTx.TxHash().String()
+	pendingTx, existingPacket, err := asset.multisigPendingTx(txID)
+	if err == nil {
+		mergePSBTSignatures(existingPacket, packet)
+		packet = existingPacket
+	} else {
+		var amount, outputAmount int64
+		for _, txOut := range packet.UnsignedTx.TxOut {
+			outputAmount += txOut.Value
+			if !ownScripts[string(txOut.PkScript)] {
+				amount += txOut.Value
+			}
+		}
+		pendingTx = &sharedW.MultisigPendingTx{
+			TxID:      txID,
+			Amount:    amount,
+			Fee:       inputAmount - outputAmount,
+			CreatedAt: time.Now().Unix(),
+		}
+	}
+
+	if err := asset.saveMultisigPendingTx(pendingTx, packet); err != nil {
+		return nil, err
+	}
+	return pendingTx, nil
+}
+
+// ExportMultisigPSBT returns the binary PSBT of the pending tx.
+func (asset *Asset) ExportMultisigPSBT(txID string) ([]byte, error) {
+	_, packet, err := asset.multisigPendingTx(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SignMultisigTx adds the local cosigner's signatures to the pending tx.
+func (asset *Asset) SignMultisigTx(txID, privatePassphrase string) error {
+	if !asset.WalletOpened() {
+		return utils.ErrBTCNotInitialized
+	}
+
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return err
+	}
+	pendingTx, packet, err := asset.multisigPendingTx(txID)
+	if err != nil {
+		return err
+	}
+
+	local := cfg.LocalCosigner()
+	if local == nil {
+		return errors.E(utils.ErrInvalid, "the wallet holds no cosigner key")
+	}
+	localFingerprint, err := sharedW.PSBTFingerprint(local.Fingerprint)
+	if err != nil {
+		return err
+	}
+	localPath, err := sharedW.ParseDerivationPath(local.Path)
+	if err != nil {
+		return err
+	}
+
+	masterKey, err := asset.masterExtendedKey(privatePassphrase)
+	if err != nil {
+		return err
+	}
+	defer masterKey.Zero()
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOutFetcher)
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return err
+	}
+
+	for i, input := range packet.Inputs {
+		derivation := localDerivation(input.Bip32Derivation, localFingerprint, localPath)
+		if derivation == nil {
+			return errors.E(utils.ErrInvalid, fmt.Sprintf("input %d cannot be signed by this wallet", i))
+		}
+
+		signed := false
+		for _, sig := range input.PartialSigs {
+			signed = signed || bytes.Equal(sig.PubKey, derivation.PubKey)
+		}
+		if signed {
+			continue
+		}
+
+		key := masterKey
+		for _, index := range derivation.Bip32Path {
+			if key, err = key.Derive(index); err != nil {
+				return err
+			}
+		}
+		privKey, err := key.ECPrivKey()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(privKey.PubKey().SerializeCompressed(), derivation.PubKey) {
+			return errors.E(utils.ErrInvalid, fmt.Sprintf("input %d key does not match this wallet", i))
+		}
+
+		sig, err := txscript.RawTxInWitnessSignature(packet.UnsignedTx, sigHashes, i,
+			input.WitnessUtxo.Value, input.WitnessScript, txscript.SigHashAll, privKey)
+		if err != nil {
+			return err
+		}
+		if _, err := updater.Sign(i, sig, derivation.PubKey, nil, input.WitnessScript); err != nil {
+			return err
+		}
+	}
+
+	return asset.saveMultisigPendingTx(pendingTx, packet)
+}
+
+// localDerivation returns the derivation of the local cosigner's key.
+func localDerivation(derivations []*psbt.Bip32Derivation, fingerprint uint32, path []uint32) *psbt.Bip32Derivation {
+	for _, derivation := range derivations {
+		if sharedW.IsCosignerDerivation(derivation.MasterKeyFingerprint, derivation.Bip32Path, fingerprint, path) {
+			return derivation
+		}
+	}
+	return nil
+}
+
+// MultisigSignatureProgress returns the cosigners that signed each input of
+// the pending tx.
+func (asset *Asset) MultisigSignatureProgress(txID string) (*sharedW.PSBTSignatureProgress, error) {
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return nil, err
+	}
+	_, packet, err := asset.multisigPendingTx(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &sharedW.PSBTSignatureProgress{
+		Threshold: cfg.Threshold,
+		Signers:   make([][]string, len(packet.Inputs)),
+	}
+	for i, input := range packet.Inputs {
+		for _, sig := range input.PartialSigs {
+			for _, derivation := range input.Bip32Derivation {
+				if bytes.Equal(derivation.PubKey, sig.PubKey) {
+					progress.Signers[i] = append(progress.Signers[i], sharedW.FingerprintHex(derivation.MasterKeyFingerprint))
+				}
+			}
+		}
+	}
+	return progress, nil
+}
+
+// BroadcastMultisigTx finalizes the fully signed pending tx and publishes it.
+func (asset *Asset) BroadcastMultisigTx(txID string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrBTCNotInitialized
+	}
+
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	progress, err := asset.MultisigSignatureProgress(txID)
+	if err != nil {
+		return "", err
+	}
+	if !progress.IsComplete() {
+		return "", errors.E(utils.ErrInvalid, "the tx needs more signatures")
+	}
+
+	_, packet, err := asset.multisigPendingTx(txID)
+	if err != nil {
+		return "", err
+	}
+
+	// The multisig witness holds exactly the threshold of signatures.
+	for i := range packet.Inputs {
+		if len(packet.Inputs[i].PartialSigs) > progress.Threshold {
+			packet.Inputs[i].PartialSigs = packet.Inputs[i].PartialSigs[:progress.Threshold]
+		}
+	}
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return "", err
+	}
+	msgTx, err := psbt.Extract(packet)
+	if err != nil {
+		return "", err
+	}
+
+	if err := asset.Internal().BTC.PublishTransaction(msgTx, ""); err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	pendingTxs := asset.ReadMultisigPendingTxs()
+	delete(pendingTxs, txID)
+	asset.SaveMultisigPendingTxs(pendingTxs)
+	return msgTx.TxHash().String(), nil
+}
+
+// DeleteMultisigPendingTx discards the pending tx, its inputs can then be
+// spent by another tx.
+func (asset *Asset) DeleteMultisigPendingTx(txID string) error {
+	asset.multisigMu.Lock()
+	defer asset.multisigMu.Unlock()
+
+	pendingTxs := asset.ReadMultisigPendingTxs()
+	if _, ok := pendingTxs[txID]; !ok {
+		return errors.E(utils.ErrNotExist, "pending multisig tx not found")
+	}
+	delete(pendingTxs, txID)
+	asset.SaveMultisigPendingTxs(pendingTxs)
+	return nil
+}
@@ -0,0 +1,126 @@
+package btc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+func TestDropInvalidSignatures(t *testing.T) {
+	keys := make([]*btcec.PrivateKey, 3)
+	for i := range keys {
+		var err error
+		if keys[i], err = btcec.NewPrivateKey(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A 2 of 2 multisig of the first two keys, the third key is unrelated.
+	witnessScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_2).
+		AddData(keys[0].PubKey().SerializeCompressed()).
+		AddData(keys[1].PubKey().SerializeCompressed()).
+		AddOp(txscript.OP_2).
+		AddOp(txscript.OP_CHECKMULTISIG).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptHash := sha256.Sum256(witnessScript)
+	pkScript := append([]byte{txscript.OP_0, txscript.OP_DATA_32}, scriptHash[:]...)
+	const amount = 100000
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(amount-1000, pkScript))
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, amount)
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+	sign := func(key *btcec.PrivateKey, value int64) []byte {
+		sig, err := txscript.RawTxInWitnessSignature(tx, sigHashes, 0, value, witnessScript, txscript.SigHashAll, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sig
+	}
+	validSig := &psbt.PartialSig{PubKey: keys[0].PubKey().SerializeCompressed(), Signature: sign(keys[0], amount)}
+
+	tests := []struct {
+		name  string
+		sigs  []*psbt.PartialSig
+		valid int
+	}{
+		{
+			name:  "valid signature",
+			sigs:  []*psbt.PartialSig{validSig},
+			valid: 1,
+		},
+		{
+			name: "forged signature",
+			sigs: []*psbt.PartialSig{validSig, {
+				PubKey:    keys[1].PubKey().SerializeCompressed(),
+				Signature: sign(keys[2], amount),
+			}},
+			valid: 1,
+		},
+		{
+			name: "signature of another amount",
+			sigs: []*psbt.PartialSig{validSig, {
+				PubKey:    keys[1].PubKey().SerializeCompressed(),
+				Signature: sign(keys[1], amount/2),
+			}},
+			valid: 1,
+		},
+		{
+			name: "key not in the witness script",
+			sigs: []*psbt.PartialSig{validSig, {
+				PubKey:    keys[2].PubKey().SerializeCompressed(),
+				Signature: sign(keys[2], amount),
+			}},
+			valid: 1,
+		},
+		{
+			name:  "duplicate signature",
+			sigs:  []*psbt.PartialSig{validSig, validSig},
+			valid: 1,
+		},
+		{
+			name: "both signatures valid",
+			sigs: []*psbt.PartialSig{validSig, {
+				PubKey:    keys[1].PubKey().SerializeCompressed(),
+				Signature: sign(keys[1], amount),
+			}},
+			valid: 2,
+		},
+	}
+
+	for _, test := range tests {
+		packet, err := psbt.NewFromUnsignedTx(tx.Copy())
+		if err != nil {
+			t.Fatal(err)
+		}
+		packet.Inputs[0].WitnessUtxo = wire.NewTxOut(amount, pkScript)
+		packet.Inputs[0].WitnessScript = witnessScript
+		packet.Inputs[0].PartialSigs = test.sigs
+		packet.Inputs[0].FinalScriptWitness = []byte{0x01}
+
+		dropInvalidSignatures(packet)
+
+		input := packet.Inputs[0]
+		if len(input.PartialSigs) != test.valid {
+			t.Errorf("%s: expected %d signatures, got %d", test.name, test.valid, len(input.PartialSigs))
+		}
+		if !bytes.Equal(input.PartialSigs[0].Signature, validSig.Signature) {
+			t.Errorf("%s: expected the valid signature to be kept", test.name)
+		}
+		if input.FinalScriptWitness != nil {
+			t.Errorf("%s: expected the final script witness to be removed", test.name)
+		}
+	}
+}
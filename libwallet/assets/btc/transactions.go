@@ -141,6 +141,46 @@ func (asset *Asset) GetTransactionsRaw(offset, limit, txFilter int32, newestFirs
 	return txs, nil
 }
 
+// QueryTransactions returns the transactions matching the query. Ticket
+// statuses are not supported, querying them returns
+// utils.ErrTicketStatusUnsupported.
+func (asset *Asset) QueryTransactions(query *sharedW.TxQuery) ([]*sharedW.Transaction, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+	if query.TicketStatus != sharedW.AnyTicketStatus {
+		return nil, utils.ErrTicketStatusUnsupported
+	}
+
+	transactions, err := asset.getTransactionsRaw(0, 0, query.NewestFirst)
+	if err != nil {
+		return nil, err
+	}
+
+	txType := asset.btcSupportedTxFilter(query.TxFilter)
+	addressOutpoints := query.AddressOutpoints(transactions)
+	requiredConfirmations, bestBlock := asset.RequiredConfirmations(), asset.GetBestBlockHeight()
+	txs := make([]*sharedW.Transaction, 0)
+	var skipped int32
+	for _, tx := range transactions {
+		if query.Limit > 0 && len(txs) == int(query.Limit) {
+			break
+		}
+		if txType != txhelper.TxDirectionAll && tx.Direction != txType {
+			continue
+		}
+		if !query.Match(tx, requiredConfirmations, bestBlock, addressOutpoints) {
+			continue
+		}
+		if skipped < query.Offset {
+			skipped++
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
 func (asset *Asset) btcSupportedTxFilter(txFilter int32) int32 {
 	switch txFilter {
 	case utils.TxFilterSent:
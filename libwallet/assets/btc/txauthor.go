@@ -38,6 +38,8 @@ type TxAuthor struct {
 	needsConstruct bool
 
 	selectedUXTOs []*sharedW.UnspentOutput
+	// feeRate overrides the user fee rate for this tx if set.
+	feeRate btcutil.Amount
 
 	mu sync.RWMutex
 }
@@ -95,7 +97,16 @@ func (asset *Asset) ComputeTxSizeEstimation(dstAddress string, utxos []*sharedW.
 		return -1, fmt.Errorf("computing utxo size failed: %v", err)
 	}
 
-	estimatedSize := txsizes.EstimateSerializeSize(len(utxos), []*wire.TxOut{output}, true)
+	prevScripts := make([][]byte, 0, len(utxos))
+	for _, utxo := range utxos {
+		script, err := hex.DecodeString(utxo.ScriptPubKey)
+		if err != nil {
+			return -1, fmt.Errorf("invalid utxo pkScript: %v", err)
+		}
+		prevScripts = append(prevScripts, script)
+	}
+
+	estimatedSize := estimateVirtualSize(prevScripts, []*wire.TxOut{output}, txsizes.P2WPKHPkScriptSize)
 	return estimatedSize, nil
 }
 
@@ -126,6 +137,29 @@ func (asset *Asset) AddSendDestination(id int, address string, satoshiAmount int
 	return nil
 }
 
+// SetUnsignedTxFeeRate sets the fee rate of the unsigned tx, in place of the
+// user fee rate, without changing the fee rate of the other txs.
+func (asset *Asset) SetUnsignedTxFeeRate(feeRatePerkvB int64) error {
+	if feeRatePerkvB < int64(MinFeeRatePerkvB) {
+		return fmt.Errorf("minimum rate is %d Sat/kvB", int64(MinFeeRatePerkvB))
+	}
+
+	asset.TxAuthoredInfo.mu.Lock()
+	defer asset.TxAuthoredInfo.mu.Unlock()
+
+	asset.TxAuthoredInfo.feeRate = btcutil.Amount(feeRatePerkvB)
+	asset.TxAuthoredInfo.needsConstruct = true
+	return nil
+}
+
+// unsignedTxFeeRate returns the fee rate of the unsigned tx.
+func (asset *Asset) unsignedTxFeeRate() btcutil.Amount {
+	if asset.TxAuthoredInfo.feeRate > 0 {
+		return asset.TxAuthoredInfo.feeRate
+	}
+	return btcutil.Amount(asset.GetUserFeeRate().ToInt())
+}
+
 func (asset *Asset) UpdateSendDestination(id int, address string, atomAmount int64, sendMax bool) error {
 	if err := asset.validateSendAmount(sendMax, atomAmount); err != nil {
 		return err
@@ -237,14 +271,12 @@ func (asset *Asset) EstimateFeeAndSize() (*sharedW.TxFeeAndSize, error) {
 		}
 	}
 
-	// TODO: confirm if the size on UI needs to be in vB to B.
-	// This estimation returns size in Bytes (B).
-	estimatedSize := txsizes.EstimateSerializeSize(len(unsignedTx.Tx.TxIn), unsignedTx.Tx.TxOut, true)
-	// This estimation returns size in virtualBytes (vB).
-	// estimatedSize := feeToSpend.ToBTC() / fallBackFeeRate.ToBTC()
+	// This estimation returns size in virtual bytes (vB). Inputs are sized
+	// according to their type as the account may hold any address type.
+	estimatedSize := estimateVirtualSize(unsignedTx.PrevScripts, unsignedTx.Tx.TxOut, 0)
 
 	return &sharedW.TxFeeAndSize{
-		FeeRate:             asset.GetUserFeeRate().ToInt(),
+		FeeRate:             int64(asset.unsignedTxFeeRate()),
 		EstimatedSignedSize: estimatedSize,
 		Fee:                 feeAmount,
 		Change:              change,
@@ -307,12 +339,22 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 		log.Errorf("unlocking the wallet failed: %v", err)
 		return "", errors.New(utils.ErrInvalidPassphrase)
 	}
+	asset.addAccountsToAddressScopes()
 
 	// To discourage fee sniping, LockTime is explicitly set in the raw tx.
 	// More documentation on this:
 	// https://bitcoin.stackexchange.com/questions/48384/why-bitcoin-core-creates-time-locked-transactions-by-default
 	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
 
+	// Taproot signatures commit to all the outputs spent by the tx, so the
+	// sighashes are computed with every previous output known.
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for index, txIn := range msgTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, wire.NewTxOut(
+			int64(asset.TxAuthoredInfo.inputValues[index]), unsignedTx.PrevScripts[index]))
+	}
+	sigHashes := txscript.NewTxSigHashes(msgTx, prevOutFetcher)
+
 	for index, txIn := range msgTx.TxIn {
 		_, previousTXout, _, _, err := asset.Internal().BTC.FetchInputInfo(&txIn.PreviousOutPoint)
 		if err != nil {
@@ -320,10 +362,7 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 			return "", err
 		}
 
-		prevOutScript := unsignedTx.PrevScripts[index]
 		prevOutAmount := int64(asset.TxAuthoredInfo.inputValues[index])
-		prevOutFetcher := txscript.NewCannedPrevOutputFetcher(prevOutScript, prevOutAmount)
-		sigHashes := txscript.NewTxSigHashes(msgTx, prevOutFetcher)
 
 		witness, signature, err := asset.Internal().BTC.ComputeInputScript(
 			msgTx, previousTXout, index, sigHashes, txscript.SigHashAll, nil,
@@ -339,8 +378,9 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 		// Prove that the transaction has been validly signed by executing the
 		// script pair.
 		flags := txscript.ScriptBip16 | txscript.ScriptVerifyDERSignatures |
-			txscript.ScriptStrictMultiSig | txscript.ScriptDiscourageUpgradableNops
-		vm, err := txscript.NewEngine(previousTXout.PkScript, msgTx, 0, flags, nil, nil,
+			txscript.ScriptStrictMultiSig | txscript.ScriptDiscourageUpgradableNops |
+			txscript.ScriptVerifyWitness | txscript.ScriptVerifyTaproot
+		vm, err := txscript.NewEngine(previousTXout.PkScript, msgTx, index, flags, nil, sigHashes,
 			prevOutAmount, prevOutFetcher)
 		if err != nil {
 			log.Errorf("creating validation engine failed: %v", err)
@@ -390,7 +430,7 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 	var err error
 	outputs := make([]*wire.TxOut, 0)
 	var changeSource *txauthor.ChangeSource
-	setFeeRate := btcutil.Amount(asset.GetUserFeeRate().ToInt())
+	setFeeRate := asset.unsignedTxFeeRate()
 	var sendMax bool
 
 	for _, destination := range asset.TxAuthoredInfo.destinations {
@@ -478,7 +518,7 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 func (asset *Asset) changeSource() (*txauthor.ChangeSource, error) {
 	if asset.TxAuthoredInfo.changeAddress == "" {
 		changeAccount := asset.TxAuthoredInfo.sourceAccountNumber
-		address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, GetScope())
+		address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, asset.accountScope())
 		if err != nil {
 			return nil, fmt.Errorf("change address error: %v", err)
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb" // bdb init() registers a driver
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/internal/loader"
@@ -56,6 +57,13 @@ type Asset struct {
 	// been introduced.
 	fees feeEstimateCache
 
+	// multisigMu guards the updates of the multisig config and pending txs.
+	multisigMu sync.Mutex
+
+	// descriptorScope is the key scope of the accounts of a watch-only
+	// wallet created from a single key descriptor.
+	descriptorScope *waddrmgr.KeyScope
+
 	notificationListenersMu sync.RWMutex
 
 	syncData                        *SyncData
@@ -146,6 +154,17 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		return nil, err
 	}
 
+	// Watch-only wallets are created from either an account xpub or an
+	// output descriptor.
+	var desc *sharedW.Descriptor
+	if sharedW.IsDescriptor(extendedPublicKey) {
+		desc, err = parseWatchOnlyDescriptor(extendedPublicKey, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		extendedPublicKey = ""
+	}
+
 	ldr := initWalletLoader(chainParams, params.RootDir)
 	w, err := sharedW.CreateWatchOnlyWallet(walletName, extendedPublicKey,
 		ldr, params, utils.BTCWalletAsset)
@@ -166,6 +185,15 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		return nil, err
 	}
 
+	if desc != nil {
+		if err := btcWallet.importDescriptor(desc); err != nil {
+			if delErr := btcWallet.DeleteWallet(""); delErr != nil {
+				log.Errorf("(%v) Deleting the incomplete watch-only wallet failed: %v", btcWallet.GetWalletName(), delErr)
+			}
+			return nil, err
+		}
+	}
+
 	btcWallet.SetNetworkCancelCallback(btcWallet.SafelyCancelSync)
 
 	return btcWallet, nil
@@ -250,6 +278,10 @@ func LoadExisting(w *sharedW.Wallet, params *sharedW.InitParams) (sharedW.Asset,
 		return nil, err
 	}
 
+	if err := btcWallet.loadDescriptorScope(); err != nil {
+		return nil, err
+	}
+
 	if err := btcWallet.prepareChain(); err != nil {
 		return nil, err
 	}
@@ -480,15 +512,15 @@ func (asset *Asset) SetSpecificPeer(addresses string) {
 }
 
 // GetExtendedPubKey returns the extended public key of the given account,
-// to do that it calls btcwallet's AccountProperties method, using KeyScopeBIP0084
-// and the account number. On failure it returns error.
+// to do that it calls btcwallet's AccountProperties method, using the key
+// scope of the wallet's accounts and the account number. On failure it returns error.
 func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 	loadedAsset := asset.Internal().BTC
 	if loadedAsset == nil {
 		return "", utils.ErrBTCNotInitialized
 	}
 
-	extendedPublicKey, err := loadedAsset.AccountProperties(GetScope(), uint32(account))
+	extendedPublicKey, err := loadedAsset.AccountProperties(asset.accountScope(), uint32(account))
 	if err != nil {
 		return "", err
 	}
@@ -498,7 +530,7 @@ func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 // AccountXPubMatches checks if the xpub of the provided account matches the
 // provided xpub.
 func (asset *Asset) AccountXPubMatches(account uint32, xPub string) (bool, error) {
-	acctXPubKey, err := asset.Internal().BTC.AccountProperties(GetScope(), account)
+	acctXPubKey, err := asset.Internal().BTC.AccountProperties(asset.accountScope(), account)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,408 @@
+package dcr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	w "decred.org/dcrwallet/v4/wallet"
+	"decred.org/dcrwallet/v4/wallet/udb"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// deepRecoveryProgressInterval is the number of blocks scanned between two
+// deep recovery progress reports.
+const deepRecoveryProgressInterval = 2000
+
+// bip44Purpose is the purpose of the BIP0044 key scopes used by dcrwallet.
+const bip44Purpose = 44
+
+// recoveredAddress is the derivation path of an address watched during a
+// deep recovery.
+type recoveredAddress struct {
+	account uint32
+	branch  uint32
+	index   uint32
+}
+
+// DeepRecoveryKeyScopes returns the key scopes that can be scanned during a
+// deep recovery, i.e. BIP0044 with the legacy and SLIP0044 coin types.
+func (asset *Asset) DeepRecoveryKeyScopes() []sharedW.KeyScope {
+	legacyCoinType, slip0044CoinType := udb.CoinTypes(asset.chainParams)
+	return []sharedW.KeyScope{
+		{Purpose: bip44Purpose, Coin: slip0044CoinType},
+		{Purpose: bip44Purpose, Coin: legacyCoinType},
+	}
+}
+
+// IsDeepRecovering returns true if a deep recovery is in progress.
+func (asset *Asset) IsDeepRecovering() bool {
+	asset.syncData.mu.RLock()
+	defer asset.syncData.mu.RUnlock()
+	return asset.syncData.deepRecovering
+}
+
+// DeepRecovery scans the chain from the wallet's birthday block for the
+// addresses of the provided key scopes using the gap limits in params. Used accounts and addresses are then added
+// to the wallet and their txs are recovered with a rescan. A wallet only
+// supports a single coin type, addresses found under the SLIP0044 coin type
+// upgrade a wallet still on the legacy coin type. The scan runs in the
+// background, its progress is reported through the sync progress listeners.
+func (asset *Asset) DeepRecovery(privatePassphrase string, params *sharedW.DeepRecoveryParams) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+
+	if asset.IsWatchingOnlyWallet() {
+		return errors.E(utils.ErrWalletIsWatchOnly)
+	}
+
+	if !asset.IsSynced() {
+		return errors.New(utils.ErrNotSynced)
+	}
+
+	if asset.IsRescanning() || asset.IsDeepRecovering() {
+		return errors.New(utils.ErrSyncAlreadyInProgress)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	for _, scope := range params.KeyScopes {
+		if scope.Purpose != bip44Purpose {
+			return errors.E(utils.ErrInvalid, fmt.Sprintf("unsupported key scope %v", scope))
+		}
+	}
+
+	seedMnemonic, err := asset.DecryptSeed(privatePassphrase)
+	if err != nil {
+		return err
+	}
+
+	seedType := sharedW.SeedTypeFromMnemonic(seedMnemonic, asset.Type)
+	seed, err := sharedW.DecodeSeedMnemonic(seedMnemonic, asset.Type, seedType)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for i := range seed {
+			seed[i] = 0
+		}
+	}()
+
+	masterNode, err := hdkeychain.NewMaster(seed, asset.chainParams)
+	if err != nil {
+		return err
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	startHeight := asset.birthdayHeight(ctx)
+
+	// The wallet needs to be unlocked to add the recovered accounts. It is
+	// only locked again if it was locked before.
+	wasLocked := asset.IsLocked()
+	if wasLocked {
+		if err = asset.UnlockWallet(privatePassphrase); err != nil {
+			masterNode.Zero()
+			return err
+		}
+	}
+
+	asset.syncData.mu.Lock()
+	asset.syncData.deepRecovering = true
+	asset.syncData.mu.Unlock()
+
+	go func() {
+		defer func() {
+			masterNode.Zero()
+			if wasLocked {
+				asset.LockWallet()
+			}
+
+			asset.syncData.mu.Lock()
+			asset.syncData.deepRecovering = false
+			asset.syncData.mu.Unlock()
+		}()
+
+		if err := asset.deepRecovery(ctx, masterNode, params, startHeight); err != nil {
+			log.Errorf("[%d] Deep recovery failed: %v", asset.ID, err)
+		}
+	}()
+
+	return nil
+}
+
+// birthdayHeight returns the height of the wallet's birthday block, the
+// first block that can hold txs of the wallet. It is 1 if the birthday is
+// not known yet.
+func (asset *Asset) birthdayHeight(ctx context.Context) int32 {
+	bs, err := asset.Internal().DCR.BirthState(ctx)
+	if err != nil {
+		log.Errorf("[%d] Error reading the wallet birthday: %v", asset.ID, err)
+		return 1
+	}
+	// A birthday set from a time is only resolved to a block once the
+	// block of that time is found.
+	if bs == nil || bs.SetFromTime || bs.Height < 1 {
+		return 1
+	}
+	return int32(bs.Height)
+}
+
+func (asset *Asset) deepRecovery(ctx context.Context, masterNode *hdkeychain.ExtendedKey,
+	params *sharedW.DeepRecoveryParams, startHeight int32,
+) error {
+	startTime := time.Now()
+	var usedScopes []*sharedW.ScopeRecoveryState
+
+	for i, scope := range params.KeyScopes {
+		state := sharedW.NewScopeRecoveryState(scope, params)
+		err := asset.scanKeyScope(ctx, masterNode, state, startHeight, int32(i), int32(len(params.KeyScopes)))
+		if err != nil {
+			return fmt.Errorf("scanning %v: %w", scope, err)
+		}
+
+		if state.IsUsed() {
+			usedScopes = append(usedScopes, state)
+		}
+	}
+
+	discoveredAccounts := int32(0)
+	for _, state := range usedScopes {
+		if err := asset.importRecoveredScope(ctx, state); err != nil {
+			return fmt.Errorf("importing %v: %w", state.Scope, err)
+		}
+		discoveredAccounts += int32(state.LastUsedAccount() + 1)
+	}
+
+	log.Infof("[%d] Deep recovery found %d account(s) in %v", asset.ID,
+		discoveredAccounts, time.Since(startTime).Round(time.Second))
+
+	report := &sharedW.AddressDiscoveryProgressReport{
+		AddressDiscoveryProgress: 100,
+		TotalDiscoveryTimeSpent:  time.Since(startTime),
+		TotalKeyScopes:           int32(len(params.KeyScopes)),
+		CurrentScanHeight:        asset.GetBestBlockHeight(),
+		DiscoveredAccounts:       discoveredAccounts,
+		DeepRecoveryFinished:     true,
+	}
+	for _, listener := range asset.syncProgressListeners() {
+		if listener.OnAddressDiscoveryProgress != nil {
+			listener.OnAddressDiscoveryProgress(report)
+		}
+	}
+
+	if len(usedScopes) == 0 {
+		return nil
+	}
+	return asset.RescanBlocksFromHeight(startHeight)
+}
+
+// scanKeyScope matches the addresses of the scope against the block filters
+// of the main chain from startHeight to the chain tip, extending the
+// derivation horizon every time a used address is found.
+func (asset *Asset) scanKeyScope(ctx context.Context, masterNode *hdkeychain.ExtendedKey,
+	state *sharedW.ScopeRecoveryState, startHeight, scopeIndex, totalScopes int32,
+) error {
+	dcrWallet := asset.Internal().DCR
+	netBackend, err := dcrWallet.NetworkBackend()
+	if err != nil {
+		return errors.E(utils.ErrNotConnected)
+	}
+
+	coinTypeKey, err := masterNode.Child(hdkeychain.HardenedKeyStart + state.Scope.Purpose)
+	if err == nil {
+		coinTypeKey, err = coinTypeKey.Child(hdkeychain.HardenedKeyStart + state.Scope.Coin)
+	}
+	if err != nil {
+		return err
+	}
+
+	watched := make(map[string]recoveredAddress)
+	var scripts [][]byte
+	branchKeys := make(map[recoveredAddress]*hdkeychain.ExtendedKey)
+
+	deriveScripts := func() error {
+		for _, r := range state.NextRanges() {
+			branchPath := recoveredAddress{account: r.Account, branch: r.Branch}
+			branchKey, ok := branchKeys[branchPath]
+			if !ok {
+				acctKey, err := coinTypeKey.Child(hdkeychain.HardenedKeyStart + r.Account)
+				if err != nil {
+					return err
+				}
+				if branchKey, err = acctKey.Child(r.Branch); err != nil {
+					return err
+				}
+				branchKeys[branchPath] = branchKey
+			}
+
+			for index := r.From; index < r.To; index++ {
+				child, err := branchKey.Child(index)
+				if errors.Is(err, hdkeychain.ErrInvalidChild) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+
+				pubKeyHash := dcrutil.Hash160(child.SerializedPubKey())
+				addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pubKeyHash, asset.chainParams)
+				if err != nil {
+					return err
+				}
+
+				_, pkScript := addr.PaymentScript()
+				watched[string(pkScript)] = recoveredAddress{r.Account, r.Branch, index}
+				scripts = append(scripts, pkScript)
+			}
+		}
+		return nil
+	}
+
+	if err = deriveScripts(); err != nil {
+		return err
+	}
+
+	scope := state.Scope
+	bestHeight := asset.GetBestBlockHeight()
+	for height := startHeight; height <= bestHeight; height++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if (height-startHeight)%deepRecoveryProgressInterval == 0 {
+			progress := int32(0)
+			if bestHeight > startHeight {
+				progress = (height - startHeight) * 100 / (bestHeight - startHeight)
+			}
+			report := &sharedW.AddressDiscoveryProgressReport{
+				AddressDiscoveryProgress: progress,
+				KeyScope:                 &scope,
+				KeyScopeIndex:            scopeIndex,
+				TotalKeyScopes:           totalScopes,
+				CurrentScanHeight:        height,
+				DiscoveredAccounts:       int32(state.LastUsedAccount() + 1),
+			}
+			for _, listener := range asset.syncProgressListeners() {
+				if listener.OnAddressDiscoveryProgress != nil {
+					listener.OnAddressDiscoveryProgress(report)
+				}
+			}
+		}
+
+		blockInfo, err := dcrWallet.BlockInfo(ctx, w.NewBlockIdentifierFromHeight(height))
+		if err != nil {
+			return err
+		}
+
+		key, filter, err := dcrWallet.CFilterV2(ctx, &blockInfo.Hash)
+		if err != nil {
+			return err
+		}
+		if filter.N() == 0 || !filter.MatchAny(key, scripts) {
+			continue
+		}
+
+		blocks, err := netBackend.Blocks(ctx, []*chainhash.Hash{&blockInfo.Hash})
+		if err != nil {
+			return err
+		}
+
+		// Addresses derived after a used one is found could also be used
+		// in the same block, keep checking until the horizon stops moving.
+		for {
+			extended := false
+			for _, tx := range append(blocks[0].Transactions, blocks[0].STransactions...) {
+				for _, txOut := range tx.TxOut {
+					addr, ok := watched[string(untaggedScript(txOut.PkScript))]
+					if ok && state.MarkUsed(addr.account, addr.branch, addr.index) {
+						extended = true
+					}
+				}
+			}
+
+			if !extended {
+				break
+			}
+			if err = deriveScripts(); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Infof("[%d] Deep recovery of %v done, last used account: %d", asset.ID,
+		scope, state.LastUsedAccount())
+	return nil
+}
+
+// importRecoveredScope adds the used accounts and addresses of the scope to
+// the wallet.
+func (asset *Asset) importRecoveredScope(ctx context.Context, state *sharedW.ScopeRecoveryState) error {
+	dcrWallet := asset.Internal().DCR
+	coinType, err := dcrWallet.CoinType(ctx)
+	if err != nil {
+		return err
+	}
+
+	if coinType != state.Scope.Coin {
+		_, slip0044CoinType := udb.CoinTypes(asset.chainParams)
+		if state.Scope.Coin != slip0044CoinType {
+			return errors.E(utils.ErrInvalid, fmt.Sprintf("funds found under %v can't be "+
+				"recovered into a wallet using coin type %d, restore the seed "+
+				"with another wallet software", state.Scope, coinType))
+		}
+
+		// The upgrade fails if any address of the legacy coin type is used.
+		if err = dcrWallet.UpgradeToSLIP0044CoinType(ctx); err != nil {
+			return err
+		}
+		log.Infof("[%d] Upgraded the wallet to the SLIP0044 coin type", asset.ID)
+	}
+
+	lastUsedAccount := uint32(state.LastUsedAccount())
+	for account := uint32(0); account <= lastUsedAccount; account++ {
+		_, err := dcrWallet.AccountName(ctx, account)
+		if errors.Is(err, errors.NotExist) {
+			_, err = dcrWallet.NextAccount(ctx, fmt.Sprintf("recovered-%d", account))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, acct := range state.Accounts {
+		for _, branch := range []uint32{sharedW.ExternalBranch, sharedW.InternalBranch} {
+			if lastUsed := acct.LastUsed[branch]; lastUsed >= 0 {
+				err = dcrWallet.SyncLastReturnedAddress(ctx, acct.Account, branch, uint32(lastUsed))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// untaggedScript strips the stake opcode of stake-tagged scripts so that
+// they match the P2PKH scripts of the watched addresses.
+func untaggedScript(pkScript []byte) []byte {
+	if len(pkScript) == 0 {
+		return pkScript
+	}
+
+	switch pkScript[0] {
+	case txscript.OP_SSTX, txscript.OP_SSGEN, txscript.OP_SSRTX,
+		txscript.OP_SSTXCHANGE, txscript.OP_TGEN:
+		return pkScript[1:]
+	}
+	return pkScript
+}
@@ -0,0 +1,55 @@
+package dcr
+
+import (
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/decred/dcrd/wire"
+)
+
+// rollbackDetachedBlocks reverts the tx index to the block before the lowest
+// detached block. It returns the block heights the affected txs were mined
+// at before the reorg, keyed by the tx hash.
+func (asset *Asset) rollbackDetachedBlocks(detachedBlocks []*wire.BlockHeader) (map[string]int32, error) {
+	forkHeight := int32(detachedBlocks[0].Height)
+	for _, header := range detachedBlocks {
+		if int32(header.Height) < forkHeight {
+			forkHeight = int32(header.Height)
+		}
+	}
+
+	log.Infof("[%d] Chain reorg detected, %d block(s) detached from height %d",
+		asset.ID, len(detachedBlocks), forkHeight)
+
+	var transactions []*sharedW.Transaction
+	err := asset.GetWalletDataDb().RollbackToHeight(forkHeight, &transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	reorgedTxs := make(map[string]int32, len(transactions))
+	for _, tx := range transactions {
+		reorgedTxs[tx.Hash] = tx.BlockHeight
+	}
+	return reorgedTxs, nil
+}
+
+// reconcileReorgedTxs re-indexes the txs from the fork point and notifies
+// the listeners of the new block heights of the txs affected by a reorg.
+func (asset *Asset) reconcileReorgedTxs(reorgedTxs map[string]int32) {
+	if err := asset.IndexTransactions(); err != nil {
+		log.Errorf("[%d] Post-reorg tx re-index error: %v", asset.ID, err)
+	}
+
+	for txHash, oldBlockHeight := range reorgedTxs {
+		newBlockHeight := sharedW.UnminedTxHeight
+		var tx sharedW.Transaction
+		if err := asset.GetWalletDataDb().FindOne("Hash", txHash, &tx); err == nil {
+			newBlockHeight = tx.BlockHeight
+		}
+
+		if newBlockHeight != oldBlockHeight {
+			log.Infof("[%d] Tx %s moved from block %d to %d after reorg",
+				asset.ID, txHash, oldBlockHeight, newBlockHeight)
+		}
+		asset.publishTransactionReorged(txHash, oldBlockHeight, newBlockHeight)
+	}
+}
@@ -37,6 +37,7 @@ type SyncData struct {
 	restartSyncRequested bool
 
 	rescanning          bool
+	deepRecovering      bool
 	numOfConnectedPeers int32
 
 	activeSyncData *activeSyncData
@@ -125,6 +125,29 @@ func (asset *Asset) GetTransactionsRaw(offset, limit, txFilter int32, newestFirs
 	return
 }
 
+// QueryTransactions returns the transactions matching the query. The
+// transactions of the query period are looked up using the Timestamp index.
+func (asset *Asset) QueryTransactions(query *sharedW.TxQuery) ([]*sharedW.Transaction, error) {
+	var addressOutpoints map[string]bool
+	if query.Address != "" {
+		var addressTxs []*sharedW.Transaction
+		if err := asset.GetWalletDataDb().Find(query.AddressMatcher(), &addressTxs); err != nil {
+			return nil, err
+		}
+		addressOutpoints = query.AddressOutpoints(addressTxs)
+	}
+
+	bestBlock := asset.GetBestBlockHeight()
+	matcher := q.And(
+		query.ConfirmationMatcher(asset.RequiredConfirmations(), bestBlock),
+		query.Matcher(addressOutpoints),
+	)
+	transactions := make([]*sharedW.Transaction, 0)
+	err := asset.GetWalletDataDb().Query(query.Offset, query.Limit, query.Filter(), query.NewestFirst,
+		bestBlock, query.From, query.To, matcher, &transactions)
+	return transactions, err
+}
+
 func (asset *Asset) CountTransactions(txFilter int32) (int, error) {
 	return asset.GetWalletDataDb().Count(txFilter, asset.RequiredConfirmations(), asset.GetBestBlockHeight(), &sharedW.Transaction{})
 }
@@ -16,6 +16,18 @@ func (asset *Asset) listenForTransactions() {
 				if v == nil {
 					return
 				}
+
+				var reorgedTxs map[string]int32
+				if len(v.DetachedBlocks) > 0 {
+					var err error
+					reorgedTxs, err = asset.rollbackDetachedBlocks(v.DetachedBlocks)
+					if err != nil {
+						// The txs of the new chain are still indexed below,
+						// the affected txs are only not reported.
+						log.Errorf("[%d] Reorg tx rollback error: %v", asset.ID, err)
+					}
+				}
+
 				for _, transaction := range v.UnminedTransactions {
 					tempTransaction, err := asset.decodeTransactionWithTxSummary(&transaction, nil)
 					if err != nil {
@@ -59,6 +71,10 @@ func (asset *Asset) listenForTransactions() {
 					asset.checkWalletMixers()
 				}
 
+				if len(v.DetachedBlocks) > 0 {
+					go asset.reconcileReorgedTxs(reorgedTxs)
+				}
+
 			case <-asset.syncData.syncCanceled:
 				n.Done()
 			}
@@ -143,6 +159,17 @@ func (asset *Asset) publishBlockAttached(blockHeight int32) {
 	}
 }
 
+func (asset *Asset) publishTransactionReorged(transactionHash string, oldBlockHeight, newBlockHeight int32) {
+	asset.notificationListenersMu.RLock()
+	defer asset.notificationListenersMu.RUnlock()
+
+	for _, txAndBlockNotificationListener := range asset.txAndBlockNotificationListeners {
+		if txAndBlockNotificationListener.OnTransactionReorged != nil {
+			go txAndBlockNotificationListener.OnTransactionReorged(asset.ID, transactionHash, oldBlockHeight, newBlockHeight)
+		}
+	}
+}
+
 func (asset *Asset) IsNotificationListenerExist(uniqueIdentifier string) bool {
 	_, ok := asset.txAndBlockNotificationListeners[uniqueIdentifier]
 	return ok
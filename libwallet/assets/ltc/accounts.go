@@ -47,7 +47,7 @@ func (asset *Asset) GetAccountsRaw() (*sharedW.Accounts, error) {
 		return nil, utils.ErrLTCNotInitialized
 	}
 
-	resp, err := asset.Internal().LTC.Accounts(GetScope())
+	resp, err := asset.Internal().LTC.Accounts(asset.accountScope())
 	if err != nil {
 		return nil, err
 	}
@@ -165,20 +165,29 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 		return nil, utils.ErrLTCNotInitialized
 	}
 
-	accountName, err := asset.AccountName(account)
-	if err != nil {
+	if _, err := asset.AccountName(account); err != nil {
 		return nil, err
 	}
 
-	// Only return UTXOs with the required number of confirmations.
+	// Only return UTXOs with the required number of confirmations. The
+	// account's UTXOs may be held by addresses of any key scope, so they
+	// are matched by account number rather than by account name.
 	unspents, err := asset.Internal().LTC.ListUnspent(asset.RequiredConfirmations(),
-		math.MaxInt32, accountName)
+		math.MaxInt32, "")
 	if err != nil {
 		return nil, err
 	}
 	resp := make([]*sharedW.UnspentOutput, 0, len(unspents))
 
 	for _, utxo := range unspents {
+		addr, err := ltcutil.DecodeAddress(utxo.Address, asset.chainParams)
+		if err != nil {
+			continue
+		}
+		if accountNumber, err := asset.Internal().LTC.AccountOfAddress(addr); err != nil || accountNumber != uint32(account) {
+			continue
+		}
+
 		// error returned is ignored because the amount value is from upstream
 		// and doesn't require an extra layer of validation.
 		amount, _ := ltcutil.NewAmount(utxo.Amount)
@@ -226,11 +235,13 @@ func (asset *Asset) NextAccount(accountName string) (int32, error) {
 		return -1, errors.New(utils.ErrWalletLocked)
 	}
 
-	accountNumber, err := asset.Internal().LTC.NextAccount(GetScope(), accountName)
+	accountNumber, err := asset.Internal().LTC.NextAccount(asset.accountScope(), accountName)
 	if err != nil {
 		return -1, err
 	}
 
+	asset.addAccountToAddressScopes(accountNumber)
+
 	return int32(accountNumber), nil
 }
 
@@ -240,11 +251,21 @@ func (asset *Asset) RenameAccount(accountNumber int32, newName string) error {
 		return utils.ErrLTCNotInitialized
 	}
 
-	err := asset.Internal().LTC.RenameAccount(GetScope(), uint32(accountNumber), newName)
+	err := asset.Internal().LTC.RenameAccount(asset.accountScope(), uint32(accountNumber), newName)
 	if err != nil {
 		return utils.TranslateError(err)
 	}
 
+	// Keep the account name in sync across the other address scopes.
+	for _, scope := range addressKeyScopes[1:] {
+		if _, err := asset.Internal().LTC.AccountName(scope, uint32(accountNumber)); err != nil {
+			continue
+		}
+		if err := asset.Internal().LTC.RenameAccount(scope, uint32(accountNumber), newName); err != nil {
+			log.Errorf("(%v) Renaming account %d in %v failed: %v", asset.GetWalletName(), accountNumber, scope, err)
+		}
+	}
+
 	return nil
 }
 
@@ -264,7 +285,7 @@ func (asset *Asset) AccountNameRaw(accountNumber uint32) (string, error) {
 		return "", utils.ErrLTCNotInitialized
 	}
 
-	return asset.Internal().LTC.AccountName(GetScope(), accountNumber)
+	return asset.Internal().LTC.AccountName(asset.accountScope(), accountNumber)
 }
 
 // AccountNumber returns the account number for the provided account name.
@@ -273,7 +294,7 @@ func (asset *Asset) AccountNumber(accountName string) (int32, error) {
 		return -1, utils.ErrLTCNotInitialized
 	}
 
-	accountNumber, err := asset.Internal().LTC.AccountNumber(GetScope(), accountName)
+	accountNumber, err := asset.Internal().LTC.AccountNumber(asset.accountScope(), accountName)
 	return int32(accountNumber), utils.TranslateError(err)
 }
 
@@ -284,7 +305,7 @@ func (asset *Asset) HasAccount(accountName string) bool {
 		return false
 	}
 
-	_, err := asset.Internal().LTC.AccountNumber(GetScope(), accountName)
+	_, err := asset.Internal().LTC.AccountNumber(asset.accountScope(), accountName)
 	return err == nil
 }
 
@@ -0,0 +1,263 @@
+package ltc
+
+import (
+	"fmt"
+
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	ltcloader "github.com/crypto-power/cryptopower/libwallet/internal/loader/ltc"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/dcrlabs/ltcwallet/waddrmgr"
+	"github.com/ltcsuite/ltcd/chaincfg"
+)
+
+// Asset confirms that LTC accounts can be exported as output descriptors.
+var _ sharedW.DescriptorAsset = (*Asset)(nil)
+
+// descriptorScripts are the descriptor script types of the address key
+// scopes, keyed by their BIP43 purpose.
+var descriptorScripts = map[uint32]sharedW.DescriptorScript{
+	44: sharedW.DescriptorPKH,
+	49: sharedW.DescriptorSHWPKH,
+	84: sharedW.DescriptorWPKH,
+	86: sharedW.DescriptorTR,
+}
+
+// addressKeyScope returns the address key scope of the BIP43 purpose.
+func addressKeyScope(purpose uint32) (waddrmgr.KeyScope, error) {
+	for _, scope := range addressKeyScopes {
+		if scope.Purpose == purpose {
+			return scope, nil
+		}
+	}
+	return waddrmgr.KeyScope{}, errors.E(utils.ErrInvalid, fmt.Sprintf("unsupported BIP43 purpose %d", purpose))
+}
+
+// parseWatchOnlyDescriptor parses the descriptor a watch-only wallet is
+// created from. Only wsh multisig descriptors and single key descriptors of
+// the supported address types are accepted.
+func parseWatchOnlyDescriptor(text string, chainParams *chaincfg.Params) (*sharedW.Descriptor, error) {
+	desc, err := sharedW.ParseDescriptor(text)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range desc.Keys {
+		xpub, err := ltcloader.ParseExtendedPubKey(key.XPub)
+		if err != nil {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("invalid descriptor key: %v", err))
+		}
+		if !xpub.IsForNet(chainParams) {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("descriptor key is not for %s", chainParams.Name))
+		}
+	}
+
+	if desc.IsMultisig() {
+		// Multisig wallets only use P2WSH scripts.
+		if desc.Script != sharedW.DescriptorWSH {
+			return nil, errors.E(utils.ErrInvalid, "only wsh multisig descriptors are supported")
+		}
+		return desc, nil
+	}
+
+	if _, err := descriptorKeyScope(desc); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// descriptorKeyScope returns the address key scope of the single key
+// descriptor.
+func descriptorKeyScope(desc *sharedW.Descriptor) (waddrmgr.KeyScope, error) {
+	purpose, err := desc.Purpose()
+	if err != nil {
+		return waddrmgr.KeyScope{}, err
+	}
+	scope, err := addressKeyScope(purpose)
+	if err != nil {
+		return waddrmgr.KeyScope{}, errors.E(utils.ErrInvalid, fmt.Sprintf("%s descriptors are not supported", desc.Script))
+	}
+	return scope, nil
+}
+
+// importDescriptor completes the setup of a watch-only wallet created from
+// the descriptor. The loader creates no account for descriptors, the account
+// of a single key descriptor is imported in the descriptor's scope only and
+// the account views of the wallet are built on that scope.
+func (asset *Asset) importDescriptor(desc *sharedW.Descriptor) error {
+	if desc.IsMultisig() {
+		keys := make([]string, len(desc.Keys))
+		for i, key := range desc.Keys {
+			keys[i] = key.XPub
+			if key.Fingerprint != "" {
+				keys[i] = key.KeyOrigin()
+			}
+		}
+		cosigners, err := parseCosignerKeys(keys, asset.chainParams)
+		if err != nil {
+			return err
+		}
+
+		err = asset.initMultisig(&sharedW.MultisigConfig{
+			Threshold: desc.Threshold,
+			Cosigners: cosigners,
+			Unsorted:  !desc.Sorted,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		scope, err := descriptorKeyScope(desc)
+		if err != nil {
+			return err
+		}
+
+		key := desc.Keys[0]
+		xpub, err := ltcloader.ParseExtendedPubKey(key.XPub)
+		if err != nil {
+			return err
+		}
+		var fingerprint uint32
+		if key.Fingerprint != "" {
+			if fingerprint, err = sharedW.PSBTFingerprint(key.Fingerprint); err != nil {
+				return err
+			}
+		}
+
+		_, err = asset.Internal().LTC.ImportAccountWithScope("default", xpub, fingerprint,
+			scope, keyScopeAddrSchema(toSharedScope(scope)))
+		if err != nil {
+			return err
+		}
+		asset.descriptorScope = &scope
+	}
+
+	asset.SaveUserConfigValue(sharedW.WatchOnlyDescriptorConfigKey, desc.WithBranch(sharedW.MultipathBranch).String())
+	return nil
+}
+
+// loadDescriptorScope sets the account scope of a watch-only wallet created
+// from a single key descriptor.
+func (asset *Asset) loadDescriptorScope() error {
+	var text string
+	if asset.ReadUserConfigValue(sharedW.WatchOnlyDescriptorConfigKey, &text) != nil {
+		return nil
+	}
+	desc, err := sharedW.ParseDescriptor(text)
+	if err != nil {
+		return err
+	}
+	if desc.IsMultisig() {
+		return nil
+	}
+
+	scope, err := descriptorKeyScope(desc)
+	if err != nil {
+		return err
+	}
+	asset.descriptorScope = &scope
+	return nil
+}
+
+// accountScope returns the key scope the accounts of the wallet are listed
+// and derive addresses from by default.
+func (asset *Asset) accountScope() waddrmgr.KeyScope {
+	if asset.descriptorScope != nil {
+		return *asset.descriptorScope
+	}
+	return GetScope()
+}
+
+// AccountDescriptors returns the receive and change descriptors of each
+// address type of the account. Watch-only wallets created from a descriptor
+// return it.
+func (asset *Asset) AccountDescriptors(account int32, privatePassphrase string) ([]string, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrLTCNotInitialized
+	}
+
+	var imported string
+	if asset.IsWatchingOnlyWallet() && asset.ReadUserConfigValue(sharedW.WatchOnlyDescriptorConfigKey, &imported) == nil {
+		desc, err := sharedW.ParseDescriptor(imported)
+		if err != nil {
+			return nil, err
+		}
+		return branchDescriptors(desc), nil
+	}
+
+	// The master key fingerprint of watch-only wallets created from an
+	// xpub is unknown, their keys are exported without origin.
+	var fingerprint string
+	if !asset.IsWatchingOnlyWallet() {
+		masterKey, err := asset.masterExtendedKey(privatePassphrase)
+		if err != nil {
+			return nil, err
+		}
+		masterPubKey, err := masterKey.ECPubKey()
+		masterKey.Zero()
+		if err != nil {
+			return nil, err
+		}
+		fingerprint = keyFingerprint(masterPubKey.SerializeCompressed())
+	}
+
+	scopes, err := asset.AccountAddressKeyScopes(account)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptors := make([]string, 0, 2*len(scopes))
+	for _, scope := range scopes {
+		script, ok := descriptorScripts[scope.Purpose]
+		if !ok {
+			continue
+		}
+
+		props, err := asset.Internal().LTC.AccountProperties(fromSharedScope(scope), uint32(account))
+		if err != nil {
+			return nil, err
+		}
+		// Descriptors use the chain's xpub version whatever the address
+		// type of the account.
+		xpub, err := props.AccountPubKey.CloneWithVersion(asset.chainParams.HDPublicKeyID[:])
+		if err != nil {
+			return nil, err
+		}
+
+		key := &sharedW.Cosigner{XPub: xpub.String()}
+		if fingerprint != "" {
+			key.Fingerprint = fingerprint
+			key.Path = sharedW.FormatDerivationPath([]uint32{hardenedKey(scope.Purpose),
+				hardenedKey(scope.Coin), hardenedKey(uint32(account))})
+		}
+		desc := &sharedW.Descriptor{Script: script, Keys: []*sharedW.Cosigner{key}}
+		descriptors = append(descriptors, branchDescriptors(desc)...)
+	}
+	return descriptors, nil
+}
+
+// MultisigDescriptors returns the receive and change descriptors of the
+// multisig wallet.
+func (asset *Asset) MultisigDescriptors() ([]string, error) {
+	cfg, err := asset.MultisigConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &sharedW.Descriptor{
+		Script:    sharedW.DescriptorWSH,
+		Keys:      cfg.Cosigners,
+		Threshold: cfg.Threshold,
+		Sorted:    !cfg.Unsorted,
+	}
+	return branchDescriptors(desc), nil
+}
+
+// branchDescriptors returns the receive and change descriptors of the
+// descriptor.
+func branchDescriptors(desc *sharedW.Descriptor) []string {
+	return []string{
+		desc.WithBranch(int(sharedW.ExternalBranch)).String(),
+		desc.WithBranch(int(sharedW.InternalBranch)).String(),
+	}
+}
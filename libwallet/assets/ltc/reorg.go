@@ -0,0 +1,61 @@
+package ltc
+
+import (
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/dcrlabs/ltcwallet/wallet"
+)
+
+// rollbackDetachedBlocks invalidates the cached txs mined at or above the fork
+// point of a reorg. It returns the block heights the affected txs were mined
+// at before the reorg, keyed by the tx hash.
+func (asset *Asset) rollbackDetachedBlocks(n *wallet.TransactionNotifications) map[string]int32 {
+	// The wallet only sends the reorg notification once the new chain is
+	// longer than the detached one, so the first attached block is the one
+	// that replaced the lowest detached block.
+	forkHeight := asset.GetBestBlockHeight() - int32(len(n.DetachedBlocks)) + 1
+	if len(n.AttachedBlocks) > 0 {
+		forkHeight = n.AttachedBlocks[0].Height
+	}
+
+	log.Infof("(%v) Chain reorg detected, %d block(s) detached from height %d",
+		asset.GetWalletName(), len(n.DetachedBlocks), forkHeight)
+
+	asset.txs.mu.Lock()
+	defer asset.txs.mu.Unlock()
+
+	reorgedTxs := make(map[string]int32)
+	for _, tx := range asset.txs.minedTxs {
+		if tx.BlockHeight >= forkHeight {
+			reorgedTxs[tx.Hash] = tx.BlockHeight
+		}
+	}
+
+	// Reset the cache height to force the txs to be fetched afresh from
+	// the wallet on the next query.
+	asset.txs.blockHeight = sharedW.UnminedTxHeight
+	return reorgedTxs
+}
+
+// reconcileReorgedTxs reloads the txs from the fork point and notifies the
+// listeners of the new block heights of the txs affected by a reorg.
+func (asset *Asset) reconcileReorgedTxs(reorgedTxs map[string]int32) {
+	txs, err := asset.getTransactionsRaw(0, 0, true)
+	if err != nil {
+		log.Errorf("(%v) Post-reorg tx reload error: %v", asset.GetWalletName(), err)
+		return
+	}
+
+	blockHeights := make(map[string]int32, len(txs))
+	for _, tx := range txs {
+		blockHeights[tx.Hash] = tx.BlockHeight
+	}
+
+	for txHash, oldBlockHeight := range reorgedTxs {
+		newBlockHeight, ok := blockHeights[txHash]
+		if !ok {
+			// Tx conflicted with one mined on the new chain.
+			newBlockHeight = sharedW.UnminedTxHeight
+		}
+		asset.publishTransactionReorged(txHash, oldBlockHeight, newBlockHeight)
+	}
+}
@@ -36,9 +36,10 @@ type SyncData struct {
 	syncstarted         uint32
 	chainServiceStopped bool
 
-	syncing  bool
-	synced   bool
-	isRescan bool
+	syncing        bool
+	synced         bool
+	isRescan       bool
+	isDeepRecovery bool
 
 	// Syncing fields
 	syncStartTime   time.Time // syncStartTime tracks the time when syncing starts.
@@ -223,6 +224,11 @@ notificationsLoop:
 	for {
 		select {
 		case n := <-notes.C:
+			var reorgedTxs map[string]int32
+			if len(n.DetachedBlocks) > 0 {
+				reorgedTxs = asset.rollbackDetachedBlocks(n)
+			}
+
 			for _, block := range n.AttachedBlocks {
 				// When syncing historical data no tx are available.
 				// Txs are reported only when chain is synced and newly mined tx
@@ -259,6 +265,10 @@ notificationsLoop:
 				asset.txs.mu.Unlock()
 			}
 
+			if len(n.DetachedBlocks) > 0 {
+				go asset.reconcileReorgedTxs(reorgedTxs)
+			}
+
 		case <-asset.syncCtx.Done():
 			break notificationsLoop
 		}
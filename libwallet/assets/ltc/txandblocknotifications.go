@@ -46,7 +46,7 @@ func (asset *Asset) mempoolTransactionNotification(transaction *sharedW.Transact
 
 	for _, txAndBlockNotificationListener := range asset.txAndBlockNotificationListeners {
 		if txAndBlockNotificationListener.OnTransaction != nil {
-			txAndBlockNotificationListener.OnTransaction(asset.ID, transaction)
+			go txAndBlockNotificationListener.OnTransaction(asset.ID, transaction)
 		}
 	}
 }
@@ -60,7 +60,7 @@ func (asset *Asset) publishTransactionConfirmed(txHash string, blockHeight int32
 
 	for _, txAndBlockNotificationListener := range asset.txAndBlockNotificationListeners {
 		if txAndBlockNotificationListener.OnTransactionConfirmed != nil {
-			txAndBlockNotificationListener.OnTransactionConfirmed(asset.ID, txHash, blockHeight)
+			go txAndBlockNotificationListener.OnTransactionConfirmed(asset.ID, txHash, blockHeight)
 		}
 	}
 }
@@ -73,7 +73,20 @@ func (asset *Asset) publishBlockAttached(blockHeight int32) {
 
 	for _, txAndBlockNotificationListener := range asset.txAndBlockNotificationListeners {
 		if txAndBlockNotificationListener.OnBlockAttached != nil {
-			txAndBlockNotificationListener.OnBlockAttached(asset.ID, blockHeight)
+			go txAndBlockNotificationListener.OnBlockAttached(asset.ID, blockHeight)
+		}
+	}
+}
+
+// publishTransactionReorged publishes the new block height of a tx whose
+// block was detached from the main chain during a reorg.
+func (asset *Asset) publishTransactionReorged(txHash string, oldBlockHeight, newBlockHeight int32) {
+	asset.notificationListenersMu.RLock()
+	defer asset.notificationListenersMu.RUnlock()
+
+	for _, txAndBlockNotificationListener := range asset.txAndBlockNotificationListeners {
+		if txAndBlockNotificationListener.OnTransactionReorged != nil {
+			go txAndBlockNotificationListener.OnTransactionReorged(asset.ID, txHash, oldBlockHeight, newBlockHeight)
 		}
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/dcrlabs/ltcwallet/chain"
 	neutrino "github.com/dcrlabs/ltcwallet/spv"
 	"github.com/dcrlabs/ltcwallet/spv/headerfs"
+	"github.com/dcrlabs/ltcwallet/waddrmgr"
 	_ "github.com/dcrlabs/ltcwallet/walletdb/bdb" // bdb init() registers a driver
 	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
 
@@ -60,6 +61,13 @@ type Asset struct {
 	// been introduced.
 	fees feeEstimateCache
 
+	// multisigMu guards the updates of the multisig config and pending txs.
+	multisigMu sync.Mutex
+
+	// descriptorScope is the key scope of the accounts of a watch-only
+	// wallet created from a single key descriptor.
+	descriptorScope *waddrmgr.KeyScope
+
 	notificationListenersMu sync.RWMutex
 
 	syncData                        *SyncData
@@ -130,6 +138,7 @@ func initWalletLoader(chainParams *ltcchaincfg.Params, dbDirPath string) loader.
 		DBDirPath:        filepath.Join(dbDirPath, dirName),
 		DefaultDBTimeout: defaultDBTimeout,
 		RecoveryWin:      recoverWindow,
+		Keyscope:         GetScope(),
 	}
 
 	return ltc.NewLoader(conf)
@@ -162,6 +171,17 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		return nil, err
 	}
 
+	// Watch-only wallets are created from either an account xpub or an
+	// output descriptor.
+	var desc *sharedW.Descriptor
+	if sharedW.IsDescriptor(extendedPublicKey) {
+		desc, err = parseWatchOnlyDescriptor(extendedPublicKey, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		extendedPublicKey = ""
+	}
+
 	ldr := initWalletLoader(chainParams, params.RootDir)
 	w, err := sharedW.CreateWatchOnlyWallet(walletName, extendedPublicKey,
 		ldr, params, utils.LTCWalletAsset)
@@ -182,6 +202,15 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		return nil, err
 	}
 
+	if desc != nil {
+		if err := ltcWallet.importDescriptor(desc); err != nil {
+			if delErr := ltcWallet.DeleteWallet(""); delErr != nil {
+				log.Errorf("(%v) Deleting the incomplete watch-only wallet failed: %v", ltcWallet.GetWalletName(), delErr)
+			}
+			return nil, err
+		}
+	}
+
 	ltcWallet.SetNetworkCancelCallback(ltcWallet.SafelyCancelSync)
 
 	return ltcWallet, nil
@@ -266,6 +295,10 @@ func LoadExisting(w *sharedW.Wallet, params *sharedW.InitParams) (sharedW.Asset,
 		return nil, err
 	}
 
+	if err := ltcWallet.loadDescriptorScope(); err != nil {
+		return nil, err
+	}
+
 	if err := ltcWallet.prepareChain(); err != nil {
 		return nil, err
 	}
@@ -504,16 +537,15 @@ func (asset *Asset) SetSpecificPeer(addresses string) {
 }
 
 // GetExtendedPubKey returns the extended public key of the given account, to do
-// that it calls LTCwallet's AccountProperties method, using
-// KeyScopeBIP0084WithBitcoinCoinID and the account number. On failure it
-// returns error.
+// that it calls LTCwallet's AccountProperties method, using the key scope of
+// the wallet's accounts and the account number. On failure it returns error.
 func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 	loadedAsset := asset.Internal().LTC
 	if loadedAsset == nil {
 		return "", utils.ErrLTCNotInitialized
 	}
 
-	extendedPublicKey, err := loadedAsset.AccountProperties(GetScope(), uint32(account))
+	extendedPublicKey, err := loadedAsset.AccountProperties(asset.accountScope(), uint32(account))
 	if err != nil {
 		return "", err
 	}
@@ -523,7 +555,7 @@ func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 // AccountXPubMatches checks if the xpub of the provided account matches the
 // provided xpub.
 func (asset *Asset) AccountXPubMatches(account uint32, xPub string) (bool, error) {
-	acctXPubKey, err := asset.Internal().LTC.AccountProperties(GetScope(), account)
+	acctXPubKey, err := asset.Internal().LTC.AccountProperties(asset.accountScope(), account)
 	if err != nil {
 		return false, err
 	}
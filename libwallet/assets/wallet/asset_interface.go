@@ -3,6 +3,7 @@ package wallet
 import (
 	"context"
 
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
 	"github.com/crypto-power/cryptopower/libwallet/internal/loader"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 )
@@ -42,6 +43,7 @@ type Asset interface {
 
 	RootDir() string
 	DataDir() string
+	GetWalletDataDb() *walletdata.DB
 	HasWalletSeed() bool
 	IsWalletBackedUp() bool
 	IsConnectedToNetwork() bool
@@ -59,12 +61,16 @@ type Asset interface {
 	GetTransactionRaw(txHash string) (*Transaction, error)
 	TxMatchesFilter(tx *Transaction, txFilter int32) bool
 	GetTransactionsRaw(offset, limit, txFilter int32, newestFirst bool, txHashSearch string) ([]*Transaction, error)
+	QueryTransactions(query *TxQuery) ([]*Transaction, error)
 
 	GetBestBlock() *BlockInfo
 	GetBestBlockHeight() int32
 	GetBestBlockTimeStamp() int64
 
 	ContainsDiscoveredAccounts() bool
+	DeepRecoveryKeyScopes() []KeyScope
+	DeepRecovery(privatePassphrase string, params *DeepRecoveryParams) error
+	IsDeepRecovering() bool
 	GetAccountsRaw() (*Accounts, error)
 	GetAccount(accountNumber int32) (*Account, error)
 	AccountName(accountNumber int32) (string, error)
@@ -118,3 +124,43 @@ type Asset interface {
 	SendDestination(id int) *TransactionDestination
 	UpdateSendDestination(id int, address string, atomAmount int64, sendMax bool) error
 }
+
+// AddressTypesAsset is implemented by the assets whose accounts can derive
+// addresses of more than one type, each type from its own key scope.
+type AddressTypesAsset interface {
+	AccountAddressKeyScopes(account int32) ([]KeyScope, error)
+	AccountAddressKeyScope(account int32) KeyScope
+	SetAccountAddressKeyScope(account int32, scope KeyScope) error
+	CurrentAddressForScope(account int32, scope KeyScope) (string, error)
+	NextAddressForScope(account int32, scope KeyScope) (string, error)
+}
+
+// MultisigAsset is implemented by the assets that support m-of-n multisig
+// wallets. Multisig txs are co-signed by exchanging PSBTs between the
+// cosigners.
+type MultisigAsset interface {
+	IsMultisig() bool
+	MultisigConfig() (*MultisigConfig, error)
+	MultisigCurrentAddress() (string, error)
+	MultisigNextAddress() (string, error)
+	MultisigBalance() (*Balance, error)
+
+	CreateMultisigTx(destinations []*TransactionDestination, feeRatePerKvB int64) (*MultisigPendingTx, error)
+	ImportMultisigPSBT(psbt []byte) (*MultisigPendingTx, error)
+	ExportMultisigPSBT(txID string) ([]byte, error)
+	SignMultisigTx(txID, privatePassphrase string) error
+	MultisigPendingTxs() ([]*MultisigPendingTx, error)
+	MultisigSignatureProgress(txID string) (*PSBTSignatureProgress, error)
+	BroadcastMultisigTx(txID string) (string, error)
+	DeleteMultisigPendingTx(txID string) error
+}
+
+// DescriptorAsset is implemented by the assets whose accounts can be exported
+// as BIP380 output descriptors.
+type DescriptorAsset interface {
+	// AccountDescriptors returns the receive and change descriptors of each
+	// address type of the account. The private passphrase is only needed to
+	// derive the master key fingerprint of wallets with a seed.
+	AccountDescriptors(account int32, privatePassphrase string) ([]string, error)
+	MultisigDescriptors() ([]string, error)
+}
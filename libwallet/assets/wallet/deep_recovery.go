@@ -0,0 +1,191 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const (
+	// DefaultDeepRecoveryAddressGap is the default number of consecutive
+	// unused addresses scanned on each account branch during a deep recovery.
+	DefaultDeepRecoveryAddressGap uint32 = 100
+
+	// DefaultDeepRecoveryAccountGap is the default number of consecutive
+	// unused accounts scanned in each key scope during a deep recovery.
+	DefaultDeepRecoveryAccountGap uint32 = 5
+
+	// MaxDeepRecoveryGap caps the gap limits to keep the number of derived
+	// addresses watched during a deep recovery manageable.
+	MaxDeepRecoveryGap uint32 = 1000
+
+	// ExternalBranch and InternalBranch are the BIP0044 branches of an account.
+	ExternalBranch uint32 = 0
+	InternalBranch uint32 = 1
+)
+
+// DeepRecoveryParams defines the options of a deep recovery. Unlike the
+// address discovery run when a wallet is restored, a deep recovery can look
+// past the default gap limits and into key scopes the wallet doesn't use.
+type DeepRecoveryParams struct {
+	// AddressGapLimit is the number of consecutive unused addresses scanned
+	// on each account branch before the branch is considered exhausted.
+	AddressGapLimit uint32
+	// AccountGapLimit is the number of consecutive unused accounts scanned
+	// before a key scope is considered exhausted.
+	AccountGapLimit uint32
+	// KeyScopes lists the key scopes to scan.
+	KeyScopes []KeyScope
+}
+
+// Validate checks that the deep recovery options are usable.
+func (p *DeepRecoveryParams) Validate() error {
+	if p.AddressGapLimit < 1 || p.AddressGapLimit > MaxDeepRecoveryGap {
+		return errors.E(utils.ErrInvalid, fmt.Sprintf("address gap limit must be between 1 and %d", MaxDeepRecoveryGap))
+	}
+	if p.AccountGapLimit < 1 || p.AccountGapLimit > MaxDeepRecoveryGap {
+		return errors.E(utils.ErrInvalid, fmt.Sprintf("account gap limit must be between 1 and %d", MaxDeepRecoveryGap))
+	}
+	if len(p.KeyScopes) == 0 {
+		return errors.E(utils.ErrInvalid, "no key scope selected")
+	}
+	return nil
+}
+
+// AddressRange identifies the addresses of an account branch that need to be
+// derived and watched.
+type AddressRange struct {
+	Account uint32
+	Branch  uint32
+	// From is the index of the first address in the range, inclusive.
+	From uint32
+	// To is the index of the last address in the range, exclusive.
+	To uint32
+}
+
+// AccountRecoveryState holds the address usage of an account discovered
+// during a deep recovery.
+type AccountRecoveryState struct {
+	Account uint32
+	// LastUsed holds the index of the last used address on the external and
+	// internal branches respectively, -1 if no address is used on the branch.
+	LastUsed [2]int64
+
+	derived [2]uint32
+}
+
+// IsUsed returns true if an address on any of the account's branches is used.
+func (a *AccountRecoveryState) IsUsed() bool {
+	return a.LastUsed[ExternalBranch] >= 0 || a.LastUsed[InternalBranch] >= 0
+}
+
+// ScopeRecoveryState tracks the accounts and addresses discovered in a key
+// scope, and how far past the last used ones the derivation horizon reaches.
+// It is not safe for concurrent access.
+type ScopeRecoveryState struct {
+	Scope    KeyScope
+	Accounts []*AccountRecoveryState
+
+	addressGap uint32
+	accountGap uint32
+}
+
+// NewScopeRecoveryState returns the recovery state of a key scope with no
+// address usage recorded yet.
+func NewScopeRecoveryState(scope KeyScope, params *DeepRecoveryParams) *ScopeRecoveryState {
+	return &ScopeRecoveryState{
+		Scope:      scope,
+		addressGap: params.AddressGapLimit,
+		accountGap: params.AccountGapLimit,
+	}
+}
+
+// LastUsedAccount returns the number of the last account with used addresses
+// or -1 if no account in the scope is used.
+func (s *ScopeRecoveryState) LastUsedAccount() int64 {
+	lastUsed := int64(-1)
+	for _, account := range s.Accounts {
+		if account.IsUsed() {
+			lastUsed = int64(account.Account)
+		}
+	}
+	return lastUsed
+}
+
+// IsUsed returns true if any address in the scope is used.
+func (s *ScopeRecoveryState) IsUsed() bool {
+	return s.LastUsedAccount() >= 0
+}
+
+// NextRanges extends the derivation horizon to cover AccountGapLimit accounts
+// past the last used account and AddressGapLimit addresses past the last used
+// address of every branch. It returns the address ranges that are yet to be
+// derived.
+func (s *ScopeRecoveryState) NextRanges() []AddressRange {
+	lastAccount := uint32(s.LastUsedAccount() + int64(s.accountGap))
+	for i := uint32(len(s.Accounts)); i <= lastAccount; i++ {
+		s.Accounts = append(s.Accounts, &AccountRecoveryState{
+			Account:  i,
+			LastUsed: [2]int64{-1, -1},
+		})
+	}
+
+	var ranges []AddressRange
+	for _, account := range s.Accounts {
+		for _, branch := range []uint32{ExternalBranch, InternalBranch} {
+			horizon := uint32(account.LastUsed[branch]+1) + s.addressGap
+			if account.derived[branch] >= horizon {
+				continue
+			}
+
+			ranges = append(ranges, AddressRange{
+				Account: account.Account,
+				Branch:  branch,
+				From:    account.derived[branch],
+				To:      horizon,
+			})
+			account.derived[branch] = horizon
+		}
+	}
+	return ranges
+}
+
+// MarkUsed records that the address at the provided derivation path was used.
+// It returns true if the derivation horizon needs to be extended.
+func (s *ScopeRecoveryState) MarkUsed(account, branch, index uint32) bool {
+	if int(account) >= len(s.Accounts) || branch > InternalBranch {
+		return false
+	}
+
+	acct := s.Accounts[account]
+	if int64(index) <= acct.LastUsed[branch] {
+		return false
+	}
+
+	acct.LastUsed[branch] = int64(index)
+	return true
+}
+
+// String returns the derivation path prefix of the key scope.
+func (k KeyScope) String() string {
+	return fmt.Sprintf("m/%d'/%d'", k.Purpose, k.Coin)
+}
+
+// SeedTypeFromMnemonic guesses the word seed type from the number of words in
+// the seed mnemonic. Hex seeds of DCR wallets are raw seeds while for the
+// other assets they are the entropy of a BIP0039 mnemonic.
+func SeedTypeFromMnemonic(seedMnemonic string, assetType utils.AssetType) WordSeedType {
+	switch words := strings.Fields(seedMnemonic); len(words) {
+	case WordSeed33.ToInt():
+		return WordSeed33
+	case WordSeed24.ToInt():
+		return WordSeed24
+	case 1:
+		if assetType == utils.DCRWalletAsset {
+			return WordSeed33
+		}
+	}
+	return WordSeed12
+}
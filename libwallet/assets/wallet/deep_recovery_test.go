@@ -0,0 +1,106 @@
+package wallet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestDeepRecoveryParamsValidate(t *testing.T) {
+	scopes := []KeyScope{{Purpose: 44, Coin: 42}}
+	tests := []struct {
+		name    string
+		params  DeepRecoveryParams
+		wantErr bool
+	}{
+		{"defaults", DeepRecoveryParams{DefaultDeepRecoveryAddressGap, DefaultDeepRecoveryAccountGap, scopes}, false},
+		{"max gaps", DeepRecoveryParams{MaxDeepRecoveryGap, MaxDeepRecoveryGap, scopes}, false},
+		{"zero address gap", DeepRecoveryParams{0, 1, scopes}, true},
+		{"address gap above max", DeepRecoveryParams{MaxDeepRecoveryGap + 1, 1, scopes}, true},
+		{"zero account gap", DeepRecoveryParams{1, 0, scopes}, true},
+		{"account gap above max", DeepRecoveryParams{1, MaxDeepRecoveryGap + 1, scopes}, true},
+		{"no key scope", DeepRecoveryParams{1, 1, nil}, true},
+	}
+	for _, test := range tests {
+		err := test.params.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestScopeRecoveryState(t *testing.T) {
+	state := NewScopeRecoveryState(KeyScope{Purpose: 84, Coin: 0}, &DeepRecoveryParams{
+		AddressGapLimit: 5,
+		AccountGapLimit: 2,
+	})
+
+	// Nothing is used yet: the horizon covers accounts 0 and 1.
+	want := []AddressRange{
+		{Account: 0, Branch: ExternalBranch, From: 0, To: 5},
+		{Account: 0, Branch: InternalBranch, From: 0, To: 5},
+		{Account: 1, Branch: ExternalBranch, From: 0, To: 5},
+		{Account: 1, Branch: InternalBranch, From: 0, To: 5},
+	}
+	if got := state.NextRanges(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("initial ranges: expected %v, got %v", want, got)
+	}
+	if state.IsUsed() {
+		t.Fatal("expected the scope to be unused")
+	}
+
+	// Nothing new to derive until an address is used.
+	if got := state.NextRanges(); len(got) != 0 {
+		t.Fatalf("expected no new ranges, got %v", got)
+	}
+
+	if !state.MarkUsed(1, ExternalBranch, 3) {
+		t.Fatal("expected the first use on the branch to extend the horizon")
+	}
+	if state.MarkUsed(1, ExternalBranch, 2) {
+		t.Fatal("expected an earlier index not to extend the horizon")
+	}
+	if state.MarkUsed(5, ExternalBranch, 0) || state.MarkUsed(0, 2, 0) {
+		t.Fatal("expected unknown accounts and branches to be ignored")
+	}
+	if last := state.LastUsedAccount(); last != 1 {
+		t.Fatalf("expected last used account 1, got %d", last)
+	}
+
+	// Account 1 is used: the branch extends past index 3 and account 3 is
+	// added to keep two unused accounts past it.
+	want = []AddressRange{
+		{Account: 1, Branch: ExternalBranch, From: 5, To: 9},
+		{Account: 2, Branch: ExternalBranch, From: 0, To: 5},
+		{Account: 2, Branch: InternalBranch, From: 0, To: 5},
+		{Account: 3, Branch: ExternalBranch, From: 0, To: 5},
+		{Account: 3, Branch: InternalBranch, From: 0, To: 5},
+	}
+	if got := state.NextRanges(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("extended ranges: expected %v, got %v", want, got)
+	}
+}
+
+func TestSeedTypeFromMnemonic(t *testing.T) {
+	words := func(n int) string {
+		return strings.TrimSpace(strings.Repeat("word ", n))
+	}
+	tests := []struct {
+		mnemonic  string
+		assetType utils.AssetType
+		want      WordSeedType
+	}{
+		{words(33), utils.DCRWalletAsset, WordSeed33},
+		{words(24), utils.BTCWalletAsset, WordSeed24},
+		{words(12), utils.LTCWalletAsset, WordSeed12},
+		{"0123abcd", utils.DCRWalletAsset, WordSeed33},
+		{"0123abcd", utils.BTCWalletAsset, WordSeed12},
+	}
+	for _, test := range tests {
+		if got := SeedTypeFromMnemonic(test.mnemonic, test.assetType); got != test.want {
+			t.Errorf("%d words of %s: expected %v, got %v", len(strings.Fields(test.mnemonic)), test.assetType, test.want, got)
+		}
+	}
+}
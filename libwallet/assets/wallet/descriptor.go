@@ -0,0 +1,290 @@
+package wallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// DescriptorScript is the script type of an output descriptor, as written
+// around its keys.
+type DescriptorScript string
+
+const (
+	DescriptorPKH    DescriptorScript = "pkh"
+	DescriptorSHWPKH DescriptorScript = "sh(wpkh)"
+	DescriptorWPKH   DescriptorScript = "wpkh"
+	DescriptorTR     DescriptorScript = "tr"
+	// DescriptorWSH, DescriptorSHWSH and DescriptorSH wrap the multi and
+	// sortedmulti multisig descriptors.
+	DescriptorWSH   DescriptorScript = "wsh"
+	DescriptorSHWSH DescriptorScript = "sh(wsh)"
+	DescriptorSH    DescriptorScript = "sh"
+)
+
+// MultipathBranch is the Descriptor branch of keys describing both the
+// receive and the change addresses with the BIP389 "/<0;1>/*" child path.
+const MultipathBranch = -1
+
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	descriptorChecksumLength  = 8
+)
+
+// Descriptor is a BIP380 output descriptor of the addresses derived from
+// account extended public keys. Only ranged descriptors with unhardened
+// "/<branch>/*" child paths are supported.
+type Descriptor struct {
+	Script DescriptorScript
+	// Keys hold the key origin and extended public key of each key, the
+	// Name and IsLocal fields are unused.
+	Keys []*Cosigner
+	// Branch is the address branch the descriptor derives, either
+	// ExternalBranch, InternalBranch or MultipathBranch.
+	Branch int
+	// Threshold and Sorted are only set for multisig descriptors.
+	Threshold int
+	Sorted    bool
+}
+
+// IsDescriptor returns true if the text looks like an output descriptor
+// rather than a plain extended key.
+func IsDescriptor(text string) bool {
+	return strings.Contains(text, "(")
+}
+
+// IsMultisig returns true if the descriptor is a multi or sortedmulti
+// descriptor.
+func (d *Descriptor) IsMultisig() bool {
+	return d.Threshold > 0
+}
+
+// Purpose returns the BIP43 purpose of the single key descriptor's address
+// type.
+func (d *Descriptor) Purpose() (uint32, error) {
+	switch d.Script {
+	case DescriptorPKH:
+		return 44, nil
+	case DescriptorSHWPKH:
+		return 49, nil
+	case DescriptorWPKH:
+		return 84, nil
+	case DescriptorTR:
+		return 86, nil
+	}
+	return 0, errors.E(utils.ErrInvalid, fmt.Sprintf("%s descriptors have no BIP43 purpose", d.Script))
+}
+
+// WithBranch returns a copy of the descriptor deriving the provided branch.
+func (d *Descriptor) WithBranch(branch int) *Descriptor {
+	desc := *d
+	desc.Branch = branch
+	return &desc
+}
+
+// String returns the descriptor with its checksum.
+func (d *Descriptor) String() string {
+	childPath := fmt.Sprintf("/%d/*", d.Branch)
+	if d.Branch == MultipathBranch {
+		childPath = "/<0;1>/*"
+	}
+
+	keys := make([]string, len(d.Keys))
+	for i, key := range d.Keys {
+		keys[i] = key.XPub + childPath
+		if key.Fingerprint != "" {
+			keys[i] = key.KeyOrigin() + childPath
+		}
+	}
+
+	var desc string
+	if d.IsMultisig() {
+		multi := "multi"
+		if d.Sorted {
+			multi = "sortedmulti"
+		}
+		desc = fmt.Sprintf("%s(%d,%s)", multi, d.Threshold, strings.Join(keys, ","))
+	} else if len(keys) > 0 {
+		desc = keys[0]
+	}
+
+	switch d.Script {
+	case DescriptorSHWPKH:
+		desc = fmt.Sprintf("sh(wpkh(%s))", desc)
+	case DescriptorSHWSH:
+		desc = fmt.Sprintf("sh(wsh(%s))", desc)
+	default:
+		desc = fmt.Sprintf("%s(%s)", d.Script, desc)
+	}
+
+	// The descriptor is built from the checksum charset, it can't fail.
+	checksum, _ := DescriptorChecksum(desc)
+	return desc + "#" + checksum
+}
+
+// ParseDescriptor parses a pkh, sh(wpkh), wpkh or tr single key descriptor,
+// or a multi or sortedmulti descriptor wrapped in wsh, sh(wsh) or sh. The
+// checksum is optional but verified if present.
+func ParseDescriptor(text string) (*Descriptor, error) {
+	text = strings.TrimSpace(text)
+	if i := strings.LastIndex(text, "#"); i >= 0 {
+		checksum, err := DescriptorChecksum(text[:i])
+		if err != nil {
+			return nil, err
+		}
+		if text[i+1:] != checksum {
+			return nil, errors.E(utils.ErrInvalid, "invalid descriptor checksum")
+		}
+		text = text[:i]
+	}
+
+	desc := new(Descriptor)
+	var inner string
+	var ok bool
+	for _, script := range []DescriptorScript{DescriptorSHWPKH, DescriptorSHWSH, DescriptorPKH,
+		DescriptorWPKH, DescriptorTR, DescriptorWSH, DescriptorSH} {
+		if inner, ok = unwrapDescriptor(text, string(script)); ok {
+			desc.Script = script
+			break
+		}
+	}
+	if !ok {
+		return nil, errors.E(utils.ErrInvalid, "unsupported descriptor")
+	}
+
+	var keys []string
+	switch desc.Script {
+	case DescriptorWSH, DescriptorSHWSH, DescriptorSH:
+		var args string
+		if args, ok = unwrapDescriptor(inner, "sortedmulti"); ok {
+			desc.Sorted = true
+		} else if args, ok = unwrapDescriptor(inner, "multi"); !ok {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("%s descriptors must wrap multi or sortedmulti", desc.Script))
+		}
+
+		parts := strings.Split(args, ",")
+		threshold, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, errors.E(utils.ErrInvalid, "invalid multisig threshold")
+		}
+		if err := ValidateMultisigParams(threshold, len(parts)-1); err != nil {
+			return nil, err
+		}
+		desc.Threshold, keys = threshold, parts[1:]
+	default:
+		if strings.ContainsAny(inner, "(,") {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("%s descriptors take a single key", desc.Script))
+		}
+		keys = []string{inner}
+	}
+
+	for i, key := range keys {
+		cosigner, branch, err := parseDescriptorKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && branch != desc.Branch {
+			return nil, errors.E(utils.ErrInvalid, "the descriptor keys must use the same child path")
+		}
+		desc.Keys = append(desc.Keys, cosigner)
+		desc.Branch = branch
+	}
+	return desc, nil
+}
+
+// unwrapDescriptor returns the arguments of the descriptor if it is the
+// named function.
+func unwrapDescriptor(text, name string) (string, bool) {
+	// Nested script types such as sh(wpkh) are matched with all their
+	// closing parentheses.
+	depth := strings.Count(name, "(") + 1
+	prefix := name
+	if depth == 1 {
+		prefix += "("
+	} else {
+		prefix = strings.TrimSuffix(prefix, ")") + "("
+	}
+	suffix := strings.Repeat(")", depth)
+	if !strings.HasPrefix(text, prefix) || !strings.HasSuffix(text, suffix) {
+		return "", false
+	}
+	return text[len(prefix) : len(text)-len(suffix)], true
+}
+
+// parseDescriptorKey parses a "[fingerprint/path]xpub/<branch>/*" key
+// expression.
+func parseDescriptorKey(key string) (*Cosigner, int, error) {
+	xpubStart := 0
+	if end := strings.Index(key, "]"); end >= 0 {
+		xpubStart = end + 1
+	}
+	pathStart := strings.Index(key[xpubStart:], "/")
+	if pathStart < 0 {
+		return nil, 0, errors.E(utils.ErrInvalid, "descriptor keys must be ranged extended keys")
+	}
+	pathStart += xpubStart
+
+	cosigner, err := ParseCosignerKey(key[:pathStart])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var branch int
+	switch key[pathStart:] {
+	case "/0/*":
+		branch = int(ExternalBranch)
+	case "/1/*":
+		branch = int(InternalBranch)
+	case "/<0;1>/*":
+		branch = MultipathBranch
+	default:
+		return nil, 0, errors.E(utils.ErrInvalid, fmt.Sprintf("unsupported descriptor key path %q", key[pathStart:]))
+	}
+	return cosigner, branch, nil
+}
+
+// DescriptorChecksum returns the BIP380 checksum of the descriptor.
+func DescriptorChecksum(desc string) (string, error) {
+	polymod := func(c uint64, val int) uint64 {
+		c0 := c >> 35
+		c = ((c & 0x7ffffffff) << 5) ^ uint64(val)
+		for i, gen := range []uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd} {
+			if c0>>i&1 == 1 {
+				c ^= gen
+			}
+		}
+		return c
+	}
+
+	c := uint64(1)
+	cls, clsCount := 0, 0
+	for _, ch := range desc {
+		pos := strings.IndexRune(descriptorInputCharset, ch)
+		if pos < 0 {
+			return "", errors.E(utils.ErrInvalid, fmt.Sprintf("invalid descriptor character %q", ch))
+		}
+		c = polymod(c, pos&31)
+		cls = cls*3 + pos>>5
+		if clsCount++; clsCount == 3 {
+			c = polymod(c, cls)
+			cls, clsCount = 0, 0
+		}
+	}
+	if clsCount > 0 {
+		c = polymod(c, cls)
+	}
+	for i := 0; i < descriptorChecksumLength; i++ {
+		c = polymod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, descriptorChecksumLength)
+	for i := range checksum {
+		checksum[i] = descriptorChecksumCharset[(c>>(5*(7-i)))&31]
+	}
+	return string(checksum), nil
+}
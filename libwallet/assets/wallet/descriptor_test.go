@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDescriptorChecksum(t *testing.T) {
+	// The BIP380 test vectors, the second has an error in the payload.
+	tests := []struct {
+		desc     string
+		checksum string
+		mismatch bool
+		wantErr  bool
+	}{
+		{desc: "raw(deadbeef)", checksum: "89f8spxm"},
+		{desc: "raw(deedbeef)", checksum: "89f8spxm", mismatch: true},
+		{desc: "raw(Ü)", wantErr: true},
+	}
+	for _, test := range tests {
+		checksum, err := DescriptorChecksum(test.desc)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: expected error %v, got %v", test.desc, test.wantErr, err)
+			continue
+		}
+		if !test.wantErr && (checksum == test.checksum) == test.mismatch {
+			t.Errorf("%q: unexpected checksum %q", test.desc, checksum)
+		}
+	}
+}
+
+func TestParseDescriptor(t *testing.T) {
+	const (
+		xpub1  = "tpubD6NzVbkrYhZ4XrdQtwHR6cLN4j9e4xV9vktTHxFa7AJmYB91cQUir6DLLqtmyPrtwTjeTVLyNiRwoGEYJUzC7Bdo5gz2G5k6phd9cn8bG2s"
+		xpub2  = "tpubD6NzVbkrYhZ4Y47Hu6c1kz2wrjeZTBVFTMHD2pqtjmTxinuZ8ciiWdprruXvzzKSdm3XCqPFUteQWsmrtD9KypP8vxEtnxsXFuBSW5bEEQx"
+		origin = "[d34db33f/84'/1'/0']"
+	)
+	key1 := &Cosigner{XPub: xpub1}
+	key2 := &Cosigner{XPub: xpub2}
+	originKey := &Cosigner{XPub: xpub1, Fingerprint: "d34db33f", Path: "m/84'/1'/0'"}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    *Descriptor
+		wantErr bool
+	}{{
+		name: "pkh",
+		text: "pkh(" + xpub1 + "/0/*)",
+		want: &Descriptor{Script: DescriptorPKH, Keys: []*Cosigner{key1}, Branch: int(ExternalBranch)},
+	}, {
+		name: "sh(wpkh)",
+		text: "sh(wpkh(" + xpub1 + "/1/*))",
+		want: &Descriptor{Script: DescriptorSHWPKH, Keys: []*Cosigner{key1}, Branch: int(InternalBranch)},
+	}, {
+		name: "wpkh with key origin",
+		text: "wpkh(" + origin + xpub1 + "/<0;1>/*)",
+		want: &Descriptor{Script: DescriptorWPKH, Keys: []*Cosigner{originKey}, Branch: MultipathBranch},
+	}, {
+		name: "tr",
+		text: "tr(" + xpub1 + "/0/*)",
+		want: &Descriptor{Script: DescriptorTR, Keys: []*Cosigner{key1}, Branch: int(ExternalBranch)},
+	}, {
+		name: "wsh sortedmulti",
+		text: "wsh(sortedmulti(1," + xpub1 + "/0/*," + xpub2 + "/0/*))",
+		want: &Descriptor{Script: DescriptorWSH, Keys: []*Cosigner{key1, key2}, Branch: int(ExternalBranch), Threshold: 1, Sorted: true},
+	}, {
+		name: "sh(wsh) multi",
+		text: "sh(wsh(multi(2," + xpub1 + "/1/*," + xpub2 + "/1/*)))",
+		want: &Descriptor{Script: DescriptorSHWSH, Keys: []*Cosigner{key1, key2}, Branch: int(InternalBranch), Threshold: 2},
+	}, {
+		name: "sh multi",
+		text: "sh(multi(2," + xpub1 + "/0/*," + xpub2 + "/0/*))",
+		want: &Descriptor{Script: DescriptorSH, Keys: []*Cosigner{key1, key2}, Branch: int(ExternalBranch), Threshold: 2},
+	}, {
+		name:    "unsupported script",
+		text:    "combo(" + xpub1 + "/0/*)",
+		wantErr: true,
+	}, {
+		name:    "wsh of a single key",
+		text:    "wsh(" + xpub1 + "/0/*)",
+		wantErr: true,
+	}, {
+		name:    "pkh of two keys",
+		text:    "pkh(" + xpub1 + "/0/*," + xpub2 + "/0/*)",
+		wantErr: true,
+	}, {
+		name:    "unranged key",
+		text:    "wpkh(" + xpub1 + ")",
+		wantErr: true,
+	}, {
+		name:    "hardened child path",
+		text:    "wpkh(" + xpub1 + "/0'/*)",
+		wantErr: true,
+	}, {
+		name:    "mixed child paths",
+		text:    "wsh(multi(1," + xpub1 + "/0/*," + xpub2 + "/1/*))",
+		wantErr: true,
+	}, {
+		name:    "threshold above the key count",
+		text:    "wsh(multi(3," + xpub1 + "/0/*," + xpub2 + "/0/*))",
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		desc, err := ParseDescriptor(test.text)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+			continue
+		}
+		if test.wantErr {
+			continue
+		}
+		if !reflect.DeepEqual(desc, test.want) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.want, desc)
+			continue
+		}
+
+		// The descriptor is written back with its checksum, which is
+		// verified when parsed again.
+		text := desc.String()
+		checksum, err := DescriptorChecksum(test.text)
+		if err != nil {
+			t.Fatalf("%s: checksum failed: %v", test.name, err)
+		}
+		if text != test.text+"#"+checksum {
+			t.Errorf("%s: expected %q, got %q", test.name, test.text+"#"+checksum, text)
+		}
+		if roundTrip, err := ParseDescriptor(text); err != nil || !reflect.DeepEqual(roundTrip, desc) {
+			t.Errorf("%s: round trip of %q: got %+v, %v", test.name, text, roundTrip, err)
+		}
+
+		// A changed checksum or descriptor is rejected.
+		last := text[len(text)-1:]
+		other := "q"
+		if last == other {
+			other = "p"
+		}
+		if _, err := ParseDescriptor(text[:len(text)-1] + other); err == nil {
+			t.Errorf("%s: expected an invalid checksum error", test.name)
+		}
+		if _, err := ParseDescriptor(strings.Replace(text, "tpub", "Tpub", 1)); err == nil {
+			t.Errorf("%s: expected an error for the changed descriptor", test.name)
+		}
+		if _, err := ParseDescriptor(text[:len(text)-1] + "Ü"); err == nil {
+			t.Errorf("%s: expected an invalid character error", test.name)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const (
+	// MultisigPurpose is the BIP48 purpose of the multisig cosigner keys.
+	MultisigPurpose = 48
+	// MultisigP2WSHScriptType is the BIP48 script type of native segwit
+	// (P2WSH) multisig cosigner keys.
+	MultisigP2WSHScriptType = 2
+
+	// MaxMultisigCosigners is the maximum number of keys of a multisig
+	// wallet. Standardness rules limit P2WSH CHECKMULTISIG scripts to 15
+	// keys.
+	MaxMultisigCosigners = 15
+
+	// MultisigAddressLookahead is the number of unused addresses of each
+	// branch that are watched ahead of the last returned address.
+	MultisigAddressLookahead = 20
+
+	// maxDERSignatureSize is the size of a DER signature with its sighash
+	// type byte.
+	maxDERSignatureSize = 73
+
+	// hardenedKeyStart is the index of the first hardened BIP32 child key.
+	hardenedKeyStart = 0x80000000
+)
+
+// Cosigner is one of the keys of a multisig wallet.
+type Cosigner struct {
+	Name string `json:"name"`
+	// XPub is the extended public key the cosigner's addresses keys are
+	// derived from.
+	XPub string `json:"xpub"`
+	// Fingerprint is the hex encoded fingerprint of the cosigner's master
+	// key, it identifies the cosigner in PSBTs.
+	Fingerprint string `json:"fingerprint"`
+	// Path is the derivation path of XPub from the master key.
+	Path    string `json:"path"`
+	IsLocal bool   `json:"is_local"`
+}
+
+// KeyOrigin returns the cosigner key in the "[fingerprint/path]xpub" format
+// used to share it with the other cosigners.
+func (c *Cosigner) KeyOrigin() string {
+	path := strings.TrimPrefix(strings.TrimPrefix(c.Path, "m"), "/")
+	if path == "" {
+		return fmt.Sprintf("[%s]%s", c.Fingerprint, c.XPub)
+	}
+	return fmt.Sprintf("[%s/%s]%s", c.Fingerprint, path, c.XPub)
+}
+
+// MultisigConfig holds the keys of a m-of-n multisig wallet and the state of
+// its address derivation.
+type MultisigConfig struct {
+	Threshold int         `json:"threshold"`
+	Cosigners []*Cosigner `json:"cosigners"`
+	// Unsorted is set if the keys are used in the witness script in the
+	// order of Cosigners rather than sorted as per BIP67.
+	Unsorted bool `json:"unsorted,omitempty"`
+	// NextIndex is the index of the next address returned on each branch.
+	NextIndex [2]uint32 `json:"next_index"`
+	// WatchedIndex is the number of addresses of each branch watched by the
+	// wallet.
+	WatchedIndex [2]uint32 `json:"watched_index"`
+}
+
+// ValidateMultisigParams checks that the threshold of signatures out of the
+// number of keys makes a valid multisig wallet.
+func ValidateMultisigParams(threshold, keys int) error {
+	if keys < 2 || keys > MaxMultisigCosigners {
+		return errors.E(utils.ErrInvalid, fmt.Sprintf("a multisig wallet needs 2 to %d keys", MaxMultisigCosigners))
+	}
+	if threshold < 1 || threshold > keys {
+		return errors.E(utils.ErrInvalid, fmt.Sprintf("the required signatures must be between 1 and %d", keys))
+	}
+	return nil
+}
+
+// Validate checks the threshold and the keys of the multisig config.
+func (cfg *MultisigConfig) Validate() error {
+	if err := ValidateMultisigParams(cfg.Threshold, len(cfg.Cosigners)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(cfg.Cosigners))
+	for _, cosigner := range cfg.Cosigners {
+		if seen[cosigner.XPub] {
+			return errors.E(utils.ErrInvalid, "duplicate cosigner key")
+		}
+		seen[cosigner.XPub] = true
+	}
+	return nil
+}
+
+// LocalCosigner returns the cosigner whose key is derived from the wallet's
+// seed.
+func (cfg *MultisigConfig) LocalCosigner() *Cosigner {
+	for _, cosigner := range cfg.Cosigners {
+		if cosigner.IsLocal {
+			return cosigner
+		}
+	}
+	return nil
+}
+
+// CosignerByFingerprint returns the cosigner with the provided master key
+// fingerprint.
+func (cfg *MultisigConfig) CosignerByFingerprint(fingerprint string) *Cosigner {
+	for _, cosigner := range cfg.Cosigners {
+		if cosigner.Fingerprint == fingerprint {
+			return cosigner
+		}
+	}
+	return nil
+}
+
+// ParseCosignerKey parses a cosigner key provided either as a plain xpub or
+// in the "[fingerprint/path]xpub" key origin format. The fingerprint and path
+// are left empty if the key has no origin.
+func ParseCosignerKey(key string) (*Cosigner, error) {
+	key = strings.TrimSpace(key)
+	cosigner := new(Cosigner)
+	if strings.HasPrefix(key, "[") {
+		end := strings.Index(key, "]")
+		if end < 0 {
+			return nil, errors.E(utils.ErrInvalid, "invalid key origin")
+		}
+
+		origin := strings.SplitN(key[1:end], "/", 2)
+		if len(origin[0]) != 8 {
+			return nil, errors.E(utils.ErrInvalid, "invalid key fingerprint")
+		}
+		if _, err := strconv.ParseUint(origin[0], 16, 32); err != nil {
+			return nil, errors.E(utils.ErrInvalid, "invalid key fingerprint")
+		}
+		cosigner.Fingerprint = strings.ToLower(origin[0])
+		cosigner.Path = "m"
+		if len(origin) == 2 {
+			if _, err := ParseDerivationPath(origin[1]); err != nil {
+				return nil, err
+			}
+			cosigner.Path = "m/" + origin[1]
+		}
+		key = key[end+1:]
+	}
+
+	if key == "" {
+		return nil, errors.E(utils.ErrInvalid, "missing extended public key")
+	}
+	cosigner.XPub = key
+	return cosigner, nil
+}
+
+// ParseDerivationPath parses a BIP32 derivation path such as "m/48'/0'/0'/2'".
+// Hardened indexes can be marked with either ' or h.
+func ParseDerivationPath(path string) ([]uint32, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "m"), "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, "/")
+	indexes := make([]uint32, len(parts))
+	for i, part := range parts {
+		var hardened uint32
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			hardened = hardenedKeyStart
+			part = part[:len(part)-1]
+		}
+
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("invalid derivation path index %q", parts[i]))
+		}
+		indexes[i] = uint32(index) + hardened
+	}
+	return indexes, nil
+}
+
+// FormatDerivationPath returns the "m/48'/0'/0'/2'" form of the path.
+func FormatDerivationPath(path []uint32) string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range path {
+		if index >= hardenedKeyStart {
+			fmt.Fprintf(&sb, "/%d'", index-hardenedKeyStart)
+		} else {
+			fmt.Fprintf(&sb, "/%d", index)
+		}
+	}
+	return sb.String()
+}
+
+// MultisigPendingTx is a multisig tx awaiting the signatures of the
+// cosigners. The tx is exchanged between the cosigners as a PSBT.
+type MultisigPendingTx struct {
+	// TxID is the hash of the unsigned tx, it identifies the tx while the
+	// signatures are collected.
+	TxID string `json:"txid"`
+	// PSBT is the base64 encoded PSBT holding the signatures collected.
+	PSBT      string `json:"psbt"`
+	Amount    int64  `json:"amount"`
+	Fee       int64  `json:"fee"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// PSBTSignatureProgress reports the cosigners that signed each input of a
+// multisig tx.
+type PSBTSignatureProgress struct {
+	Threshold int
+	// Signers holds the fingerprints of the cosigners that signed each
+	// input.
+	Signers [][]string
+}
+
+// Signatures returns the number of signatures the least signed input has.
+func (p *PSBTSignatureProgress) Signatures() int {
+	if len(p.Signers) == 0 {
+		return 0
+	}
+
+	signatures := len(p.Signers[0])
+	for _, signers := range p.Signers[1:] {
+		if len(signers) < signatures {
+			signatures = len(signers)
+		}
+	}
+	return signatures
+}
+
+// IsComplete returns true if every input has enough signatures.
+func (p *PSBTSignatureProgress) IsComplete() bool {
+	return len(p.Signers) > 0 && p.Signatures() >= p.Threshold
+}
+
+// HasSigned returns true if the cosigner signed every input.
+func (p *PSBTSignatureProgress) HasSigned(fingerprint string) bool {
+	if len(p.Signers) == 0 {
+		return false
+	}
+
+	for _, signers := range p.Signers {
+		signed := false
+		for _, signer := range signers {
+			if signer == fingerprint {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMultisig returns true if the wallet is a multisig wallet.
+func (wallet *Wallet) IsMultisig() bool {
+	_, err := wallet.MultisigConfig()
+	return err == nil
+}
+
+// MultisigConfig returns the keys of the multisig wallet.
+func (wallet *Wallet) MultisigConfig() (*MultisigConfig, error) {
+	cfg := new(MultisigConfig)
+	if err := wallet.ReadUserConfigValue(MultisigConfigKey, cfg); err != nil {
+		return nil, errors.E(utils.ErrNotExist, "not a multisig wallet")
+	}
+	return cfg, nil
+}
+
+// ReadMultisigPendingTxs returns the pending multisig txs keyed by their ID.
+func (wallet *Wallet) ReadMultisigPendingTxs() map[string]*MultisigPendingTx {
+	pendingTxs := make(map[string]*MultisigPendingTx)
+	_ = wallet.ReadUserConfigValue(MultisigPendingTxsConfigKey, &pendingTxs)
+	return pendingTxs
+}
+
+// SaveMultisigPendingTxs replaces the pending multisig txs.
+func (wallet *Wallet) SaveMultisigPendingTxs(pendingTxs map[string]*MultisigPendingTx) {
+	wallet.SaveUserConfigValue(MultisigPendingTxsConfigKey, pendingTxs)
+}
+
+// MultisigPendingTxs returns the multisig txs awaiting signatures, the most
+// recent first.
+func (wallet *Wallet) MultisigPendingTxs() ([]*MultisigPendingTx, error) {
+	if !wallet.WalletOpened() {
+		return nil, errors.New(utils.ErrWalletNotLoaded)
+	}
+
+	pendingTxs := wallet.ReadMultisigPendingTxs()
+	txs := make([]*MultisigPendingTx, 0, len(pendingTxs))
+	for _, tx := range pendingTxs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].CreatedAt > txs[j].CreatedAt
+	})
+	return txs, nil
+}
+
+// PSBTFingerprint converts a hex encoded fingerprint to its PSBT form.
+func PSBTFingerprint(fingerprint string) (uint32, error) {
+	b, err := hex.DecodeString(fingerprint)
+	if err != nil || len(b) != 4 {
+		return 0, errors.E(utils.ErrInvalid, "invalid key fingerprint")
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// FingerprintHex converts a PSBT fingerprint to its hex encoded form.
+func FingerprintHex(fingerprint uint32) string {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], fingerprint)
+	return hex.EncodeToString(b[:])
+}
+
+// IsCosignerDerivation returns true if the PSBT key derivation is the one of
+// an address key of the cosigner, i.e. the cosigner's path followed by the
+// branch and the index of the address.
+func IsCosignerDerivation(fingerprint uint32, bip32Path []uint32, cosignerFingerprint uint32, cosignerPath []uint32) bool {
+	if fingerprint != cosignerFingerprint || len(bip32Path) != len(cosignerPath)+2 {
+		return false
+	}
+	for i, index := range cosignerPath {
+		if bip32Path[i] != index {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyMultisigWitnessScript checks that the witness script of a PSBT input
+// is the one of the wallet's P2WSH address and that it hashes to the output
+// script being spent.
+func VerifyMultisigWitnessScript(pkScript, witnessScript, expected []byte) error {
+	// A P2WSH output script is OP_0 followed by the push of the 32 bytes
+	// hash of the witness script.
+	if len(pkScript) != 34 || pkScript[0] != 0x00 || pkScript[1] != 0x20 {
+		return errors.E(utils.ErrInvalid, "the output spent is not a P2WSH output")
+	}
+	scriptHash := sha256.Sum256(witnessScript)
+	if !bytes.Equal(scriptHash[:], pkScript[2:]) {
+		return errors.E(utils.ErrInvalid, "the witness script does not match the output spent")
+	}
+	if !bytes.Equal(witnessScript, expected) {
+		return errors.E(utils.ErrInvalid, "the witness script is not the multisig script of this wallet")
+	}
+	return nil
+}
+
+// EstimateMultisigTxSize returns the worst case virtual size of a tx
+// spending numInputs outputs of the multisig wallet. outputsSize is the
+// serialized size of the numOutputs outputs.
+func EstimateMultisigTxSize(cfg *MultisigConfig, numInputs, numOutputs, outputsSize int) int {
+	// Each input spends <empty> <sig>... <witness script>.
+	witnessScriptSize := 3 + len(cfg.Cosigners)*(1+33)
+	witnessSize := 1 + 1 + cfg.Threshold*(1+maxDERSignatureSize) +
+		varIntSize(witnessScriptSize) + witnessScriptSize
+
+	baseSize := 4 + 4 + varIntSize(numInputs) + numInputs*(32+4+1+4) +
+		varIntSize(numOutputs) + outputsSize
+
+	// The segwit marker and flag bytes are part of the witness data.
+	weight := baseSize*4 + 2 + numInputs*witnessSize
+	return (weight + 3) / 4
+}
+
+// varIntSize returns the serialized size of n as a bitcoin variable length
+// integer.
+func varIntSize(n int) int {
+	switch {
+	case n < 0xfd:
+		return 1
+	case n <= 0xffff:
+		return 3
+	case n <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
@@ -0,0 +1,148 @@
+package wallet
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"testing"
+)
+
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []uint32
+		wantErr bool
+	}{
+		{path: "m", want: nil},
+		{path: "", want: nil},
+		{path: "m/48'/0'/0'/2'", want: []uint32{hardenedKeyStart + 48, hardenedKeyStart, hardenedKeyStart, hardenedKeyStart + 2}},
+		{path: "48h/1h/0h/2h", want: []uint32{hardenedKeyStart + 48, hardenedKeyStart + 1, hardenedKeyStart, hardenedKeyStart + 2}},
+		{path: "m/0/7", want: []uint32{0, 7}},
+		{path: "m/48'/x", wantErr: true},
+		{path: "m/-1", wantErr: true},
+		{path: "m/2147483648", wantErr: true},
+		{path: "m//1", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDerivationPath(test.path)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: expected error %v, got %v", test.path, test.wantErr, err)
+			continue
+		}
+		if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: expected %v, got %v", test.path, test.want, got)
+		}
+	}
+
+	path := []uint32{hardenedKeyStart + 48, hardenedKeyStart + 1, hardenedKeyStart, hardenedKeyStart + 2, 5}
+	formatted := FormatDerivationPath(path)
+	if formatted != "m/48'/1'/0'/2'/5" {
+		t.Fatalf("unexpected formatted path %q", formatted)
+	}
+	if got, err := ParseDerivationPath(formatted); err != nil || !reflect.DeepEqual(got, path) {
+		t.Fatalf("round trip of %q: got %v, %v", formatted, got, err)
+	}
+}
+
+func TestParseCosignerKey(t *testing.T) {
+	const xpub = "tpubDEJbBFnGxTDRokM7Q4pX6PKvNW1HK8KTbyAJFmBXQ9CGu5gHZFBcnxkBQXDZcjmWkeP39u7CwYFjsWcu5ELRqL3ok9wMLX8oZLYyy8jLHVA"
+	tests := []struct {
+		name    string
+		key     string
+		want    *Cosigner
+		wantErr bool
+	}{{
+		name: "plain xpub",
+		key:  "  " + xpub + "\n",
+		want: &Cosigner{XPub: xpub},
+	}, {
+		name: "key origin",
+		key:  "[D34DB33F/48'/1'/0'/2']" + xpub,
+		want: &Cosigner{XPub: xpub, Fingerprint: "d34db33f", Path: "m/48'/1'/0'/2'"},
+	}, {
+		name: "fingerprint only",
+		key:  "[d34db33f]" + xpub,
+		want: &Cosigner{XPub: xpub, Fingerprint: "d34db33f", Path: "m"},
+	}, {
+		name:    "unterminated origin",
+		key:     "[d34db33f/48'" + xpub,
+		wantErr: true,
+	}, {
+		name:    "short fingerprint",
+		key:     "[d34db3/48']" + xpub,
+		wantErr: true,
+	}, {
+		name:    "non hex fingerprint",
+		key:     "[d34db33g/48']" + xpub,
+		wantErr: true,
+	}, {
+		name:    "invalid path",
+		key:     "[d34db33f/48'/a]" + xpub,
+		wantErr: true,
+	}, {
+		name:    "missing xpub",
+		key:     "[d34db33f/48']",
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		got, err := ParseCosignerKey(test.key)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+			continue
+		}
+		if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.want, got)
+		}
+	}
+
+	// The key origin is shared in the format it is parsed from.
+	cosigner, err := ParseCosignerKey("[d34db33f/48'/1'/0'/2']" + xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if origin := cosigner.KeyOrigin(); origin != "[d34db33f/48'/1'/0'/2']"+xpub {
+		t.Fatalf("unexpected key origin %q", origin)
+	}
+}
+
+func TestVerifyMultisigWitnessScript(t *testing.T) {
+	witnessScript := []byte{0x52, 0x21, 0x02, 0x52, 0xae}
+	scriptHash := sha256.Sum256(witnessScript)
+	pkScript := append([]byte{0x00, 0x20}, scriptHash[:]...)
+
+	otherScript := []byte{0x51, 0x21, 0x03, 0x51, 0xae}
+	otherHash := sha256.Sum256(otherScript)
+	otherPkScript := append([]byte{0x00, 0x20}, otherHash[:]...)
+
+	tests := []struct {
+		name          string
+		pkScript      []byte
+		witnessScript []byte
+		wantErr       bool
+	}{
+		{"wallet script", pkScript, witnessScript, false},
+		{"script of another output", pkScript, otherScript, true},
+		{"foreign script hashing to the output", otherPkScript, otherScript, true},
+		{"not a P2WSH output", append([]byte{0x00, 0x14}, scriptHash[:20]...), witnessScript, true},
+	}
+	for _, test := range tests {
+		err := VerifyMultisigWitnessScript(test.pkScript, test.witnessScript, witnessScript)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestPSBTFingerprint(t *testing.T) {
+	fingerprint, err := PSBTFingerprint("d34db33f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex := FingerprintHex(fingerprint); hex != "d34db33f" {
+		t.Fatalf("expected fingerprint d34db33f, got %s", hex)
+	}
+	for _, invalid := range []string{"", "d34db3", "d34db33f00", "zz4db33f"} {
+		if _, err := PSBTFingerprint(invalid); err == nil {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/asdine/storm/q"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// ConfirmationState selects transactions by whether they have the required
+// number of confirmations.
+type ConfirmationState int
+
+const (
+	AnyConfirmationState ConfirmationState = iota
+	Confirmed
+	Unconfirmed
+)
+
+// TicketStatus selects DCR tickets by status.
+type TicketStatus string
+
+const (
+	AnyTicketStatus TicketStatus = ""
+	TicketUnmined   TicketStatus = "unmined"
+	TicketImmature  TicketStatus = "immature"
+	TicketLive      TicketStatus = "live"
+	TicketVoted     TicketStatus = "voted"
+	TicketRevoked   TicketStatus = "revoked"
+	TicketExpired   TicketStatus = "expired"
+)
+
+// TicketStatuses are the ticket statuses that can be queried, in display
+// order.
+var TicketStatuses = []TicketStatus{TicketUnmined, TicketImmature, TicketLive, TicketVoted, TicketRevoked, TicketExpired}
+
+// ticketStatusFilters are the tx filters returning the tickets of each
+// status. Voted and revoked tickets are returned as the votes and
+// revocations that spent them.
+var ticketStatusFilters = map[TicketStatus]int32{
+	TicketUnmined:  utils.TxFilterUnmined,
+	TicketImmature: utils.TxFilterImmature,
+	TicketLive:     utils.TxFilterLive,
+	TicketVoted:    utils.TxFilterVoted,
+	TicketRevoked:  utils.TxFilterRevoked,
+	TicketExpired:  utils.TxFilterExpired,
+}
+
+// TxQuery selects the transactions returned by Asset.QueryTransactions. The
+// zero value of each condition matches every transaction.
+type TxQuery struct {
+	// TxFilter is one of the utils.TxFilter values.
+	TxFilter int32
+	// MinAmount and MaxAmount bound the transaction amount, in atoms. A zero
+	// MaxAmount doesn't bound it.
+	MinAmount int64
+	MaxAmount int64
+	// From and To bound the transaction timestamp. A zero To doesn't bound
+	// it.
+	From int64
+	To   int64
+	// Address is an address paid by one of the outputs of the transaction,
+	// or by a wallet output spent by one of its inputs.
+	Address string
+	// Label is a case insensitive part of the transaction label.
+	Label string
+	// Accounts are the wallet accounts of which at least one must be used by
+	// an input or output of the transaction.
+	Accounts     []int32
+	Confirmation ConfirmationState
+	// TicketStatus is only supported by DCR wallets, it replaces the
+	// TxFilter if set.
+	TicketStatus TicketStatus
+
+	Offset      int32
+	Limit       int32
+	NewestFirst bool
+}
+
+// Filter returns the tx filter of the transactions matching the query.
+func (query *TxQuery) Filter() int32 {
+	if filter, ok := ticketStatusFilters[query.TicketStatus]; ok {
+		return filter
+	}
+	return query.TxFilter
+}
+
+// ConfirmationMatcher returns the matcher of the transactions in the
+// confirmation state of the query, using the BlockHeight index.
+func (query *TxQuery) ConfirmationMatcher(requiredConfirmations, bestBlock int32) q.Matcher {
+	// Transactions mined at or below this height are confirmed.
+	confirmedHeight := bestBlock - requiredConfirmations + 1
+	switch query.Confirmation {
+	case Confirmed:
+		return q.And(
+			q.Gt(utils.HeightFilter, 0),
+			q.Lte(utils.HeightFilter, confirmedHeight),
+		)
+	case Unconfirmed:
+		return q.Or(
+			q.Lte(utils.HeightFilter, 0),
+			q.Gt(utils.HeightFilter, confirmedHeight),
+		)
+	}
+	return q.True()
+}
+
+// Matcher returns the matcher of the amount, address, label and account
+// conditions of the query. addressOutpoints are the wallet outputs paying
+// to the query address, as returned by AddressOutpoints.
+func (query *TxQuery) Matcher(addressOutpoints map[string]bool) q.Matcher {
+	return &txQueryMatcher{query: query, addressOutpoints: addressOutpoints}
+}
+
+// Match checks if the transaction matches all the conditions of the query
+// except its tx filter and ticket status.
+func (query *TxQuery) Match(tx *Transaction, requiredConfirmations, bestBlock int32, addressOutpoints map[string]bool) bool {
+	if tx.Timestamp < query.From || (query.To > 0 && tx.Timestamp > query.To) {
+		return false
+	}
+	confirmations := int32(0)
+	if tx.BlockHeight > 0 {
+		confirmations = bestBlock - tx.BlockHeight + 1
+	}
+	switch query.Confirmation {
+	case Confirmed:
+		if confirmations < requiredConfirmations || confirmations <= 0 {
+			return false
+		}
+	case Unconfirmed:
+		if confirmations >= requiredConfirmations && confirmations > 0 {
+			return false
+		}
+	}
+	return query.matchDetails(tx, addressOutpoints)
+}
+
+// AddressOutpoints returns the outpoints of the outputs of the transactions
+// that pay to the query address. It returns nil if the query has no address.
+func (query *TxQuery) AddressOutpoints(txs []*Transaction) map[string]bool {
+	if query.Address == "" {
+		return nil
+	}
+	outpoints := make(map[string]bool)
+	for _, tx := range txs {
+		for _, output := range tx.Outputs {
+			if output.Address == query.Address {
+				outpoints[fmt.Sprintf("%s:%d", tx.Hash, output.Index)] = true
+			}
+		}
+	}
+	return outpoints
+}
+
+// AddressMatcher returns the matcher of the transactions with an output
+// paying to the query address.
+func (query *TxQuery) AddressMatcher() q.Matcher {
+	return &txQueryMatcher{query: &TxQuery{Address: query.Address}}
+}
+
+func (query *TxQuery) matchDetails(tx *Transaction, addressOutpoints map[string]bool) bool {
+	if tx.Amount < query.MinAmount || (query.MaxAmount > 0 && tx.Amount > query.MaxAmount) {
+		return false
+	}
+	if query.Label != "" && !strings.Contains(strings.ToLower(tx.Label), strings.ToLower(query.Label)) {
+		return false
+	}
+	if query.Address != "" && !query.matchAddress(tx, addressOutpoints) {
+		return false
+	}
+	if len(query.Accounts) > 0 && !query.matchAccounts(tx) {
+		return false
+	}
+	return true
+}
+
+func (query *TxQuery) matchAddress(tx *Transaction, addressOutpoints map[string]bool) bool {
+	for _, output := range tx.Outputs {
+		if output.Address == query.Address {
+			return true
+		}
+	}
+	for _, input := range tx.Inputs {
+		if addressOutpoints[input.PreviousOutpoint] {
+			return true
+		}
+	}
+	return false
+}
+
+func (query *TxQuery) matchAccounts(tx *Transaction) bool {
+	isQueried := func(account int32) bool {
+		for _, queried := range query.Accounts {
+			if account == queried {
+				return true
+			}
+		}
+		return false
+	}
+	for _, input := range tx.Inputs {
+		if isQueried(input.AccountNumber) {
+			return true
+		}
+	}
+	for _, output := range tx.Outputs {
+		if isQueried(output.AccountNumber) {
+			return true
+		}
+	}
+	return false
+}
+
+// txQueryMatcher is a storm matcher of the TxQuery conditions that don't
+// use an index.
+type txQueryMatcher struct {
+	query            *TxQuery
+	addressOutpoints map[string]bool
+}
+
+func (m *txQueryMatcher) Match(i interface{}) (bool, error) {
+	switch tx := i.(type) {
+	case *Transaction:
+		return m.query.matchDetails(tx, m.addressOutpoints), nil
+	case Transaction:
+		return m.query.matchDetails(&tx, m.addressOutpoints), nil
+	}
+	return false, nil
+}
@@ -265,6 +265,15 @@ type AddressDiscoveryProgressReport struct {
 	*GeneralSyncProgress
 	TotalDiscoveryTimeSpent  time.Duration
 	AddressDiscoveryProgress int32 `json:"addressDiscoveryProgress"`
+
+	// Deep recovery fields. KeyScope is nil for the regular address
+	// discovery run during sync.
+	KeyScope             *KeyScope `json:"keyScope,omitempty"`
+	KeyScopeIndex        int32     `json:"keyScopeIndex"`
+	TotalKeyScopes       int32     `json:"totalKeyScopes"`
+	CurrentScanHeight    int32     `json:"currentScanHeight"`
+	DiscoveredAccounts   int32     `json:"discoveredAccounts"`
+	DeepRecoveryFinished bool      `json:"deepRecoveryFinished"`
 }
 
 type HeadersRescanProgressReport struct {
@@ -284,6 +293,10 @@ type TxAndBlockNotificationListener struct {
 	OnTransaction          func(walletID int, transaction *Transaction)
 	OnBlockAttached        func(walletID int, blockHeight int32)
 	OnTransactionConfirmed func(walletID int, hash string, blockHeight int32)
+	// OnTransactionReorged is invoked for every tx whose block was detached
+	// from the main chain during a reorg. newBlockHeight is UnminedTxHeight
+	// if the tx is yet to be included in a block on the new chain.
+	OnTransactionReorged func(walletID int, hash string, oldBlockHeight, newBlockHeight int32)
 }
 
 type BlocksRescanProgressListener struct {
@@ -18,6 +18,7 @@ const (
 	PrivacyModeConfigKey        = "privacy_mode"
 	SpendUnconfirmedConfigKey   = "spend_unconfirmed"
 	CurrencyConversionConfigKey = "currency_conversion_option"
+	FiatCurrencyConfigKey       = "fiat_currency"
 
 	IsStartupSecuritySetConfigKey = "startup_security_set"
 	StartupSecurityTypeConfigKey  = "startup_security_type"
@@ -53,9 +54,25 @@ const (
 	SpendUnmixedFundsKey             = "spend_unmixed_funds"
 	LanguagePreferenceKey            = "app_language"
 	DarkModeConfigKey                = "dark_mode"
+	ThemeConfigKey                   = "app_theme"
 	HideTotalBalanceConfigKey        = "hideTotalUSDBalance"
 	IsCEXFirstVisitConfigKey         = "is_cex_first_visit"
 
+	// AccountAddressKeyScopeConfigKey is suffixed with the account number to
+	// store the key scope receive addresses are derived from for the account.
+	AccountAddressKeyScopeConfigKey = "account_address_key_scope"
+
+	// MultisigConfigKey stores the keys of a multisig wallet, the wallet is
+	// single-sig if it is not set.
+	MultisigConfigKey = "multisig_config"
+	// MultisigPendingTxsConfigKey stores the multisig txs awaiting the
+	// signatures of the cosigners.
+	MultisigPendingTxsConfigKey = "multisig_pending_txs"
+
+	// WatchOnlyDescriptorConfigKey stores the output descriptor a watch-only
+	// wallet was imported from.
+	WatchOnlyDescriptorConfigKey = "watch_only_descriptor"
+
 	PassphraseTypePin  int32 = 0
 	PassphraseTypePass int32 = 1
 )
@@ -0,0 +1,74 @@
+package walletdata
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/asdine/storm"
+	bolt "go.etcd.io/bbolt"
+)
+
+// KeyBalanceSnapshotTxSet is the hash of the confirmed transactions the
+// saved balance snapshots were computed from.
+const KeyBalanceSnapshotTxSet = "BalanceSnapshotTxSet"
+
+// BalanceSnapshot is the balance of a wallet at the end of a day.
+type BalanceSnapshot struct {
+	Day     int64 `storm:"id"` // Unix time of the start of the UTC day.
+	Balance int64
+}
+
+// BalanceSnapshots returns the saved balance snapshots ordered by day and
+// the hash of the confirmed transactions they were computed from.
+func (db *DB) BalanceSnapshots() ([]*BalanceSnapshot, string, error) {
+	var snapshots []*BalanceSnapshot
+	err := db.walletDataDB.All(&snapshots)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, "", fmt.Errorf("error reading balance snapshots: %s", err.Error())
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Day < snapshots[j].Day
+	})
+
+	var txSetHash string
+	err = db.walletDataDB.Get(TxBucketName, KeyBalanceSnapshotTxSet, &txSetHash)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, "", fmt.Errorf("error reading balance snapshots tx set: %s", err.Error())
+	}
+	return snapshots, txSetHash, nil
+}
+
+// SaveBalanceSnapshots adds the snapshots to the saved balance snapshots and
+// records the hash of the confirmed transactions they were all computed from.
+func (db *DB) SaveBalanceSnapshots(snapshots []*BalanceSnapshot, txSetHash string) error {
+	tx, err := db.walletDataDB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, snapshot := range snapshots {
+		if err = tx.Save(snapshot); err != nil {
+			return fmt.Errorf("error saving balance snapshot: %s", err.Error())
+		}
+	}
+	if err = tx.Set(TxBucketName, KeyBalanceSnapshotTxSet, txSetHash); err != nil {
+		return fmt.Errorf("error saving balance snapshots tx set: %s", err.Error())
+	}
+	return tx.Commit()
+}
+
+// ClearBalanceSnapshots deletes the saved balance snapshots, they are
+// computed again from the transactions when next requested.
+func (db *DB) ClearBalanceSnapshots() error {
+	err := db.walletDataDB.Drop(&BalanceSnapshot{})
+	if err != nil && err != bolt.ErrBucketNotFound {
+		return err
+	}
+	if err = db.walletDataDB.Init(&BalanceSnapshot{}); err != nil {
+		return err
+	}
+	return db.walletDataDB.Set(TxBucketName, KeyBalanceSnapshotTxSet, "")
+}
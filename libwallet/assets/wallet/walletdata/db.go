@@ -52,6 +52,11 @@ func Initialize(dbPath string, txData interface{}) (*DB, error) {
 		return nil, fmt.Errorf("error initializing tx bucket for wallet: %s", err.Error())
 	}
 
+	err = walletDataDB.Init(&BalanceSnapshot{})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing balance snapshots bucket for wallet: %s", err.Error())
+	}
+
 	return &DB{
 		BTC: &BTCDB{
 			Bolt: walletDataDB.Bolt,
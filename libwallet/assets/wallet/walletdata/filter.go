@@ -7,7 +7,12 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 )
 
-func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock int32) (query storm.Query) {
+func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock int32) storm.Query {
+	return db.walletDataDB.Select(db.txFilterMatcher(txFilter, bestBlock))
+}
+
+// txFilterMatcher returns the matcher of the transactions of the txFilter.
+func (db *DB) txFilterMatcher(txFilter, bestBlock int32) (matcher q.Matcher) {
 	// tickets with block height less than this are matured.
 	maturityBlock := bestBlock - db.ticketMaturity
 
@@ -16,22 +21,22 @@ func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock in
 
 	switch txFilter {
 	case utils.TxFilterSent:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeRegular),
 			q.Eq(utils.DirectionFilter, txhelper.TxDirectionSent),
 		)
 	case utils.TxFilterReceived:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeRegular),
 			q.Eq(utils.DirectionFilter, txhelper.TxDirectionReceived),
 		)
 	case utils.TxFilterTransferred:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeRegular),
 			q.Eq(utils.DirectionFilter, txhelper.TxDirectionTransferred),
 		)
 	case utils.TxFilterStaking:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Or(
 				q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 				q.Eq(utils.TypeFilter, txhelper.TxTypeVote),
@@ -39,34 +44,34 @@ func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock in
 			),
 		)
 	case utils.TxFilterCoinBase:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeCoinBase),
 		)
 	case utils.TxFilterRegular:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeRegular),
 		)
 	case utils.TxFilterMixed:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeMixed),
 		)
 	case utils.TxFilterVoted:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeVote),
 		)
 	case utils.TxFilterRevoked:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeRevocation),
 		)
 	case utils.TxFilterImmature:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 			q.And(
 				q.Gt(utils.HeightFilter, maturityBlock),
 			),
 		)
 	case utils.TxFilterLive:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 			q.Eq(utils.TicketSpenderFilter, ""),      // not spent by a vote or revoke
 			q.Gt(utils.HeightFilter, 0),              // mined
@@ -74,25 +79,25 @@ func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock in
 			q.Gt(utils.HeightFilter, expiryBlock),    // not expired
 		)
 	case utils.TxFilterUnmined:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 			q.Or(
 				q.Eq(utils.HeightFilter, -1),
 			),
 		)
 	case utils.TxFilterExpired:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 			q.Eq(utils.TicketSpenderFilter, ""), // not spent by a vote or revoke
 			q.Gt(utils.HeightFilter, 0),         // mined
 			q.Lte(utils.HeightFilter, expiryBlock),
 		)
 	case utils.TxFilterTickets:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Eq(utils.TypeFilter, txhelper.TxTypeTicketPurchase),
 		)
 	case utils.TxFilterAll:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.Or(
 				q.Eq(utils.TypeFilter, txhelper.TxTypeRegular),
 				q.Eq(utils.TypeFilter, txhelper.TxTypeMixed),
@@ -100,7 +105,7 @@ func (db *DB) prepareTxQuery(txFilter, _ /*requiredConfirmations*/, bestBlock in
 			),
 		)
 	default:
-		query = db.walletDataDB.Select(
+		matcher = q.And(
 			q.True(),
 		)
 	}
@@ -0,0 +1,220 @@
+package walletdata_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/asdine/storm/q"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const (
+	testBestBlock             int32 = 20
+	testRequiredConfirmations int32 = 2
+)
+
+var testTxs = []*sharedW.Transaction{{
+	Hash:        "a",
+	Type:        txhelper.TxTypeRegular,
+	Timestamp:   100,
+	BlockHeight: 10,
+	Amount:      1000,
+	Direction:   txhelper.TxDirectionReceived,
+	Label:       "Rent March",
+	Outputs:     []*sharedW.TxOutput{{Index: 0, Address: "addrA", AccountNumber: 0}},
+}, {
+	Hash:        "b",
+	Type:        txhelper.TxTypeRegular,
+	Timestamp:   200,
+	BlockHeight: 19,
+	Amount:      5000,
+	Direction:   txhelper.TxDirectionSent,
+	Label:       "groceries",
+	Inputs:      []*sharedW.TxInput{{PreviousOutpoint: "a:0", AccountNumber: 0}},
+	Outputs:     []*sharedW.TxOutput{{Index: 0, Address: "addrB", AccountNumber: -1}},
+}, {
+	Hash:        "c",
+	Type:        txhelper.TxTypeRegular,
+	Timestamp:   300,
+	BlockHeight: -1,
+	Amount:      20000,
+	Direction:   txhelper.TxDirectionReceived,
+	Outputs:     []*sharedW.TxOutput{{Index: 0, Address: "addrC", AccountNumber: 1}},
+}, {
+	Hash:        "d",
+	Type:        txhelper.TxTypeRegular,
+	Timestamp:   400,
+	BlockHeight: 20,
+	Amount:      300,
+	Direction:   txhelper.TxDirectionTransferred,
+	Label:       "rent april",
+	Outputs:     []*sharedW.TxOutput{{Index: 0, Address: "addrD", AccountNumber: 1}},
+}}
+
+func openTestDB(t *testing.T, txs []*sharedW.Transaction) *walletdata.DB {
+	t.Helper()
+	db, err := walletdata.Initialize(filepath.Join(t.TempDir(), walletdata.DCRDbName), &sharedW.Transaction{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for _, tx := range txs {
+		if _, err := db.SaveOrUpdate(&sharedW.Transaction{}, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+// queryTxs runs the query the way the wallets do and returns the hashes of
+// the matched transactions.
+func queryTxs(t *testing.T, db *walletdata.DB, query *sharedW.TxQuery) []string {
+	t.Helper()
+	var addressOutpoints map[string]bool
+	if query.Address != "" {
+		var addressTxs []*sharedW.Transaction
+		if err := db.Find(query.AddressMatcher(), &addressTxs); err != nil {
+			t.Fatal(err)
+		}
+		addressOutpoints = query.AddressOutpoints(addressTxs)
+	}
+
+	matcher := q.And(query.ConfirmationMatcher(testRequiredConfirmations, testBestBlock), query.Matcher(addressOutpoints))
+	var txs []*sharedW.Transaction
+	err := db.Query(query.Offset, query.Limit, query.Filter(), query.NewestFirst, testBestBlock, query.From, query.To, matcher, &txs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		if !query.Match(tx, testRequiredConfirmations, testBestBlock, addressOutpoints) {
+			t.Errorf("%s does not match the query it was returned for", tx.Hash)
+		}
+		hashes = append(hashes, tx.Hash)
+	}
+	return hashes
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query sharedW.TxQuery
+		want  []string
+	}{{
+		name:  "all",
+		query: sharedW.TxQuery{},
+		want:  []string{"a", "b", "c", "d"},
+	}, {
+		name:  "confirmed",
+		query: sharedW.TxQuery{Confirmation: sharedW.Confirmed},
+		want:  []string{"a", "b"},
+	}, {
+		name:  "unconfirmed",
+		query: sharedW.TxQuery{Confirmation: sharedW.Unconfirmed},
+		want:  []string{"c", "d"},
+	}, {
+		name:  "amount range",
+		query: sharedW.TxQuery{MinAmount: 1000, MaxAmount: 5000},
+		want:  []string{"a", "b"},
+	}, {
+		name:  "min amount",
+		query: sharedW.TxQuery{MinAmount: 5000},
+		want:  []string{"b", "c"},
+	}, {
+		name:  "date range",
+		query: sharedW.TxQuery{From: 150, To: 350},
+		want:  []string{"b", "c"},
+	}, {
+		name:  "address paid and spent",
+		query: sharedW.TxQuery{Address: "addrA"},
+		want:  []string{"a", "b"},
+	}, {
+		name:  "unknown address",
+		query: sharedW.TxQuery{Address: "addrX"},
+		want:  []string{},
+	}, {
+		name:  "case insensitive label",
+		query: sharedW.TxQuery{Label: "rent"},
+		want:  []string{"a", "d"},
+	}, {
+		name:  "accounts",
+		query: sharedW.TxQuery{Accounts: []int32{1}},
+		want:  []string{"c", "d"},
+	}, {
+		name:  "sent",
+		query: sharedW.TxQuery{TxFilter: utils.TxFilterSent},
+		want:  []string{"b"},
+	}, {
+		name:  "combined conditions",
+		query: sharedW.TxQuery{Label: "rent", Confirmation: sharedW.Unconfirmed},
+		want:  []string{"d"},
+	}, {
+		name:  "newest first page",
+		query: sharedW.TxQuery{NewestFirst: true, Offset: 1, Limit: 2},
+		want:  []string{"c", "b"},
+	}, {
+		name:  "newest first page of a period",
+		query: sharedW.TxQuery{From: 1, NewestFirst: true, Offset: 1, Limit: 2},
+		want:  []string{"c", "b"},
+	}, {
+		name:  "page of a filtered period",
+		query: sharedW.TxQuery{From: 1, MinAmount: 1000, Offset: 1, Limit: 5},
+		want:  []string{"b", "c"},
+	}}
+
+	db := openTestDB(t, testTxs)
+	for _, test := range tests {
+		got := queryTxs(t, db, &test.query)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+// TestQueryPeriodBatches checks that the pages of a period query are read
+// past the first batch of transactions.
+func TestQueryPeriodBatches(t *testing.T) {
+	const txCount = 1200
+	txs := make([]*sharedW.Transaction, 0, txCount)
+	for i := 0; i < txCount; i++ {
+		txs = append(txs, &sharedW.Transaction{
+			Hash:        fmt.Sprintf("%04d", i),
+			Type:        txhelper.TxTypeRegular,
+			Timestamp:   int64(i + 1),
+			BlockHeight: 10,
+			Amount:      int64(i % 2),
+		})
+	}
+	db := openTestDB(t, txs)
+
+	tests := []struct {
+		name  string
+		query sharedW.TxQuery
+		want  []string
+	}{{
+		name:  "page in a later batch",
+		query: sharedW.TxQuery{From: 1, Offset: 1100, Limit: 2},
+		want:  []string{"1100", "1101"},
+	}, {
+		name:  "filtered page spanning batches",
+		query: sharedW.TxQuery{From: 1, MinAmount: 1, Offset: 249, Limit: 2},
+		want:  []string{"0499", "0501"},
+	}, {
+		name:  "newest first page in a later batch",
+		query: sharedW.TxQuery{From: 1, NewestFirst: true, Offset: 1198, Limit: 5},
+		want:  []string{"0001", "0000"},
+	}}
+	for _, test := range tests {
+		got := queryTxs(t, db, &test.query)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package walletdata
 
 import (
+	"reflect"
+
 	"github.com/asdine/storm"
+	"github.com/asdine/storm/index"
 	"github.com/asdine/storm/q"
 )
 
@@ -48,6 +51,96 @@ func (db *DB) Read(offset, limit, txFilter int32, newestFirst bool, requiredConf
 	return nil
 }
 
+// queryBatchSize is the number of transactions of a period read at once by
+// Query.
+const queryBatchSize = 500
+
+// Query reads the transactions that match both the `txFilter` and the `matcher`
+// into `transactions`, which should be a pointer to a slice of Transaction
+// objects. If a `from` or `to` timestamp is set, the transactions of that
+// period are read in batches using the Timestamp index and the matchers are
+// applied to each batch until `offset` + `limit` transactions matched,
+// otherwise all the transactions are scanned.
+func (db *DB) Query(offset, limit, txFilter int32, newestFirst bool, bestBlock int32, from, to int64, matcher q.Matcher, transactions interface{}) error {
+	matcher = q.And(db.txFilterMatcher(txFilter, bestBlock), matcher)
+	if from == 0 && to == 0 {
+		query := db.walletDataDB.Select(matcher).Skip(int(offset))
+		if limit > 0 {
+			query = query.Limit(int(limit))
+		}
+		query = query.OrderBy("Timestamp")
+		if newestFirst {
+			query = query.Reverse()
+		}
+
+		err := query.Find(transactions)
+		if err != nil && err != storm.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+
+	list := reflect.ValueOf(transactions).Elem()
+
+	// A reverse range returns nothing if its end is past the newest
+	// transaction, so the period ends at the newest transaction at most.
+	newest := reflect.New(list.Type())
+	err := db.walletDataDB.Select().OrderBy("Timestamp").Reverse().Limit(1).Find(newest.Interface())
+	if err == storm.ErrNotFound {
+		list.Set(reflect.MakeSlice(list.Type(), 0, 0))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	newestTimestamp := reflect.Indirect(newest.Elem().Index(0)).FieldByName("Timestamp").Int()
+	if to == 0 || to > newestTimestamp {
+		to = newestTimestamp
+	}
+	if from > to {
+		list.Set(reflect.MakeSlice(list.Type(), 0, 0))
+		return nil
+	}
+
+	matched := reflect.MakeSlice(list.Type(), 0, 0)
+	var skipped int32
+	for batchStart := 0; limit <= 0 || matched.Len() < int(limit); batchStart += queryBatchSize {
+		options := []func(*index.Options){storm.Skip(batchStart), storm.Limit(queryBatchSize)}
+		if newestFirst {
+			options = append(options, storm.Reverse())
+		}
+		batch := reflect.New(list.Type())
+		err := db.walletDataDB.Range("Timestamp", from, to, batch.Interface(), options...)
+		if err != nil && err != storm.ErrNotFound {
+			return err
+		}
+
+		txs := batch.Elem()
+		for i := 0; i < txs.Len(); i++ {
+			if limit > 0 && matched.Len() == int(limit) {
+				break
+			}
+			ok, err := matcher.Match(txs.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			matched = reflect.Append(matched, txs.Index(i))
+		}
+		if txs.Len() < queryBatchSize {
+			break
+		}
+	}
+	list.Set(matched)
+	return nil
+}
+
 // Count queries the db for transactions of the `txObj` type
 // to return the number of records matching the specified `txFilter`.
 func (db *DB) Count(txFilter int32, requiredConfirmations, bestBlock int32, txObj interface{}) (int, error) {
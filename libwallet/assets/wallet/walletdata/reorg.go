@@ -0,0 +1,68 @@
+package walletdata
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+)
+
+// unminedBlockHeight is the block height recorded for txs that are yet to be
+// included in a block.
+const unminedBlockHeight int32 = -1
+
+// RollbackToHeight reverts the tx index to the state it was in before the
+// block at `forkHeight` was connected. All the transactions recorded at or
+// above `forkHeight` are marked as unmined and the last index point is moved
+// back to the block preceding the fork point so that the next indexing
+// operation picks up the transactions from the new chain. The rollback is
+// done in a single db transaction, nothing is changed if it fails.
+// `transactions` should be a pointer to a slice of Transaction objects and
+// is populated with the transactions as they were before the rollback.
+func (db *DB) RollbackToHeight(forkHeight int32, transactions interface{}) error {
+	dbTx, err := db.walletDataDB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbTx.Rollback()
+	}()
+
+	err = dbTx.Select(q.Gte("BlockHeight", forkHeight)).Find(transactions)
+	if err != nil && err != storm.ErrNotFound {
+		return fmt.Errorf("error reading txs above the fork point: %s", err.Error())
+	}
+
+	txs := reflect.Indirect(reflect.ValueOf(transactions))
+	for i := 0; i < txs.Len(); i++ {
+		// Make a copy of the record so that the caller still gets to see
+		// the block heights the txs were previously mined at.
+		record := reflect.New(txs.Index(i).Type().Elem())
+		record.Elem().Set(txs.Index(i).Elem())
+		record.Elem().FieldByName("BlockHeight").SetInt(int64(unminedBlockHeight))
+
+		if err = dbTx.Save(record.Interface()); err != nil {
+			return fmt.Errorf("error rolling back tx: %s", err.Error())
+		}
+	}
+
+	lastIndexPoint := forkHeight - 1
+	if lastIndexPoint < 0 {
+		lastIndexPoint = 0
+	}
+
+	var endBlockHeight int32
+	err = dbTx.Get(TxBucketName, KeyEndBlock, &endBlockHeight)
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+
+	if endBlockHeight > lastIndexPoint {
+		err = dbTx.Set(TxBucketName, KeyEndBlock, &lastIndexPoint)
+		if err != nil {
+			return fmt.Errorf("error setting block height for last indexed tx: %s", err.Error())
+		}
+	}
+	return dbTx.Commit()
+}
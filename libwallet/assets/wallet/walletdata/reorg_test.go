@@ -0,0 +1,102 @@
+package walletdata
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+type testTx struct {
+	Hash        string `storm:"id,unique"`
+	Timestamp   int64  `storm:"index"`
+	BlockHeight int32  `storm:"index"`
+	Label       string
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	tests := []struct {
+		name           string
+		forkHeight     int32
+		lastIndexPoint int32
+		wantReorged    map[string]int32
+		wantIndexPoint int32
+	}{{
+		name:           "txs above the fork point",
+		forkHeight:     10,
+		lastIndexPoint: 12,
+		wantReorged:    map[string]int32{"b": 10, "c": 12},
+		wantIndexPoint: 9,
+	}, {
+		name:           "no txs above the fork point",
+		forkHeight:     13,
+		lastIndexPoint: 14,
+		wantReorged:    map[string]int32{},
+		wantIndexPoint: 12,
+	}, {
+		name:           "index point below the fork point",
+		forkHeight:     12,
+		lastIndexPoint: 8,
+		wantReorged:    map[string]int32{"c": 12},
+		wantIndexPoint: 8,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := Initialize(filepath.Join(t.TempDir(), DCRDbName), &testTx{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			for _, tx := range []*testTx{
+				{Hash: "a", Timestamp: 1, BlockHeight: 5},
+				{Hash: "b", Timestamp: 2, BlockHeight: 10},
+				{Hash: "c", Timestamp: 3, BlockHeight: 12},
+				{Hash: "d", Timestamp: 4, BlockHeight: unminedBlockHeight},
+			} {
+				if _, err := db.SaveOrUpdate(&testTx{}, tx); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := db.SaveLastIndexPoint(test.lastIndexPoint); err != nil {
+				t.Fatal(err)
+			}
+
+			var reorged []*testTx
+			if err := db.RollbackToHeight(test.forkHeight, &reorged); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(reorged) != len(test.wantReorged) {
+				t.Fatalf("expected %d reorged txs, got %d", len(test.wantReorged), len(reorged))
+			}
+			for _, tx := range reorged {
+				if height, ok := test.wantReorged[tx.Hash]; !ok || tx.BlockHeight != height {
+					t.Errorf("unexpected reorged tx %s at height %d", tx.Hash, tx.BlockHeight)
+				}
+
+				var saved testTx
+				if err := db.FindOne("Hash", tx.Hash, &saved); err != nil {
+					t.Fatal(err)
+				}
+				if saved.BlockHeight != unminedBlockHeight {
+					t.Errorf("expected tx %s to be unmined, got height %d", tx.Hash, saved.BlockHeight)
+				}
+			}
+
+			var kept testTx
+			if err := db.FindOne("Hash", "a", &kept); err != nil {
+				t.Fatal(err)
+			}
+			if kept.BlockHeight != 5 {
+				t.Errorf("expected the tx below the fork point to stay at height 5, got %d", kept.BlockHeight)
+			}
+
+			indexPoint, err := db.LastIndexPoint()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if indexPoint != test.wantIndexPoint {
+				t.Errorf("expected last index point %d, got %d", test.wantIndexPoint, indexPoint)
+			}
+		})
+	}
+}
@@ -84,5 +84,9 @@ func (db *DB) ClearSavedTransactions(emptyTxPointer interface{}) error {
 		return err
 	}
 
+	if err = db.ClearBalanceSnapshots(); err != nil {
+		return err
+	}
+
 	return db.SaveLastIndexPoint(0)
 }
@@ -5,6 +5,7 @@ import (
 
 	"decred.org/dcrwallet/v4/errors"
 	"github.com/asdine/storm"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 
@@ -138,6 +139,19 @@ func (mgr *AssetsManager) SetDarkMode(data bool) {
 	mgr.SaveAppConfigValue(sharedW.DarkModeConfigKey, data)
 }
 
+// GetTheme returns the name of the theme palette, it is empty if the user
+// has not selected one.
+func (mgr *AssetsManager) GetTheme() string {
+	var name string
+	mgr.ReadAppConfigValue(sharedW.ThemeConfigKey, &name)
+	return name
+}
+
+// SetTheme sets the name of the theme palette.
+func (mgr *AssetsManager) SetTheme(name string) {
+	mgr.SaveAppConfigValue(sharedW.ThemeConfigKey, name)
+}
+
 // GetCurrencyConversionExchange returns the currency conversion exchange.
 func (mgr *AssetsManager) GetCurrencyConversionExchange() string {
 	if mgr.RateSource != nil {
@@ -164,6 +178,32 @@ func (mgr *AssetsManager) SetCurrencyConversionExchange(xc string) {
 	}()
 }
 
+// GetFiatCurrency returns the fiat currency asset values are displayed in.
+func (mgr *AssetsManager) GetFiatCurrency() string {
+	if mgr.RateSource != nil {
+		return mgr.RateSource.FiatCurrency()
+	}
+	var currency string
+	mgr.ReadAppConfigValue(sharedW.FiatCurrencyConfigKey, &currency)
+	if !ext.IsSupportedFiatCurrency(currency) {
+		return ext.DefaultFiatCurrency
+	}
+	return currency
+}
+
+// SetFiatCurrency sets the fiat currency asset values are displayed in and
+// fetches its rate.
+func (mgr *AssetsManager) SetFiatCurrency(currency string) {
+	mgr.rateMutex.Lock()
+	defer mgr.rateMutex.Unlock()
+	if err := mgr.RateSource.SetFiatCurrency(currency); err != nil {
+		log.Errorf("Failed to set fiat currency: %v", err)
+		return
+	}
+	mgr.SaveAppConfigValue(sharedW.FiatCurrencyConfigKey, currency)
+	go mgr.RateSource.Refresh(false)
+}
+
 // ExchangeRateFetchingEnabled returns true if privacy mode isn't turned on and
 // a valid exchange rate source is configured.
 func (mgr *AssetsManager) ExchangeRateFetchingEnabled() bool {
@@ -16,14 +16,18 @@ import (
 	"github.com/asdine/storm/q"
 	"github.com/crypto-power/cryptopower/appos"
 	"github.com/crypto-power/cryptopower/dexc"
+	"github.com/crypto-power/cryptopower/libwallet/dexorders"
 	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	"github.com/crypto-power/cryptopower/libwallet/internal/politeia"
+	"github.com/crypto-power/cryptopower/libwallet/portfolio"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/notification"
 	"github.com/crypto-power/cryptopower/ui/values"
 	bolt "go.etcd.io/bbolt"
 
+	"github.com/crypto-power/cryptopower/libwallet/activity"
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
@@ -67,10 +71,22 @@ type AssetsManager struct {
 	ConsensusAgenda *dcr.ConsensusAgenda
 	Politeia        *politeia.Politeia
 	InstantSwap     *instantswap.InstantSwap
+	// ConditionalOrders places conditional DEX orders once their triggers
+	// are met.
+	ConditionalOrders *dexorders.Engine
+	// Portfolio saves the target allocation of the assets and tracks the
+	// scheduled rebalancing checks.
+	Portfolio *portfolio.Portfolio
+	// TxExporter exports the transaction history of the wallets and caches
+	// the historical prices used for the exported fiat values.
+	TxExporter      *txexport.Exporter
 	ExternalService *ext.Service
 	RateSource      ext.RateSource
 	rateMutex       sync.Mutex
 
+	// activity tracks the listeners of the activity feed.
+	activity activityFeed
+
 	dexcMtx     sync.RWMutex
 	dexcCtx     context.Context
 	dexc        DEXClient
@@ -180,11 +196,30 @@ func NewAssetsManager(rootDir, logDir string, netType utils.NetworkType, dexTest
 		return nil, err
 	}
 
+	conditionalOrders, err := dexorders.NewEngine(mwDB)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio, err := portfolio.NewPortfolio(mwDB)
+	if err != nil {
+		return nil, err
+	}
+
+	txExporter, err := txexport.NewExporter(mwDB)
+	if err != nil {
+		return nil, err
+	}
+
 	mgr.ConsensusAgenda = dcr.NewConsensusAgenda(mgr.chainsParams.DCR, mwDB)
 
 	mgr.params.DB = mwDB
 	mgr.Politeia = politeia
 	mgr.InstantSwap = instantSwap
+	mgr.ConditionalOrders = conditionalOrders
+	mgr.Portfolio = portfolio
+	mgr.TxExporter = txExporter
+	mgr.activity.listeners = make(map[string]*activity.FeedListener)
 
 	// initialize the ExternalService. ExternalService provides assetsManager
 	// with the functionalities to retrieve data from some 3rd party services.
@@ -232,6 +267,7 @@ func (mgr *AssetsManager) initRateSource() (err error) {
 	mgr.cancelFuncs = append(mgr.cancelFuncs, cancel)
 
 	rateSource := mgr.GetCurrencyConversionExchange()
+	fiatCurrency := mgr.GetFiatCurrency()
 	disabled := mgr.IsPrivacyModeOn()
 
 	mgr.RateSource, err = ext.NewCommonRateSource(ctx, rateSource, mgr.disableConversionExchange)
@@ -240,6 +276,9 @@ func (mgr *AssetsManager) initRateSource() (err error) {
 	}
 
 	mgr.RateSource.ToggleStatus(disabled)
+	if err = mgr.RateSource.SetFiatCurrency(fiatCurrency); err != nil {
+		return fmt.Errorf("RateSource.SetFiatCurrency error: %w", err)
+	}
 
 	// Start the refresh goroutine even if rate source is disabled.
 	go func() {
@@ -347,6 +386,14 @@ func (mgr *AssetsManager) Shutdown() {
 		mgr.InstantSwap.StopSync()
 	}
 
+	// Stop the running swap schedules, they resume once the passphrase is
+	// provided again.
+	mgr.InstantSwap.StopSchedules()
+	mgr.Portfolio.Stop()
+
+	// Stop placing conditional DEX orders before the DEX client shuts down.
+	mgr.ConditionalOrders.Stop()
+
 	// Shutdown dexc before closing wallets.
 	if mgr.DEXCInitialized() {
 		mgr.dexcMtx.RLock()
@@ -890,6 +937,39 @@ func (mgr *AssetsManager) CalculateAssetsUSDBalance(balances map[utils.AssetType
 	return assetsTotalUSDBalance, nil
 }
 
+// CalculateAssetsFiatBalance returns the value of the balances in the selected
+// fiat currency.
+func (mgr *AssetsManager) CalculateAssetsFiatBalance(balances map[utils.AssetType]sharedW.AssetAmount) (map[utils.AssetType]float64, error) {
+	usdBalances, err := mgr.CalculateAssetsUSDBalance(balances)
+	if err != nil {
+		return nil, err
+	}
+
+	fiatRate := mgr.RateSource.FiatRate(true)
+	if fiatRate <= 0 {
+		return nil, fmt.Errorf("no %s rate information available", mgr.RateSource.FiatCurrency())
+	}
+
+	for assetType, usdBal := range usdBalances {
+		usdBalances[assetType] = usdBal * fiatRate
+	}
+	return usdBalances, nil
+}
+
+// ToFiat converts the USD amount to the selected fiat currency and returns the
+// converted amount with its currency. The USD amount is returned as is if the
+// fiat rate is not available yet.
+func (mgr *AssetsManager) ToFiat(usdAmt float64) (float64, string) {
+	if mgr.RateSource == nil {
+		return usdAmt, ext.FiatUSD
+	}
+	fiatRate := mgr.RateSource.FiatRate(true)
+	if fiatRate <= 0 {
+		return usdAmt, ext.FiatUSD
+	}
+	return usdAmt * fiatRate, mgr.RateSource.FiatCurrency()
+}
+
 // DexClient returns a dexc client that MUST never be modified.
 func (mgr *AssetsManager) DexClient() DEXClient {
 	mgr.dexcMtx.RLock()
@@ -952,6 +1032,8 @@ func (mgr *AssetsManager) DeleteDEXData() error {
 		return nil // nothing to do.
 	}
 
+	mgr.ConditionalOrders.Stop()
+
 	mgr.dexcMtx.Lock()
 	defer mgr.dexcMtx.Unlock()
 
@@ -0,0 +1,172 @@
+package libwallet
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/balancehistory"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// WalletBalanceHistory returns the daily balance of the wallet over the
+// range. Balances are valued in the selected fiat currency on the days a
+// price is known if exchange rates are enabled.
+func (mgr *AssetsManager) WalletBalanceHistory(ctx context.Context, wallet sharedW.Asset, rng balancehistory.Range) (*balancehistory.History, error) {
+	const op errors.Op = "mgr.WalletBalanceHistory"
+
+	currency := mgr.balanceHistoryCurrency()
+	points, err := mgr.walletBalancePoints(ctx, wallet, rng, currency)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &balancehistory.History{
+		Asset:    wallet.GetAssetType(),
+		Currency: currency,
+		Points:   points,
+	}, nil
+}
+
+// TotalBalanceHistory returns the daily fiat value of all the wallets over
+// the range. A day is only valued if the prices of all the wallet assets are
+// known for that day.
+func (mgr *AssetsManager) TotalBalanceHistory(ctx context.Context, rng balancehistory.Range) (*balancehistory.History, error) {
+	const op errors.Op = "mgr.TotalBalanceHistory"
+
+	currency := mgr.balanceHistoryCurrency()
+	today := balancehistory.DayStart(time.Now().Unix())
+	totals := make(map[int64]*balancehistory.Point)
+	firstDay := today
+	for _, wallet := range mgr.AllWallets() {
+		points, err := mgr.walletBalancePoints(ctx, wallet, rng, currency)
+		if err != nil {
+			// Wallets that are not loaded yet are left out.
+			log.Errorf("Error getting %s balance history: %v", wallet.GetWalletName(), err)
+			continue
+		}
+
+		for _, point := range points {
+			total, ok := totals[point.Day]
+			if !ok {
+				total = &balancehistory.Point{Day: point.Day, HasValue: currency != ""}
+				totals[point.Day] = total
+			}
+			total.Value += point.Value
+			total.HasValue = total.HasValue && point.HasValue
+		}
+		if len(points) > 0 && points[0].Day < firstDay {
+			firstDay = points[0].Day
+		}
+	}
+
+	history := &balancehistory.History{Currency: currency}
+	for day := firstDay; day <= today; day += balancehistory.Day {
+		total, ok := totals[day]
+		if !ok {
+			// The wallets had no balance yet.
+			total = &balancehistory.Point{Day: day, HasValue: currency != ""}
+		}
+		history.Points = append(history.Points, total)
+	}
+	return history, nil
+}
+
+// balanceHistoryCurrency returns the fiat currency balances are valued in,
+// or an empty currency if exchange rates are disabled.
+func (mgr *AssetsManager) balanceHistoryCurrency() string {
+	if !mgr.ExchangeRateFetchingEnabled() {
+		return ""
+	}
+	return mgr.GetFiatCurrency()
+}
+
+// walletBalancePoints returns the daily balances of the wallet over the
+// range, starting on the range's first day. The balances are valued in the
+// currency unless it is empty.
+func (mgr *AssetsManager) walletBalancePoints(ctx context.Context, wallet sharedW.Asset, rng balancehistory.Range, currency string) ([]*balancehistory.Point, error) {
+	txs, err := wallet.GetTransactionsRaw(0, math.MaxInt32, utils.TxFilterAll, false, "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	snapshots, err := balancehistory.DailyBalances(wallet.GetWalletDataDb(), txs, now)
+	if err != nil {
+		return nil, err
+	}
+
+	today := balancehistory.DayStart(now.Unix())
+	start := rng.Start(today)
+	var points []*balancehistory.Point
+	if len(snapshots) > 0 && start > 0 {
+		// Days before the first transaction of the range have no balance.
+		for day := start; day < snapshots[0].Day; day += balancehistory.Day {
+			points = append(points, &balancehistory.Point{Day: day})
+		}
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Day < start {
+			continue
+		}
+		points = append(points, &balancehistory.Point{
+			Day:     snapshot.Day,
+			Balance: wallet.ToAmount(snapshot.Balance).ToCoin(),
+		})
+	}
+
+	if currency == "" {
+		return points, nil
+	}
+
+	asset := wallet.GetAssetType()
+	days := make([]int64, 0, len(points))
+	for _, point := range points {
+		if point.Day < today {
+			days = append(days, point.Day)
+		}
+	}
+	prices, err := mgr.TxExporter.DailyPrices(ctx, asset, currency, days)
+	if err != nil {
+		log.Errorf("Error getting %s historical prices: %v", asset, err)
+	}
+	if price, ok := mgr.currentFiatPrice(asset); ok {
+		if prices == nil {
+			prices = make(map[int64]float64)
+		}
+		prices[today] = price
+	}
+
+	for _, point := range points {
+		if point.Balance == 0 {
+			point.HasValue = true
+			continue
+		}
+		if price, ok := prices[point.Day]; ok {
+			point.Value = point.Balance * price
+			point.HasValue = true
+		}
+	}
+	return points, nil
+}
+
+// currentFiatPrice returns the current price of the asset in the selected
+// fiat currency using the cached exchange rates.
+func (mgr *AssetsManager) currentFiatPrice(asset utils.AssetType) (float64, bool) {
+	market, ok := values.AssetExchangeMarketValue[asset]
+	if !ok || mgr.RateSource == nil {
+		return 0, false
+	}
+	ticker := mgr.RateSource.GetTicker(market, true)
+	if ticker == nil || ticker.LastTradePrice <= 0 {
+		return 0, false
+	}
+	fiatRate := mgr.RateSource.FiatRate(true)
+	if fiatRate <= 0 {
+		return 0, false
+	}
+	return ticker.LastTradePrice * fiatRate, true
+}
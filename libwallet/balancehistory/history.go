@@ -0,0 +1,132 @@
+package balancehistory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
+)
+
+// Day is the length of a day in seconds.
+const Day = int64(24 * time.Hour / time.Second)
+
+// DayStart returns the Unix time of the start of the UTC day of timestamp.
+func DayStart(timestamp int64) int64 {
+	return timestamp - timestamp%Day
+}
+
+// Start returns the first day of the range ending on today, or zero if the
+// range has no start.
+func (r Range) Start(today int64) int64 {
+	switch r {
+	case Week:
+		return today - 6*Day
+	case Month:
+		return today - 29*Day
+	case Year:
+		return today - 364*Day
+	}
+	return 0
+}
+
+// DailyBalances returns the balance of the wallet at the end of each day,
+// from the day of its first transaction to today. The balances of the days
+// before yesterday are saved to the wallet db and reused until a transaction
+// of those days is added, removed, confirmed or mined in another block.
+func DailyBalances(db *walletdata.DB, txs []*sharedW.Transaction, now time.Time) ([]*walletdata.BalanceSnapshot, error) {
+	today := DayStart(now.Unix())
+	savedEnd := today - Day
+
+	// Confirmed transactions change the balance of the day they were mined
+	// and unconfirmed transactions the balance of the current day.
+	changes := make(map[int64]int64)
+	firstDay := today
+	for _, tx := range txs {
+		day := today
+		if tx.BlockHeight > 0 {
+			day = DayStart(tx.Timestamp)
+		}
+		changes[day] += balanceChange(tx)
+		if day < firstDay {
+			firstDay = day
+		}
+	}
+
+	snapshots, savedTxSetHash, err := db.BalanceSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	startDay, balance := firstDay, int64(0)
+	if n := len(snapshots); n > 0 {
+		cachedEnd := snapshots[n-1].Day + Day
+		if savedTxSetHash != "" && confirmedTxSetHash(txs, cachedEnd) == savedTxSetHash {
+			startDay, balance = cachedEnd, snapshots[n-1].Balance
+		} else {
+			log.Debugf("Balance snapshots are outdated, computing them again")
+			if err = db.ClearBalanceSnapshots(); err != nil {
+				return nil, err
+			}
+			snapshots = nil
+		}
+	}
+
+	var newSnapshots []*walletdata.BalanceSnapshot
+	for day := startDay; day <= today; day += Day {
+		balance += changes[day]
+		snapshot := &walletdata.BalanceSnapshot{Day: day, Balance: balance}
+		snapshots = append(snapshots, snapshot)
+		if day < savedEnd {
+			newSnapshots = append(newSnapshots, snapshot)
+		}
+	}
+
+	if n := len(newSnapshots); n > 0 {
+		txSetHash := confirmedTxSetHash(txs, newSnapshots[n-1].Day+Day)
+		if err = db.SaveBalanceSnapshots(newSnapshots, txSetHash); err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+// balanceChange returns the amount the transaction added to the wallet
+// balance, the wallet outputs it created less the wallet outputs it spent.
+func balanceChange(tx *sharedW.Transaction) int64 {
+	var change int64
+	for _, output := range tx.Outputs {
+		if output.AccountNumber != -1 {
+			change += output.Amount
+		}
+	}
+	for _, input := range tx.Inputs {
+		if input.AccountNumber != -1 {
+			change -= input.Amount
+		}
+	}
+	return change
+}
+
+// confirmedTxSetHash returns the hash of the confirmed transactions mined
+// before the end time, their blocks and balance changes. It changes if the
+// set of transactions the saved balances were computed from changes, even if
+// their number does not.
+func confirmedTxSetHash(txs []*sharedW.Transaction, end int64) string {
+	var entries []string
+	for _, tx := range txs {
+		if tx.BlockHeight > 0 && tx.Timestamp < end {
+			entries = append(entries, fmt.Sprintf("%s:%d:%d", tx.Hash, tx.BlockHeight, balanceChange(tx)))
+		}
+	}
+	sort.Strings(entries)
+
+	hash := sha256.New()
+	for _, entry := range entries {
+		hash.Write([]byte(entry + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,96 @@
+package balancehistory
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
+)
+
+func TestDailyBalances(t *testing.T) {
+	db, err := walletdata.Initialize(filepath.Join(t.TempDir(), walletdata.DCRDbName), &sharedW.Transaction{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Unix(100*Day+3600, 0)
+	today := DayStart(now.Unix())
+	received := func(hash string, day int64, height int32, amount int64) *sharedW.Transaction {
+		return &sharedW.Transaction{
+			Hash:        hash,
+			Timestamp:   day + 60,
+			BlockHeight: height,
+			Outputs:     []*sharedW.TxOutput{{Amount: amount, AccountNumber: 0}},
+		}
+	}
+	balances := func(snapshots []*walletdata.BalanceSnapshot) []int64 {
+		var balances []int64
+		for _, snapshot := range snapshots {
+			balances = append(balances, snapshot.Balance)
+		}
+		return balances
+	}
+
+	tests := []struct {
+		name string
+		txs  []*sharedW.Transaction
+		want []int64 // balances from 4 days ago to today.
+	}{{
+		name: "confirmed and unconfirmed transactions",
+		txs: []*sharedW.Transaction{
+			received("a", today-4*Day, 10, 5),
+			received("b", today-2*Day, 20, 3),
+			received("c", today-3*Day, -1, 7), // unconfirmed, counted today.
+		},
+		want: []int64{5, 5, 8, 8, 15},
+	}, {
+		name: "saved balances reused",
+		txs: []*sharedW.Transaction{
+			received("a", today-4*Day, 10, 5),
+			received("b", today-2*Day, 20, 3),
+			received("c", today-3*Day, -1, 7),
+		},
+		want: []int64{5, 5, 8, 8, 15},
+	}, {
+		// The same number of confirmed transactions as saved, "b" was
+		// reorged out and "d" mined on another day.
+		name: "transaction replaced",
+		txs: []*sharedW.Transaction{
+			received("a", today-4*Day, 10, 5),
+			received("d", today-3*Day, 15, 2),
+		},
+		want: []int64{5, 7, 7, 7, 7},
+	}, {
+		name: "transaction mined in another block",
+		txs: []*sharedW.Transaction{
+			received("a", today-4*Day, 10, 5),
+			received("d", today-2*Day, 18, 2),
+		},
+		want: []int64{5, 5, 7, 7, 7},
+	}}
+	for _, test := range tests {
+		snapshots, err := DailyBalances(db, test.txs, now)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := balances(snapshots); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected balances %v, got %v", test.name, test.want, got)
+		}
+		if snapshots[0].Day != today-4*Day || snapshots[len(snapshots)-1].Day != today {
+			t.Errorf("%s: unexpected range %d to %d", test.name, snapshots[0].Day, snapshots[len(snapshots)-1].Day)
+		}
+
+		// The days before yesterday are saved.
+		saved, txSetHash, err := db.BalanceSnapshots()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(saved) != 3 || txSetHash == "" {
+			t.Errorf("%s: expected 3 saved balances and a tx set hash, got %d and %q", test.name, len(saved), txSetHash)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package balancehistory
+
+import (
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Range is the period of time a balance history covers, ending today.
+type Range string
+
+const (
+	Week  Range = "1W"
+	Month Range = "1M"
+	Year  Range = "1Y"
+	All   Range = "All"
+)
+
+// Ranges are the supported ranges, in display order.
+var Ranges = []Range{Week, Month, Year, All}
+
+// Point is the balance at the end of a day.
+type Point struct {
+	// Day is the Unix time of the start of the UTC day.
+	Day int64
+	// Balance is the balance in coins of a wallet. It is zero for the total
+	// of the wallets of different assets, which is only known in fiat.
+	Balance float64
+	// Value is the fiat value of the balance, it is only set if HasValue
+	// is true.
+	Value    float64
+	HasValue bool
+}
+
+// History is the daily balance of a wallet or the total of all wallets,
+// oldest first.
+type History struct {
+	// Asset is the asset of the wallet, it is empty for totals.
+	Asset    utils.AssetType
+	Currency string
+	Points   []*Point
+}
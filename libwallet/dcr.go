@@ -37,6 +37,7 @@ func (mgr *AssetsManager) CreateNewDCRWallet(walletName, privatePassphrase strin
 	}
 
 	mgr.Assets.DCR.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	// Allow spending from the default account by default.
 	wallet.SetBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, true)
@@ -52,6 +53,7 @@ func (mgr *AssetsManager) CreateNewDCRWatchOnlyWallet(walletName, extendedPublic
 	}
 
 	mgr.Assets.DCR.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	// Allow spending from the default account by default.
 	wallet.SetBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, true)
@@ -73,6 +75,7 @@ func (mgr *AssetsManager) RestoreDCRWallet(walletName, seedMnemonic, privatePass
 	}
 
 	mgr.Assets.DCR.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	// Allow spending from the default account by default.
 	wallet.SetBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, true)
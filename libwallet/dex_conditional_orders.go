@@ -0,0 +1,31 @@
+package libwallet
+
+import (
+	"decred.org/dcrwallet/v4/errors"
+)
+
+// StartConditionalOrders resumes the active conditional DEX orders. appPW is
+// the DEX password used to place the orders once their triggers are met. It
+// is a no-op if the conditional orders are already running.
+func (mgr *AssetsManager) StartConditionalOrders(appPW []byte) error {
+	const op errors.Op = "mgr.StartConditionalOrders"
+
+	if !mgr.DEXCInitialized() {
+		return errors.E(op, "DEX client is not initialized")
+	}
+
+	if mgr.ConditionalOrders.IsRunning() {
+		return nil
+	}
+
+	if err := mgr.ConditionalOrders.Start(mgr.dexcCtx, mgr.DexClient(), appPW); err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// StopConditionalOrders stops placing conditional DEX orders until
+// StartConditionalOrders is called again.
+func (mgr *AssetsManager) StopConditionalOrders() {
+	mgr.ConditionalOrders.Stop()
+}
@@ -19,17 +19,22 @@ type DEXClient interface {
 	DBPath() string
 	DiscoverAccount(dexAddr string, appPW []byte, certI any) (*core.Exchange, bool, error)
 	GetDEXConfig(dexAddr string, certI any) (*core.Exchange, error)
+	AddDEX(appPW []byte, dexAddr string, certI any) error
+	ToggleAccountStatus(pw []byte, host string, disable bool) error
+	UpdateCert(host string, cert []byte) error
 	BondsFeeBuffer(assetID uint32) uint64
 	HasWallet(assetID int32) bool
 	AddWallet(assetID uint32, settings map[string]string, appPW, walletPW []byte) error
 	SetWalletPassword(appPW []byte, assetID uint32, newPW []byte) error
 	PostBond(form *core.PostBondForm) (*core.PostBondResult, error)
+	UpdateBondOptions(form *core.BondOptionsForm) error
 	NotificationFeed() *core.NoteFeed
 	Exchanges() map[string]*core.Exchange
 	Exchange(host string) (*core.Exchange, error)
 	ExportSeed(pw []byte) (string, error)
 	SyncBook(dex string, base, quote uint32) (*orderbook.OrderBook, core.BookFeed, error)
 	Orders(filter *core.OrderFilter) ([]*core.Order, error)
+	Order(oid dex.Bytes) (*core.Order, error)
 	ActiveOrders() (map[string][]*core.Order, map[string][]*core.InFlightOrder, error)
 	Active() bool
 	Trade(pw []byte, form *core.TradeForm) (*core.Order, error)
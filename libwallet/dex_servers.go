@@ -0,0 +1,120 @@
+package libwallet
+
+import (
+	"fmt"
+	"sort"
+
+	"decred.org/dcrdex/client/comms"
+	"decred.org/dcrdex/dex"
+	"decred.org/dcrwallet/v4/errors"
+)
+
+// DEXMarketBook summarizes the order book of a market on a DEX server.
+type DEXMarketBook struct {
+	Host     string
+	LotSize  uint64
+	RateStep uint64
+	MidGap   uint64
+	// BestBuy and BestSell are the rates of the best buy and sell orders in
+	// the book, zero if that side of the book is empty.
+	BestBuy  uint64
+	BestSell uint64
+	// VWAP is the volume weighted average rate at which the book fills the
+	// requested quantity, zero if the book cannot fill it.
+	VWAP uint64
+	// CanTrade is true if the user has an account with trading tier on the
+	// server.
+	CanTrade bool
+	Err      error
+}
+
+// CompareDEXMarket returns the order books of the base/quote market on the
+// connected DEX servers that list the market. The VWAP of each book is
+// calculated for a buy or sell (if sell is true) of qty base asset atoms.
+func (mgr *AssetsManager) CompareDEXMarket(base, quote uint32, qty uint64, sell bool) ([]*DEXMarketBook, error) {
+	const op errors.Op = "mgr.CompareDEXMarket"
+
+	if !mgr.DEXCInitialized() {
+		return nil, errors.E(op, "DEX client is not initialized")
+	}
+
+	mktName, err := dex.MarketName(base, quote)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	dexClient := mgr.DexClient()
+	var books []*DEXMarketBook
+	for host, xc := range dexClient.Exchanges() {
+		mkt, ok := xc.Markets[mktName]
+		if !ok || xc.Disabled || xc.ConnectionStatus != comms.Connected {
+			continue
+		}
+
+		mktBook := &DEXMarketBook{
+			Host:     host,
+			LotSize:  mkt.LotSize,
+			RateStep: mkt.RateStep,
+			CanTrade: !xc.ViewOnly && xc.Auth.EffectiveTier > 0,
+		}
+		books = append(books, mktBook)
+
+		book, feed, err := dexClient.SyncBook(host, base, quote)
+		if err != nil {
+			mktBook.Err = err
+			continue
+		}
+
+		mktBook.MidGap, _ = book.MidGap()
+		if buys, _, err := book.BestNOrders(1, false); err == nil && len(buys) > 0 {
+			mktBook.BestBuy = buys[0].Rate
+		}
+		if sells, _, err := book.BestNOrders(1, true); err == nil && len(sells) > 0 {
+			mktBook.BestSell = sells[0].Rate
+		}
+		if qty > 0 && qty%mkt.LotSize == 0 {
+			// Sells are filled by the buy orders of the book and buys by the
+			// sell orders.
+			vwap, _, filled, err := book.VWAP(qty/mkt.LotSize, mkt.LotSize, !sell)
+			if err == nil && filled {
+				mktBook.VWAP = vwap
+			}
+		}
+		feed.Close()
+	}
+
+	sort.Slice(books, func(i, j int) bool {
+		return books[i].Host < books[j].Host
+	})
+	return books, nil
+}
+
+// BestDEXServer returns the book of the DEX server that fills a buy or sell
+// (if sell is true) of qty base asset atoms at the best average rate. Only
+// servers where the user can trade and whose lot size and rate step allow
+// the order are considered. rate is the rate of limit orders, zero for
+// market orders.
+func (mgr *AssetsManager) BestDEXServer(base, quote uint32, qty, rate uint64, sell bool) (*DEXMarketBook, error) {
+	books, err := mgr.CompareDEXMarket(base, quote, qty, sell)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *DEXMarketBook
+	for _, book := range books {
+		if !book.CanTrade || book.Err != nil || book.VWAP == 0 {
+			continue
+		}
+		if rate > 0 && rate%book.RateStep != 0 {
+			continue
+		}
+		if best == nil || (sell && book.VWAP > best.VWAP) || (!sell && book.VWAP < best.VWAP) {
+			best = book
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no DEX server can fill the order")
+	}
+	return best, nil
+}
@@ -0,0 +1,466 @@
+package dexorders
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/client/orderbook"
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+)
+
+// checkInterval is how often the active orders are checked when no price
+// update is received, e.g. for the next buy of DCA orders.
+const checkInterval = 15 * time.Second
+
+// DEXClient is the DEX client functionality used by the Engine to watch
+// market prices and place orders.
+type DEXClient interface {
+	SyncBook(dex string, base, quote uint32) (*orderbook.OrderBook, core.BookFeed, error)
+	Trade(pw []byte, form *core.TradeForm) (*core.Order, error)
+}
+
+// Engine places the DEX orders of conditional orders once their triggers are
+// met. Conditional orders are saved to the database so they survive
+// restarts, but the Engine only runs while it holds the DEX password needed
+// to place orders, see Start.
+type Engine struct {
+	db *storm.DB
+
+	mtx    sync.Mutex
+	dexc   DEXClient
+	appPW  []byte
+	cancel context.CancelFunc
+	// midGaps are the latest mid-gap rates of the watched markets.
+	midGaps  map[string]uint64
+	watching map[string]bool
+	// placing holds the IDs of the orders whose DEX order is being placed.
+	placing map[int]bool
+	wake    chan struct{}
+
+	notificationListenersMu *sync.RWMutex // Pointer required to avoid copying literal values.
+	notificationListeners   map[string]*OrderNotificationListener
+}
+
+// NewEngine returns an Engine that saves conditional orders to db.
+func NewEngine(db *storm.DB) (*Engine, error) {
+	if err := db.Init(&ConditionalOrder{}); err != nil {
+		log.Errorf("Error initializing conditional orders database: %s", err.Error())
+		return nil, err
+	}
+	if err := db.Init(&Event{}); err != nil {
+		log.Errorf("Error initializing conditional order events database: %s", err.Error())
+		return nil, err
+	}
+
+	return &Engine{
+		db:                      db,
+		wake:                    make(chan struct{}, 1),
+		notificationListenersMu: &sync.RWMutex{},
+		notificationListeners:   make(map[string]*OrderNotificationListener),
+	}, nil
+}
+
+// Start resumes the active conditional orders. appPW is the DEX password,
+// it is kept in memory to place the DEX orders until Stop is called or ctx
+// is canceled.
+func (e *Engine) Start(ctx context.Context, dexc DEXClient, appPW []byte) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.cancel != nil {
+		return errors.New(ErrEngineRunning)
+	}
+
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.dexc, e.appPW = dexc, appPW
+	e.midGaps, e.watching = make(map[string]uint64), make(map[string]bool)
+	e.placing = make(map[int]bool)
+
+	log.Info("Conditional orders: engine started")
+	go e.run(ctx)
+	return nil
+}
+
+// Stop stops the Engine and clears the DEX password. Active orders are
+// resumed on the next Start.
+func (e *Engine) Stop() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	e.cancel, e.dexc, e.appPW = nil, nil, nil
+	log.Info("Conditional orders: engine stopped")
+}
+
+// IsRunning is true if the Engine has been started.
+func (e *Engine) IsRunning() bool {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return e.cancel != nil
+}
+
+// AddOrder validates and saves a new conditional order. It is checked right
+// away if the Engine is running.
+func (e *Engine) AddOrder(order *ConditionalOrder) error {
+	const op errors.Op = "dexorders.AddOrder"
+
+	if order.Host == "" || order.Base == order.Quote {
+		return errors.E(op, errors.Invalid, "invalid market")
+	}
+	if order.Qty == 0 {
+		return errors.E(op, errors.Invalid, "quantity must be greater than zero")
+	}
+
+	now := time.Now()
+	switch order.Type {
+	case StopLimit, TakeProfit:
+		if order.TriggerRate == 0 {
+			return errors.E(op, errors.Invalid, "trigger price must be greater than zero")
+		}
+	case DCA:
+		if order.Sell || order.IsLimit() {
+			return errors.E(op, errors.Invalid, "DCA orders are market buys")
+		}
+		if order.Interval <= 0 {
+			return errors.E(op, errors.Invalid, "interval must be greater than zero")
+		}
+		if order.NextRun == 0 {
+			order.NextRun = now.Unix()
+		}
+	default:
+		return errors.E(op, errors.Invalid, fmt.Sprintf("unknown order type %q", order.Type))
+	}
+
+	order.ID = 0
+	order.Status = StatusActive
+	order.Created = now.Unix()
+	order.Runs = 0
+	order.PlacedOrderIDs, order.LastError = nil, ""
+	if err := e.db.Save(order); err != nil {
+		return errors.E(op, err)
+	}
+
+	e.recordEvent(order, EventCreated, e.describe(order))
+	e.signal()
+	return nil
+}
+
+// CancelOrder cancels an active conditional order. DEX orders already placed
+// by the order are not canceled.
+func (e *Engine) CancelOrder(id int) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	order, err := e.OrderByID(id)
+	if err != nil {
+		return err
+	}
+	if !order.IsActive() {
+		return fmt.Errorf("order %d is %s", id, order.Status)
+	}
+
+	order.Status = StatusCanceled
+	if err := e.db.Update(order); err != nil {
+		return err
+	}
+	e.recordEvent(order, EventCanceled, "canceled by user")
+	return nil
+}
+
+// Orders returns the saved conditional orders, newest first. If status is
+// specified, only orders with that status are returned.
+func (e *Engine) Orders(status ...Status) ([]*ConditionalOrder, error) {
+	matcher := q.True()
+	if len(status) > 0 {
+		matcher = q.Eq("Status", status[0])
+	}
+
+	var orders []*ConditionalOrder
+	err := e.db.Select(matcher).OrderBy("Created").Reverse().Find(&orders)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, fmt.Errorf("error fetching conditional orders: %s", err.Error())
+	}
+	return orders, nil
+}
+
+// OrderByID returns the conditional order with the ID.
+func (e *Engine) OrderByID(id int) (*ConditionalOrder, error) {
+	var order ConditionalOrder
+	if err := e.db.One("ID", id, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// Events returns the events of the conditional order, oldest first.
+func (e *Engine) Events(orderID int) ([]*Event, error) {
+	var events []*Event
+	err := e.db.Select(q.Eq("OrderID", orderID)).OrderBy("Stamp").Find(&events)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, fmt.Errorf("error fetching conditional order events: %s", err.Error())
+	}
+	return events, nil
+}
+
+func (e *Engine) signal() {
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Engine) run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		e.checkOrders(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-e.wake:
+		}
+	}
+}
+
+// checkOrders places the DEX orders of the active orders whose triggers are
+// met and starts watching the markets of price triggered orders.
+func (e *Engine) checkOrders(ctx context.Context) {
+	orders, err := e.Orders(StatusActive)
+	if err != nil {
+		log.Errorf("Conditional orders: %v", err)
+		return
+	}
+
+	for _, order := range orders {
+		if ctx.Err() != nil {
+			return
+		}
+		e.checkOrder(ctx, order.ID)
+	}
+}
+
+// checkOrder places the DEX order of the conditional order if its trigger is
+// met. The DEX order is placed without holding e.mtx, the order is marked as
+// being placed so that it is not placed twice meanwhile.
+func (e *Engine) checkOrder(ctx context.Context, id int) {
+	e.mtx.Lock()
+	if ctx.Err() != nil || e.placing[id] {
+		e.mtx.Unlock()
+		return
+	}
+
+	// The order is read again as it may have been canceled since the active
+	// orders were listed.
+	order, err := e.OrderByID(id)
+	if err != nil || !order.IsActive() {
+		e.mtx.Unlock()
+		return
+	}
+
+	reason, triggered := e.triggerReason(ctx, order, time.Now())
+	if !triggered {
+		e.mtx.Unlock()
+		return
+	}
+	e.placing[id] = true
+	dexc, appPW := e.dexc, e.appPW
+	e.mtx.Unlock()
+
+	e.recordEvent(order, EventTriggered, reason)
+	dexOrder, tradeErr := dexc.Trade(appPW, order.tradeForm())
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	delete(e.placing, id)
+
+	// Keep the changes made while the DEX order was placed, e.g. the order
+	// being canceled.
+	if current, err := e.OrderByID(id); err == nil {
+		order = current
+	}
+	e.placed(order, dexOrder, tradeErr)
+}
+
+// triggerReason checks if the trigger of the active order is met and returns
+// a description of the trigger. Markets of price triggered orders are watched
+// from the first check. e.mtx MUST be held.
+func (e *Engine) triggerReason(ctx context.Context, order *ConditionalOrder, now time.Time) (string, bool) {
+	switch order.Type {
+	case DCA:
+		if !order.due(now) {
+			return "", false
+		}
+		return fmt.Sprintf("scheduled buy %d", order.Runs+1), true
+
+	case StopLimit, TakeProfit:
+		key := order.marketKey()
+		if !e.watching[key] {
+			e.watching[key] = true
+			go e.watchMarket(ctx, order.Host, order.Base, order.Quote)
+			return "", false
+		}
+
+		midGap, ok := e.midGaps[key]
+		if !ok || !order.triggered(midGap) {
+			return "", false
+		}
+		return fmt.Sprintf("mid-gap rate %d reached trigger rate %d", midGap, order.TriggerRate), true
+	}
+	return "", false
+}
+
+// placed updates the conditional order with the result of placing its DEX
+// order. The status of orders that are no longer active, e.g. canceled while
+// the DEX order was placed, is kept. e.mtx MUST be held.
+func (e *Engine) placed(order *ConditionalOrder, dexOrder *core.Order, tradeErr error) {
+	active := order.IsActive()
+	if tradeErr != nil {
+		order.LastError = tradeErr.Error()
+		if order.Type == DCA {
+			// Retry the buy at the next interval.
+			order.NextRun = time.Now().Add(order.Interval).Unix()
+		} else if active {
+			order.Status = StatusFailed
+		}
+		e.recordEvent(order, EventFailed, tradeErr.Error())
+	} else {
+		order.Runs++
+		order.LastError = ""
+		order.PlacedOrderIDs = append(order.PlacedOrderIDs, dexOrder.ID.String())
+		if order.Type == DCA {
+			order.NextRun = time.Now().Add(order.Interval).Unix()
+		} else if active {
+			order.Status = StatusPlaced
+		}
+		e.recordEvent(order, EventPlaced, fmt.Sprintf("placed DEX order %s", dexOrder.ID))
+	}
+
+	if order.Type == DCA && active && order.MaxRuns > 0 && order.Runs >= order.MaxRuns {
+		order.Status = StatusCompleted
+		e.recordEvent(order, EventCompleted, fmt.Sprintf("placed %d of %d buys", order.Runs, order.MaxRuns))
+	}
+
+	if err := e.db.Update(order); err != nil {
+		log.Errorf("Conditional orders: error updating order %d: %v", order.ID, err)
+	}
+}
+
+// watchMarket keeps the mid-gap rate of the market up to date until ctx is
+// canceled. It MUST be called from a goroutine.
+func (e *Engine) watchMarket(ctx context.Context, host string, base, quote uint32) {
+	key := fmt.Sprintf("%s-%d-%d", host, base, quote)
+
+	// Keep the maps of this run, they are replaced if the Engine is restarted.
+	e.mtx.Lock()
+	dexc, midGaps, watching := e.dexc, e.midGaps, e.watching
+	e.mtx.Unlock()
+	defer func() {
+		e.mtx.Lock()
+		delete(watching, key)
+		e.mtx.Unlock()
+	}()
+	if dexc == nil {
+		return
+	}
+
+	book, feed, err := dexc.SyncBook(host, base, quote)
+	if err != nil {
+		log.Errorf("Conditional orders: error syncing %s book: %v", key, err)
+		return
+	}
+	defer feed.Close()
+	log.Infof("Conditional orders: watching %s", key)
+
+	for {
+		if midGap, err := book.MidGap(); err == nil {
+			e.mtx.Lock()
+			changed := midGaps[key] != midGap
+			midGaps[key] = midGap
+			e.mtx.Unlock()
+			if changed {
+				e.signal()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-feed.Next():
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// describe summarizes the order for its creation event.
+func (e *Engine) describe(order *ConditionalOrder) string {
+	side := "buy"
+	if order.Sell {
+		side = "sell"
+	}
+	switch order.Type {
+	case DCA:
+		return fmt.Sprintf("%s %d every %s on %s", side, order.Qty, order.Interval, order.marketKey())
+	default:
+		return fmt.Sprintf("%s %s %d at rate %d when rate reaches %d on %s", order.Type, side, order.Qty, order.Rate, order.TriggerRate, order.marketKey())
+	}
+}
+
+// recordEvent logs and saves the event of the order and notifies the
+// listeners. The listeners are notified asynchronously with a copy of the
+// order, they may then call the Engine methods even if e.mtx is held.
+func (e *Engine) recordEvent(order *ConditionalOrder, kind EventKind, details string) {
+	log.Infof("Conditional order %d: %s: %s", order.ID, kind, details)
+
+	event := &Event{
+		OrderID: order.ID,
+		Kind:    kind,
+		Stamp:   time.Now().Unix(),
+		Details: details,
+	}
+	if err := e.db.Save(event); err != nil {
+		log.Errorf("Conditional orders: error saving event: %v", err)
+	}
+
+	orderCopy := *order
+	orderCopy.PlacedOrderIDs = append([]string(nil), order.PlacedOrderIDs...)
+
+	e.notificationListenersMu.RLock()
+	defer e.notificationListenersMu.RUnlock()
+	for _, notificationListener := range e.notificationListeners {
+		if notificationListener.OnOrderUpdated != nil {
+			go notificationListener.OnOrderUpdated(&orderCopy, event)
+		}
+	}
+}
+
+func (e *Engine) AddNotificationListener(notificationListener *OrderNotificationListener, uniqueIdentifier string) error {
+	e.notificationListenersMu.Lock()
+	defer e.notificationListenersMu.Unlock()
+
+	if _, ok := e.notificationListeners[uniqueIdentifier]; ok {
+		return errors.New(ErrListenerAlreadyExist)
+	}
+
+	e.notificationListeners[uniqueIdentifier] = notificationListener
+	return nil
+}
+
+func (e *Engine) RemoveNotificationListener(uniqueIdentifier string) {
+	e.notificationListenersMu.Lock()
+	defer e.notificationListenersMu.Unlock()
+
+	delete(e.notificationListeners, uniqueIdentifier)
+}
@@ -0,0 +1,214 @@
+package dexorders
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/client/orderbook"
+	"decred.org/dcrdex/dex"
+	"github.com/asdine/storm"
+)
+
+type testDEXClient struct {
+	trades  []*core.TradeForm
+	err     error
+	onTrade func()
+}
+
+func (c *testDEXClient) SyncBook(string, uint32, uint32) (*orderbook.OrderBook, core.BookFeed, error) {
+	return nil, nil, errors.New("no book")
+}
+
+func (c *testDEXClient) Trade(_ []byte, form *core.TradeForm) (*core.Order, error) {
+	if c.onTrade != nil {
+		c.onTrade()
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.trades = append(c.trades, form)
+	return &core.Order{ID: dex.Bytes{byte(len(c.trades))}}, nil
+}
+
+// testEngine returns an Engine set up as if started with dexc, without
+// running its check loop.
+func testEngine(t *testing.T, dexc DEXClient) *Engine {
+	t.Helper()
+
+	db, err := storm.Open(filepath.Join(t.TempDir(), "orders.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	e, err := NewEngine(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.dexc, e.appPW = dexc, []byte("pass")
+	e.midGaps, e.watching, e.placing = make(map[string]uint64), make(map[string]bool), make(map[int]bool)
+	return e
+}
+
+func TestTriggered(t *testing.T) {
+	tests := []struct {
+		name      string
+		orderType OrderType
+		sell      bool
+		midGap    uint64
+		want      bool
+	}{
+		{"stop-limit sell above trigger", StopLimit, true, 101, false},
+		{"stop-limit sell at trigger", StopLimit, true, 100, true},
+		{"stop-limit sell below trigger", StopLimit, true, 99, true},
+		{"stop-limit buy below trigger", StopLimit, false, 99, false},
+		{"stop-limit buy at trigger", StopLimit, false, 100, true},
+		{"stop-limit buy above trigger", StopLimit, false, 101, true},
+		{"take-profit sell below trigger", TakeProfit, true, 99, false},
+		{"take-profit sell at trigger", TakeProfit, true, 100, true},
+		{"take-profit sell above trigger", TakeProfit, true, 101, true},
+		{"take-profit buy above trigger", TakeProfit, false, 101, false},
+		{"take-profit buy at trigger", TakeProfit, false, 100, true},
+		{"take-profit buy below trigger", TakeProfit, false, 99, true},
+		{"recurring orders have no price trigger", DCA, false, 100, false},
+	}
+	for _, test := range tests {
+		order := &ConditionalOrder{Type: test.orderType, Sell: test.sell, TriggerRate: 100}
+		if got := order.triggered(test.midGap); got != test.want {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestCheckPriceTriggeredOrder(t *testing.T) {
+	dexc := new(testDEXClient)
+	e := testEngine(t, dexc)
+	ctx := context.Background()
+
+	order := &ConditionalOrder{Type: StopLimit, Host: "dex", Base: 42, Quote: 0, Sell: true, Qty: 10, Rate: 90, TriggerRate: 100}
+	if err := e.AddOrder(order); err != nil {
+		t.Fatal(err)
+	}
+	key := order.marketKey()
+
+	// The first check starts watching the market.
+	e.checkOrder(ctx, order.ID)
+	if !e.watching[key] || len(dexc.trades) != 0 {
+		t.Fatal("expected the market to be watched and no order placed")
+	}
+
+	e.midGaps[key] = 105
+	e.checkOrder(ctx, order.ID)
+	if len(dexc.trades) != 0 {
+		t.Fatal("expected no order placed above the trigger rate")
+	}
+
+	e.midGaps[key] = 100
+	e.checkOrder(ctx, order.ID)
+	e.checkOrder(ctx, order.ID)
+	if len(dexc.trades) != 1 {
+		t.Fatalf("expected one order placed, got %d", len(dexc.trades))
+	}
+	if form := dexc.trades[0]; !form.IsLimit || !form.Sell || form.Rate != 90 || form.Qty != 10 {
+		t.Fatalf("unexpected trade form %+v", form)
+	}
+
+	saved, err := e.OrderByID(order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Status != StatusPlaced || saved.Runs != 1 || len(saved.PlacedOrderIDs) != 1 {
+		t.Fatalf("unexpected order state %+v", saved)
+	}
+}
+
+func TestCheckRecurringOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRuns    int
+		checks     int
+		tradeErr   error
+		wantTrades int
+		wantStatus Status
+	}{
+		{"unlimited buys", 0, 3, nil, 3, StatusActive},
+		{"last buy completes the order", 2, 2, nil, 2, StatusCompleted},
+		{"no buy after completion", 2, 4, nil, 2, StatusCompleted},
+		{"failed buys are retried", 2, 3, errors.New("no funds"), 0, StatusActive},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dexc := &testDEXClient{err: test.tradeErr}
+			e := testEngine(t, dexc)
+
+			order := &ConditionalOrder{Type: DCA, Host: "dex", Base: 42, Quote: 0, Qty: 10, Interval: time.Hour, MaxRuns: test.maxRuns}
+			if err := e.AddOrder(order); err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < test.checks; i++ {
+				e.checkOrder(context.Background(), order.ID)
+
+				// Not due before the next interval.
+				e.checkOrder(context.Background(), order.ID)
+
+				saved, err := e.OrderByID(order.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				saved.NextRun = time.Now().Unix()
+				if err := e.db.Update(saved); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			saved, err := e.OrderByID(order.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(dexc.trades) != test.wantTrades || saved.Runs != test.wantTrades {
+				t.Errorf("expected %d buys, got %d trades and %d runs", test.wantTrades, len(dexc.trades), saved.Runs)
+			}
+			if saved.Status != test.wantStatus {
+				t.Errorf("expected status %s, got %s", test.wantStatus, saved.Status)
+			}
+			if test.tradeErr != nil && saved.LastError != test.tradeErr.Error() {
+				t.Errorf("expected the last error to be recorded, got %q", saved.LastError)
+			}
+		})
+	}
+}
+
+func TestCancelWhilePlacing(t *testing.T) {
+	dexc := new(testDEXClient)
+	e := testEngine(t, dexc)
+
+	order := &ConditionalOrder{Type: DCA, Host: "dex", Base: 42, Quote: 0, Qty: 10, Interval: time.Hour}
+	if err := e.AddOrder(order); err != nil {
+		t.Fatal(err)
+	}
+
+	// The DEX order is placed without holding the Engine lock, the order can
+	// be canceled meanwhile.
+	dexc.onTrade = func() {
+		if err := e.CancelOrder(order.ID); err != nil {
+			t.Error(err)
+		}
+	}
+	e.checkOrder(context.Background(), order.ID)
+
+	saved, err := e.OrderByID(order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Status != StatusCanceled {
+		t.Fatalf("expected the order to stay canceled, got %s", saved.Status)
+	}
+	if len(saved.PlacedOrderIDs) != 1 {
+		t.Fatalf("expected the placed DEX order to be recorded, got %v", saved.PlacedOrderIDs)
+	}
+}
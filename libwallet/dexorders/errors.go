@@ -0,0 +1,6 @@
+package dexorders
+
+const (
+	ErrListenerAlreadyExist = "listener_already_exist"
+	ErrEngineRunning        = "engine_already_running"
+)
@@ -0,0 +1,160 @@
+package dexorders
+
+import (
+	"fmt"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+)
+
+// OrderType is the kind of a conditional order.
+type OrderType string
+
+const (
+	// StopLimit places a limit order once the mid-gap price crosses the
+	// trigger price against the position, i.e. falls to the trigger for sells
+	// and rises to the trigger for buys.
+	StopLimit OrderType = "stoplimit"
+	// TakeProfit places an order once the mid-gap price crosses the trigger
+	// price in favour of the position, i.e. rises to the trigger for sells
+	// and falls to the trigger for buys.
+	TakeProfit OrderType = "takeprofit"
+	// DCA places a market buy of a fixed quote asset amount at every
+	// interval.
+	DCA OrderType = "dca"
+)
+
+// Status is the state of a conditional order.
+type Status string
+
+const (
+	// StatusActive orders are waiting for their trigger.
+	StatusActive Status = "active"
+	// StatusPlaced orders have been triggered and their DEX order placed.
+	StatusPlaced Status = "placed"
+	// StatusCompleted recurring orders have placed all their DEX orders.
+	StatusCompleted Status = "completed"
+	// StatusCanceled orders were canceled by the user before they
+	// triggered.
+	StatusCanceled Status = "canceled"
+	// StatusFailed orders were triggered but their DEX order could not be
+	// placed.
+	StatusFailed Status = "failed"
+)
+
+// ConditionalOrder is a DEX order that is placed by the Engine once its
+// trigger is met. Rates are message rates and quantities are in atoms, as
+// used by core.TradeForm.
+type ConditionalOrder struct {
+	ID      int       `storm:"id,increment" json:"id"`
+	Type    OrderType `json:"type"`
+	Host    string    `json:"host" storm:"index"`
+	Base    uint32    `json:"base"`
+	Quote   uint32    `json:"quote"`
+	Sell    bool      `json:"sell"`
+	Status  Status    `json:"status" storm:"index"`
+	Created int64     `json:"created" storm:"index"`
+
+	// TriggerRate is the mid-gap rate that triggers StopLimit and TakeProfit
+	// orders.
+	TriggerRate uint64 `json:"triggerRate"`
+	// Rate is the rate of the limit order placed when the order triggers. A
+	// zero rate places a market order.
+	Rate uint64 `json:"rate"`
+	// Qty is the quantity of the placed orders. It is in units of the base
+	// asset except for market buys, where it is in units of the quote asset.
+	Qty uint64 `json:"qty"`
+
+	// Interval is the time between the buys of DCA orders.
+	Interval time.Duration `json:"interval"`
+	// MaxRuns is the number of buys of DCA orders. Zero repeats the buys
+	// until the order is canceled.
+	MaxRuns int `json:"maxRuns"`
+	// Runs is the number of DEX orders placed.
+	Runs int `json:"runs"`
+	// NextRun is the unix time of the next buy of DCA orders.
+	NextRun int64 `json:"nextRun"`
+
+	// PlacedOrderIDs are the IDs of the DEX orders placed.
+	PlacedOrderIDs []string `json:"placedOrderIDs"`
+	LastError      string   `json:"lastError"`
+}
+
+// IsLimit is true if the placed DEX orders are limit orders.
+func (o *ConditionalOrder) IsLimit() bool {
+	return o.Rate > 0
+}
+
+// IsActive is true if the order may still place DEX orders.
+func (o *ConditionalOrder) IsActive() bool {
+	return o.Status == StatusActive
+}
+
+// marketKey identifies the DEX market of the order.
+func (o *ConditionalOrder) marketKey() string {
+	return fmt.Sprintf("%s-%d-%d", o.Host, o.Base, o.Quote)
+}
+
+// due is true if the next buy of DCA orders is scheduled at or before now.
+func (o *ConditionalOrder) due(now time.Time) bool {
+	return now.Unix() >= o.NextRun
+}
+
+// tradeForm returns the form of the DEX order placed when the order
+// triggers.
+func (o *ConditionalOrder) tradeForm() *core.TradeForm {
+	return &core.TradeForm{
+		Host:    o.Host,
+		IsLimit: o.IsLimit(),
+		Sell:    o.Sell,
+		Base:    o.Base,
+		Quote:   o.Quote,
+		Qty:     o.Qty,
+		Rate:    o.Rate,
+	}
+}
+
+// triggered checks if the mid-gap rate meets the trigger of StopLimit and
+// TakeProfit orders.
+func (o *ConditionalOrder) triggered(midGap uint64) bool {
+	switch o.Type {
+	case StopLimit:
+		if o.Sell {
+			return midGap <= o.TriggerRate
+		}
+		return midGap >= o.TriggerRate
+	case TakeProfit:
+		if o.Sell {
+			return midGap >= o.TriggerRate
+		}
+		return midGap <= o.TriggerRate
+	}
+	return false
+}
+
+// EventKind is the kind of an order Event.
+type EventKind string
+
+const (
+	EventCreated   EventKind = "created"
+	EventTriggered EventKind = "triggered"
+	EventPlaced    EventKind = "placed"
+	EventFailed    EventKind = "failed"
+	EventCanceled  EventKind = "canceled"
+	EventCompleted EventKind = "completed"
+)
+
+// Event records a change of a conditional order, e.g. the trigger being met
+// or a DEX order being placed.
+type Event struct {
+	ID      int       `storm:"id,increment" json:"id"`
+	OrderID int       `json:"orderID" storm:"index"`
+	Kind    EventKind `json:"kind"`
+	Stamp   int64     `json:"stamp"`
+	Details string    `json:"details"`
+}
+
+// OrderNotificationListener receives the updates of conditional orders.
+type OrderNotificationListener struct {
+	OnOrderUpdated func(order *ConditionalOrder, event *Event)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2023, The Cryptopower developers
+// See LICENSE for details.
+
+package ext
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Fiat currencies asset rates can be displayed in. Rate sources only provide
+// USDT markets, USDT rates are converted to the other currencies using the
+// fiat rates fetched from the fiat sources below.
+const (
+	FiatUSD = "USD"
+	FiatEUR = "EUR"
+	FiatBRL = "BRL"
+	FiatGBP = "GBP"
+	FiatJPY = "JPY"
+	FiatCAD = "CAD"
+	FiatAUD = "AUD"
+	FiatCHF = "CHF"
+	FiatCNY = "CNY"
+	FiatINR = "INR"
+
+	// DefaultFiatCurrency is the fiat currency used when the user has not
+	// selected one.
+	DefaultFiatCurrency = FiatUSD
+)
+
+var (
+	// SupportedFiatCurrencies lists the fiat currencies that can be selected,
+	// in display order.
+	SupportedFiatCurrencies = []string{
+		FiatUSD, FiatEUR, FiatBRL, FiatGBP, FiatJPY,
+		FiatCAD, FiatAUD, FiatCHF, FiatCNY, FiatINR,
+	}
+
+	fiatSymbols = map[string]string{
+		FiatUSD: "$",
+		FiatEUR: "€",
+		FiatBRL: "R$",
+		FiatGBP: "£",
+		FiatJPY: "¥",
+		FiatCAD: "CA$",
+		FiatAUD: "A$",
+		FiatCHF: "CHF ",
+		FiatCNY: "CN¥",
+		FiatINR: "₹",
+	}
+
+	// Coinpaprika quotes the USDT ticker in any of the supported fiat
+	// currencies, which gives the exact conversion for the USDT markets. The
+	// call is made at most once every rateExpiry.
+	coinpaprikaFiatURL = "https://api.coinpaprika.com/v1/tickers/usdt-tether?quotes=%s"
+
+	// Frankfurter publishes the European Central Bank reference rates and is
+	// used when coinpaprika is unavailable. USD is used in place of USDT.
+	frankfurterFiatURL = "https://api.frankfurter.app/latest?from=USD&to=%s"
+)
+
+// fiatRate is the cached price of 1 USDT in a fiat currency.
+type fiatRate struct {
+	currency   string
+	rate       float64
+	lastUpdate time.Time
+}
+
+// IsSupportedFiatCurrency returns true if the currency can be selected as the
+// fiat currency.
+func IsSupportedFiatCurrency(currency string) bool {
+	for _, c := range SupportedFiatCurrencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// FiatSymbol returns the symbol used to display amounts in the fiat currency.
+func FiatSymbol(currency string) string {
+	if symbol, ok := fiatSymbols[currency]; ok {
+		return symbol
+	}
+	return currency + " "
+}
+
+// FiatCurrency returns the fiat currency rates are converted to.
+func (cs *CommonRateSource) FiatCurrency() string {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.fiatCurrency
+}
+
+// SetFiatCurrency changes the fiat currency rates are converted to. The cached
+// fiat rate is dropped, Refresh should be called to fetch the new rate.
+func (cs *CommonRateSource) SetFiatCurrency(currency string) error {
+	currency = strings.ToUpper(currency)
+	if !IsSupportedFiatCurrency(currency) {
+		return fmt.Errorf("fiat currency %s is not supported", currency)
+	}
+
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	if cs.fiatCurrency != currency {
+		cs.fiatCurrency = currency
+		cs.fiatRate = nil
+	}
+	return nil
+}
+
+// FiatRate returns the price of 1 USDT in the selected fiat currency. Data will
+// be retrieved from cache if its available and still valid. Returns 0 if no
+// rate is available. If cacheOnly is false and no valid, cached rate is
+// available, a network call will be made to fetch the latest rate.
+func (cs *CommonRateSource) FiatRate(cacheOnly bool) float64 {
+	cs.mtx.RLock()
+	currency, cached := cs.fiatCurrency, cs.fiatRate
+	cs.mtx.RUnlock()
+
+	if currency == FiatUSD {
+		return 1
+	}
+
+	if cached != nil && (cacheOnly || time.Since(cached.lastUpdate) < rateExpiry) {
+		return cached.rate
+	}
+	if cacheOnly || cs.source == none || cs.isDisabled() {
+		return 0
+	}
+
+	if newRate := cs.fetchFiatRate(currency); newRate != nil {
+		return newRate.rate
+	}
+	if cached != nil {
+		return cached.rate
+	}
+	return 0
+}
+
+// refreshFiatRate replaces the cached fiat rate if it has expired.
+func (cs *CommonRateSource) refreshFiatRate(force bool) {
+	cs.mtx.RLock()
+	currency, cached := cs.fiatCurrency, cs.fiatRate
+	cs.mtx.RUnlock()
+
+	if currency == FiatUSD {
+		return
+	}
+	if !force && cached != nil && time.Since(cached.lastUpdate) < rateExpiry {
+		return
+	}
+	cs.fetchFiatRate(currency)
+}
+
+// fetchFiatRate fetches the fiat rate from the fiat sources and updates the
+// cache. Returns nil if no source could provide the rate.
+func (cs *CommonRateSource) fetchFiatRate(currency string) *fiatRate {
+	var rate float64
+	var err error
+	for _, getRate := range []func(string) (float64, error){coinpaprikaGetFiatRate, frankfurterGetFiatRate} {
+		select {
+		case <-cs.ctx.Done():
+			log.Errorf("fetching fiat rate canceled: %v", cs.ctx.Err())
+			return nil
+		default:
+		}
+
+		rate, err = getRate(currency)
+		if err == nil {
+			break
+		}
+		log.Errorf("Error fetching %s fiat rate: %v", currency, err)
+	}
+	if err != nil {
+		return nil
+	}
+
+	newRate := &fiatRate{
+		currency:   currency,
+		rate:       rate,
+		lastUpdate: time.Now(),
+	}
+
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	// Ignore the rate if the currency changed while it was being fetched.
+	if cs.fiatCurrency != currency {
+		return nil
+	}
+	cs.fiatRate = newRate
+	return newRate
+}
+
+func coinpaprikaGetFiatRate(currency string) (float64, error) {
+	reqCfg := &utils.ReqConfig{
+		HTTPURL: fmt.Sprintf(coinpaprikaFiatURL, currency),
+		Method:  "GET",
+	}
+
+	var res struct {
+		Quotes map[string]struct {
+			Price float64 `json:"price"`
+		} `json:"quotes"`
+	}
+	_, err := utils.HTTPRequest(reqCfg, &res)
+	if err != nil {
+		return 0, fmt.Errorf("%s failed to fetch %s rate: %w", coinpaprika, currency, err)
+	}
+
+	quote, ok := res.Quotes[currency]
+	if !ok || quote.Price <= 0 {
+		return 0, fmt.Errorf("%s returned no %s rate", coinpaprika, currency)
+	}
+	return quote.Price, nil
+}
+
+func frankfurterGetFiatRate(currency string) (float64, error) {
+	reqCfg := &utils.ReqConfig{
+		HTTPURL: fmt.Sprintf(frankfurterFiatURL, currency),
+		Method:  "GET",
+	}
+
+	var res struct {
+		Rates map[string]float64 `json:"rates"`
+	}
+	_, err := utils.HTTPRequest(reqCfg, &res)
+	if err != nil {
+		return 0, fmt.Errorf("frankfurter failed to fetch %s rate: %w", currency, err)
+	}
+
+	rate, ok := res.Rates[currency]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("frankfurter returned no %s rate", currency)
+	}
+	return rate, nil
+}
@@ -121,6 +121,9 @@ type RateSource interface {
 	Refreshing() bool
 	LastUpdate() time.Time
 	GetTicker(market values.Market, cacheOnly bool) *Ticker
+	FiatCurrency() string
+	SetFiatCurrency(currency string) error
+	FiatRate(cacheOnly bool) float64
 	ToggleStatus(disable bool)
 	ToggleSource(newSource string) error
 	AddRateListener(listener *RateListener, uniqueIdentifier string) error
@@ -150,6 +153,8 @@ type CommonRateSource struct {
 	getTicker     tickerFunc
 	sourceChanged chan *struct{}
 	lastUpdate    time.Time
+	fiatCurrency  string
+	fiatRate      *fiatRate
 
 	disableConversionExchange func()
 
@@ -168,6 +173,7 @@ func NewCommonRateSource(ctx context.Context, source string, disableConversionEx
 		source:                    source,
 		tickers:                   make(map[values.Market]*Ticker),
 		sourceChanged:             make(chan *struct{}),
+		fiatCurrency:              DefaultFiatCurrency,
 		disableConversionExchange: disableConversionExchange,
 		ratesListeners:            make(map[string]*RateListener),
 	}
@@ -296,7 +302,7 @@ func (cs *CommonRateSource) copyRates() map[values.Market]*Ticker {
 	return tickers
 }
 
-// Refresh refreshes all expired rates if it
+// Refresh refreshes all expired rates and the fiat rate if it
 // was previously disconnect. This method takes some time to refresh the rates
 // and should be executed a a goroutine.
 func (cs *CommonRateSource) Refresh(force bool) {
@@ -347,6 +353,8 @@ func (cs *CommonRateSource) Refresh(force bool) {
 	cs.mtx.Lock()
 	cs.tickers = tickers
 	cs.mtx.Unlock()
+
+	cs.refreshFiatRate(force)
 }
 
 // GetTicker retrieves ticker information for the provided market. Data will be
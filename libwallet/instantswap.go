@@ -10,8 +10,6 @@ import (
 	"decred.org/dcrwallet/v4/errors"
 	api "github.com/crypto-power/instantswap/instantswap"
 
-	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
-	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
@@ -56,312 +54,388 @@ func DefaultRateRequestAmt(fromCurrency string) float64 {
 	return DefaultRateRequestAmount
 }
 
-// StartScheduler starts the automatic order scheduler.
-func (mgr *AssetsManager) StartScheduler(ctx context.Context, params instantswap.SchedulerParams) error {
-	const op errors.Op = "mgr.StartScheduler"
-	log.Info("Order Scheduler: started")
+// StartSchedule runs the schedule with the provided ID in the background
+// until it is paused, deleted or the assets manager shuts down. The
+// spending passphrase is kept in memory to fund the orders of the runs.
+func (mgr *AssetsManager) StartSchedule(scheduleID int, spendingPassphrase string) error {
+	const op errors.Op = "mgr.StartSchedule"
 
-	log.Info("Order Scheduler: verifying source wallet")
-	sourceWallet := mgr.WalletWithID(params.Order.SourceWalletID)
-	if sourceWallet == nil {
-		return errors.E(op, errors.Errorf("wallet with id:%d not found", params.Order.SourceWalletID))
+	schedule, err := mgr.InstantSwap.ScheduleByID(scheduleID)
+	if err != nil {
+		return errors.E(op, err)
 	}
 
-	mgr.InstantSwap.CancelOrderSchedulerMu.RLock()
+	if mgr.WalletWithID(schedule.Order.SourceWalletID) == nil {
+		return errors.E(op, errors.Errorf("wallet with id:%d not found", schedule.Order.SourceWalletID))
+	}
 
-	if mgr.InstantSwap.CancelOrderScheduler != nil {
-		mgr.InstantSwap.CancelOrderSchedulerMu.RUnlock()
-		return errors.New("scheduler already running")
+	ctx, err := mgr.InstantSwap.TrackSchedule(context.Background(), scheduleID)
+	if err != nil {
+		return errors.E(op, err)
 	}
 
-	mgr.InstantSwap.SchedulerStartTime = time.Now()
-	mgr.InstantSwap.SchedulerCtx, mgr.InstantSwap.CancelOrderScheduler = context.WithCancel(ctx)
-	mgr.InstantSwap.PublishOrderSchedulerStarted()
-	defer func() {
-		mgr.InstantSwap.CancelOrderScheduler = nil
-		mgr.InstantSwap.SchedulerStartTime = time.Time{}
-		mgr.InstantSwap.PublishOrderSchedulerEnded()
-		log.Info("Order Scheduler: exited")
-	}()
-	mgr.InstantSwap.CancelOrderSchedulerMu.RUnlock()
+	schedule.Paused = false
+	if err := mgr.InstantSwap.SaveSchedule(schedule); err != nil {
+		mgr.InstantSwap.UntrackSchedule(scheduleID)
+		return errors.E(op, err)
+	}
+
+	go mgr.runSchedule(ctx, schedule, spendingPassphrase)
+	return nil
+}
 
-	// Initialize the exchange server.
-	log.Info("Order Scheduler: initializing exchange server")
-	exchangeObject, err := mgr.InstantSwap.NewExchangeServer(params.Order.ExchangeServer)
+// PauseSchedule stops the schedule with the provided ID and keeps it paused
+// across restarts until it is started again.
+func (mgr *AssetsManager) PauseSchedule(scheduleID int) error {
+	const op errors.Op = "mgr.PauseSchedule"
+
+	mgr.InstantSwap.StopSchedule(scheduleID)
+
+	schedule, err := mgr.InstantSwap.ScheduleByID(scheduleID)
 	if err != nil {
 		return errors.E(op, err)
 	}
 
-	var lastOrderTime time.Time
-	for {
-		// Check if scheduler has been shutdown and exit if true.
-		if mgr.InstantSwap.SchedulerCtx.Err() != nil {
-			return mgr.InstantSwap.SchedulerCtx.Err()
-		}
+	schedule.Paused = true
+	if err := mgr.InstantSwap.SaveSchedule(schedule); err != nil {
+		return errors.E(op, err)
+	}
 
-		sourceAccountBalance, err := sourceWallet.GetAccountBalance(params.Order.SourceAccountNumber)
-		if err != nil {
-			log.Error("unable to get account balance")
-			return err
-		}
+	log.Infof("Schedule %q: paused", schedule.Name)
+	return nil
+}
 
-		walletBalance := sourceAccountBalance.Spendable.ToCoin()
-		if walletBalance <= params.BalanceToMaintain {
-			if !lastOrderTime.IsZero() { // some orders have already been concluded
-				return nil
-			}
+// PauseSchedules pauses all the running schedules.
+func (mgr *AssetsManager) PauseSchedules() {
+	schedules, err := mgr.InstantSwap.Schedules()
+	if err != nil {
+		log.Errorf("Error loading schedules: %v", err)
+		return
+	}
 
-			log.Error("source wallet balance is less than or equals the set balance to maintain")
-			return errors.E(op, "source wallet balance is less than or equals the set balance to maintain") // stop scheduling if the source wallet balance is less than or equals the set balance to maintain
+	for _, schedule := range schedules {
+		if !mgr.InstantSwap.IsScheduleRunning(schedule.ID) {
+			continue
+		}
+		if err := mgr.PauseSchedule(schedule.ID); err != nil {
+			log.Error(err)
 		}
+	}
+}
 
-		if !lastOrderTime.IsZero() {
-			log.Info("Order Scheduler: creating next order based on selected frequency")
+// runSchedule executes the runs of the schedule at its frequency until ctx
+// is canceled. A run only creates an order once the order of the previous run
+// completed, the schedule is paused if an order fails.
+func (mgr *AssetsManager) runSchedule(ctx context.Context, schedule *instantswap.Schedule, spendingPassphrase string) {
+	defer func() {
+		mgr.InstantSwap.UntrackSchedule(schedule.ID)
+		log.Infof("Schedule %q: stopped", schedule.Name)
+	}()
+	log.Infof("Schedule %q: started", schedule.Name)
 
-			// calculate time until the next order
-			timeUntilNextOrder := params.Frequency - time.Since(lastOrderTime)
-			if timeUntilNextOrder <= 0 {
-				log.Info("Order Scheduler: the scheduler start time is equal to or greater than the frequency, starting next order immediately")
-			} else {
-				log.Infof("Order Scheduler: %s until the next order is executed", timeUntilNextOrder)
-				time.Sleep(timeUntilNextOrder)
+	for {
+		if orderUUID := schedule.PendingOrderUUID; orderUUID != "" {
+			orderErr := mgr.waitForScheduleOrder(ctx, schedule, orderUUID)
+			if ctx.Err() != nil {
+				return
 			}
-		}
 
-		fromCur := params.Order.FromCurrency
-		toCur := params.Order.ToCurrency
-		rateRequestParams := api.ExchangeRateRequest{
-			From:        fromCur,
-			To:          toCur,
-			Amount:      DefaultRateRequestAmt(fromCur), // amount needs to be greater than 0 to get the exchange rate
-			FromNetwork: params.Order.FromNetwork,
-			ToNetwork:   params.Order.ToNetwork,
-		}
-		log.Info("Order Scheduler: getting exchange rate info")
-		res, err := mgr.InstantSwap.GetExchangeRateInfo(exchangeObject, rateRequestParams)
-		if err != nil {
-			log.Error("unable to get exchange server rate info")
-			return errors.E(op, err)
-		}
+			if latest, err := mgr.InstantSwap.ScheduleByID(schedule.ID); err == nil {
+				schedule = latest
+			}
+			schedule.PendingOrderUUID = ""
+			if err := mgr.InstantSwap.SaveSchedule(schedule); err != nil {
+				log.Errorf("Schedule %q: error saving schedule: %v", schedule.Name, err)
+			}
 
-		if params.MaxDeviationRate <= 0 {
-			params.MaxDeviationRate = DefaultMarketDeviation // default 5%
+			if orderErr != nil {
+				mgr.pauseFailedSchedule(schedule, &instantswap.ScheduleRun{OrderUUID: orderUUID, Message: orderErr.Error()})
+				return
+			}
+			log.Infof("Schedule %q: order %s completed", schedule.Name, orderUUID)
 		}
 
-		market := values.NewMarket(fromCur, toCur)
-		source := mgr.RateSource.Name()
-		ticker := mgr.RateSource.GetTicker(market, false)
-		if ticker == nil {
-			log.Errorf("unable to get market(%s) rate from %s.", market, source)
-			log.Infof("Proceeding without checking market rate deviation...")
-		} else {
-			exchangeServerRate := res.ExchangeRate // estimated receivable value for libwallet.DefaultRateRequestAmount (1)
-			rateSourceRate := ticker.LastTradePrice
-
-			// Current rate source supported Binance and Bittrex always returns
-			// ticker.LastTradePrice in's the quote asset unit e.g DCR-BTC, LTC-BTC.
-			// We will also do this when and if USDT is supported.
-			if strings.EqualFold(fromCur, "btc") {
-				rateSourceRate = 1 / ticker.LastTradePrice
+		if schedule.NextRunAt > 0 {
+			timeUntilNextRun := time.Until(time.Unix(schedule.NextRunAt, 0))
+			log.Infof("Schedule %q: %s until the next run", schedule.Name, timeUntilNextRun.Round(time.Second))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(timeUntilNextRun):
 			}
+		}
 
-			serverRateStr := values.StringF(values.StrServerRate, params.Order.ExchangeServer.Server, fromCur, exchangeServerRate, toCur)
-			log.Info(serverRateStr)
-			binanceRateStr := values.StringF(values.StrCurrencyConverterRate, source, fromCur, rateSourceRate, toCur)
-			log.Info(binanceRateStr)
-
-			// Check if the server rate deviates from the market rate by ± 5%
-			// exit if true
-			percentageDiff := math.Abs((exchangeServerRate-rateSourceRate)/((exchangeServerRate+rateSourceRate)/2)) * 100
-			if percentageDiff > params.MaxDeviationRate {
-				errMsg := fmt.Errorf("exchange rate deviates from the market rate by (%.2f%%) more than %.2f%%", percentageDiff-params.MaxDeviationRate, params.MaxDeviationRate)
-				log.Error(errMsg)
-				return errors.E(op, errMsg)
-			}
+		if ctx.Err() != nil {
+			return
 		}
 
-		// set the max send amount to the max limit set by the server
-		invoicedAmount := res.Max
+		run := mgr.executeScheduleRun(schedule, spendingPassphrase)
 
-		estimatedBalanceAfterExchange := walletBalance - invoicedAmount
-		// if the max send limit is 0, then the server does not have a max limit
-		// constraint so we can send the entire source wallet balance
-		if res.Max == 0 || estimatedBalanceAfterExchange < params.BalanceToMaintain {
-			invoicedAmount = walletBalance - params.BalanceToMaintain // deduct the balance to maintain from the source wallet balance
+		// Reload the schedule in case it was paused during the run.
+		if latest, err := mgr.InstantSwap.ScheduleByID(schedule.ID); err == nil {
+			schedule = latest
 		}
 
-		if invoicedAmount <= 0 {
-			errMsg := fmt.Errorf("balance to maintain is the same or greater than wallet balance(Current Balance: %v, Balance to Maintain: %v)", walletBalance, params.BalanceToMaintain)
-			log.Error(errMsg)
-			return errors.E(op, errMsg)
+		// The order created by the run failed to be funded.
+		if run.OrderUUID != "" && !run.Success {
+			mgr.pauseFailedSchedule(schedule, run)
+			return
 		}
 
-		if invoicedAmount == walletBalance {
-			errMsg := "Specify a little balance to maintain to cover for transaction fees... e.g 0.001 for DCR to BTC or LTC swaps"
-			log.Error(errMsg)
-			return errors.E(op, errMsg)
+		now := time.Now()
+		schedule.LastRunAt = now.Unix()
+		schedule.NextRunAt = now.Add(schedule.Frequency).Unix()
+		schedule.PendingOrderUUID = run.OrderUUID
+		if err := mgr.InstantSwap.SaveSchedule(schedule); err != nil {
+			log.Errorf("Schedule %q: error saving schedule: %v", schedule.Name, err)
 		}
 
-		log.Info("Order Scheduler: creating order")
-		params.Order.InvoicedAmount = invoicedAmount
-		order, err := mgr.InstantSwap.CreateOrder(exchangeObject, params.Order)
-		if err != nil {
-			log.Error("error creating order: ", err.Error())
-			return errors.E(op, err)
+		if err := mgr.InstantSwap.RecordScheduleRun(schedule, run); err != nil {
+			log.Errorf("Schedule %q: error saving run: %v", schedule.Name, err)
 		}
-		lastOrderTime = time.Now()
+	}
+}
 
-		log.Info("Order Scheduler: creating unsigned transaction")
+// pauseFailedSchedule records the run of the failed order and pauses the
+// schedule, no more orders are created until the user starts it again.
+func (mgr *AssetsManager) pauseFailedSchedule(schedule *instantswap.Schedule, run *instantswap.ScheduleRun) {
+	if err := mgr.InstantSwap.RecordScheduleRun(schedule, run); err != nil {
+		log.Errorf("Schedule %q: error saving run: %v", schedule.Name, err)
+	}
+	if err := mgr.PauseSchedule(schedule.ID); err != nil {
+		log.Errorf("Schedule %q: error pausing schedule: %v", schedule.Name, err)
+	}
+}
 
-		// construct the transaction to send the invoiced amount to the exchange server
-		err = sourceWallet.NewUnsignedTx(params.Order.SourceAccountNumber, nil)
-		if err != nil {
-			return errors.E(op, err)
-		}
+// executeScheduleRun creates and funds an order of the schedule with the
+// amount computed by its strategy. The outcome is returned as a run.
+func (mgr *AssetsManager) executeScheduleRun(schedule *instantswap.Schedule, spendingPassphrase string) *instantswap.ScheduleRun {
+	failed := func(format string, args ...interface{}) *instantswap.ScheduleRun {
+		return &instantswap.ScheduleRun{Message: fmt.Sprintf(format, args...)}
+	}
+
+	sourceWallet := mgr.WalletWithID(schedule.Order.SourceWalletID)
+	if sourceWallet == nil {
+		return failed("source wallet with id:%d not found", schedule.Order.SourceWalletID)
+	}
+
+	exchangeObject, err := mgr.InstantSwap.NewExchangeServer(schedule.Order.ExchangeServer)
+	if err != nil {
+		return failed("unable to initialize exchange server: %v", err)
+	}
 
-		var amount int64
-		switch sourceWallet.GetAssetType() {
-		case utils.BTCWalletAsset:
-			amount = btc.AmountSatoshi(params.Order.InvoicedAmount)
-		case utils.DCRWalletAsset:
-			amount = dcr.AmountAtom(params.Order.InvoicedAmount)
+	fromCur := schedule.Order.FromCurrency
+	toCur := schedule.Order.ToCurrency
+	rateRequestParams := api.ExchangeRateRequest{
+		From:        fromCur,
+		To:          toCur,
+		Amount:      DefaultRateRequestAmt(fromCur), // amount needs to be greater than 0 to get the exchange rate
+		FromNetwork: schedule.Order.FromNetwork,
+		ToNetwork:   schedule.Order.ToNetwork,
+	}
+	res, err := mgr.InstantSwap.GetExchangeRateInfo(exchangeObject, rateRequestParams)
+	if err != nil {
+		return failed("unable to get exchange server rate info: %v", err)
+	}
+
+	maxDeviationRate := schedule.MaxDeviationRate
+	if maxDeviationRate <= 0 {
+		maxDeviationRate = DefaultMarketDeviation
+	}
+
+	exchangeServerRate := res.ExchangeRate
+	rateSourceRate, percentageDiff, ok := mgr.marketRateDeviation(fromCur, toCur, exchangeServerRate)
+	if !ok {
+		log.Infof("Schedule %q: proceeding without checking market rate deviation...", schedule.Name)
+	} else {
+		log.Info(values.StringF(values.StrServerRate, schedule.Order.ExchangeServer.Server, fromCur, exchangeServerRate, toCur))
+		log.Info(values.StringF(values.StrCurrencyConverterRate, mgr.RateSource.Name(), fromCur, rateSourceRate, toCur))
+
+		if percentageDiff > maxDeviationRate {
+			return failed("exchange rate deviates from the market rate by %.2f%%, more than %.2f%%", percentageDiff, maxDeviationRate)
 		}
+	}
 
-		log.Infof("Order Scheduler: adding send destination, address: %s, amount: %.2f", order.DepositAddress, params.Order.InvoicedAmount)
-		// TODO: Broadcast will fail below if params.Order.InvoicedAmount is the
-		// same as the current wallet balance. We should be able to consider
-		// wallet fees for the transaction whilst constructing the transaction.
-		// As a temporary band aid, a check has been added above to error if
-		// swap amount does not consider tx fees.
-		err = sourceWallet.AddSendDestination(0, order.DepositAddress, amount, false)
-		if err != nil {
-			log.Error("error adding send destination: ", err.Error())
-			return errors.E(op, err)
+	sourceAccountBalance, err := sourceWallet.GetAccountBalance(schedule.Order.SourceAccountNumber)
+	if err != nil {
+		return failed("unable to get source account balance: %v", err)
+	}
+
+	var destinationBalance float64
+	if schedule.Strategy == instantswap.TargetWeightStrategy {
+		destinationWallet := mgr.WalletWithID(schedule.Order.DestinationWalletID)
+		if destinationWallet == nil {
+			return failed("destination wallet with id:%d not found", schedule.Order.DestinationWalletID)
 		}
 
-		log.Info("Order Scheduler: broadcasting tx")
-		txHash, err := sourceWallet.Broadcast(params.SpendingPassphrase, "")
+		destinationAccountBalance, err := destinationWallet.GetAccountBalance(schedule.Order.DestinationAccountNumber)
 		if err != nil {
-			log.Error("error broadcasting tx: ", err.Error())
-			return errors.E(op, err)
+			return failed("unable to get destination account balance: %v", err)
 		}
+		destinationBalance = destinationAccountBalance.Spendable.ToCoin()
+	}
 
-		// wait for the order to be completed before scheduling the next order
-		var isRefunded bool
-		for {
-			// Check if scheduler has been shutdown and exit if true.
-			if mgr.InstantSwap.SchedulerCtx.Err() != nil {
-				return mgr.InstantSwap.SchedulerCtx.Err()
-			}
-
-			// depending on the block time for the asset, the order may take a while to complete
-			// so we wait for the estimated block time before checking the order status
-			switch params.Order.ToCurrency {
-			case utils.BTCWalletAsset.String():
-				log.Info("Order Scheduler: waiting for btc block time (10 minutes)")
-				time.Sleep(BTCBlockTime)
-			case utils.DCRWalletAsset.String():
-				log.Info("Order Scheduler: waiting for dcr block time (5 minutes)")
-				time.Sleep(DCRBlockTime)
-			case utils.LTCWalletAsset.String():
-				log.Info("Order Scheduler: waiting for ltc block time (~3 minutes)")
-				time.Sleep(LTCBlockTime)
-			}
+	invoicedAmount, err := schedule.RunAmount(sourceAccountBalance.Spendable.ToCoin(), destinationBalance, exchangeServerRate, res.Min, res.Max)
+	if err != nil {
+		return failed("%v", err)
+	}
 
-			log.Info("Order Scheduler: get newly created order info")
-			orderInfo, err := mgr.InstantSwap.GetOrderInfo(exchangeObject, order.UUID)
-			if err != nil {
-				return errors.E(op, err)
-			}
+	orderParams := schedule.Order
+	orderParams.InvoicedAmount = invoicedAmount
+	order, err := mgr.InstantSwap.CreateOrder(exchangeObject, orderParams)
+	if err != nil {
+		return failed("error creating order: %v", err)
+	}
 
-			// If this is empty for any reason, default to the actual tx hash.
-			if orderInfo.TxID == "" {
-				orderInfo.TxID = txHash
-			}
+	if _, err := mgr.FundInstantSwapOrder(order, spendingPassphrase, 0); err != nil {
+		return &instantswap.ScheduleRun{
+			OrderUUID: order.UUID,
+			Amount:    invoicedAmount,
+			Message:   fmt.Sprintf("error funding order: %v", err),
+		}
+	}
 
-			if orderInfo.Status == api.OrderStatusRefunded {
-				log.Info("order was refunded. verifying that the order was refunded successfully from the blockchain explorer")
-				tmpFromCurrency := params.Order.FromCurrency
-				tmpToCurrency := params.Order.ToCurrency
+	return &instantswap.ScheduleRun{
+		OrderUUID: order.UUID,
+		Amount:    invoicedAmount,
+		Success:   true,
+	}
+}
 
-				// swap ToCurrency and FromCurrency
-				params.Order.ToCurrency = tmpFromCurrency
-				params.Order.FromCurrency = tmpToCurrency
+// waitForScheduleOrder checks the status of the order at the exchange server
+// every block of the destination currency until the order completes and its
+// payout is verified, or until ctx is canceled. An error is returned if the
+// order is refunded or fails.
+func (mgr *AssetsManager) waitForScheduleOrder(ctx context.Context, schedule *instantswap.Schedule, orderUUID string) error {
+	order, err := mgr.InstantSwap.GetOrderByUUIDRaw(orderUUID)
+	if err != nil {
+		return errors.Errorf("order %s not found: %v", orderUUID, err)
+	}
 
-				orderInfo.ReceiveAmount = params.Order.InvoicedAmount
+	exchangeObject, err := mgr.InstantSwap.NewExchangeServer(order.ExchangeServer)
+	if err != nil {
+		return errors.Errorf("unable to initialize exchange server: %v", err)
+	}
 
-				isRefunded = true
-			}
+	for {
+		// The payout was already received by the destination wallet.
+		if order.PayoutTxID != "" {
+			return nil
+		}
 
-			log.Info("Order Scheduler: instantiate block explorer")
-			// verify that the order was completed successfully from the blockchain explorer
-			config := blockexplorer.Config{
-				EnableOutput: false,
-				Symbol:       params.Order.ToCurrency,
-			}
-			explorer, err := blockexplorer.NewExplorer(config) // TODO: Confirm if this still works as intended
+		switch order.Status {
+		case api.OrderStatusCompleted:
+			verified, err := mgr.verifyOrderPayout(order)
 			if err != nil {
-				log.Error("error instantiating block explorer: ", err.Error())
-				return errors.E(op, err)
+				return err
 			}
-
-			verificationInfo := blockexplorer.TxVerifyRequest{
-				TxId:      orderInfo.TxID,
-				Amount:    orderInfo.ReceiveAmount,
-				CreatedAt: orderInfo.CreatedAt,
-				Address:   orderInfo.DestinationAddress,
-				Confirms:  DefaultConfirmations,
-			}
-
-			log.Infof("Order Scheduler: verifying transaction with ID: %s", orderInfo.TxID)
-			verification, err := explorer.VerifyTransaction(verificationInfo)
-			if err != nil {
-				log.Error("error verifying transaction: ", err.Error())
-				return errors.E(op, err)
+			if verified {
+				return nil
 			}
+		case api.OrderStatusRefunded:
+			return errors.Errorf("order %s was refunded", order.UUID)
+		case api.OrderStatusFailed, api.OrderStatusCanceled, api.OrderStatusExpired:
+			return errors.Errorf("order %s ended with status %s", order.UUID, order.Status)
+		}
 
-			if verification.Verified {
-				if verification.BlockExplorerAmount.ToCoin() != orderInfo.ReceiveAmount {
-					log.Infof("received amount: %f", verification.BlockExplorerAmount.ToCoin())
-					log.Infof("expected amount: %f", orderInfo.ReceiveAmount)
-					log.Error("received amount does not match the expected amount")
-					return errors.E(op, err)
-				}
-
-				if isRefunded {
-					log.Info("order was refunded successfully")
-				} else {
-					log.Info("order was completed successfully")
-				}
-
-				break // order is completed, break out of the loop
-			}
+		// Depending on the block time of the destination currency, the order
+		// may take a while to complete.
+		blockTime := DCRBlockTime
+		switch order.ToCurrency {
+		case utils.BTCWalletAsset.String():
+			blockTime = BTCBlockTime
+		case utils.LTCWalletAsset.String():
+			blockTime = LTCBlockTime
+		}
+		log.Infof("Schedule %q: waiting %s for order %s to complete", schedule.Name, blockTime, order.UUID)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(blockTime):
+		}
 
-			continue // order is not completed, check again
+		orderInfo, err := mgr.InstantSwap.GetOrderInfo(exchangeObject, order.UUID)
+		if err != nil {
+			log.Errorf("Schedule %q: error getting info of order %s: %v", schedule.Name, order.UUID, err)
+			continue
 		}
+		order = orderInfo
 	}
 }
 
-// StopScheduler stops the order scheduler.
-func (mgr *AssetsManager) StopScheduler() {
-	mgr.InstantSwap.CancelOrderSchedulerMu.RLock()
-	if mgr.InstantSwap.CancelOrderScheduler != nil {
-		mgr.InstantSwap.CancelOrderScheduler()
-		mgr.InstantSwap.CancelOrderScheduler = nil
+// verifyOrderPayout is true once the payout tx of the completed order is
+// confirmed on the block explorer of the destination currency. An error is
+// returned if the payout is short of the expected amount.
+func (mgr *AssetsManager) verifyOrderPayout(order *instantswap.Order) (bool, error) {
+	if order.ServerTxID == "" {
+		return false, nil // The payout tx is not known yet.
+	}
+
+	explorer, err := blockexplorer.NewExplorer(blockexplorer.Config{
+		EnableOutput: false,
+		Symbol:       order.ToCurrency,
+	})
+	if err != nil {
+		// Wait for the destination wallet to receive the payout instead.
+		log.Errorf("error instantiating block explorer: %v", err)
+		return false, nil
+	}
+
+	verification, err := explorer.VerifyTransaction(blockexplorer.TxVerifyRequest{
+		TxId:      order.ServerTxID,
+		Amount:    order.ReceiveAmount,
+		CreatedAt: order.CreatedAt,
+		Address:   order.DestinationAddress,
+		Confirms:  DefaultConfirmations,
+	})
+	if err != nil {
+		log.Errorf("error verifying transaction %s: %v", order.ServerTxID, err)
+		return false, nil
+	}
+	if !verification.Verified {
+		return false, nil
+	}
+
+	receivedAmount := verification.BlockExplorerAmount.ToCoin()
+	if !order.IsPayoutAmount(receivedAmount) {
+		return false, errors.Errorf("order %s paid out %f %s, expected %f", order.UUID, receivedAmount, order.ToCurrency, order.ExpectedPayout())
 	}
-	mgr.InstantSwap.CancelOrderSchedulerMu.RUnlock()
-	log.Info("Order Scheduler: stopped")
+	return true, nil
 }
 
-// IsOrderSchedulerRunning returns true if the order scheduler is running.
-func (mgr *AssetsManager) IsOrderSchedulerRunning() bool {
-	mgr.InstantSwap.CancelOrderSchedulerMu.RLock()
-	defer mgr.InstantSwap.CancelOrderSchedulerMu.RUnlock()
-	return mgr.InstantSwap.CancelOrderScheduler != nil
+// marketRateDeviation returns the market rate of the fromCur/toCur pair from
+// the rate source and the percentage by which serverRate deviates from it.
+// ok is false if the rate source has no rate for the pair.
+func (mgr *AssetsManager) marketRateDeviation(fromCur, toCur string, serverRate float64) (marketRate, deviation float64, ok bool) {
+	market := values.NewMarket(fromCur, toCur)
+	ticker := mgr.RateSource.GetTicker(market, false)
+	if ticker == nil || ticker.LastTradePrice <= 0 {
+		log.Errorf("unable to get market(%s) rate from %s.", market, mgr.RateSource.Name())
+		return 0, 0, false
+	}
+
+	marketRate = ticker.LastTradePrice
+	// Current rate source supported Binance and Bittrex always returns
+	// ticker.LastTradePrice in's the quote asset unit e.g DCR-BTC, LTC-BTC.
+	// We will also do this when and if USDT is supported.
+	if strings.EqualFold(fromCur, "btc") {
+		marketRate = 1 / ticker.LastTradePrice
+	}
+
+	deviation = math.Abs((serverRate-marketRate)/((serverRate+marketRate)/2)) * 100
+	return marketRate, deviation, true
 }
 
-// GetSchedulerRuntime returns the duration the order scheduler has been
-// running.
-func (mgr *AssetsManager) GetSchedulerRuntime() string {
-	return time.Since(mgr.InstantSwap.SchedulerStartTime).Round(time.Second).String()
+// GetInstantSwapQuotes requests quotes for the swap from every enabled
+// exchange server and compares each quote to the market rate. See
+// instantswap.GetQuotes for the ranking of the quotes.
+func (mgr *AssetsManager) GetInstantSwapQuotes(ctx context.Context, params api.ExchangeRateRequest) []*instantswap.Quote {
+	quotes := mgr.InstantSwap.GetQuotes(ctx, params, instantswap.DefaultQuoteTimeout)
+	for _, quote := range quotes {
+		if quote.Err != nil {
+			continue
+		}
+		quote.MarketRate, quote.Deviation, _ = mgr.marketRateDeviation(params.From, params.To, quote.Rate)
+	}
+	return quotes
 }
@@ -2,4 +2,11 @@ package instantswap
 
 const (
 	ErrListenerAlreadyExist = "listener_already_exist"
+
+	ErrScheduleNameRequired     = "schedule_name_required"
+	ErrScheduleNameExists       = "schedule_name_exists"
+	ErrInvalidScheduleFrequency = "invalid_schedule_frequency"
+	ErrInvalidScheduleAmount    = "invalid_schedule_amount"
+	ErrInvalidScheduleStrategy  = "invalid_schedule_strategy"
+	ErrScheduleRunning          = "schedule_running"
 )
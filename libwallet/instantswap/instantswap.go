@@ -66,6 +66,21 @@ func NewInstantSwap(db *storm.DB) (*InstantSwap, error) {
 		return nil, err
 	}
 
+	if err := db.Init(&Schedule{}); err != nil {
+		log.Errorf("Error initializing instantSwap schedules database: %s", err.Error())
+		return nil, err
+	}
+
+	if err := db.Init(&ScheduleRun{}); err != nil {
+		log.Errorf("Error initializing instantSwap schedule runs database: %s", err.Error())
+		return nil, err
+	}
+
+	if err := migrateOrderTxIDs(db); err != nil {
+		log.Errorf("Error migrating instantSwap orders: %s", err.Error())
+		return nil, err
+	}
+
 	// TODO: Callers should provide a ctx that is tied to the lifetime of the
 	// app, since InstantSwap is not tied to any single page. If it is tied to a
 	// specific page, then that page's ctx should be provided.
@@ -75,11 +90,49 @@ func NewInstantSwap(db *storm.DB) (*InstantSwap, error) {
 		db:  db,
 		ctx: ctx,
 
+		runningSchedules: make(map[int]context.CancelFunc),
+
 		notificationListenersMu: &sync.RWMutex{},
 		notificationListeners:   make(map[string]*OrderNotificationListener),
 	}, nil
 }
 
+// migrateOrderTxIDs moves the tx IDs reported by the exchange servers from
+// Order.TxID to Order.ServerTxID. Orders saved before the deposits were
+// tracked held the server's tx ID in TxID, which now holds the ID of the
+// deposit tx. Orders funded since then have FundedAt set.
+func migrateOrderTxIDs(db *storm.DB) error {
+	dbTx, err := db.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbTx.Rollback()
+	}()
+
+	var orders []*Order
+	err = dbTx.Select(q.Not(q.Eq("TxID", "")), q.Eq("FundedAt", int64(0))).Find(&orders)
+	if err != nil {
+		if err == storm.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	for _, order := range orders {
+		if order.ServerTxID == "" {
+			order.ServerTxID = order.TxID
+		}
+		order.TxID = ""
+		if err := dbTx.Save(order); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Migrated the exchange tx IDs of %d orders", len(orders))
+	return dbTx.Commit()
+}
+
 func (instantSwap *InstantSwap) saveOrOverwriteOrder(order *Order) error {
 	var oldOrder Order
 	err := instantSwap.db.One("UUID", order.UUID, &oldOrder)
@@ -229,7 +282,7 @@ func (instantSwap *InstantSwap) CreateOrder(exchangeObject instantswap.IDExchang
 		ToCurrency:     res.ToCurrency,
 
 		DepositAddress:     res.DepositAddress,
-		RefundAddress:      res.DepositAddress,
+		RefundAddress:      params.RefundAddress,
 		DestinationAddress: res.Destination,
 		ExchangeRate:       res.ExchangeRate,
 		ChargedFee:         res.ChargedFee,
@@ -259,7 +312,8 @@ func (instantSwap *InstantSwap) GetOrderInfo(exchangeObject instantswap.IDExchan
 		return nil, errors.E(op, err)
 	}
 
-	order.TxID = res.TxID
+	statusChanged := order.Status != res.InternalStatus
+	order.ServerTxID = res.TxID
 	order.ReceiveAmount = res.ReceiveAmount
 	order.Status = res.InternalStatus
 	order.ExpiryTime = res.Expires
@@ -271,6 +325,10 @@ func (instantSwap *InstantSwap) GetOrderInfo(exchangeObject instantswap.IDExchan
 		return nil, errors.E(op, err)
 	}
 
+	if statusChanged {
+		instantSwap.publishOrderUpdated(order)
+	}
+
 	return order, nil
 }
 
@@ -0,0 +1,277 @@
+package instantswap
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/instantswap/instantswap"
+)
+
+// DefaultOrderOverdueTime is how long a funded order may go without its
+// payout or refund being received before it is considered overdue.
+const DefaultOrderOverdueTime = 3 * time.Hour
+
+// payoutAmountTolerance is the fraction of the expected amount a payout may
+// fall short of. The rate of floating rate orders moves until the deposit is
+// exchanged.
+const payoutAmountTolerance = 0.05
+
+// IsOpen is true if the exchange server has not yet completed, refunded or
+// otherwise closed the order.
+func (order *Order) IsOpen() bool {
+	switch order.Status {
+	case instantswap.OrderStatusCompleted, instantswap.OrderStatusRefunded,
+		instantswap.OrderStatusCanceled, instantswap.OrderStatusExpired,
+		instantswap.OrderStatusFailed:
+		return false
+	}
+	return true
+}
+
+// isFinal is true if the exchange server reported the order as completed,
+// refunded or failed. No payout or refund is expected from it anymore.
+func (order *Order) isFinal() bool {
+	switch order.Status {
+	case instantswap.OrderStatusCompleted, instantswap.OrderStatusRefunded,
+		instantswap.OrderStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsFunded is true if the deposit of the order was sent from the wallet.
+func (order *Order) IsFunded() bool {
+	return order.TxID != ""
+}
+
+// IsSettled is true if the payout or the refund of the order was received
+// by the wallet.
+func (order *Order) IsSettled() bool {
+	return order.PayoutTxID != "" || order.RefundTxID != ""
+}
+
+// IsOverdue is true if the order was funded more than
+// DefaultOrderOverdueTime ago and neither its payout nor its refund has
+// been received yet.
+func (order *Order) IsOverdue() bool {
+	if !order.IsFunded() || order.IsSettled() || !order.IsOpen() {
+		return false
+	}
+	return time.Since(time.Unix(order.FundedAt, 0)) > DefaultOrderOverdueTime
+}
+
+// ExpectedPayout returns the amount the order pays out to its destination
+// address, as reported by the exchange server once known.
+func (order *Order) ExpectedPayout() float64 {
+	if order.ReceiveAmount > 0 {
+		return order.ReceiveAmount
+	}
+	return order.OrderedAmount
+}
+
+// IsPayout is true if a tx paying amount to the destination address at the
+// unix time timestamp can be the payout of the order. The payout is only
+// expected after the deposit and for about the expected amount.
+func (order *Order) IsPayout(amount float64, timestamp int64) bool {
+	if !order.IsFunded() || timestamp < order.FundedAt {
+		return false
+	}
+	return order.IsPayoutAmount(amount)
+}
+
+// IsPayoutAmount is true if amount is about the expected payout of the order.
+func (order *Order) IsPayoutAmount(amount float64) bool {
+	return amount > 0 && amount >= order.ExpectedPayout()*(1-payoutAmountTolerance)
+}
+
+// IsRefund is true if a tx paying amount to the refund address at the unix
+// time timestamp can be the refund of the order. The refund is only expected
+// after the deposit and for at most the deposited amount.
+func (order *Order) IsRefund(amount float64, timestamp int64) bool {
+	if !order.IsFunded() || timestamp < order.FundedAt {
+		return false
+	}
+	return amount > 0 && amount <= order.InvoicedAmount
+}
+
+// WatchedOrders returns the funded orders whose payout or refund has not been
+// received yet, unless the exchange server reported them as completed,
+// refunded or failed.
+func (instantSwap *InstantSwap) WatchedOrders() ([]*Order, error) {
+	orders, err := instantSwap.GetOrdersRaw(0, 0, true, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	watched := make([]*Order, 0)
+	for _, order := range orders {
+		if order.IsFunded() && !order.IsSettled() && !order.isFinal() {
+			watched = append(watched, order)
+		}
+	}
+	return watched, nil
+}
+
+// RecordOrderFunding saves txID as the tx that funded the deposit address of
+// the order.
+func (instantSwap *InstantSwap) RecordOrderFunding(order *Order, txID string) error {
+	const op errors.Op = "instantSwap.RecordOrderFunding"
+
+	order.TxID = txID
+	order.FundedAt = time.Now().Unix()
+	if err := instantSwap.updateOrder(order); err != nil {
+		return errors.E(op, err)
+	}
+
+	log.Infof("Order %s: deposit sent in tx %s", order.UUID, txID)
+	instantSwap.publishOrderUpdated(order)
+	return nil
+}
+
+// RecordOrderPayout saves txID as the tx that paid the order out to its
+// destination address.
+func (instantSwap *InstantSwap) RecordOrderPayout(order *Order, txID string) error {
+	const op errors.Op = "instantSwap.RecordOrderPayout"
+
+	order.PayoutTxID = txID
+	order.PaidOutAt = time.Now().Unix()
+	if err := instantSwap.updateOrder(order); err != nil {
+		return errors.E(op, err)
+	}
+
+	log.Infof("Order %s: payout received in tx %s", order.UUID, txID)
+	instantSwap.publishOrderUpdated(order)
+	return nil
+}
+
+// RecordOrderRefund saves txID as the tx that refunded the order to its
+// refund address.
+func (instantSwap *InstantSwap) RecordOrderRefund(order *Order, txID string) error {
+	const op errors.Op = "instantSwap.RecordOrderRefund"
+
+	order.RefundTxID = txID
+	order.RefundedAt = time.Now().Unix()
+	if err := instantSwap.updateOrder(order); err != nil {
+		return errors.E(op, err)
+	}
+
+	log.Infof("Order %s: refund received in tx %s", order.UUID, txID)
+	instantSwap.publishOrderUpdated(order)
+	return nil
+}
+
+// CheckOverdueOrders notifies the listeners once of every order that became
+// overdue.
+func (instantSwap *InstantSwap) CheckOverdueOrders() {
+	orders, err := instantSwap.WatchedOrders()
+	if err != nil {
+		log.Errorf("Error checking overdue orders: %v", err)
+		return
+	}
+
+	for _, order := range orders {
+		if order.OverdueNotified || !order.IsOverdue() {
+			continue
+		}
+
+		order.OverdueNotified = true
+		if err := instantSwap.updateOrder(order); err != nil {
+			log.Errorf("Error updating overdue order: %v", err)
+			continue
+		}
+
+		log.Warnf("Order %s: no payout or refund received %s after funding", order.UUID, DefaultOrderOverdueTime)
+		instantSwap.publishOrderOverdue(order)
+	}
+}
+
+// SupportBundle holds the details of an order an exchange server support
+// team needs to investigate a dispute.
+type SupportBundle struct {
+	OrderID            string
+	ExchangeServer     string
+	Status             string
+	FromCurrency       string
+	ToCurrency         string
+	InvoicedAmount     float64
+	OrderedAmount      float64
+	ReceiveAmount      float64
+	DepositAddress     string
+	DestinationAddress string
+	RefundAddress      string
+	FundingTxID        string
+	ServerTxID         string
+	PayoutTxID         string
+	RefundTxID         string
+	CreatedAt          int64
+	FundedAt           int64
+	PaidOutAt          int64
+	RefundedAt         int64
+}
+
+// SupportBundle returns the dispute details of the order.
+func (order *Order) SupportBundle() *SupportBundle {
+	return &SupportBundle{
+		OrderID:            order.UUID,
+		ExchangeServer:     order.ExchangeServer.Server.CapFirstLetter(),
+		Status:             order.Status.String(),
+		FromCurrency:       strings.ToUpper(order.FromCurrency),
+		ToCurrency:         strings.ToUpper(order.ToCurrency),
+		InvoicedAmount:     order.InvoicedAmount,
+		OrderedAmount:      order.OrderedAmount,
+		ReceiveAmount:      order.ReceiveAmount,
+		DepositAddress:     order.DepositAddress,
+		DestinationAddress: order.DestinationAddress,
+		RefundAddress:      order.RefundAddress,
+		FundingTxID:        order.TxID,
+		ServerTxID:         order.ServerTxID,
+		PayoutTxID:         order.PayoutTxID,
+		RefundTxID:         order.RefundTxID,
+		CreatedAt:          order.CreatedAt,
+		FundedAt:           order.FundedAt,
+		PaidOutAt:          order.PaidOutAt,
+		RefundedAt:         order.RefundedAt,
+	}
+}
+
+// String formats the support bundle as plain text to share with the
+// exchange server support team. Empty fields are left out.
+func (bundle *SupportBundle) String() string {
+	var sb strings.Builder
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&sb, "%s: %s\n", name, value)
+		}
+	}
+	amount := func(name string, value float64, currency string) {
+		if value > 0 {
+			field(name, fmt.Sprintf("%.8f %s", value, currency))
+		}
+	}
+	timestamp := func(name string, value int64) {
+		if value > 0 {
+			field(name, time.Unix(value, 0).UTC().Format(time.RFC3339))
+		}
+	}
+
+	field("Order ID", bundle.OrderID)
+	field("Exchange", bundle.ExchangeServer)
+	field("Status", bundle.Status)
+	amount("Sent", bundle.InvoicedAmount, bundle.FromCurrency)
+	amount("Ordered", bundle.OrderedAmount, bundle.ToCurrency)
+	amount("Received", bundle.ReceiveAmount, bundle.ToCurrency)
+	field("Deposit address", bundle.DepositAddress)
+	field("Destination address", bundle.DestinationAddress)
+	field("Refund address", bundle.RefundAddress)
+	field("Deposit tx", bundle.FundingTxID)
+	field("Exchange tx", bundle.ServerTxID)
+	field("Payout tx", bundle.PayoutTxID)
+	field("Refund tx", bundle.RefundTxID)
+	timestamp("Created", bundle.CreatedAt)
+	timestamp("Funded", bundle.FundedAt)
+	timestamp("Paid out", bundle.PaidOutAt)
+	timestamp("Refunded", bundle.RefundedAt)
+	return sb.String()
+}
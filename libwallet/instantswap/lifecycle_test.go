@@ -0,0 +1,122 @@
+package instantswap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/asdine/storm"
+	"github.com/crypto-power/instantswap/instantswap"
+)
+
+func TestMigrateOrderTxIDs(t *testing.T) {
+	db, err := storm.Open(filepath.Join(t.TempDir(), "instantswap.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	orders := []*Order{
+		{UUID: "legacy", TxID: "server-tx"},
+		{UUID: "legacy-with-server-tx", TxID: "old-tx", ServerTxID: "server-tx"},
+		{UUID: "funded", TxID: "deposit-tx", ServerTxID: "server-tx", FundedAt: 100},
+		{UUID: "unfunded"},
+	}
+	for _, order := range orders {
+		if err := db.Save(order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Migrating twice must not move the IDs of funded orders.
+	for i := 0; i < 2; i++ {
+		if _, err := NewInstantSwap(db); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := map[string][2]string{
+		"legacy":                {"", "server-tx"},
+		"legacy-with-server-tx": {"", "server-tx"},
+		"funded":                {"deposit-tx", "server-tx"},
+		"unfunded":              {"", ""},
+	}
+	for uuid, txIDs := range want {
+		var order Order
+		if err := db.One("UUID", uuid, &order); err != nil {
+			t.Fatal(err)
+		}
+		if order.TxID != txIDs[0] || order.ServerTxID != txIDs[1] {
+			t.Errorf("%s: expected tx IDs %v, got %q and %q", uuid, txIDs, order.TxID, order.ServerTxID)
+		}
+	}
+}
+
+func TestOrderSettlement(t *testing.T) {
+	fundedAt := time.Now().Add(-time.Hour).Unix()
+	order := &Order{
+		TxID:           "deposit-tx",
+		FundedAt:       fundedAt,
+		InvoicedAmount: 1,
+		OrderedAmount:  2,
+		ReceiveAmount:  1.9,
+		Status:         instantswap.OrderStatusExchanging,
+	}
+
+	payouts := []struct {
+		name      string
+		amount    float64
+		timestamp int64
+		want      bool
+	}{
+		{"receive amount", 1.9, fundedAt + 600, true},
+		{"within the rate tolerance", 1.81, fundedAt + 600, true},
+		{"short of the expected amount", 1, fundedAt + 600, false},
+		{"received before the deposit", 1.9, fundedAt - 600, false},
+		{"nothing paid", 0, fundedAt + 600, false},
+	}
+	for _, test := range payouts {
+		if got := order.IsPayout(test.amount, test.timestamp); got != test.want {
+			t.Errorf("payout %s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+
+	refunds := []struct {
+		name      string
+		amount    float64
+		timestamp int64
+		want      bool
+	}{
+		{"deposit less fees", 0.99, fundedAt + 600, true},
+		{"more than the deposit", 1.5, fundedAt + 600, false},
+		{"received before the deposit", 0.99, fundedAt - 600, false},
+	}
+	for _, test := range refunds {
+		if got := order.IsRefund(test.amount, test.timestamp); got != test.want {
+			t.Errorf("refund %s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+
+	overdue := *order
+	overdue.FundedAt = time.Now().Add(-2 * DefaultOrderOverdueTime).Unix()
+	tests := []struct {
+		status      instantswap.Status
+		wantOverdue bool
+		wantFinal   bool
+	}{
+		{instantswap.OrderStatusExchanging, true, false},
+		{instantswap.OrderStatusCompleted, false, true},
+		{instantswap.OrderStatusRefunded, false, true},
+		{instantswap.OrderStatusFailed, false, true},
+		{instantswap.OrderStatusExpired, false, false},
+	}
+	for _, test := range tests {
+		overdue.Status = test.status
+		if got := overdue.IsOverdue(); got != test.wantOverdue {
+			t.Errorf("overdue with status %v: expected %v, got %v", test.status, test.wantOverdue, got)
+		}
+		if got := overdue.isFinal(); got != test.wantFinal {
+			t.Errorf("final with status %v: expected %v, got %v", test.status, test.wantFinal, got)
+		}
+	}
+}
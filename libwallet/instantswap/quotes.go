@@ -0,0 +1,194 @@
+package instantswap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crypto-power/instantswap/instantswap"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// DefaultQuoteTimeout is how long each exchange server has to return a quote.
+const DefaultQuoteTimeout = 15 * time.Second
+
+// Quote is the normalised exchange rate offered by an exchange server for a
+// swap of the requested amount.
+type Quote struct {
+	ExchangeServer ExchangeServer
+	// Amount is the requested amount in the From currency.
+	Amount float64
+	// ReceiveAmount is the estimated amount of the To currency received for
+	// Amount after the exchange server fees.
+	ReceiveAmount float64
+	// Rate is the net rate of the swap, i.e. ReceiveAmount/Amount.
+	Rate float64
+	// Min and Max are the limits of the swap amount in the From currency. A
+	// zero Max means the amount is not limited.
+	Min float64
+	Max float64
+
+	// Provider and Signature are required by some exchange servers to create
+	// the order of the quote.
+	Provider  string
+	Signature string
+	// FromNetwork and ToNetwork are the networks of the currencies quoted
+	// by the exchange server.
+	FromNetwork string
+	ToNetwork   string
+
+	// MarketRate is the market rate of the currency pair from the rate
+	// source, zero if it is not available. Deviation is the percentage
+	// difference between Rate and MarketRate.
+	MarketRate float64
+	Deviation  float64
+
+	Err error
+}
+
+// WithinLimits is true if the quote amount is within the server limits.
+func (quote *Quote) WithinLimits() bool {
+	return quote.Amount >= quote.Min && (quote.Max == 0 || quote.Amount <= quote.Max)
+}
+
+// GetQuotes requests the rates for a swap of params.Amount from every
+// enabled exchange server in parallel. Each server has timeout to respond,
+// servers that fail have the Err field of their quote set. The quotes are
+// ranked by the amount received, with quotes outside the server limits and
+// failed quotes last.
+func (instantSwap *InstantSwap) GetQuotes(ctx context.Context, params instantswap.ExchangeRateRequest, timeout time.Duration) []*Quote {
+	if timeout <= 0 {
+		timeout = DefaultQuoteTimeout
+	}
+
+	servers := instantSwap.ExchangeServers()
+	quotes := make([]*Quote, len(servers))
+
+	var wg sync.WaitGroup
+	for i, server := range servers {
+		wg.Add(1)
+		go func(i int, server ExchangeServer) {
+			defer wg.Done()
+			quotes[i] = instantSwap.getQuote(ctx, server, params, timeout)
+		}(i, server)
+	}
+	wg.Wait()
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		a, b := quotes[i], quotes[j]
+		if (a.Err == nil) != (b.Err == nil) {
+			return a.Err == nil
+		}
+		if a.WithinLimits() != b.WithinLimits() {
+			return a.WithinLimits()
+		}
+		return a.ReceiveAmount > b.ReceiveAmount
+	})
+
+	return quotes
+}
+
+// CurrencyNetwork returns the network of the currency with the symbol among
+// the currencies of an exchange server. The mainnet network is preferred if
+// the currency is listed on several networks.
+func CurrencyNetwork(symbol string, currencies []instantswap.Currency) string {
+	var lowerName = strings.ToLower(symbol)
+	var currency *instantswap.Currency
+	for _, c := range currencies {
+		if strings.ToLower(c.Symbol) == lowerName {
+			currency = &c
+			break
+		}
+	}
+	if currency == nil || len(currency.Networks) == 0 {
+		return ""
+	}
+	for _, network := range currency.Networks {
+		var lowerNetwork = strings.ToLower(network)
+		if lowerNetwork == string(utils.Mainnet) {
+			return network
+		}
+		if lowerNetwork == lowerName {
+			return network
+		}
+	}
+	return currency.Networks[0]
+}
+
+// getQuote requests the rate of a single exchange server.
+func (instantSwap *InstantSwap) getQuote(ctx context.Context, server ExchangeServer, params instantswap.ExchangeRateRequest, timeout time.Duration) *Quote {
+	quote := &Quote{
+		ExchangeServer: server,
+		Amount:         params.Amount,
+	}
+
+	exchangeObject, err := instantSwap.NewExchangeServer(server)
+	if err != nil {
+		quote.Err = err
+		return quote
+	}
+
+	type result struct {
+		info   *instantswap.ExchangeRateInfo
+		params instantswap.ExchangeRateRequest
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		// The networks of the currencies are named differently by each
+		// exchange server.
+		if params.FromNetwork == "" || params.ToNetwork == "" {
+			currencies, err := exchangeObject.GetCurrencies()
+			if err != nil {
+				resCh <- result{nil, params, err}
+				return
+			}
+			if params.FromNetwork == "" {
+				params.FromNetwork = CurrencyNetwork(params.From, currencies)
+			}
+			if params.ToNetwork == "" {
+				params.ToNetwork = CurrencyNetwork(params.To, currencies)
+			}
+		}
+
+		info, err := instantSwap.GetExchangeRateInfo(exchangeObject, params)
+		resCh <- result{info, params, err}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		quote.Err = fmt.Errorf("%s did not respond in time", server.Server.CapFirstLetter())
+		return quote
+	case res := <-resCh:
+		if res.err != nil {
+			quote.Err = res.err
+			return quote
+		}
+
+		info := res.info
+		// Some servers only estimate the received amount through the rate.
+		quote.ReceiveAmount = info.EstimatedAmount
+		if quote.ReceiveAmount <= 0 {
+			quote.ReceiveAmount = info.ExchangeRate * params.Amount
+		}
+		if params.Amount > 0 {
+			quote.Rate = quote.ReceiveAmount / params.Amount
+		}
+		quote.Min, quote.Max = info.Min, info.Max
+		quote.Provider, quote.Signature = info.Provider, info.Signature
+		quote.FromNetwork, quote.ToNetwork = res.params.FromNetwork, res.params.ToNetwork
+		if quote.Rate <= 0 {
+			quote.Err = fmt.Errorf("%s returned no rate", server.Server.CapFirstLetter())
+		}
+	}
+
+	log.Debugf("Quote from %s: %f %s -> %f %s", server.Server, params.Amount, params.From, quote.ReceiveAmount, params.To)
+	return quote
+}
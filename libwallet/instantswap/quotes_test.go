@@ -0,0 +1,177 @@
+package instantswap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/instantswap/instantswap"
+)
+
+// stubExchange is an exchange server returning a fixed rate.
+type stubExchange struct {
+	instantswap.IDExchange
+
+	info       instantswap.ExchangeRateInfo
+	err        error
+	currencies []instantswap.Currency
+	// block delays the rate until it is closed.
+	block chan struct{}
+
+	mu       sync.Mutex
+	requests []instantswap.ExchangeRateRequest
+}
+
+func (stub *stubExchange) GetCurrencies() ([]instantswap.Currency, error) {
+	return stub.currencies, nil
+}
+
+func (stub *stubExchange) GetExchangeRateInfo(params instantswap.ExchangeRateRequest) (instantswap.ExchangeRateInfo, error) {
+	stub.mu.Lock()
+	stub.requests = append(stub.requests, params)
+	stub.mu.Unlock()
+	if stub.block != nil {
+		<-stub.block
+	}
+	return stub.info, stub.err
+}
+
+var (
+	stubExchangesMu sync.Mutex
+	stubExchanges   = make(map[Server]*stubExchange)
+)
+
+func init() {
+	for _, name := range []Server{"stub-best", "stub-cheap", "stub-limited", "stub-failing", "stub-slow"} {
+		name := name
+		instantswap.RegisterExchange(name.ToString(), func(instantswap.ExchangeConfig) (instantswap.IDExchange, error) {
+			stubExchangesMu.Lock()
+			defer stubExchangesMu.Unlock()
+			return stubExchanges[name], nil
+		})
+	}
+}
+
+// useStubExchanges makes the stubs the only exchange servers until the
+// returned function is called.
+func useStubExchanges(stubs map[Server]*stubExchange) func() {
+	stubExchangesMu.Lock()
+	defer stubExchangesMu.Unlock()
+
+	oldPrivKeyMap := privKeyMap
+	privKeyMap = make(map[Server]string, len(stubs))
+	for name, stub := range stubs {
+		privKeyMap[name] = ""
+		stubExchanges[name] = stub
+	}
+	return func() {
+		privKeyMap = oldPrivKeyMap
+	}
+}
+
+func TestGetQuotes(t *testing.T) {
+	currencies := []instantswap.Currency{
+		{Symbol: "DCR", Networks: []string{"DCR"}},
+		{Symbol: "BTC", Networks: []string{"Lightning", "Mainnet"}},
+	}
+	slow := &stubExchange{info: instantswap.ExchangeRateInfo{ExchangeRate: 20}, block: make(chan struct{})}
+	defer close(slow.block)
+	stubs := map[Server]*stubExchange{
+		// Only the rate is returned, the received amount is derived from it.
+		"stub-best":  {info: instantswap.ExchangeRateInfo{ExchangeRate: 10, Min: 0.1}, currencies: currencies},
+		"stub-cheap": {info: instantswap.ExchangeRateInfo{EstimatedAmount: 18, ExchangeRate: 11}, currencies: currencies},
+		// The best rate is ranked after the others as the amount is above
+		// the server maximum.
+		"stub-limited": {info: instantswap.ExchangeRateInfo{EstimatedAmount: 30, Max: 1}, currencies: currencies},
+		"stub-failing": {err: errors.New("server down"), currencies: currencies},
+		"stub-slow":    slow,
+	}
+	defer useStubExchanges(stubs)()
+
+	params := instantswap.ExchangeRateRequest{From: "DCR", To: "BTC", Amount: 2}
+	quotes := new(InstantSwap).GetQuotes(context.Background(), params, 100*time.Millisecond)
+	if len(quotes) != len(stubs) {
+		t.Fatalf("expected %d quotes, got %d", len(stubs), len(quotes))
+	}
+
+	ranked := []struct {
+		server        Server
+		receiveAmount float64
+		rate          float64
+		withinLimits  bool
+	}{
+		{server: "stub-best", receiveAmount: 20, rate: 10, withinLimits: true},
+		{server: "stub-cheap", receiveAmount: 18, rate: 9, withinLimits: true},
+		{server: "stub-limited", receiveAmount: 30, rate: 15, withinLimits: false},
+	}
+	for i, want := range ranked {
+		quote := quotes[i]
+		if quote.ExchangeServer.Server != want.server {
+			t.Errorf("quote %d: expected %s, got %s", i, want.server, quote.ExchangeServer.Server)
+			continue
+		}
+		if quote.Err != nil {
+			t.Errorf("%s: unexpected error %v", want.server, quote.Err)
+		}
+		if quote.ReceiveAmount != want.receiveAmount || quote.Rate != want.rate {
+			t.Errorf("%s: expected %f at %f, got %f at %f", want.server, want.receiveAmount, want.rate, quote.ReceiveAmount, quote.Rate)
+		}
+		if quote.WithinLimits() != want.withinLimits {
+			t.Errorf("%s: expected within limits %v", want.server, want.withinLimits)
+		}
+		if quote.FromNetwork != "DCR" || quote.ToNetwork != "Mainnet" {
+			t.Errorf("%s: expected the DCR and Mainnet networks, got %q and %q", want.server, quote.FromNetwork, quote.ToNetwork)
+		}
+	}
+
+	// The failed quotes are last.
+	for _, quote := range quotes[len(ranked):] {
+		switch quote.ExchangeServer.Server {
+		case "stub-failing":
+			if quote.Err == nil || !strings.Contains(quote.Err.Error(), "server down") {
+				t.Errorf("stub-failing: expected the server error, got %v", quote.Err)
+			}
+		case "stub-slow":
+			if quote.Err == nil || !strings.Contains(quote.Err.Error(), "did not respond in time") {
+				t.Errorf("stub-slow: expected a timeout error, got %v", quote.Err)
+			}
+		default:
+			t.Errorf("unexpected failed quote of %s: %v", quote.ExchangeServer.Server, quote.Err)
+		}
+	}
+
+	// The networks are resolved before the rate is requested.
+	best := stubs["stub-best"]
+	best.mu.Lock()
+	defer best.mu.Unlock()
+	if len(best.requests) != 1 || best.requests[0].FromNetwork != "DCR" || best.requests[0].ToNetwork != "Mainnet" {
+		t.Errorf("expected a rate request on the resolved networks, got %+v", best.requests)
+	}
+}
+
+func TestCurrencyNetwork(t *testing.T) {
+	currencies := []instantswap.Currency{
+		{Symbol: "BTC", Networks: []string{"Lightning", "mainnet"}},
+		{Symbol: "DCR", Networks: []string{"ERC20", "dcr"}},
+		{Symbol: "USDT", Networks: []string{"TRC20", "ERC20"}},
+		{Symbol: "LTC"},
+	}
+	tests := []struct {
+		symbol string
+		want   string
+	}{
+		{symbol: "btc", want: "mainnet"},
+		{symbol: "DCR", want: "dcr"},
+		{symbol: "USDT", want: "TRC20"},
+		{symbol: "LTC", want: ""},
+		{symbol: "XMR", want: ""},
+	}
+	for _, test := range tests {
+		if got := CurrencyNetwork(test.symbol, currencies); got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.symbol, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package instantswap
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+)
+
+// Validate checks that the schedule can be saved.
+func (schedule *Schedule) Validate() error {
+	if strings.TrimSpace(schedule.Name) == "" {
+		return errors.New(ErrScheduleNameRequired)
+	}
+	if schedule.Frequency <= 0 {
+		return errors.New(ErrInvalidScheduleFrequency)
+	}
+
+	switch schedule.Strategy {
+	case FixedAmountStrategy:
+		if schedule.Amount <= 0 {
+			return errors.New(ErrInvalidScheduleAmount)
+		}
+	case BalancePercentageStrategy, TargetWeightStrategy:
+		if schedule.Amount <= 0 || schedule.Amount > 100 {
+			return errors.New(ErrInvalidScheduleAmount)
+		}
+	default:
+		return errors.New(ErrInvalidScheduleStrategy)
+	}
+
+	if schedule.BalanceToMaintain < 0 {
+		return errors.New(ErrInvalidScheduleAmount)
+	}
+	return nil
+}
+
+// RunAmount returns the amount of the source currency swapped by a run of the
+// schedule. sourceBalance and destinationBalance are the spendable balances of
+// the source and destination accounts, rate is the amount of the destination
+// currency received per unit of the source currency and min and max are the
+// limits of the exchange server. A max of zero is no limit.
+func (schedule *Schedule) RunAmount(sourceBalance, destinationBalance, rate, min, max float64) (float64, error) {
+	var amount float64
+	switch schedule.Strategy {
+	case FixedAmountStrategy:
+		amount = schedule.Amount
+	case BalancePercentageStrategy:
+		amount = sourceBalance * schedule.Amount / 100
+	case TargetWeightStrategy:
+		if rate <= 0 {
+			return 0, errors.New("invalid exchange rate")
+		}
+		// Bring the value of the destination account to schedule.Amount
+		// percent of the combined value, in the source currency.
+		destinationValue := destinationBalance / rate
+		targetValue := (sourceBalance + destinationValue) * schedule.Amount / 100
+		amount = targetValue - destinationValue
+		if amount <= 0 {
+			return 0, errors.Errorf("target weight of %.2f%% already reached", schedule.Amount)
+		}
+	default:
+		return 0, errors.New(ErrInvalidScheduleStrategy)
+	}
+
+	availableBalance := sourceBalance - schedule.BalanceToMaintain
+	if availableBalance <= 0 {
+		return 0, errors.Errorf("balance to maintain is the same or greater than wallet balance(Current Balance: %v, Balance to Maintain: %v)", sourceBalance, schedule.BalanceToMaintain)
+	}
+	amount = math.Min(amount, availableBalance)
+	if max > 0 {
+		amount = math.Min(amount, max)
+	}
+
+	if amount < min {
+		return 0, errors.Errorf("amount %f %s is below the exchange server minimum of %f", amount, schedule.Order.FromCurrency, min)
+	}
+
+	if amount == sourceBalance {
+		return 0, errors.New("specify a little balance to maintain to cover for transaction fees")
+	}
+	return amount, nil
+}
+
+// SaveSchedule validates and saves a new or updated schedule.
+func (instantSwap *InstantSwap) SaveSchedule(schedule *Schedule) error {
+	const op errors.Op = "instantSwap.SaveSchedule"
+
+	if err := schedule.Validate(); err != nil {
+		return errors.E(op, err)
+	}
+
+	if schedule.CreatedAt == 0 {
+		schedule.CreatedAt = time.Now().Unix()
+	}
+
+	if err := instantSwap.writeSchedule(schedule.ID, schedule); err != nil {
+		if err == storm.ErrAlreadyExists {
+			return errors.E(op, errors.New(ErrScheduleNameExists))
+		}
+		return errors.E(op, err)
+	}
+
+	instantSwap.publishScheduleUpdated(schedule)
+	return nil
+}
+
+// writeSchedule saves data, the schedule or one of its runs, unless the
+// schedule with the ID was deleted. Saving it then would add it back. A
+// zero ID is a new schedule.
+func (instantSwap *InstantSwap) writeSchedule(scheduleID int, data interface{}) error {
+	instantSwap.scheduleWriteMu.Lock()
+	defer instantSwap.scheduleWriteMu.Unlock()
+
+	if scheduleID != 0 {
+		if _, err := instantSwap.ScheduleByID(scheduleID); err != nil {
+			return err
+		}
+	}
+	return instantSwap.db.Save(data)
+}
+
+// Schedules returns all the saved schedules, oldest first.
+func (instantSwap *InstantSwap) Schedules() ([]*Schedule, error) {
+	var schedules []*Schedule
+	err := instantSwap.db.All(&schedules)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// SchedulesAwaitingPassphrase returns the schedules that are not paused but
+// not running either, as after a restart. They are resumed with the spending
+// passphrase of their source wallet.
+func (instantSwap *InstantSwap) SchedulesAwaitingPassphrase() ([]*Schedule, error) {
+	schedules, err := instantSwap.Schedules()
+	if err != nil {
+		return nil, err
+	}
+
+	var awaiting []*Schedule
+	for _, schedule := range schedules {
+		if !schedule.Paused && !instantSwap.IsScheduleRunning(schedule.ID) {
+			awaiting = append(awaiting, schedule)
+		}
+	}
+	return awaiting, nil
+}
+
+// ScheduleByID returns the schedule with the provided ID.
+func (instantSwap *InstantSwap) ScheduleByID(scheduleID int) (*Schedule, error) {
+	var schedule Schedule
+	if err := instantSwap.db.One("ID", scheduleID, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// DeleteSchedule stops and deletes the schedule and its run history.
+func (instantSwap *InstantSwap) DeleteSchedule(scheduleID int) error {
+	const op errors.Op = "instantSwap.DeleteSchedule"
+
+	instantSwap.StopSchedule(scheduleID)
+
+	// The schedule may still be running until its context cancelation is
+	// noticed, its later writes fail once it is deleted.
+	instantSwap.scheduleWriteMu.Lock()
+	defer instantSwap.scheduleWriteMu.Unlock()
+
+	schedule, err := instantSwap.ScheduleByID(scheduleID)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = instantSwap.db.Select(q.Eq("ScheduleID", scheduleID)).Delete(&ScheduleRun{})
+	if err != nil && err != storm.ErrNotFound {
+		return errors.E(op, err)
+	}
+
+	if err := instantSwap.db.DeleteStruct(schedule); err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// RecordScheduleRun saves the outcome of a run of the schedule.
+func (instantSwap *InstantSwap) RecordScheduleRun(schedule *Schedule, run *ScheduleRun) error {
+	const op errors.Op = "instantSwap.RecordScheduleRun"
+
+	run.ScheduleID = schedule.ID
+	if run.Timestamp == 0 {
+		run.Timestamp = time.Now().Unix()
+	}
+	if err := instantSwap.writeSchedule(schedule.ID, run); err != nil {
+		return errors.E(op, err)
+	}
+
+	if run.Success {
+		log.Infof("Schedule %q: swapped %f %s, order %s", schedule.Name, run.Amount, schedule.Order.FromCurrency, run.OrderUUID)
+	} else {
+		log.Infof("Schedule %q: run skipped: %s", schedule.Name, run.Message)
+	}
+
+	instantSwap.publishScheduleRun(schedule, run)
+	return nil
+}
+
+// ScheduleRuns returns the run history of the schedule, newest first. A
+// limit of zero returns all the runs.
+func (instantSwap *InstantSwap) ScheduleRuns(scheduleID, limit int) ([]*ScheduleRun, error) {
+	query := instantSwap.db.Select(q.Eq("ScheduleID", scheduleID)).OrderBy("Timestamp").Reverse()
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var runs []*ScheduleRun
+	err := query.Find(&runs)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// TrackSchedule registers the schedule as running and returns the context
+// that is canceled when the schedule is stopped. UntrackSchedule must be
+// called once the schedule exits.
+func (instantSwap *InstantSwap) TrackSchedule(ctx context.Context, scheduleID int) (context.Context, error) {
+	instantSwap.schedulesMu.Lock()
+	defer instantSwap.schedulesMu.Unlock()
+
+	if _, ok := instantSwap.runningSchedules[scheduleID]; ok {
+		return nil, errors.New(ErrScheduleRunning)
+	}
+
+	scheduleCtx, cancel := context.WithCancel(ctx)
+	instantSwap.runningSchedules[scheduleID] = cancel
+	return scheduleCtx, nil
+}
+
+// UntrackSchedule unregisters the running schedule.
+func (instantSwap *InstantSwap) UntrackSchedule(scheduleID int) {
+	instantSwap.schedulesMu.Lock()
+	if cancel, ok := instantSwap.runningSchedules[scheduleID]; ok {
+		cancel()
+		delete(instantSwap.runningSchedules, scheduleID)
+	}
+	instantSwap.schedulesMu.Unlock()
+
+	if schedule, err := instantSwap.ScheduleByID(scheduleID); err == nil {
+		instantSwap.publishScheduleUpdated(schedule)
+	}
+}
+
+// StopSchedule stops the schedule if it is running.
+func (instantSwap *InstantSwap) StopSchedule(scheduleID int) {
+	instantSwap.schedulesMu.RLock()
+	defer instantSwap.schedulesMu.RUnlock()
+
+	if cancel, ok := instantSwap.runningSchedules[scheduleID]; ok {
+		cancel()
+	}
+}
+
+// StopSchedules stops all the running schedules.
+func (instantSwap *InstantSwap) StopSchedules() {
+	instantSwap.schedulesMu.RLock()
+	defer instantSwap.schedulesMu.RUnlock()
+
+	for _, cancel := range instantSwap.runningSchedules {
+		cancel()
+	}
+}
+
+// IsScheduleRunning is true if the schedule is running.
+func (instantSwap *InstantSwap) IsScheduleRunning(scheduleID int) bool {
+	instantSwap.schedulesMu.RLock()
+	defer instantSwap.schedulesMu.RUnlock()
+
+	_, ok := instantSwap.runningSchedules[scheduleID]
+	return ok
+}
+
+// RunningSchedulesCount returns the number of running schedules.
+func (instantSwap *InstantSwap) RunningSchedulesCount() int {
+	instantSwap.schedulesMu.RLock()
+	defer instantSwap.schedulesMu.RUnlock()
+
+	return len(instantSwap.runningSchedules)
+}
@@ -0,0 +1,138 @@
+package instantswap
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/asdine/storm"
+)
+
+func TestScheduleRunAmount(t *testing.T) {
+	tests := []struct {
+		name               string
+		strategy           ScheduleStrategy
+		amount             float64
+		balanceToMaintain  float64
+		sourceBalance      float64
+		destinationBalance float64
+		rate               float64
+		min, max           float64
+		want               float64
+		wantErr            bool
+	}{
+		{name: "fixed amount", strategy: FixedAmountStrategy, amount: 2, sourceBalance: 10, rate: 4, want: 2},
+		{name: "balance percentage", strategy: BalancePercentageStrategy, amount: 25, sourceBalance: 10, rate: 4, want: 2.5},
+		// 20 destination coins are worth 5 source coins: half of the
+		// combined 15 is 7.5, 2.5 more than the destination holds.
+		{name: "target weight", strategy: TargetWeightStrategy, amount: 50, sourceBalance: 10, destinationBalance: 20, rate: 4, want: 2.5},
+		{name: "target weight reached", strategy: TargetWeightStrategy, amount: 25, sourceBalance: 10, destinationBalance: 20, rate: 4, wantErr: true},
+		{name: "target weight without rate", strategy: TargetWeightStrategy, amount: 50, sourceBalance: 10, wantErr: true},
+		{name: "capped by the balance to maintain", strategy: FixedAmountStrategy, amount: 8, balanceToMaintain: 5, sourceBalance: 10, rate: 4, want: 5},
+		{name: "balance to maintain above balance", strategy: FixedAmountStrategy, amount: 1, balanceToMaintain: 10, sourceBalance: 10, rate: 4, wantErr: true},
+		{name: "capped by the server maximum", strategy: FixedAmountStrategy, amount: 3, sourceBalance: 10, rate: 4, max: 1.5, want: 1.5},
+		{name: "below the server minimum", strategy: FixedAmountStrategy, amount: 0.1, sourceBalance: 10, rate: 4, min: 0.5, wantErr: true},
+		{name: "whole balance", strategy: BalancePercentageStrategy, amount: 100, sourceBalance: 10, rate: 4, wantErr: true},
+		{name: "unknown strategy", strategy: "unknown", amount: 1, sourceBalance: 10, rate: 4, wantErr: true},
+	}
+	for _, test := range tests {
+		schedule := &Schedule{Strategy: test.strategy, Amount: test.amount, BalanceToMaintain: test.balanceToMaintain}
+		got, err := schedule.RunAmount(test.sourceBalance, test.destinationBalance, test.rate, test.min, test.max)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+			continue
+		}
+		if math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s: expected %f, got %f", test.name, test.want, got)
+		}
+	}
+}
+
+func TestSchedulesAwaitingPassphrase(t *testing.T) {
+	db, err := storm.Open(filepath.Join(t.TempDir(), "instantswap.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	instantSwap, err := NewInstantSwap(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"running", "paused", "stopped"}
+	schedules := make(map[string]*Schedule)
+	for _, name := range names {
+		schedule := &Schedule{Name: name, Frequency: time.Hour, Strategy: FixedAmountStrategy, Amount: 1, Paused: name == "paused"}
+		if err := instantSwap.SaveSchedule(schedule); err != nil {
+			t.Fatal(err)
+		}
+		schedules[name] = schedule
+	}
+
+	if _, err := instantSwap.TrackSchedule(context.Background(), schedules["running"].ID); err != nil {
+		t.Fatal(err)
+	}
+	defer instantSwap.UntrackSchedule(schedules["running"].ID)
+
+	awaiting, err := instantSwap.SchedulesAwaitingPassphrase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(awaiting) != 1 || awaiting[0].Name != "stopped" {
+		t.Fatalf("expected only the stopped schedule to await the passphrase, got %v", awaiting)
+	}
+}
+
+func TestDeletedScheduleNotSaved(t *testing.T) {
+	db, err := storm.Open(filepath.Join(t.TempDir(), "instantswap.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	instantSwap, err := NewInstantSwap(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schedule := &Schedule{Name: "deleted", Frequency: time.Hour, Strategy: FixedAmountStrategy, Amount: 1}
+	if err := instantSwap.SaveSchedule(schedule); err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := instantSwap.TrackSchedule(context.Background(), schedule.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer instantSwap.UntrackSchedule(schedule.ID)
+
+	if err := instantSwap.DeleteSchedule(schedule.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("expected the deleted schedule to be stopped")
+	}
+
+	// The writes of the schedule still running must not add it back.
+	schedule.LastRunAt = time.Now().Unix()
+	if err := instantSwap.SaveSchedule(schedule); err == nil {
+		t.Fatal("expected saving the deleted schedule to fail")
+	}
+	if err := instantSwap.RecordScheduleRun(schedule, &ScheduleRun{Message: "skipped"}); err == nil {
+		t.Fatal("expected recording a run of the deleted schedule to fail")
+	}
+
+	schedules, err := instantSwap.Schedules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs, err := instantSwap.ScheduleRuns(schedule.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 0 || len(runs) != 0 {
+		t.Fatalf("expected no schedules and runs, got %d and %d", len(schedules), len(runs))
+	}
+}
@@ -65,6 +65,7 @@ func (instantSwap *InstantSwap) Sync() {
 
 	log.Info("Exchange sync: completed")
 	instantSwap.saveLastSyncedTimestamp(time.Now().Unix())
+	instantSwap.CheckOverdueOrders()
 	instantSwap.publishSynced()
 }
 
@@ -193,24 +194,46 @@ func (instantSwap *InstantSwap) publishOrderCreated(order *Order) {
 	}
 }
 
-func (instantSwap *InstantSwap) PublishOrderSchedulerStarted() {
+func (instantSwap *InstantSwap) publishOrderUpdated(order *Order) {
 	instantSwap.notificationListenersMu.Lock()
 	defer instantSwap.notificationListenersMu.Unlock()
 
 	for _, notificationListener := range instantSwap.notificationListeners {
-		if notificationListener.OnOrderSchedulerStarted != nil {
-			notificationListener.OnOrderSchedulerStarted()
+		if notificationListener.OnOrderUpdated != nil {
+			notificationListener.OnOrderUpdated(order)
 		}
 	}
 }
 
-func (instantSwap *InstantSwap) PublishOrderSchedulerEnded() {
+func (instantSwap *InstantSwap) publishOrderOverdue(order *Order) {
 	instantSwap.notificationListenersMu.Lock()
 	defer instantSwap.notificationListenersMu.Unlock()
 
 	for _, notificationListener := range instantSwap.notificationListeners {
-		if notificationListener.OnOrderSchedulerEnded != nil {
-			notificationListener.OnOrderSchedulerEnded()
+		if notificationListener.OnOrderOverdue != nil {
+			notificationListener.OnOrderOverdue(order)
+		}
+	}
+}
+
+func (instantSwap *InstantSwap) publishScheduleUpdated(schedule *Schedule) {
+	instantSwap.notificationListenersMu.Lock()
+	defer instantSwap.notificationListenersMu.Unlock()
+
+	for _, notificationListener := range instantSwap.notificationListeners {
+		if notificationListener.OnScheduleUpdated != nil {
+			notificationListener.OnScheduleUpdated(schedule)
+		}
+	}
+}
+
+func (instantSwap *InstantSwap) publishScheduleRun(schedule *Schedule, run *ScheduleRun) {
+	instantSwap.notificationListenersMu.Lock()
+	defer instantSwap.notificationListenersMu.Unlock()
+
+	for _, notificationListener := range instantSwap.notificationListeners {
+		if notificationListener.OnScheduleRun != nil {
+			notificationListener.OnScheduleRun(schedule, run)
 		}
 	}
 }
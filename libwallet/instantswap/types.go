@@ -56,20 +56,24 @@ type InstantSwap struct {
 	syncMu     sync.RWMutex
 	cancelSync context.CancelFunc
 
-	SchedulerCtx           context.Context
-	CancelOrderScheduler   context.CancelFunc
-	CancelOrderSchedulerMu sync.RWMutex
-	SchedulerStartTime     time.Time
+	schedulesMu      sync.RWMutex
+	runningSchedules map[int]context.CancelFunc
+
+	// scheduleWriteMu orders the writes of a schedule and its runs with its
+	// deletion, a running schedule can't write back a deleted schedule.
+	scheduleWriteMu sync.Mutex
 
 	notificationListenersMu *sync.RWMutex // Pointer required to avoid copying literal values.
 	notificationListeners   map[string]*OrderNotificationListener
 }
 
 type OrderNotificationListener struct {
-	OnExchangeOrdersSynced  func()
-	OnOrderCreated          func(order *Order)
-	OnOrderSchedulerStarted func()
-	OnOrderSchedulerEnded   func()
+	OnExchangeOrdersSynced func()
+	OnOrderCreated         func(order *Order)
+	OnOrderUpdated         func(order *Order)
+	OnOrderOverdue         func(order *Order)
+	OnScheduleUpdated      func(schedule *Schedule)
+	OnScheduleRun          func(schedule *Schedule, run *ScheduleRun)
 }
 
 type Order struct {
@@ -85,7 +89,8 @@ type Order struct {
 	OrderedAmount  float64 `json:"orderedAmount"`
 	InvoicedAmount float64 `json:"invoicedAmount"`
 	ReceiveAmount  float64 `json:"receiveAmount"`
-	TxID           string  `json:"txid"`
+	TxID           string  `json:"txid"`       // ID of the tx that funded the deposit address
+	ServerTxID     string  `json:"serverTxid"` // Payout or refund tx ID reported by the exchange server
 
 	FromCurrency string `json:"fromCurrency"`
 	ToCurrency   string `json:"toCurrency"`
@@ -105,21 +110,73 @@ type Order struct {
 	CreatedAt     int64              `storm:"index" json:"createdAt"`
 	LastUpdate    string             `json:"lastUpdate"` // should be timestamp (api currently returns string)
 
+	FundedAt        int64  `json:"fundedAt"`
+	PayoutTxID      string `json:"payoutTxid"` // ID of the payout tx received at DestinationAddress
+	PaidOutAt       int64  `json:"paidOutAt"`
+	RefundTxID      string `json:"refundTxid"` // ID of the refund tx received at RefundAddress
+	RefundedAt      int64  `json:"refundedAt"`
+	OverdueNotified bool   `json:"overdueNotified"`
+
 	ExtraID string `json:"extraId"` // changenow.io requirement //changelly payinExtraId value
 	UserID  string `json:"userId"`  // changenow.io partner requirement
 
 	Signature string `json:"signature"` // evercoin requirement
 }
 
-type SchedulerParams struct {
-	Order Order
+// ScheduleStrategy determines the amount swapped by each run of a schedule.
+type ScheduleStrategy string
+
+const (
+	// FixedAmountStrategy swaps Schedule.Amount of the source currency.
+	FixedAmountStrategy ScheduleStrategy = "fixed_amount"
+	// BalancePercentageStrategy swaps Schedule.Amount percent of the
+	// spendable balance of the source account.
+	BalancePercentageStrategy ScheduleStrategy = "balance_percentage"
+	// TargetWeightStrategy swaps enough to bring the value of the
+	// destination account to Schedule.Amount percent of the combined value
+	// of the source and destination accounts.
+	TargetWeightStrategy ScheduleStrategy = "target_weight"
+)
+
+// Schedule is a named recurring swap. Order is the template of the orders
+// created by the schedule.
+type Schedule struct {
+	ID   int    `storm:"id,increment"`
+	Name string `storm:"unique" json:"name"`
 
-	Frequency         time.Duration // in hours
-	BalanceToMaintain float64
+	Order Order `json:"order"`
+
+	Frequency         time.Duration    `json:"frequency"`
+	Strategy          ScheduleStrategy `json:"strategy"`
+	Amount            float64          `json:"amount"`
+	BalanceToMaintain float64          `json:"balanceToMaintain"`
 	// MaxDeviationRate is the maximum deviation rate allowed between
 	// the exchange server rate and the market rate. If the deviation
 	// rate is greater than the MaxDeviationRate, the order is not created
-	MaxDeviationRate float64
+	MaxDeviationRate float64 `json:"maxDeviationRate"`
+
+	// Paused is true if the user paused the schedule. Schedules that are not
+	// paused must be resumed with the spending passphrase after a restart.
+	Paused    bool  `json:"paused"`
+	CreatedAt int64 `json:"createdAt"`
+	LastRunAt int64 `json:"lastRunAt"`
+	NextRunAt int64 `json:"nextRunAt"`
+
+	// PendingOrderUUID is the order of the last run until its payout is
+	// verified. No new order is created meanwhile.
+	PendingOrderUUID string `json:"pendingOrderUUID"`
+}
 
-	SpendingPassphrase string
+// ScheduleRun is the outcome of a single run of a schedule.
+type ScheduleRun struct {
+	ID         int   `storm:"id,increment"`
+	ScheduleID int   `storm:"index" json:"scheduleID"`
+	Timestamp  int64 `storm:"index" json:"timestamp"`
+
+	// OrderUUID is set if the run created an order.
+	OrderUUID string  `json:"orderUUID"`
+	Amount    float64 `json:"amount"`
+	Success   bool    `json:"success"`
+	// Message explains why the run failed or was skipped.
+	Message string `json:"message"`
 }
@@ -0,0 +1,144 @@
+package libwallet
+
+import (
+	"decred.org/dcrwallet/v4/errors"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+)
+
+// instantSwapOrdersListenerID identifies the wallet tx listener that watches
+// the payouts and refunds of the instant swap orders.
+const instantSwapOrdersListenerID = "instantswap_orders"
+
+// FundInstantSwapOrder sends the invoiced amount of the order from its source
+// account to its deposit address and records the tx in order.TxID.
+// feeRatePerKvB sets the fee rate of BTC and LTC deposits, zero keeps the
+// wallet fee rate. It is ignored for DCR.
+func (mgr *AssetsManager) FundInstantSwapOrder(order *instantswap.Order, spendingPassphrase string, feeRatePerKvB int64) (string, error) {
+	const op errors.Op = "mgr.FundInstantSwapOrder"
+
+	if order.IsFunded() {
+		return "", errors.E(op, errors.Exist, "order is already funded")
+	}
+
+	sourceWallet := mgr.WalletWithID(order.SourceWalletID)
+	if sourceWallet == nil {
+		return "", errors.E(op, errors.NotExist, "source wallet not found")
+	}
+
+	if err := sourceWallet.NewUnsignedTx(order.SourceAccountNumber, nil); err != nil {
+		return "", errors.E(op, err)
+	}
+
+	// The fee rate only applies to the deposit tx, the wallet fee rate is
+	// left unchanged.
+	var amount int64
+	var err error
+	switch asset := sourceWallet.(type) {
+	case *btc.Asset:
+		amount = btc.AmountSatoshi(order.InvoicedAmount)
+		if feeRatePerKvB > 0 {
+			err = asset.SetUnsignedTxFeeRate(feeRatePerKvB)
+		}
+	case *ltc.Asset:
+		amount = ltc.AmountLitoshi(order.InvoicedAmount)
+		if feeRatePerKvB > 0 {
+			err = asset.SetUnsignedTxFeeRate(feeRatePerKvB)
+		}
+	case *dcr.Asset:
+		amount = dcr.AmountAtom(order.InvoicedAmount)
+	default:
+		return "", errors.E(op, errors.Invalid, "unsupported source wallet")
+	}
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	if err := sourceWallet.AddSendDestination(0, order.DepositAddress, amount, false); err != nil {
+		return "", errors.E(op, err)
+	}
+
+	txHash, err := sourceWallet.Broadcast(spendingPassphrase, "")
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	if err := mgr.InstantSwap.RecordOrderFunding(order, txHash); err != nil {
+		// The deposit was sent, only saving the txid failed.
+		log.Errorf("Error recording funding tx %s of order %s: %v", txHash, order.UUID, err)
+	}
+
+	return txHash, nil
+}
+
+// WatchInstantSwapOrders watches the wallets for the payouts and refunds of
+// the funded instant swap orders and checks for overdue orders as new blocks
+// are attached. It is safe to call again when wallets are added.
+func (mgr *AssetsManager) WatchInstantSwapOrders() {
+	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
+		OnTransaction: mgr.checkInstantSwapOrdersTx,
+		OnBlockAttached: func(_ int, _ int32) {
+			mgr.InstantSwap.CheckOverdueOrders()
+		},
+	}
+
+	for _, wallet := range mgr.AllWallets() {
+		if wallet.IsNotificationListenerExist(instantSwapOrdersListenerID) {
+			continue
+		}
+		if err := wallet.AddTxAndBlockNotificationListener(txAndBlockNotificationListener, instantSwapOrdersListenerID); err != nil {
+			log.Errorf("Can't watch instant swap orders on %s wallet: %v", wallet.GetWalletName(), err)
+		}
+	}
+}
+
+// checkInstantSwapOrdersTx records tx as the payout of the orders it pays the
+// expected amount to the destination address of, or as the refund of the
+// orders it pays to the refund address of. Only txs received after the
+// deposit of the order are considered.
+func (mgr *AssetsManager) checkInstantSwapOrdersTx(walletID int, tx *sharedW.Transaction) {
+	wallet := mgr.WalletWithID(walletID)
+	if wallet == nil {
+		return
+	}
+
+	orders, err := mgr.InstantSwap.WatchedOrders()
+	if err != nil {
+		log.Errorf("Error loading instant swap orders: %v", err)
+		return
+	}
+
+	// received sums the outputs of tx paying to the address.
+	received := func(address string) float64 {
+		var amount int64
+		for _, output := range tx.Outputs {
+			if output.Address == address {
+				amount += output.Amount
+			}
+		}
+		return wallet.ToAmount(amount).ToCoin()
+	}
+
+	for _, order := range orders {
+		if tx.Hash == order.TxID {
+			continue // the deposit tx
+		}
+
+		switch {
+		case walletID == order.DestinationWalletID && order.IsPayout(received(order.DestinationAddress), tx.Timestamp):
+			err = mgr.InstantSwap.RecordOrderPayout(order, tx.Hash)
+		case walletID == order.SourceWalletID && order.IsRefund(received(order.RefundAddress), tx.Timestamp):
+			err = mgr.InstantSwap.RecordOrderRefund(order, tx.Hash)
+		default:
+			continue
+		}
+
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
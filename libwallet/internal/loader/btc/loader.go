@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb" // bdb init() registers a driver
 
 	"github.com/crypto-power/cryptopower/libwallet/internal/loader"
@@ -20,6 +21,9 @@ import (
 
 var log = loader.Log
 
+// waddrmgrNamespaceKey is the wallet database bucket of the address manager.
+var waddrmgrNamespaceKey = []byte("waddrmgr")
+
 // btcLoader implements the creating of new and opening of existing btc wallets.
 // This is primarily intended for use by the RPC servers, to enable
 // methods and services which require the wallet when the wallet is loaded by
@@ -155,8 +159,24 @@ func (l *btcLoader) CreateWatchingOnlyWallet(_ context.Context, params *loader.W
 		return nil, err
 	}
 
+	// Watch-only wallets created from a descriptor import their accounts or
+	// multisig scripts themselves, only the default scope is created.
+	if params.ExtendedPubKey == "" {
+		err = walletdb.Update(wal.Database(), func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			_, err := wal.Manager.NewScopedKeyManager(ns, l.keyscope, waddrmgr.ScopeAddrMap[l.keyscope])
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		l.wallet = wal
+		return &loader.LoadedWallets{BTC: wal}, nil
+	}
+
 	// Create extended key from the xpub string.
-	extendedKety, err := hdkeychain.NewKeyFromString(params.ExtendedPubKey)
+	extendedKety, err := ParseExtendedPubKey(params.ExtendedPubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -243,3 +263,16 @@ func (l *btcLoader) WalletExists(walletID string) (bool, error) {
 	}
 	return exists, nil
 }
+
+// ParseExtendedPubKey parses the provided extended public key string.
+// Extended private keys are rejected.
+func ParseExtendedPubKey(xpub string) (*hdkeychain.ExtendedKey, error) {
+	extendedKey, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, err
+	}
+	if extendedKey.IsPrivate() {
+		return nil, errors.New("an extended public key is required")
+	}
+	return extendedKey, nil
+}
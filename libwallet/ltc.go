@@ -35,6 +35,7 @@ func (mgr *AssetsManager) CreateNewLTCWallet(walletName, privatePassphrase strin
 	}
 
 	mgr.Assets.LTC.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	return wallet, nil
 }
@@ -47,6 +48,43 @@ func (mgr *AssetsManager) CreateNewLTCWatchOnlyWallet(walletName, extendedPublic
 	}
 
 	mgr.Assets.LTC.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
+
+	return wallet, nil
+}
+
+// CreateNewLTCMultisigWallet creates a new m-of-n LTC multisig wallet from
+// the new wallet's key and the cosigner xpubs, and returns it. Cosigner
+// xpubs that belong to a wallet of this app are rejected, each cosigner
+// must use its own key.
+func (mgr *AssetsManager) CreateNewLTCMultisigWallet(walletName, privatePassphrase string, privatePassphraseType int32, wordSeedType sharedW.WordSeedType, threshold int, cosignerKeys []string) (sharedW.Asset, error) {
+	for _, key := range cosignerKeys {
+		cosigner, err := sharedW.ParseCosignerKey(key)
+		if err != nil {
+			return nil, err
+		}
+		walletID, err := mgr.LTCWalletWithXPub(cosigner.XPub)
+		if err != nil {
+			return nil, err
+		}
+		if walletID != -1 {
+			return nil, errors.E(utils.ErrInvalid, fmt.Sprintf("cosigner key belongs to wallet %d", walletID))
+		}
+	}
+
+	pass := &sharedW.AuthInfo{
+		Name:            walletName,
+		PrivatePass:     privatePassphrase,
+		PrivatePassType: privatePassphraseType,
+		WordSeedType:    wordSeedType,
+	}
+	wallet, err := ltc.CreateNewMultisigWallet(pass, mgr.params, threshold, cosignerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.Assets.LTC.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	return wallet, nil
 }
@@ -65,6 +103,7 @@ func (mgr *AssetsManager) RestoreLTCWallet(walletName, seedMnemonic, privatePass
 	}
 
 	mgr.Assets.LTC.Wallets[wallet.GetWalletID()] = wallet
+	mgr.watchWalletActivity(wallet)
 
 	return wallet, nil
 }
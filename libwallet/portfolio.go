@@ -0,0 +1,475 @@
+package libwallet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex"
+	"decred.org/dcrdex/dex/calc"
+	"decred.org/dcrwallet/v4/errors"
+	api "github.com/crypto-power/instantswap/instantswap"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/libwallet/portfolio"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// atomsPerCoin converts DCR, BTC and LTC amounts to atoms, all three have
+// eight decimal places.
+const atomsPerCoin = 1e8
+
+// minPortfolioRetryDelay is the delay before retrying a portfolio check that
+// could not be saved. It doubles on each failure up to the check frequency.
+const minPortfolioRetryDelay = time.Minute
+
+// PortfolioProposal returns the current allocation of the spendable
+// balances of the portfolio and the swaps that rebalance it to the target
+// weights, with their fees and slippage estimated on the route of the
+// settings. Watch-only wallets are left out as their funds cannot be
+// swapped.
+func (mgr *AssetsManager) PortfolioProposal(ctx context.Context) (*portfolio.Proposal, error) {
+	const op errors.Op = "mgr.PortfolioProposal"
+
+	settings, err := mgr.Portfolio.Settings()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	assetsBalance, sources, err := mgr.portfolioBalances()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	assetsUSDBalance, err := mgr.CalculateAssetsUSDBalance(assetsBalance)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	balances := make(map[utils.AssetType]float64, len(assetsBalance))
+	for assetType, balance := range assetsBalance {
+		balances[assetType] = balance.ToCoin()
+	}
+
+	proposal := portfolio.Propose(balances, assetsUSDBalance, settings)
+	for _, swap := range proposal.Swaps {
+		if ctx.Err() != nil {
+			return nil, errors.E(op, ctx.Err())
+		}
+
+		// Swaps are funded from a single account, the amount is capped to
+		// its spendable balance.
+		source := sources[swap.From]
+		swap.SourceWalletID, swap.SourceAccount = source.walletID, source.account
+		if spendable := source.spendable.ToCoin(); swap.Amount > spendable {
+			swap.USDValue *= spendable / swap.Amount
+			swap.Amount = spendable
+		}
+		mgr.estimateRebalanceSwap(ctx, swap)
+	}
+	return proposal, nil
+}
+
+// fundingAccount is an account the swaps of an asset are funded from.
+type fundingAccount struct {
+	walletID  int
+	account   int32
+	spendable sharedW.AssetAmount
+}
+
+// portfolioBalances returns the spendable balance of each asset in the
+// wallets that are not watch-only, and the account of each asset with the
+// largest spendable balance.
+func (mgr *AssetsManager) portfolioBalances() (map[utils.AssetType]sharedW.AssetAmount, map[utils.AssetType]fundingAccount, error) {
+	balances := make(map[utils.AssetType]sharedW.AssetAmount)
+	sources := make(map[utils.AssetType]fundingAccount)
+	for _, wal := range mgr.AllWallets() {
+		if wal.IsWatchingOnlyWallet() {
+			continue
+		}
+
+		accountsResult, err := wal.GetAccountsRaw()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		assetType := wal.GetAssetType()
+		for _, account := range accountsResult.Accounts {
+			spendable := account.Balance.Spendable
+			if balance, ok := balances[assetType]; ok {
+				balances[assetType] = wal.ToAmount(balance.ToInt() + spendable.ToInt())
+			} else {
+				balances[assetType] = spendable
+			}
+
+			if source, ok := sources[assetType]; !ok || spendable.ToInt() > source.spendable.ToInt() {
+				sources[assetType] = fundingAccount{wal.GetWalletID(), account.Number, spendable}
+			}
+		}
+	}
+	return balances, sources, nil
+}
+
+// estimateRebalanceSwap quotes the swap on its route and sets the server
+// with the best rate, the amount received and the estimated fee and
+// slippage. Quoting errors are set on swap.EstimateErr.
+func (mgr *AssetsManager) estimateRebalanceSwap(ctx context.Context, swap *portfolio.Swap) {
+	toPrice := mgr.assetUSDPrice(swap.To)
+
+	switch swap.Route {
+	case portfolio.DEXRoute:
+		book, _, _, sell, qty, err := mgr.rebalanceDEXOrder(swap)
+		if err != nil {
+			swap.EstimateErr = err
+			return
+		}
+
+		swap.Server = book.Host
+		if sell {
+			swap.ReceiveAmount = float64(calc.BaseToQuote(book.VWAP, qty)) / atomsPerCoin
+		} else {
+			swap.ReceiveAmount = float64(calc.QuoteToBase(book.VWAP, toAtoms(swap.Amount))) / atomsPerCoin
+		}
+		if book.MidGap > 0 {
+			swap.EstimatedSlippage = math.Abs(float64(book.VWAP)-float64(book.MidGap)) / float64(book.MidGap) * 100
+		}
+
+	default:
+		quote := mgr.bestRebalanceQuote(ctx, swap)
+		if quote == nil {
+			swap.EstimateErr = fmt.Errorf("no exchange server can fill the swap")
+			return
+		}
+
+		swap.Server = quote.ExchangeServer.Server.CapFirstLetter()
+		swap.ReceiveAmount = quote.ReceiveAmount
+		if quote.MarketRate > 0 {
+			swap.EstimatedSlippage = quote.Deviation
+		}
+	}
+
+	if toPrice > 0 {
+		swap.EstimatedFee = math.Max(swap.USDValue-swap.ReceiveAmount*toPrice, 0)
+	}
+}
+
+// bestRebalanceQuote returns the quote with the best rate for the swap
+// among the exchange servers that accept its amount, nil if there is none.
+func (mgr *AssetsManager) bestRebalanceQuote(ctx context.Context, swap *portfolio.Swap) *instantswap.Quote {
+	params := api.ExchangeRateRequest{
+		From:   swap.From.String(),
+		To:     swap.To.String(),
+		Amount: swap.Amount,
+	}
+	// Quotes are sorted best first. The networks of the currencies are
+	// resolved by each exchange server.
+	for _, quote := range mgr.GetInstantSwapQuotes(ctx, params) {
+		if quote.Err == nil && quote.WithinLimits() {
+			return quote
+		}
+	}
+	return nil
+}
+
+// rebalanceDEXOrder returns the book of the DEX server that fills the swap
+// at the best rate and the market order that executes the swap on it. The
+// swap sells the From asset if it is the base asset of the market and
+// otherwise buys the To asset. qty is in base asset atoms for sells and in
+// quote asset atoms for buys, as DEX market buys are.
+func (mgr *AssetsManager) rebalanceDEXOrder(swap *portfolio.Swap) (book *DEXMarketBook, base, quote uint32, sell bool, qty uint64, err error) {
+	fromID, ok := dex.BipSymbolID(strings.ToLower(swap.From.String()))
+	if !ok {
+		return nil, 0, 0, false, 0, fmt.Errorf("unsupported DEX asset %s", swap.From)
+	}
+	toID, ok := dex.BipSymbolID(strings.ToLower(swap.To.String()))
+	if !ok {
+		return nil, 0, 0, false, 0, fmt.Errorf("unsupported DEX asset %s", swap.To)
+	}
+
+	amount := toAtoms(swap.Amount)
+	for _, sell := range []bool{true, false} {
+		base, quote := fromID, toID
+		if !sell {
+			base, quote = toID, fromID
+		}
+
+		books, err := mgr.CompareDEXMarket(base, quote, 0, sell)
+		if err != nil {
+			return nil, 0, 0, false, 0, err
+		}
+
+		var lotSize, midGap uint64
+		for _, book := range books {
+			if book.CanTrade && book.Err == nil && book.MidGap > 0 {
+				lotSize, midGap = book.LotSize, book.MidGap
+				break
+			}
+		}
+		if lotSize == 0 {
+			continue
+		}
+
+		// The books are compared for whole lots of the base asset.
+		baseQty := amount
+		if !sell {
+			baseQty = calc.QuoteToBase(midGap, amount)
+		}
+		baseQty -= baseQty % lotSize
+		if baseQty == 0 {
+			return nil, 0, 0, false, 0, fmt.Errorf("swap amount is less than one lot")
+		}
+
+		book, err := mgr.BestDEXServer(base, quote, baseQty, 0, sell)
+		if err != nil {
+			return nil, 0, 0, false, 0, err
+		}
+
+		if sell {
+			return book, base, quote, true, baseQty, nil
+		}
+		return book, base, quote, false, amount, nil
+	}
+
+	return nil, 0, 0, false, 0, fmt.Errorf("no DEX server lists a %s/%s market", swap.From, swap.To)
+}
+
+// ExecuteRebalanceSwap executes the swap on its route and returns the ID of
+// the instant swap order or DEX order placed. Instant swap orders are funded
+// from the source account of the swap and password is the spending
+// passphrase of its wallet. DEX orders are placed with password as the DEX
+// password.
+func (mgr *AssetsManager) ExecuteRebalanceSwap(ctx context.Context, swap *portfolio.Swap, password string) (string, error) {
+	const op errors.Op = "mgr.ExecuteRebalanceSwap"
+
+	if swap.Route == portfolio.DEXRoute {
+		dexClient := mgr.DexClient()
+		if dexClient == nil || !dexClient.IsLoggedIn() {
+			return "", errors.E(op, "not logged in to the DEX")
+		}
+
+		book, base, quote, sell, qty, err := mgr.rebalanceDEXOrder(swap)
+		if err != nil {
+			return "", errors.E(op, err)
+		}
+
+		order, err := dexClient.Trade([]byte(password), &core.TradeForm{
+			Host:  book.Host,
+			Sell:  sell,
+			Base:  base,
+			Quote: quote,
+			Qty:   qty,
+		})
+		if err != nil {
+			return "", errors.E(op, err)
+		}
+		return order.ID.String(), nil
+	}
+
+	sourceWallet := mgr.WalletWithID(swap.SourceWalletID)
+	if sourceWallet == nil {
+		return "", errors.E(op, errors.Errorf("wallet with id:%d not found", swap.SourceWalletID))
+	}
+	destinationWallet, destinationAccount, err := mgr.rebalanceWallet(swap.To)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	quote := mgr.bestRebalanceQuote(ctx, swap)
+	if quote == nil {
+		return "", errors.E(op, "no exchange server can fill the swap")
+	}
+
+	exchangeObject, err := mgr.InstantSwap.NewExchangeServer(quote.ExchangeServer)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	refundAddress, err := sourceWallet.CurrentAddress(swap.SourceAccount)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	destinationAddress, err := destinationWallet.CurrentAddress(destinationAccount)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	order, err := mgr.InstantSwap.CreateOrder(exchangeObject, instantswap.Order{
+		ExchangeServer:           quote.ExchangeServer,
+		SourceWalletID:           sourceWallet.GetWalletID(),
+		SourceAccountNumber:      swap.SourceAccount,
+		DestinationWalletID:      destinationWallet.GetWalletID(),
+		DestinationAccountNumber: destinationAccount,
+		InvoicedAmount:           swap.Amount,
+		FromCurrency:             swap.From.String(),
+		ToCurrency:               swap.To.String(),
+		FromNetwork:              quote.FromNetwork,
+		ToNetwork:                quote.ToNetwork,
+		Provider:                 quote.Provider,
+		Signature:                quote.Signature,
+		DestinationAddress:       destinationAddress,
+		RefundAddress:            refundAddress,
+	})
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	if _, err := mgr.FundInstantSwapOrder(order, password, 0); err != nil {
+		return order.UUID, errors.E(op, err)
+	}
+	return order.UUID, nil
+}
+
+// rebalanceWallet returns the first wallet of the asset that can spend, and
+// its default account, to receive the swaps of the asset.
+func (mgr *AssetsManager) rebalanceWallet(assetType utils.AssetType) (sharedW.Asset, int32, error) {
+	for _, wallet := range mgr.AssetWallets(assetType) {
+		if wallet.IsWatchingOnlyWallet() {
+			continue
+		}
+
+		switch wallet.(type) {
+		case *btc.Asset:
+			return wallet, btc.DefaultAccountNum, nil
+		case *ltc.Asset:
+			return wallet, ltc.DefaultAccountNum, nil
+		case *dcr.Asset:
+			return wallet, dcr.DefaultAccountNum, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("no %s wallet to swap with", assetType)
+}
+
+// assetUSDPrice returns the USD price of the asset from the rate source,
+// zero if it is not available.
+func (mgr *AssetsManager) assetUSDPrice(assetType utils.AssetType) float64 {
+	market, ok := values.AssetExchangeMarketValue[assetType]
+	if !ok {
+		return 0
+	}
+	ticker := mgr.RateSource.GetTicker(market, true)
+	if ticker == nil {
+		return 0
+	}
+	return ticker.LastTradePrice
+}
+
+func toAtoms(amount float64) uint64 {
+	return uint64(math.Round(amount * atomsPerCoin))
+}
+
+// StartPortfolioMonitor checks the drift of the portfolio at the check
+// frequency of its settings until StopPortfolioMonitor is called. The
+// listeners are notified when the drift is above the threshold and, if the
+// settings enable it, the proposed swaps are executed with
+// spendingPassphrase.
+func (mgr *AssetsManager) StartPortfolioMonitor(spendingPassphrase string) error {
+	const op errors.Op = "mgr.StartPortfolioMonitor"
+
+	settings, err := mgr.Portfolio.Settings()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if err := settings.Validate(); err != nil {
+		return errors.E(op, err)
+	}
+	if settings.CheckFrequency <= 0 {
+		return errors.E(op, errors.Invalid, "check frequency must be greater than zero")
+	}
+
+	ctx, err := mgr.Portfolio.Track(context.Background())
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	go mgr.runPortfolioMonitor(ctx, spendingPassphrase)
+	return nil
+}
+
+// StopPortfolioMonitor stops the scheduled portfolio checks.
+func (mgr *AssetsManager) StopPortfolioMonitor() {
+	mgr.Portfolio.Stop()
+}
+
+func (mgr *AssetsManager) runPortfolioMonitor(ctx context.Context, spendingPassphrase string) {
+	defer func() {
+		mgr.Portfolio.Untrack()
+		log.Info("Portfolio monitor: stopped")
+	}()
+	log.Info("Portfolio monitor: started")
+
+	// retryDelay backs off the checks while they cannot be saved, the last
+	// check time is not updated and the next check would be due right away.
+	var retryDelay time.Duration
+	for {
+		settings, err := mgr.Portfolio.Settings()
+		if err != nil {
+			log.Errorf("Portfolio monitor: error loading settings: %v", err)
+			return
+		}
+
+		nextCheck := time.Unix(settings.LastCheckAt, 0).Add(settings.CheckFrequency)
+		if retryDelay > 0 {
+			nextCheck = time.Now().Add(retryDelay)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(nextCheck)):
+		}
+
+		if err := mgr.checkPortfolio(ctx, settings, spendingPassphrase); err != nil {
+			retryDelay = min(max(2*retryDelay, minPortfolioRetryDelay), settings.CheckFrequency)
+			log.Errorf("Portfolio monitor: error saving check, retrying in %s: %v", retryDelay, err)
+			continue
+		}
+		retryDelay = 0
+	}
+}
+
+// checkPortfolio records a check of the portfolio drift and executes the
+// proposed swaps if the drift is above the threshold and the settings
+// enable it. DEX swaps are never executed by the monitor as they need the
+// DEX password. An error is returned if the check cannot be saved.
+func (mgr *AssetsManager) checkPortfolio(ctx context.Context, settings *portfolio.Settings, spendingPassphrase string) error {
+	proposal, err := mgr.PortfolioProposal(ctx)
+	if err != nil {
+		log.Errorf("Portfolio monitor: %v", err)
+		// Retry at the next check.
+		proposal = &portfolio.Proposal{CreatedAt: time.Now().Unix()}
+	}
+
+	if err := mgr.Portfolio.RecordCheck(proposal); err != nil {
+		return err
+	}
+
+	if !proposal.NeedsRebalance || !settings.AutoExecute || settings.Route == portfolio.DEXRoute || spendingPassphrase == "" {
+		return nil
+	}
+
+	for _, swap := range proposal.Swaps {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if swap.EstimateErr != nil {
+			mgr.Portfolio.PublishSwapExecuted(swap, "", swap.EstimateErr)
+			continue
+		}
+
+		orderID, err := mgr.ExecuteRebalanceSwap(ctx, swap, spendingPassphrase)
+		if err != nil {
+			log.Errorf("Portfolio monitor: error swapping %f %s to %s: %v", swap.Amount, swap.From, swap.To, err)
+		} else {
+			log.Infof("Portfolio monitor: swapping %f %s to %s, order %s", swap.Amount, swap.From, swap.To, orderID)
+		}
+		mgr.Portfolio.PublishSwapExecuted(swap, orderID, err)
+	}
+	return nil
+}
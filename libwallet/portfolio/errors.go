@@ -0,0 +1,9 @@
+package portfolio
+
+const (
+	ErrListenerAlreadyExist = "listener_already_exist"
+	ErrMonitorRunning       = "monitor_already_running"
+	ErrInvalidTargets       = "invalid_target_weights"
+	ErrInvalidThreshold     = "invalid_drift_threshold"
+	ErrInvalidRoute         = "invalid_rebalance_route"
+)
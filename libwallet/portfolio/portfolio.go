@@ -0,0 +1,213 @@
+package portfolio
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/asdine/storm"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Portfolio saves the target allocation of the user's assets and tracks the
+// monitor that checks the portfolio drift on a schedule.
+type Portfolio struct {
+	db *storm.DB
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+
+	notificationListenersMu *sync.RWMutex // Pointer required to avoid copying literal values.
+	notificationListeners   map[string]*RebalanceNotificationListener
+}
+
+// NewPortfolio returns a Portfolio that saves its settings to db.
+func NewPortfolio(db *storm.DB) (*Portfolio, error) {
+	if err := db.Init(&Settings{}); err != nil {
+		log.Errorf("Error initializing portfolio database: %s", err.Error())
+		return nil, err
+	}
+
+	return &Portfolio{
+		db:                      db,
+		notificationListenersMu: &sync.RWMutex{},
+		notificationListeners:   make(map[string]*RebalanceNotificationListener),
+	}, nil
+}
+
+// DefaultSettings are the settings used until the user saves a target
+// allocation.
+func DefaultSettings() *Settings {
+	return &Settings{
+		ID:             settingsID,
+		Targets:        make(map[utils.AssetType]float64),
+		DriftThreshold: DefaultDriftThreshold,
+		Route:          InstantSwapRoute,
+		CheckFrequency: 24 * time.Hour,
+	}
+}
+
+// Validate checks that the target weights add up to 100 percent and that
+// the rebalancing options are valid.
+func (settings *Settings) Validate() error {
+	var total float64
+	for _, weight := range settings.Targets {
+		if weight < 0 || weight > 100 {
+			return errors.New(ErrInvalidTargets)
+		}
+		total += weight
+	}
+	if math.Abs(total-100) > 0.01 {
+		return errors.New(ErrInvalidTargets)
+	}
+
+	if settings.DriftThreshold <= 0 || settings.DriftThreshold >= 100 {
+		return errors.New(ErrInvalidThreshold)
+	}
+
+	if settings.Route != InstantSwapRoute && settings.Route != DEXRoute {
+		return errors.New(ErrInvalidRoute)
+	}
+	return nil
+}
+
+// Settings returns the saved settings or the default settings if none were
+// saved yet.
+func (p *Portfolio) Settings() (*Settings, error) {
+	var settings Settings
+	err := p.db.One("ID", settingsID, &settings)
+	if err == storm.ErrNotFound {
+		return DefaultSettings(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if settings.Targets == nil {
+		settings.Targets = make(map[utils.AssetType]float64)
+	}
+	return &settings, nil
+}
+
+// SaveSettings validates and saves the settings.
+func (p *Portfolio) SaveSettings(settings *Settings) error {
+	const op errors.Op = "portfolio.SaveSettings"
+
+	if err := settings.Validate(); err != nil {
+		return errors.E(op, err)
+	}
+
+	settings.ID = settingsID
+	if err := p.db.Save(settings); err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// RecordCheck saves the time of a scheduled check and notifies the
+// listeners if the proposal needs rebalancing.
+func (p *Portfolio) RecordCheck(proposal *Proposal) error {
+	settings, err := p.Settings()
+	if err != nil {
+		return err
+	}
+
+	settings.LastCheckAt = proposal.CreatedAt
+	if err := p.db.Save(settings); err != nil {
+		return err
+	}
+
+	if proposal.NeedsRebalance {
+		log.Infof("Portfolio: drift of %.2f%% is above the %.2f%% threshold", proposal.MaxDrift, settings.DriftThreshold)
+		p.publishRebalanceNeeded(proposal)
+	}
+	return nil
+}
+
+// Track registers the monitor as running and returns the context that is
+// canceled when it is stopped. Untrack must be called once the monitor
+// exits.
+func (p *Portfolio) Track(ctx context.Context) (context.Context, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.cancel != nil {
+		return nil, errors.New(ErrMonitorRunning)
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	return ctx, nil
+}
+
+// Untrack unregisters the monitor.
+func (p *Portfolio) Untrack() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+}
+
+// Stop stops the monitor if it is running.
+func (p *Portfolio) Stop() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// IsRunning is true if the monitor is running.
+func (p *Portfolio) IsRunning() bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.cancel != nil
+}
+
+func (p *Portfolio) publishRebalanceNeeded(proposal *Proposal) {
+	p.notificationListenersMu.RLock()
+	defer p.notificationListenersMu.RUnlock()
+
+	for _, notificationListener := range p.notificationListeners {
+		if notificationListener.OnRebalanceNeeded != nil {
+			notificationListener.OnRebalanceNeeded(proposal)
+		}
+	}
+}
+
+// PublishSwapExecuted notifies the listeners of the outcome of a swap
+// executed by the monitor.
+func (p *Portfolio) PublishSwapExecuted(swap *Swap, orderID string, err error) {
+	p.notificationListenersMu.RLock()
+	defer p.notificationListenersMu.RUnlock()
+
+	for _, notificationListener := range p.notificationListeners {
+		if notificationListener.OnSwapExecuted != nil {
+			notificationListener.OnSwapExecuted(swap, orderID, err)
+		}
+	}
+}
+
+func (p *Portfolio) AddNotificationListener(notificationListener *RebalanceNotificationListener, uniqueIdentifier string) error {
+	p.notificationListenersMu.Lock()
+	defer p.notificationListenersMu.Unlock()
+
+	if _, ok := p.notificationListeners[uniqueIdentifier]; ok {
+		return errors.New(ErrListenerAlreadyExist)
+	}
+
+	p.notificationListeners[uniqueIdentifier] = notificationListener
+	return nil
+}
+
+func (p *Portfolio) RemoveNotificationListener(uniqueIdentifier string) {
+	p.notificationListenersMu.Lock()
+	defer p.notificationListenersMu.Unlock()
+
+	delete(p.notificationListeners, uniqueIdentifier)
+}
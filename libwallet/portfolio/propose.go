@@ -0,0 +1,92 @@
+package portfolio
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Propose returns the allocation of the assets with the provided balances
+// and USD values, and the swaps that move the overweight assets into the
+// underweight ones to reach the target weights of settings. Swaps worth
+// less than MinSwapUSDValue are left out.
+func Propose(balances, usdValues map[utils.AssetType]float64, settings *Settings) *Proposal {
+	proposal := &Proposal{CreatedAt: time.Now().Unix()}
+
+	assets := make([]utils.AssetType, 0, len(balances))
+	seen := make(map[utils.AssetType]bool)
+	for asset := range balances {
+		assets, seen[asset] = append(assets, asset), true
+	}
+	for asset := range settings.Targets {
+		if !seen[asset] {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Slice(assets, func(i, j int) bool { return assets[i] < assets[j] })
+
+	for _, asset := range assets {
+		proposal.TotalUSDValue += usdValues[asset]
+	}
+
+	// excess is the USD value each asset is over (positive) or under
+	// (negative) its target weight.
+	type excess struct {
+		asset utils.AssetType
+		usd   float64
+	}
+	var over, under []*excess
+	for _, asset := range assets {
+		allocation := &Allocation{
+			Asset:        asset,
+			Balance:      balances[asset],
+			USDValue:     usdValues[asset],
+			TargetWeight: settings.Targets[asset],
+		}
+		if proposal.TotalUSDValue > 0 {
+			allocation.Weight = allocation.USDValue / proposal.TotalUSDValue * 100
+		}
+		allocation.Drift = allocation.Weight - allocation.TargetWeight
+		proposal.Allocations = append(proposal.Allocations, allocation)
+		proposal.MaxDrift = math.Max(proposal.MaxDrift, math.Abs(allocation.Drift))
+
+		usd := allocation.Drift / 100 * proposal.TotalUSDValue
+		switch {
+		case usd > 0 && allocation.Balance > 0:
+			over = append(over, &excess{asset, usd})
+		case usd < 0:
+			under = append(under, &excess{asset, -usd})
+		}
+	}
+	proposal.NeedsRebalance = proposal.MaxDrift > settings.DriftThreshold
+
+	sort.Slice(over, func(i, j int) bool { return over[i].usd > over[j].usd })
+	sort.Slice(under, func(i, j int) bool { return under[i].usd > under[j].usd })
+
+	for i, j := 0, 0; i < len(over) && j < len(under); {
+		usd := math.Min(over[i].usd, under[j].usd)
+		if usd >= MinSwapUSDValue {
+			price := usdValues[over[i].asset] / balances[over[i].asset]
+			proposal.Swaps = append(proposal.Swaps, &Swap{
+				From:     over[i].asset,
+				To:       under[j].asset,
+				Amount:   usd / price,
+				USDValue: usd,
+				Route:    settings.Route,
+			})
+		}
+
+		over[i].usd -= usd
+		under[j].usd -= usd
+		if over[i].usd <= 0 {
+			i++
+		}
+		if under[j].usd <= 0 {
+			j++
+		}
+	}
+
+	return proposal
+}
@@ -0,0 +1,93 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestPropose(t *testing.T) {
+	const (
+		dcr = utils.DCRWalletAsset
+		btc = utils.BTCWalletAsset
+		ltc = utils.LTCWalletAsset
+	)
+
+	tests := []struct {
+		name           string
+		balances       map[utils.AssetType]float64
+		usdValues      map[utils.AssetType]float64
+		targets        map[utils.AssetType]float64
+		wantSwaps      []Swap
+		wantRebalance  bool
+		wantMaxDrift   float64
+		wantAllocation int
+	}{{
+		name:           "overweight asset swapped into the underweight one",
+		balances:       map[utils.AssetType]float64{dcr: 100, btc: 0.01},
+		usdValues:      map[utils.AssetType]float64{dcr: 1000, btc: 500},
+		targets:        map[utils.AssetType]float64{dcr: 50, btc: 50},
+		wantSwaps:      []Swap{{From: dcr, To: btc, Amount: 25, USDValue: 250}},
+		wantRebalance:  true,
+		wantMaxDrift:   100.0 / 6,
+		wantAllocation: 2,
+	}, {
+		name:           "target asset without balance",
+		balances:       map[utils.AssetType]float64{dcr: 100},
+		usdValues:      map[utils.AssetType]float64{dcr: 1000},
+		targets:        map[utils.AssetType]float64{dcr: 80, ltc: 20},
+		wantSwaps:      []Swap{{From: dcr, To: ltc, Amount: 20, USDValue: 200}},
+		wantRebalance:  true,
+		wantMaxDrift:   20,
+		wantAllocation: 2,
+	}, {
+		name:           "swaps below the minimum value left out",
+		balances:       map[utils.AssetType]float64{dcr: 100, btc: 1},
+		usdValues:      map[utils.AssetType]float64{dcr: 1000, btc: 990},
+		targets:        map[utils.AssetType]float64{dcr: 50, btc: 50},
+		wantRebalance:  false,
+		wantMaxDrift:   1000/19.9 - 50,
+		wantAllocation: 2,
+	}, {
+		name:      "overweight asset split between the underweight ones",
+		balances:  map[utils.AssetType]float64{dcr: 100, btc: 1, ltc: 1},
+		usdValues: map[utils.AssetType]float64{dcr: 900, btc: 50, ltc: 50},
+		targets:   map[utils.AssetType]float64{dcr: 40, btc: 35, ltc: 25},
+		wantSwaps: []Swap{
+			{From: dcr, To: btc, Amount: 300.0 / 9, USDValue: 300},
+			{From: dcr, To: ltc, Amount: 200.0 / 9, USDValue: 200},
+		},
+		wantRebalance:  true,
+		wantMaxDrift:   50,
+		wantAllocation: 3,
+	}}
+
+	equal := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+	for _, test := range tests {
+		settings := &Settings{Targets: test.targets, DriftThreshold: DefaultDriftThreshold, Route: InstantSwapRoute}
+		proposal := Propose(test.balances, test.usdValues, settings)
+
+		if proposal.NeedsRebalance != test.wantRebalance {
+			t.Errorf("%s: expected rebalance %v, got %v", test.name, test.wantRebalance, proposal.NeedsRebalance)
+		}
+		if !equal(proposal.MaxDrift, test.wantMaxDrift) {
+			t.Errorf("%s: expected max drift %f, got %f", test.name, test.wantMaxDrift, proposal.MaxDrift)
+		}
+		if len(proposal.Allocations) != test.wantAllocation {
+			t.Errorf("%s: expected %d allocations, got %d", test.name, test.wantAllocation, len(proposal.Allocations))
+		}
+
+		if len(proposal.Swaps) != len(test.wantSwaps) {
+			t.Errorf("%s: expected %d swaps, got %d", test.name, len(test.wantSwaps), len(proposal.Swaps))
+			continue
+		}
+		for i, want := range test.wantSwaps {
+			got := proposal.Swaps[i]
+			if got.From != want.From || got.To != want.To || !equal(got.Amount, want.Amount) ||
+				!equal(got.USDValue, want.USDValue) || got.Route != InstantSwapRoute {
+				t.Errorf("%s: swap %d: expected %+v, got %+v", test.name, i, want, *got)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Route is how the swaps of a rebalance are executed.
+type Route string
+
+const (
+	// InstantSwapRoute executes the swaps as instant swap orders with the
+	// exchange server that quotes the best rate.
+	InstantSwapRoute Route = "instantswap"
+	// DEXRoute executes the swaps as DEX market orders on the DEX server
+	// that fills them at the best rate.
+	DEXRoute Route = "dex"
+)
+
+const (
+	// DefaultDriftThreshold is the drift in percentage points above which
+	// the portfolio needs rebalancing.
+	DefaultDriftThreshold = 5
+
+	// MinSwapUSDValue is the smallest swap proposed to rebalance the
+	// portfolio, smaller swaps cost more in fees than they correct.
+	MinSwapUSDValue = 10
+
+	// settingsID is the ID of the saved settings, only one is saved.
+	settingsID = 1
+)
+
+// Settings are the target allocation of the portfolio and how it is
+// rebalanced.
+type Settings struct {
+	ID int `storm:"id"`
+	// Targets are the target weights of the assets in percent, they add up
+	// to 100.
+	Targets map[utils.AssetType]float64 `json:"targets"`
+	// DriftThreshold is the drift of an asset from its target weight, in
+	// percentage points, above which the portfolio needs rebalancing.
+	DriftThreshold float64 `json:"driftThreshold"`
+	Route          Route   `json:"route"`
+	// CheckFrequency is how often the monitor checks the drift of the
+	// portfolio.
+	CheckFrequency time.Duration `json:"checkFrequency"`
+	// AutoExecute executes the proposed swaps when a scheduled check finds
+	// the portfolio above the drift threshold. Otherwise the listeners are
+	// only notified.
+	AutoExecute bool  `json:"autoExecute"`
+	LastCheckAt int64 `json:"lastCheckAt"`
+}
+
+// Allocation is the current and target weight of an asset in the portfolio.
+type Allocation struct {
+	Asset    utils.AssetType
+	Balance  float64
+	USDValue float64
+	// Weight and TargetWeight are in percent. Drift is Weight-TargetWeight
+	// in percentage points.
+	Weight       float64
+	TargetWeight float64
+	Drift        float64
+}
+
+// Swap is a swap proposed to rebalance the portfolio.
+type Swap struct {
+	From utils.AssetType
+	To   utils.AssetType
+	// Amount is the amount of the From asset to swap and USDValue its
+	// value.
+	Amount   float64
+	USDValue float64
+	Route    Route
+	// SourceWalletID and SourceAccount are the account instant swaps are
+	// funded from.
+	SourceWalletID int
+	SourceAccount  int32
+
+	// The estimates below are set once the swap is quoted. Server is the
+	// exchange server or DEX host that quoted the best rate.
+	Server        string
+	ReceiveAmount float64
+	// EstimatedFee is the USD value lost to fees and spreads.
+	EstimatedFee float64
+	// EstimatedSlippage is the percentage by which the quoted rate is worse
+	// than the market rate.
+	EstimatedSlippage float64
+	EstimateErr       error
+}
+
+// Proposal is the allocation of the portfolio and the swaps that rebalance
+// it to the target weights.
+type Proposal struct {
+	TotalUSDValue float64
+	Allocations   []*Allocation
+	Swaps         []*Swap
+	// MaxDrift is the largest drift of an asset in percentage points.
+	MaxDrift float64
+	// NeedsRebalance is true if MaxDrift is above the drift threshold.
+	NeedsRebalance bool
+	CreatedAt      int64
+}
+
+// RebalanceNotificationListener receives the outcomes of the scheduled
+// portfolio checks.
+type RebalanceNotificationListener struct {
+	OnRebalanceNeeded func(proposal *Proposal)
+	OnSwapExecuted    func(swap *Swap, orderID string, err error)
+}
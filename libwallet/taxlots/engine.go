@@ -0,0 +1,224 @@
+package taxlots
+
+import (
+	"sort"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// dust is the amount below which a lot is considered fully disposed of, to
+// absorb float rounding.
+const dust = 1e-9
+
+// Ledger matches the disposals of each asset against the lots previously
+// acquired using a lot method.
+type Ledger struct {
+	method Method
+	lots   map[utils.AssetType][]*Lot
+}
+
+// NewLedger returns an empty ledger that uses method to select lots.
+func NewLedger(method Method) (*Ledger, error) {
+	switch method {
+	case FIFO, LIFO, HIFO:
+	default:
+		return nil, errors.New(ErrUnsupportedMethod)
+	}
+	return &Ledger{
+		method: method,
+		lots:   make(map[utils.AssetType][]*Lot),
+	}, nil
+}
+
+// Report processes the events in chronological order and returns the gains
+// and income realised during year, in the local time zone. Events after the
+// year are ignored.
+func Report(events []*Event, method Method, year int, currency string) (*YearReport, error) {
+	ledger, err := NewLedger(method)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Time != sorted[j].Time {
+			return sorted[i].Time < sorted[j].Time
+		}
+		// Acquisitions made at the same time as a disposal may fund it.
+		return sorted[i].Kind != Disposal && sorted[j].Kind == Disposal
+	})
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local).Unix()
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.Local).Unix()
+
+	report := &YearReport{
+		Year:     year,
+		Currency: currency,
+		Method:   method,
+		Gains:    []*Gain{},
+		Income:   []*IncomeItem{},
+	}
+	for _, event := range sorted {
+		if event.Time >= end {
+			break
+		}
+
+		inYear := event.Time >= start
+		switch event.Kind {
+		case Acquisition:
+			ledger.Acquire(event)
+		case Income:
+			ledger.Acquire(event)
+			if inYear {
+				report.Income = append(report.Income, &IncomeItem{
+					Asset:  event.Asset,
+					TxHash: event.TxHash,
+					Note:   event.Note,
+					Time:   event.Time,
+					Amount: event.Amount,
+					Value:  event.Value,
+				})
+				report.TotalIncome += event.Value
+			}
+		case Disposal:
+			gains := ledger.Dispose(event)
+			if !inYear {
+				continue
+			}
+			for _, gain := range gains {
+				if gain.LongTerm {
+					report.LongTermGain += gain.Proceeds - gain.CostBasis
+				} else {
+					report.ShortTermGain += gain.Proceeds - gain.CostBasis
+				}
+			}
+			report.Gains = append(report.Gains, gains...)
+		}
+	}
+
+	report.OpenLots = ledger.OpenLots()
+	return report, nil
+}
+
+// Acquire adds a lot for the event's amount with the event value as its cost.
+func (l *Ledger) Acquire(event *Event) {
+	if event.Amount <= 0 {
+		return
+	}
+	l.lots[event.Asset] = append(l.lots[event.Asset], &Lot{
+		Asset:     event.Asset,
+		Acquired:  event.Time,
+		Amount:    event.Amount,
+		Remaining: event.Amount,
+		Cost:      event.Value,
+		TxHash:    event.TxHash,
+	})
+}
+
+// Dispose removes the event's amount from the lots selected by the ledger's
+// method and returns a gain for each lot used. The event value is shared
+// between the lots in proportion to the amount taken from each.
+func (l *Ledger) Dispose(event *Event) []*Gain {
+	if event.Amount <= 0 {
+		return nil
+	}
+
+	var gains []*Gain
+	remaining := event.Amount
+	for remaining > dust {
+		lot := l.nextLot(event.Asset)
+		if lot == nil {
+			break
+		}
+
+		amount := lot.Remaining
+		if amount > remaining {
+			amount = remaining
+		}
+		lot.Remaining -= amount
+		remaining -= amount
+
+		gains = append(gains, &Gain{
+			Asset:     event.Asset,
+			TxHash:    event.TxHash,
+			Note:      event.Note,
+			Acquired:  lot.Acquired,
+			Disposed:  event.Time,
+			Amount:    amount,
+			Proceeds:  event.Value * amount / event.Amount,
+			CostBasis: lot.Cost * amount / lot.Amount,
+			LongTerm:  event.Time-lot.Acquired > longTermHolding,
+		})
+	}
+
+	if remaining > dust {
+		gains = append(gains, &Gain{
+			Asset:     event.Asset,
+			TxHash:    event.TxHash,
+			Note:      event.Note,
+			Acquired:  event.Time,
+			Disposed:  event.Time,
+			Amount:    remaining,
+			Proceeds:  event.Value * remaining / event.Amount,
+			Unmatched: true,
+		})
+	}
+
+	l.prune(event.Asset)
+	return gains
+}
+
+// nextLot returns the open lot of the asset the method disposes of first.
+func (l *Ledger) nextLot(asset utils.AssetType) *Lot {
+	var next *Lot
+	for _, lot := range l.lots[asset] {
+		if lot.Remaining <= dust {
+			continue
+		}
+		if next == nil {
+			next = lot
+			continue
+		}
+
+		switch l.method {
+		case FIFO:
+			// Lots are kept in acquisition order, the first open lot is
+			// the oldest.
+			return next
+		case LIFO:
+			next = lot
+		case HIFO:
+			if lot.Cost/lot.Amount > next.Cost/next.Amount {
+				next = lot
+			}
+		}
+	}
+	return next
+}
+
+// prune drops the lots of the asset that are fully disposed of.
+func (l *Ledger) prune(asset utils.AssetType) {
+	lots := l.lots[asset][:0]
+	for _, lot := range l.lots[asset] {
+		if lot.Remaining > dust {
+			lots = append(lots, lot)
+		}
+	}
+	l.lots[asset] = lots
+}
+
+// OpenLots returns the lots that are not fully disposed of, oldest first.
+func (l *Ledger) OpenLots() []*Lot {
+	lots := []*Lot{}
+	for _, assetLots := range l.lots {
+		lots = append(lots, assetLots...)
+	}
+	sort.SliceStable(lots, func(i, j int) bool {
+		return lots[i].Acquired < lots[j].Acquired
+	})
+	return lots
+}
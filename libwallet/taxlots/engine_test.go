@@ -0,0 +1,167 @@
+package taxlots
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const dcr = utils.DCRWalletAsset
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestLedgerDispose(t *testing.T) {
+	// Three lots of one coin bought at 100, 300 and 200.
+	lots := []*Event{
+		{Asset: dcr, Time: 1, Kind: Acquisition, Amount: 1, Value: 100, TxHash: "a"},
+		{Asset: dcr, Time: 2, Kind: Acquisition, Amount: 1, Value: 300, TxHash: "b"},
+		{Asset: dcr, Time: 3, Kind: Acquisition, Amount: 1, Value: 200, TxHash: "c"},
+	}
+
+	type gain struct {
+		acquired            int64
+		amount              float64
+		proceeds, costBasis float64
+		unmatched           bool
+	}
+	tests := []struct {
+		name      string
+		method    Method
+		amount    float64
+		value     float64
+		wantGains []gain
+		wantOpen  []float64 // remaining amount of the open lots, oldest first.
+	}{{
+		name:      "fifo disposes of the oldest lots",
+		method:    FIFO,
+		amount:    1.5,
+		value:     600,
+		wantGains: []gain{{1, 1, 400, 100, false}, {2, 0.5, 200, 150, false}},
+		wantOpen:  []float64{0.5, 1},
+	}, {
+		name:      "lifo disposes of the newest lots",
+		method:    LIFO,
+		amount:    1.5,
+		value:     600,
+		wantGains: []gain{{3, 1, 400, 200, false}, {2, 0.5, 200, 150, false}},
+		wantOpen:  []float64{1, 0.5},
+	}, {
+		name:      "hifo disposes of the most expensive lots",
+		method:    HIFO,
+		amount:    1.5,
+		value:     600,
+		wantGains: []gain{{2, 1, 400, 300, false}, {3, 0.5, 200, 100, false}},
+		wantOpen:  []float64{1, 0.5},
+	}, {
+		name:      "partial lot",
+		method:    FIFO,
+		amount:    0.25,
+		value:     50,
+		wantGains: []gain{{1, 0.25, 50, 25, false}},
+		wantOpen:  []float64{0.75, 1, 1},
+	}, {
+		name:   "disposal beyond the held lots",
+		method: FIFO,
+		amount: 4,
+		value:  800,
+		wantGains: []gain{
+			{1, 1, 200, 100, false},
+			{2, 1, 200, 300, false},
+			{3, 1, 200, 200, false},
+			{10, 1, 200, 0, true},
+		},
+		wantOpen: []float64{},
+	}}
+
+	for _, test := range tests {
+		ledger, err := NewLedger(test.method)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, lot := range lots {
+			ledger.Acquire(lot)
+		}
+
+		gains := ledger.Dispose(&Event{Asset: dcr, Time: 10, Kind: Disposal, Amount: test.amount, Value: test.value})
+		if len(gains) != len(test.wantGains) {
+			t.Errorf("%s: expected %d gains, got %d", test.name, len(test.wantGains), len(gains))
+			continue
+		}
+		for i, want := range test.wantGains {
+			got := gains[i]
+			if got.Acquired != want.acquired || !equal(got.Amount, want.amount) || !equal(got.Proceeds, want.proceeds) ||
+				!equal(got.CostBasis, want.costBasis) || got.Unmatched != want.unmatched {
+				t.Errorf("%s: gain %d: expected %+v, got %+v", test.name, i, want, *got)
+			}
+		}
+
+		open := ledger.OpenLots()
+		if len(open) != len(test.wantOpen) {
+			t.Errorf("%s: expected %d open lots, got %d", test.name, len(test.wantOpen), len(open))
+			continue
+		}
+		for i, remaining := range test.wantOpen {
+			if !equal(open[i].Remaining, remaining) {
+				t.Errorf("%s: open lot %d: expected %f remaining, got %f", test.name, i, remaining, open[i].Remaining)
+			}
+		}
+	}
+
+	if _, err := NewLedger("average"); err == nil {
+		t.Fatal("expected an unsupported method to be rejected")
+	}
+}
+
+func TestReportYearBoundaries(t *testing.T) {
+	at := func(year int, month time.Month, day, hour int) int64 {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.Local).Unix()
+	}
+
+	events := []*Event{
+		// Carried over from the previous years.
+		{Asset: dcr, Time: at(2022, time.June, 1, 12), Kind: Acquisition, Amount: 2, Value: 200, TxHash: "bought"},
+		{Asset: dcr, Time: at(2023, time.March, 1, 12), Kind: Disposal, Amount: 1, Value: 150, TxHash: "sold-2023"},
+		{Asset: dcr, Time: at(2023, time.December, 31, 23), Kind: Income, Amount: 1, Value: 50, TxHash: "reward-2023"},
+		// Realised during the year.
+		{Asset: dcr, Time: at(2024, time.January, 1, 0), Kind: Disposal, Amount: 1, Value: 300, TxHash: "sold-2024"},
+		{Asset: dcr, Time: at(2024, time.December, 31, 23), Kind: Income, Amount: 1, Value: 80, TxHash: "reward-2024"},
+		// After the year, ignored.
+		{Asset: dcr, Time: at(2025, time.January, 1, 0), Kind: Disposal, Amount: 2, Value: 1000, TxHash: "sold-2025"},
+	}
+
+	report, err := Report(events, FIFO, 2024, "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The 2023 disposal used half of the 2022 lot, the 2024 disposal uses
+	// the other half, held for more than a year.
+	if len(report.Gains) != 1 {
+		t.Fatalf("expected one gain, got %d", len(report.Gains))
+	}
+	gain := report.Gains[0]
+	if gain.TxHash != "sold-2024" || !gain.LongTerm || !equal(gain.CostBasis, 100) || !equal(gain.Proceeds, 300) {
+		t.Fatalf("unexpected gain %+v", *gain)
+	}
+	if !equal(report.LongTermGain, 200) || !equal(report.ShortTermGain, 0) {
+		t.Fatalf("expected a long term gain of 200, got %f long and %f short", report.LongTermGain, report.ShortTermGain)
+	}
+
+	if len(report.Income) != 1 || report.Income[0].TxHash != "reward-2024" || !equal(report.TotalIncome, 80) {
+		t.Fatalf("expected only the 2024 reward as income, got %+v", report.Income)
+	}
+
+	// The 2023 and 2024 rewards are still held at the end of the year.
+	if len(report.OpenLots) != 2 {
+		t.Fatalf("expected two open lots, got %d", len(report.OpenLots))
+	}
+	for i, txHash := range []string{"reward-2023", "reward-2024"} {
+		if lot := report.OpenLots[i]; lot.TxHash != txHash || !equal(lot.Remaining, 1) {
+			t.Errorf("open lot %d: expected all of %s, got %+v", i, txHash, *lot)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package taxlots
+
+const (
+	ErrUnsupportedMethod = "unsupported_lot_method"
+)
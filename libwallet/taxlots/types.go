@@ -0,0 +1,110 @@
+package taxlots
+
+import (
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Method selects the lots a disposal is matched against.
+type Method string
+
+const (
+	// FIFO disposes of the oldest lots first.
+	FIFO Method = "fifo"
+	// LIFO disposes of the newest lots first.
+	LIFO Method = "lifo"
+	// HIFO disposes of the lots with the highest cost per coin first.
+	HIFO Method = "hifo"
+
+	// longTermHolding is the holding period, in seconds, after which gains
+	// are long term.
+	longTermHolding = 365 * 24 * 60 * 60
+)
+
+// Methods are the supported lot methods, in display order.
+var Methods = []Method{FIFO, LIFO, HIFO}
+
+// EventKind is the tax treatment of an event.
+type EventKind int
+
+const (
+	// Acquisition adds a lot with the event value as its cost basis.
+	Acquisition EventKind = iota
+	// Disposal removes coins from the lots and realises the difference
+	// between the event value and the cost basis of the coins.
+	Disposal
+	// Income is taxed at its value when received and adds a lot with that
+	// value as its cost basis.
+	Income
+)
+
+// Event is a taxable change of the holdings of an asset.
+type Event struct {
+	Asset utils.AssetType
+	Time  int64
+	Kind  EventKind
+	// Amount is the number of coins acquired, disposed of or received.
+	Amount float64
+	// Value is the fiat cost of an acquisition, the fiat proceeds of a
+	// disposal or the fiat value of income.
+	Value  float64
+	TxHash string
+	Note   string
+}
+
+// Lot is an amount of an asset acquired at the same time and cost.
+type Lot struct {
+	Asset     utils.AssetType `json:"asset"`
+	Acquired  int64           `json:"acquired"`
+	Amount    float64         `json:"amount"`
+	Remaining float64         `json:"remaining"`
+	Cost      float64         `json:"cost"` // fiat cost of Amount.
+	TxHash    string          `json:"txHash"`
+}
+
+// Gain is the gain or loss realised by disposing of (part of) a lot.
+type Gain struct {
+	Asset     utils.AssetType `json:"asset"`
+	TxHash    string          `json:"txHash"`
+	Note      string          `json:"note,omitempty"`
+	Acquired  int64           `json:"acquired"`
+	Disposed  int64           `json:"disposed"`
+	Amount    float64         `json:"amount"`
+	Proceeds  float64         `json:"proceeds"`
+	CostBasis float64         `json:"costBasis"`
+	LongTerm  bool            `json:"longTerm"`
+	// Unmatched is true if no lot was left to dispose of. Its cost basis is
+	// zero and should be reviewed, it is usually caused by transactions
+	// missing from the wallets.
+	Unmatched bool `json:"unmatched,omitempty"`
+}
+
+// IncomeItem is taxable income received.
+type IncomeItem struct {
+	Asset  utils.AssetType `json:"asset"`
+	TxHash string          `json:"txHash"`
+	Note   string          `json:"note,omitempty"`
+	Time   int64           `json:"time"`
+	Amount float64         `json:"amount"`
+	Value  float64         `json:"value"`
+}
+
+// YearReport is the realised gains and income of a calendar year.
+type YearReport struct {
+	Year     int    `json:"year"`
+	Currency string `json:"currency"`
+	Method   Method `json:"method"`
+
+	Gains  []*Gain       `json:"gains"`
+	Income []*IncomeItem `json:"income"`
+
+	ShortTermGain float64 `json:"shortTermGain"`
+	LongTermGain  float64 `json:"longTermGain"`
+	TotalIncome   float64 `json:"totalIncome"`
+
+	// OpenLots are the lots still held at the end of the year.
+	OpenLots []*Lot `json:"openLots"`
+
+	// MissingPrices is the number of events valued at zero because no
+	// historical price was available.
+	MissingPrices int `json:"missingPrices"`
+}
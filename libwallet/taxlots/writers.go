@@ -0,0 +1,86 @@
+package taxlots
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+func formatFiat(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func formatTime(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
+
+// WriteCSV writes the gains of the report followed by its income to w, one
+// row per gain or income item.
+func WriteCSV(w io.Writer, report *YearReport) error {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = runtime.GOOS == "windows"
+
+	headers := []string{"Type", "Asset", "Acquired", "Disposed", "Amount",
+		fmt.Sprintf("Proceeds (%s)", report.Currency),
+		fmt.Sprintf("Cost basis (%s)", report.Currency),
+		fmt.Sprintf("Gain (%s)", report.Currency),
+		"Term", "Hash", "Note"}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("csv.Writer.Write error: %w", err)
+	}
+
+	for _, gain := range report.Gains {
+		term := "short"
+		if gain.LongTerm {
+			term = "long"
+		}
+		note := gain.Note
+		if gain.Unmatched {
+			note += " (no lot matched)"
+		}
+		record := []string{
+			"disposal",
+			string(gain.Asset),
+			formatTime(gain.Acquired),
+			formatTime(gain.Disposed),
+			strconv.FormatFloat(gain.Amount, 'f', 8, 64),
+			formatFiat(gain.Proceeds),
+			formatFiat(gain.CostBasis),
+			formatFiat(gain.Proceeds - gain.CostBasis),
+			term,
+			gain.TxHash,
+			note,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("csv.Writer.Write error: %w", err)
+		}
+	}
+
+	for _, income := range report.Income {
+		record := []string{
+			"income",
+			string(income.Asset),
+			formatTime(income.Time),
+			"",
+			strconv.FormatFloat(income.Amount, 'f', 8, 64),
+			formatFiat(income.Value),
+			"",
+			"",
+			"",
+			income.TxHash,
+			income.Note,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("csv.Writer.Write error: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("csv.Writer error: %w", err)
+	}
+	return nil
+}
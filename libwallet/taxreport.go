@@ -0,0 +1,355 @@
+package libwallet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex/calc"
+	"decred.org/dcrwallet/v4/errors"
+	api "github.com/crypto-power/instantswap/instantswap"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/taxlots"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// dexOrdersLimit is the number of DEX orders searched for the trades that
+// funded or paid out wallet transactions.
+const dexOrdersLimit = 10000
+
+// tradeLeg is what a wallet transaction that funded a swap was traded for.
+type tradeLeg struct {
+	asset    utils.AssetType // empty if the asset is not a wallet asset.
+	amount   float64
+	time     int64
+	refunded bool
+}
+
+// walletTx is a transaction of a wallet, the same transaction appears once
+// for each wallet it pays to or spends from.
+type walletTx struct {
+	wallet sharedW.Asset
+	tx     *sharedW.Transaction
+}
+
+// TaxReport returns the gains realised and the income received during year,
+// valued in the fiat currency of the settings and with lots disposed of using
+// method. The whole history of the wallets is processed so lots acquired in
+// earlier years are carried over.
+//
+// Transfers between wallets are not taxable, only their fee is disposed of.
+// Vote rewards and coinbase outputs are income. Swap proceeds are the value
+// of what the swap paid out, refunded swaps are neutral. The fee of a sent
+// transaction is disposed of with its amount, other fees are disposed of at
+// their market value.
+func (mgr *AssetsManager) TaxReport(ctx context.Context, year int, method taxlots.Method) (*taxlots.YearReport, error) {
+	const op errors.Op = "mgr.TaxReport"
+	if !mgr.IsHTTPAPIPrivacyModeOff(utils.ExchangeHTTPAPI) {
+		return nil, errors.E(op, errors.New(txexport.ErrPriceUnavailable))
+	}
+	currency := mgr.GetFiatCurrency()
+
+	groups := make(map[string][]*walletTx)
+	var hashes []string
+	for _, wallet := range mgr.AllWallets() {
+		txs, err := wallet.GetTransactionsRaw(0, math.MaxInt32, utils.TxFilterAll, false, "")
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		for _, tx := range txs {
+			key := txKey(wallet.GetAssetType(), tx.Hash)
+			if _, ok := groups[key]; !ok {
+				hashes = append(hashes, key)
+			}
+			groups[key] = append(groups[key], &walletTx{wallet: wallet, tx: tx})
+		}
+	}
+
+	legs, refunds := mgr.tradeLegs()
+
+	// Prices are needed on the days of the transactions and on the days
+	// swaps paid out.
+	timestamps := make(map[utils.AssetType][]int64)
+	for _, key := range hashes {
+		wtx := groups[key][0]
+		asset := wtx.wallet.GetAssetType()
+		timestamps[asset] = append(timestamps[asset], wtx.tx.Timestamp)
+		if leg, ok := legs[key]; ok && leg.asset != "" {
+			timestamps[leg.asset] = append(timestamps[leg.asset], leg.time)
+		}
+	}
+	prices := make(map[utils.AssetType]map[int64]float64, len(timestamps))
+	for asset, assetTimestamps := range timestamps {
+		assetPrices, err := mgr.TxExporter.DailyPrices(ctx, asset, currency, assetTimestamps)
+		if err != nil {
+			log.Errorf("Error getting %s historical prices: %v", asset, err)
+		}
+		prices[asset] = assetPrices
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local).Unix()
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.Local).Unix()
+	var missingPrices int
+	value := func(asset utils.AssetType, amount float64, timestamp int64) float64 {
+		price, ok := prices[asset][txexport.DayStart(timestamp)]
+		if !ok && timestamp >= start && timestamp < end {
+			missingPrices++
+		}
+		return amount * price
+	}
+
+	var events []*taxlots.Event
+	for _, key := range hashes {
+		events = append(events, taxEvents(groups[key], legs[key], refunds[key], value)...)
+	}
+
+	report, err := taxlots.Report(events, method, year, currency)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	report.MissingPrices = missingPrices
+	return report, nil
+}
+
+func txKey(asset utils.AssetType, hash string) string {
+	return string(asset) + ":" + hash
+}
+
+// taxEvents returns the taxable events of a transaction seen by the wallets
+// in wtxs. leg is set if the transaction funded a swap and refund is true if
+// it returned the funds of a failed swap.
+func taxEvents(wtxs []*walletTx, leg *tradeLeg, refund bool,
+	value func(asset utils.AssetType, amount float64, timestamp int64) float64,
+) []*taxlots.Event {
+	var sender *walletTx
+	var received bool
+	for _, wtx := range wtxs {
+		switch wtx.tx.Direction {
+		case txhelper.TxDirectionSent:
+			sender = wtx
+		case txhelper.TxDirectionReceived:
+			received = true
+		}
+	}
+
+	feeEvent := func(wtx *walletTx, note string) *taxlots.Event {
+		asset := wtx.wallet.GetAssetType()
+		fee := wtx.wallet.ToAmount(wtx.tx.Fee).ToCoin()
+		return &taxlots.Event{
+			Asset:  asset,
+			Time:   wtx.tx.Timestamp,
+			Kind:   taxlots.Disposal,
+			Amount: fee,
+			Value:  value(asset, fee, wtx.tx.Timestamp),
+			TxHash: wtx.tx.Hash,
+			Note:   note,
+		}
+	}
+
+	// A transaction sent by one of the wallets to another is a transfer.
+	if sender != nil && received {
+		return []*taxlots.Event{feeEvent(sender, "transfer fee")}
+	}
+
+	var events []*taxlots.Event
+	for _, wtx := range wtxs {
+		asset := wtx.wallet.GetAssetType()
+		tx := wtx.tx
+		amount := wtx.wallet.ToAmount(tx.Amount).ToCoin()
+
+		switch {
+		case tx.Type == txhelper.TxTypeVote:
+			reward := wtx.wallet.ToAmount(tx.VoteReward).ToCoin()
+			events = append(events, &taxlots.Event{
+				Asset:  asset,
+				Time:   tx.Timestamp,
+				Kind:   taxlots.Income,
+				Amount: reward,
+				Value:  value(asset, reward, tx.Timestamp),
+				TxHash: tx.Hash,
+				Note:   "vote reward",
+			})
+
+		case tx.Type == txhelper.TxTypeCoinBase:
+			events = append(events, &taxlots.Event{
+				Asset:  asset,
+				Time:   tx.Timestamp,
+				Kind:   taxlots.Income,
+				Amount: amount,
+				Value:  value(asset, amount, tx.Timestamp),
+				TxHash: tx.Hash,
+				Note:   "coinbase",
+			})
+
+		case tx.Type == txhelper.TxTypeRevocation:
+			// The ticket price is returned to the wallet.
+
+		case tx.Type == txhelper.TxTypeTicketPurchase,
+			tx.Direction == txhelper.TxDirectionTransferred:
+			events = append(events, feeEvent(wtx, "fee"))
+
+		case tx.Direction == txhelper.TxDirectionSent:
+			if leg != nil && leg.refunded {
+				events = append(events, feeEvent(wtx, "refunded swap fee"))
+				continue
+			}
+
+			note := "sent"
+			if leg != nil {
+				note = "swap"
+			}
+			var proceeds float64
+			if leg != nil && leg.asset != "" {
+				proceeds = value(leg.asset, leg.amount, leg.time)
+			} else {
+				proceeds = value(asset, amount, tx.Timestamp)
+			}
+			fee := wtx.wallet.ToAmount(tx.Fee).ToCoin()
+			events = append(events, &taxlots.Event{
+				Asset:  asset,
+				Time:   tx.Timestamp,
+				Kind:   taxlots.Disposal,
+				Amount: amount + fee,
+				Value:  proceeds,
+				TxHash: tx.Hash,
+				Note:   note,
+			})
+
+		case tx.Direction == txhelper.TxDirectionReceived:
+			if refund {
+				continue
+			}
+			events = append(events, &taxlots.Event{
+				Asset:  asset,
+				Time:   tx.Timestamp,
+				Kind:   taxlots.Acquisition,
+				Amount: amount,
+				Value:  value(asset, amount, tx.Timestamp),
+				TxHash: tx.Hash,
+				Note:   "received",
+			})
+		}
+	}
+	return events
+}
+
+// tradeLegs returns what the funding transactions of instant swap orders and
+// DEX matches were traded for, keyed by txKey, and the keys of the
+// transactions that refunded failed swaps.
+func (mgr *AssetsManager) tradeLegs() (map[string]*tradeLeg, map[string]bool) {
+	legs := make(map[string]*tradeLeg)
+	refunds := make(map[string]bool)
+
+	orders, err := mgr.InstantSwap.GetOrdersRaw(0, 0, true, "", "")
+	if err != nil {
+		log.Errorf("Error loading instant swap orders: %v", err)
+	}
+	for _, order := range orders {
+		// TxID is the deposit sent by the wallet, ServerTxID the payout
+		// sent by the exchange server. The payout is an acquisition of the
+		// destination wallet like any received transaction.
+		if !order.IsFunded() {
+			continue
+		}
+		fromAsset := walletAsset(order.FromCurrency)
+		leg := &tradeLeg{
+			asset:    walletAsset(order.ToCurrency),
+			amount:   order.ExpectedPayout(),
+			time:     order.PaidOutAt,
+			refunded: order.RefundTxID != "" || order.Status == api.OrderStatusRefunded,
+		}
+		if leg.time == 0 {
+			leg.time = order.FundedAt
+		}
+		legs[txKey(fromAsset, order.TxID)] = leg
+		if leg.refunded {
+			refunds[txKey(fromAsset, order.RefundTxID)] = true
+		}
+	}
+
+	dexClient := mgr.DexClient()
+	if dexClient == nil || !dexClient.IsInitialized() {
+		return legs, refunds
+	}
+	dexOrders, err := dexClient.Orders(&core.OrderFilter{N: dexOrdersLimit})
+	if err != nil {
+		log.Errorf("Error loading DEX orders: %v", err)
+		return legs, refunds
+	}
+	for _, order := range dexOrders {
+		for _, match := range order.Matches {
+			if match.IsCancel || match.Swap == nil {
+				continue
+			}
+
+			fundingKey := txKey(walletAsset(match.Swap.Symbol), coinTxHash(match.Swap))
+			// Matches funded by the same transaction add up.
+			leg, ok := legs[fundingKey]
+			if !ok {
+				leg = &tradeLeg{}
+				legs[fundingKey] = leg
+			}
+			if match.Refund != nil {
+				leg.refunded = true
+				refunds[txKey(walletAsset(match.Refund.Symbol), coinTxHash(match.Refund))] = true
+				continue
+			}
+
+			received, receivedSymbol := match.Qty, order.BaseSymbol
+			if order.Sell {
+				received, receivedSymbol = calc.BaseToQuote(match.Rate, match.Qty), order.QuoteSymbol
+			}
+			leg.asset = walletAsset(receivedSymbol)
+			leg.amount += float64(received) / atomsPerCoin
+			leg.time = int64(match.Stamp / 1000)
+		}
+	}
+	return legs, refunds
+}
+
+// walletAsset returns the wallet asset of a currency symbol, or an empty
+// asset type if the currency has no wallet.
+func walletAsset(symbol string) utils.AssetType {
+	assetType := utils.AssetType(strings.ToUpper(symbol))
+	switch assetType {
+	case utils.DCRWalletAsset, utils.BTCWalletAsset, utils.LTCWalletAsset:
+		return assetType
+	}
+	return ""
+}
+
+// coinTxHash returns the hash of the transaction of a DEX coin.
+func coinTxHash(coin *core.Coin) string {
+	hash, _, _ := strings.Cut(coin.StringID, ":")
+	return hash
+}
+
+// SaveTaxReport writes the gains and income of the report to a CSV file.
+func (mgr *AssetsManager) SaveTaxReport(report *taxlots.YearReport, fileName string) error {
+	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
+		return fmt.Errorf("os.MkdirAll error: %w", err)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("os.Create error: %w", err)
+	}
+
+	err = taxlots.WriteCSV(f, report)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(fileName)
+		return err
+	}
+	return nil
+}
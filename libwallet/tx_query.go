@@ -0,0 +1,70 @@
+package libwallet
+
+import (
+	"sort"
+
+	"decred.org/dcrwallet/v4/errors"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// WalletTransaction is a transaction of one of the wallets queried by
+// QueryTransactions.
+type WalletTransaction struct {
+	*sharedW.Transaction
+	WalletID int
+}
+
+// QueryTransactions returns the transactions of the wallets that match the
+// query, ordered by timestamp. The offset and limit of the query apply to
+// the transactions of all the wallets. The query amounts are in atoms, which
+// are the same fraction of a coin for all the supported assets. Wallets that
+// are not synced are skipped, as are the wallets without tickets if a ticket
+// status is queried.
+func (mgr *AssetsManager) QueryTransactions(wallets []sharedW.Asset, query *sharedW.TxQuery) ([]*WalletTransaction, error) {
+	const op errors.Op = "mgr.QueryTransactions"
+
+	// Each wallet returns enough transactions to fill the requested page
+	// once the transactions of all the wallets are merged.
+	walletQuery := *query
+	walletQuery.Offset = 0
+	if query.Limit > 0 {
+		walletQuery.Limit = query.Offset + query.Limit
+	}
+
+	var txs []*WalletTransaction
+	for _, wallet := range wallets {
+		if !wallet.IsSynced() {
+			continue
+		}
+
+		walletTxs, err := wallet.QueryTransactions(&walletQuery)
+		if errors.Is(err, utils.ErrTicketStatusUnsupported) {
+			// The wallet has no tickets.
+			continue
+		}
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		for _, tx := range walletTxs {
+			txs = append(txs, &WalletTransaction{Transaction: tx, WalletID: wallet.GetWalletID()})
+		}
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		if query.NewestFirst {
+			return txs[i].Timestamp > txs[j].Timestamp
+		}
+		return txs[i].Timestamp < txs[j].Timestamp
+	})
+
+	if int(query.Offset) >= len(txs) {
+		return []*WalletTransaction{}, nil
+	}
+	txs = txs[query.Offset:]
+	if query.Limit > 0 && len(txs) > int(query.Limit) {
+		txs = txs[:query.Limit]
+	}
+	return txs, nil
+}
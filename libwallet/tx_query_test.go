@@ -0,0 +1,116 @@
+package libwallet
+
+import (
+	"reflect"
+	"testing"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// queryWallet is a wallet returning its transactions from a slice ordered
+// by timestamp.
+type queryWallet struct {
+	sharedW.Asset
+	id          int
+	synced      bool
+	hasTickets  bool
+	timestamps  []int64
+	lastQueries []sharedW.TxQuery
+}
+
+func (w *queryWallet) IsSynced() bool   { return w.synced }
+func (w *queryWallet) GetWalletID() int { return w.id }
+
+func (w *queryWallet) QueryTransactions(query *sharedW.TxQuery) ([]*sharedW.Transaction, error) {
+	w.lastQueries = append(w.lastQueries, *query)
+	if query.TicketStatus != sharedW.AnyTicketStatus && !w.hasTickets {
+		return nil, utils.ErrTicketStatusUnsupported
+	}
+
+	txs := make([]*sharedW.Transaction, 0, len(w.timestamps))
+	for i := range w.timestamps {
+		timestamp := w.timestamps[i]
+		if query.NewestFirst {
+			timestamp = w.timestamps[len(w.timestamps)-1-i]
+		}
+		txs = append(txs, &sharedW.Transaction{Timestamp: timestamp})
+	}
+	txs = txs[query.Offset:]
+	if query.Limit > 0 && len(txs) > int(query.Limit) {
+		txs = txs[:query.Limit]
+	}
+	return txs, nil
+}
+
+func TestQueryTransactions(t *testing.T) {
+	tests := []struct {
+		name  string
+		query sharedW.TxQuery
+		want  []int64
+	}{{
+		name:  "all",
+		query: sharedW.TxQuery{},
+		want:  []int64{1, 2, 3, 4, 5, 6},
+	}, {
+		name:  "first page",
+		query: sharedW.TxQuery{Limit: 4},
+		want:  []int64{1, 2, 3, 4},
+	}, {
+		name:  "page across wallets",
+		query: sharedW.TxQuery{Offset: 2, Limit: 3},
+		want:  []int64{3, 4, 5},
+	}, {
+		name:  "newest first page",
+		query: sharedW.TxQuery{NewestFirst: true, Offset: 1, Limit: 3},
+		want:  []int64{5, 4, 3},
+	}, {
+		name:  "offset past the end",
+		query: sharedW.TxQuery{Offset: 6, Limit: 3},
+		want:  []int64{},
+	}, {
+		name:  "ticket status skips wallets without tickets",
+		query: sharedW.TxQuery{TicketStatus: sharedW.TicketLive},
+		want:  []int64{1, 3, 5},
+	}}
+
+	for _, test := range tests {
+		dcrWallet := &queryWallet{id: 1, synced: true, hasTickets: true, timestamps: []int64{1, 3, 5}}
+		btcWallet := &queryWallet{id: 2, synced: true, timestamps: []int64{2, 4, 6}}
+		unsyncedWallet := &queryWallet{id: 3, timestamps: []int64{0}}
+		wallets := []sharedW.Asset{dcrWallet, btcWallet, unsyncedWallet}
+
+		txs, err := new(AssetsManager).QueryTransactions(wallets, &test.query)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		got := make([]int64, 0, len(txs))
+		for _, tx := range txs {
+			wantID := 2
+			if tx.Timestamp%2 == 1 {
+				wantID = 1
+			}
+			if tx.WalletID != wantID {
+				t.Errorf("%s: expected tx %d of wallet %d, got wallet %d", test.name, tx.Timestamp, wantID, tx.WalletID)
+			}
+			got = append(got, tx.Timestamp)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+
+		if len(unsyncedWallet.lastQueries) != 0 {
+			t.Errorf("%s: expected the unsynced wallet to be skipped", test.name)
+		}
+		for _, walletQuery := range dcrWallet.lastQueries {
+			wantLimit := int32(0)
+			if test.query.Limit > 0 {
+				wantLimit = test.query.Offset + test.query.Limit
+			}
+			if walletQuery.Offset != 0 || walletQuery.Limit != wantLimit {
+				t.Errorf("%s: expected wallet query offset 0 limit %d, got offset %d limit %d",
+					test.name, wantLimit, walletQuery.Offset, walletQuery.Limit)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package libwallet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// ExportTransactions writes the transactions of the wallets selected by opts
+// to fileName. Fiat values are left out if exchange API calls are disabled by
+// the privacy settings.
+func (mgr *AssetsManager) ExportTransactions(ctx context.Context, wallets []sharedW.Asset, opts *txexport.Options, fileName string) error {
+	if !mgr.IsHTTPAPIPrivacyModeOff(utils.ExchangeHTTPAPI) {
+		opts.FiatCurrency = ""
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
+		return fmt.Errorf("os.MkdirAll error: %w", err)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("os.Create error: %w", err)
+	}
+
+	err = mgr.TxExporter.Export(ctx, wallets, opts, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(fileName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package txexport
+
+const (
+	ErrUnsupportedFormat  = "unsupported_export_format"
+	ErrInvalidDateRange   = "invalid_date_range"
+	ErrPriceUnavailable   = "historical_price_unavailable"
+	ErrUnsupportedAccount = "account_filter_needs_single_wallet"
+)
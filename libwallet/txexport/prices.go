@@ -0,0 +1,286 @@
+package txexport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/asdine/storm"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const (
+	day = int64(24 * time.Hour / time.Second)
+	// maxPriceHistory is how far back, in seconds, historical prices are
+	// available.
+	maxPriceHistory = 364 * day
+)
+
+// CoinGecko returns one price per day for ranges longer than 90 days and
+// hourly prices for shorter ranges, all prices of the range are requested in
+// a single call. The free API only serves the last 365 days, older prices
+// are fetched from Coinpaprika.
+var coingeckoRangeURL = "https://api.coingecko.com/api/v3/coins/%s/market_chart/range?vs_currency=%s&from=%d&to=%d"
+
+var coingeckoIDs = map[utils.AssetType]string{
+	utils.DCRWalletAsset: "decred",
+	utils.BTCWalletAsset: "bitcoin",
+	utils.LTCWalletAsset: "litecoin",
+}
+
+// Coinpaprika returns the daily OHLCV of up to coinpaprikaMaxDays days per
+// call, quoted in USD only.
+var coinpaprikaOHLCVURL = "https://api.coinpaprika.com/v1/coins/%s/ohlcv/historical?start=%s&end=%s&quote=usd&limit=%d"
+
+const coinpaprikaMaxDays = 366
+
+var coinpaprikaIDs = map[utils.AssetType]string{
+	utils.DCRWalletAsset: "dcr-decred",
+	utils.BTCWalletAsset: "btc-bitcoin",
+	utils.LTCWalletAsset: "ltc-litecoin",
+}
+
+// Frankfurter returns the daily ECB reference rates of a date range, the
+// older USD prices are converted to the other currencies with them. No rates
+// are published on weekends and holidays.
+var frankfurterRatesURL = "https://api.frankfurter.app/%s..%s?from=USD&to=%s"
+
+// fxRateLookback is how long before the first day the exchange rates are
+// fetched from, for the first day to have a previous rate if none is
+// published on it.
+const fxRateLookback = 7 * day
+
+// DayStart returns the start of the UTC day of the timestamp, the key of the
+// prices returned by DailyPrices.
+func DayStart(timestamp int64) int64 {
+	return timestamp - timestamp%day
+}
+
+func priceID(asset utils.AssetType, currency string, dayStart int64) string {
+	return fmt.Sprintf("%s-%s-%d", asset, currency, dayStart)
+}
+
+// DailyPrices returns the price of the asset in the currency for the days of
+// the timestamps. Cached prices are used where available, the missing prices
+// are fetched and cached, except for the current day whose price still
+// changes. Days without a known price are left out of the returned map.
+func (e *Exporter) DailyPrices(ctx context.Context, asset utils.AssetType, currency string, timestamps []int64) (map[int64]float64, error) {
+	currency = strings.ToLower(currency)
+	prices := make(map[int64]float64)
+	var missingFrom, missingTo int64
+	for _, timestamp := range timestamps {
+		start := DayStart(timestamp)
+		if _, ok := prices[start]; ok {
+			continue
+		}
+
+		var price HistoricalPrice
+		err := e.db.One("ID", priceID(asset, currency, start), &price)
+		if err == nil {
+			prices[start] = price.Price
+			continue
+		}
+		if err != storm.ErrNotFound {
+			return nil, err
+		}
+
+		if missingFrom == 0 || start < missingFrom {
+			missingFrom = start
+		}
+		if start > missingTo {
+			missingTo = start
+		}
+	}
+
+	if missingTo == 0 {
+		return prices, nil
+	}
+
+	// CoinGecko fails the whole range if it starts before the prices it
+	// serves, the older prices are fetched separately.
+	today := DayStart(time.Now().Unix())
+	oldest := today - maxPriceHistory
+	fetched := make(map[int64]float64)
+	var fetchErr error
+	if missingFrom < oldest {
+		older, err := fetchOlderDailyPrices(ctx, asset, currency, missingFrom, min(missingTo, oldest-day))
+		if err != nil {
+			fetchErr = err
+		}
+		for start, price := range older {
+			fetched[start] = price
+		}
+	}
+	if missingTo >= oldest {
+		recent, err := fetchDailyPrices(ctx, asset, currency, max(missingFrom, oldest), missingTo+day)
+		if err != nil {
+			fetchErr = err
+		}
+		for start, price := range recent {
+			fetched[start] = price
+		}
+	}
+
+	for start, price := range fetched {
+		if _, ok := prices[start]; ok {
+			continue
+		}
+		prices[start] = price
+		if start >= today {
+			continue
+		}
+
+		err := e.db.Save(&HistoricalPrice{
+			ID:       priceID(asset, currency, start),
+			Asset:    asset,
+			Currency: currency,
+			Day:      start,
+			Price:    price,
+		})
+		if err != nil {
+			log.Errorf("Error caching %s price: %v", asset, err)
+		}
+	}
+	return prices, fetchErr
+}
+
+// fetchDailyPrices fetches the prices of the asset between the from and to
+// timestamps and returns the average price of each day.
+func fetchDailyPrices(ctx context.Context, asset utils.AssetType, currency string, from, to int64) (map[int64]float64, error) {
+	coinID, ok := coingeckoIDs[asset]
+	if !ok {
+		return nil, fmt.Errorf("no historical price source for %s", asset)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	reqCfg := &utils.ReqConfig{
+		HTTPURL: fmt.Sprintf(coingeckoRangeURL, coinID, currency, from, to),
+		Method:  "GET",
+	}
+	var res struct {
+		Prices [][2]float64 `json:"prices"` // [timestamp in ms, price]
+	}
+	if _, err := utils.HTTPRequest(reqCfg, &res); err != nil {
+		log.Errorf("Error fetching %s historical prices: %v", asset, err)
+		return nil, errors.New(ErrPriceUnavailable)
+	}
+
+	sums := make(map[int64]float64)
+	counts := make(map[int64]int)
+	for _, point := range res.Prices {
+		start := DayStart(int64(point[0]) / 1000)
+		sums[start] += point[1]
+		counts[start]++
+	}
+
+	prices := make(map[int64]float64, len(sums))
+	for start, sum := range sums {
+		prices[start] = sum / float64(counts[start])
+	}
+	return prices, nil
+}
+
+const dateFormat = "2006-01-02"
+
+// fetchOlderDailyPrices fetches the prices of the asset for the days between
+// the from and to day starts, included, from Coinpaprika. The opening price
+// of each day is used, as the daily prices of CoinGecko are. Coinpaprika only
+// quotes USD, the prices in other currencies are converted with the exchange
+// rates of each day.
+func fetchOlderDailyPrices(ctx context.Context, asset utils.AssetType, currency string, from, to int64) (map[int64]float64, error) {
+	coinID, ok := coinpaprikaIDs[asset]
+	if !ok {
+		return nil, fmt.Errorf("no historical price source for %s", asset)
+	}
+
+	var rates map[int64]float64
+	if currency != "usd" {
+		var err error
+		if rates, err = fetchUSDRates(ctx, currency, from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	prices := make(map[int64]float64)
+	for start := from; start <= to; start += coinpaprikaMaxDays * day {
+		if ctx.Err() != nil {
+			return prices, ctx.Err()
+		}
+
+		end := min(start+(coinpaprikaMaxDays-1)*day, to)
+		reqCfg := &utils.ReqConfig{
+			HTTPURL: fmt.Sprintf(coinpaprikaOHLCVURL, coinID, time.Unix(start, 0).UTC().Format(dateFormat),
+				time.Unix(end, 0).UTC().Format(dateFormat), coinpaprikaMaxDays),
+			Method: "GET",
+		}
+		var res []struct {
+			TimeOpen time.Time `json:"time_open"`
+			Open     float64   `json:"open"`
+		}
+		if _, err := utils.HTTPRequest(reqCfg, &res); err != nil {
+			log.Errorf("Error fetching %s historical prices: %v", asset, err)
+			return prices, errors.New(ErrPriceUnavailable)
+		}
+
+		for _, ohlcv := range res {
+			start := DayStart(ohlcv.TimeOpen.Unix())
+			if rates == nil {
+				prices[start] = ohlcv.Open
+			} else if rate, ok := rates[start]; ok {
+				prices[start] = ohlcv.Open * rate
+			}
+		}
+	}
+	return prices, nil
+}
+
+// fetchUSDRates fetches the USD exchange rates of the currency for the days
+// between the from and to day starts, included. Days without a published
+// rate use the previous one.
+func fetchUSDRates(ctx context.Context, currency string, from, to int64) (map[int64]float64, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	symbol := strings.ToUpper(currency)
+	reqCfg := &utils.ReqConfig{
+		HTTPURL: fmt.Sprintf(frankfurterRatesURL, time.Unix(from-fxRateLookback, 0).UTC().Format(dateFormat),
+			time.Unix(to, 0).UTC().Format(dateFormat), symbol),
+		Method: "GET",
+	}
+	var res struct {
+		Rates map[string]map[string]float64 `json:"rates"`
+	}
+	if _, err := utils.HTTPRequest(reqCfg, &res); err != nil {
+		log.Errorf("Error fetching USD to %s exchange rates: %v", symbol, err)
+		return nil, errors.New(ErrPriceUnavailable)
+	}
+
+	published := make(map[int64]float64, len(res.Rates))
+	for date, rates := range res.Rates {
+		t, err := time.Parse(dateFormat, date)
+		if err != nil {
+			continue
+		}
+		if rate, ok := rates[symbol]; ok && rate > 0 {
+			published[DayStart(t.Unix())] = rate
+		}
+	}
+
+	rates := make(map[int64]float64)
+	var rate float64
+	for start := from - fxRateLookback; start <= to; start += day {
+		if published[start] > 0 {
+			rate = published[start]
+		}
+		if start >= from && rate > 0 {
+			rates[start] = rate
+		}
+	}
+	return rates, nil
+}
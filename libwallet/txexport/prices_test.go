@@ -0,0 +1,103 @@
+package txexport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asdine/storm"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestDailyPrices(t *testing.T) {
+	today := DayStart(time.Now().Unix())
+	recentDay := today - 10*day
+	olderDay := today - 2*maxPriceHistory
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		var res interface{}
+		if strings.Contains(r.URL.Path, "frankfurter") {
+			// No rate is published on the older day, the rate of the day
+			// before applies.
+			res = map[string]interface{}{
+				"rates": map[string]map[string]float64{
+					time.Unix(olderDay-day, 0).UTC().Format("2006-01-02"): {"EUR": 0.5},
+				},
+			}
+		} else if strings.Contains(r.URL.Path, "ohlcv") {
+			res = []map[string]interface{}{{
+				"time_open": time.Unix(olderDay, 0).UTC().Format(time.RFC3339),
+				"open":      10.0,
+			}}
+		} else {
+			// Two prices of the recent day are averaged.
+			res = map[string]interface{}{
+				"prices": [][2]float64{
+					{float64(recentDay * 1000), 20},
+					{float64((recentDay + 3600) * 1000), 30},
+				},
+			}
+		}
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	defer func(coingecko, coinpaprika, frankfurter string) {
+		coingeckoRangeURL, coinpaprikaOHLCVURL, frankfurterRatesURL = coingecko, coinpaprika, frankfurter
+	}(coingeckoRangeURL, coinpaprikaOHLCVURL, frankfurterRatesURL)
+	coingeckoRangeURL = server.URL + "/coingecko/%s?vs_currency=%s&from=%d&to=%d"
+	coinpaprikaOHLCVURL = server.URL + "/coinpaprika/%s/ohlcv?start=%s&end=%s&limit=%d"
+	frankfurterRatesURL = server.URL + "/frankfurter/%s..%s?to=%s"
+
+	db, err := storm.Open(filepath.Join(t.TempDir(), "txexport.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	e, err := NewExporter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timestamps := []int64{recentDay + 100, olderDay + 100, recentDay + 200}
+	want := map[int64]float64{recentDay: 25, olderDay: 10}
+	for i := 0; i < 2; i++ {
+		prices, err := e.DailyPrices(context.Background(), utils.DCRWalletAsset, "USD", timestamps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(prices) != len(want) || prices[recentDay] != want[recentDay] || prices[olderDay] != want[olderDay] {
+			t.Fatalf("expected prices %v, got %v", want, prices)
+		}
+	}
+
+	// The second call is served from the cache.
+	if len(requests) != 2 {
+		t.Fatalf("expected one request to each price source, got %v", requests)
+	}
+
+	// Coinpaprika only quotes USD, older prices in other currencies are
+	// converted with the exchange rate of the day.
+	requests = nil
+	prices, err := e.DailyPrices(context.Background(), utils.DCRWalletAsset, "EUR", []int64{olderDay, recentDay})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prices[olderDay] != 5 || prices[recentDay] != 25 {
+		t.Fatalf("expected the converted older EUR price, got %v", prices)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected requests to the exchange rate and both price sources, got %v", requests)
+	}
+}
@@ -0,0 +1,170 @@
+package txexport
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/asdine/storm"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+)
+
+// Exporter writes the transaction history of wallets to files used for
+// accounting. The historical prices used for the fiat values are cached in
+// its db.
+type Exporter struct {
+	db *storm.DB
+}
+
+// NewExporter returns an Exporter that caches historical prices in db.
+func NewExporter(db *storm.DB) (*Exporter, error) {
+	if err := db.Init(&HistoricalPrice{}); err != nil {
+		log.Errorf("Error initializing tx export database: %s", err.Error())
+		return nil, err
+	}
+	return &Exporter{db: db}, nil
+}
+
+// Validate checks that the options select a valid range and format for the
+// number of wallets exported.
+func (opts *Options) Validate(walletCount int) error {
+	switch opts.Format {
+	case CSVFormat, JSONFormat, OFXFormat:
+	default:
+		return errors.New(ErrUnsupportedFormat)
+	}
+
+	if !opts.From.IsZero() && !opts.To.IsZero() && opts.To.Before(opts.From) {
+		return errors.New(ErrInvalidDateRange)
+	}
+
+	if opts.Account != AllAccounts && walletCount != 1 {
+		return errors.New(ErrUnsupportedAccount)
+	}
+	return nil
+}
+
+// Export writes the transactions of the wallets selected by opts to w.
+// Transactions are ordered by wallet, newest first.
+func (e *Exporter) Export(ctx context.Context, wallets []sharedW.Asset, opts *Options, w io.Writer) error {
+	const op errors.Op = "txexport.Export"
+	if err := opts.Validate(len(wallets)); err != nil {
+		return errors.E(op, err)
+	}
+
+	var rows []*Row
+	for _, wallet := range wallets {
+		walletRows, err := e.walletRows(ctx, wallet, opts)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		rows = append(rows, walletRows...)
+	}
+
+	var err error
+	switch opts.Format {
+	case CSVFormat:
+		err = writeCSV(w, rows, opts.FiatCurrency)
+	case JSONFormat:
+		err = writeJSON(w, rows)
+	case OFXFormat:
+		err = writeOFX(w, rows)
+	}
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// walletRows returns the rows of the wallet's transactions selected by opts.
+func (e *Exporter) walletRows(ctx context.Context, wallet sharedW.Asset, opts *Options) ([]*Row, error) {
+	txs, err := wallet.GetTransactionsRaw(0, math.MaxInt32, opts.TxFilter, true, "")
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*Row, 0, len(txs))
+	timestamps := make([]int64, 0, len(txs))
+	for _, tx := range txs {
+		if !opts.From.IsZero() && tx.Timestamp < opts.From.Unix() {
+			continue
+		}
+		if !opts.To.IsZero() && tx.Timestamp > opts.To.Unix() {
+			continue
+		}
+		if opts.Account != AllAccounts && !involvesAccount(tx, opts.Account) {
+			continue
+		}
+
+		rows = append(rows, &Row{
+			Asset:       wallet.GetAssetType(),
+			Wallet:      wallet.GetWalletName(),
+			Hash:        tx.Hash,
+			Timestamp:   tx.Timestamp,
+			Type:        tx.Type,
+			Direction:   txhelper.TxDirectionString(tx.Direction),
+			Amount:      wallet.ToAmount(tx.Amount).ToCoin(),
+			Fee:         wallet.ToAmount(tx.Fee).ToCoin(),
+			Label:       tx.Label,
+			BlockHeight: tx.BlockHeight,
+			Addresses:   counterpartyAddresses(tx),
+			sent:        tx.Direction == txhelper.TxDirectionSent,
+		})
+		timestamps = append(timestamps, tx.Timestamp)
+	}
+
+	if opts.FiatCurrency == "" || len(rows) == 0 {
+		return rows, nil
+	}
+
+	// Rows are still exported if the prices are unavailable, without the
+	// fiat values that could not be filled in.
+	prices, err := e.DailyPrices(ctx, wallet.GetAssetType(), opts.FiatCurrency, timestamps)
+	if err != nil {
+		log.Errorf("Error getting %s historical prices: %v", wallet.GetAssetType(), err)
+	}
+	for _, row := range rows {
+		if price, ok := prices[DayStart(row.Timestamp)]; ok {
+			row.FiatValue = row.Amount * price
+			row.FiatCurrency = opts.FiatCurrency
+		}
+	}
+	return rows, nil
+}
+
+// involvesAccount returns true if the transaction spends from or pays to the
+// account.
+func involvesAccount(tx *sharedW.Transaction, account int32) bool {
+	for _, input := range tx.Inputs {
+		if input.AccountNumber == account {
+			return true
+		}
+	}
+	for _, output := range tx.Outputs {
+		if output.AccountNumber == account {
+			return true
+		}
+	}
+	return false
+}
+
+// counterpartyAddresses returns the addresses that were paid outside the
+// wallet for sent transactions and the wallet addresses that were paid
+// otherwise.
+func counterpartyAddresses(tx *sharedW.Transaction) []string {
+	sent := tx.Direction == txhelper.TxDirectionSent
+	var addresses []string
+	for _, output := range tx.Outputs {
+		if output.Address == "" {
+			continue
+		}
+		isWalletOutput := output.AccountNumber != -1
+		if sent != isWalletOutput {
+			addresses = append(addresses, output.Address)
+		}
+	}
+	return addresses
+}
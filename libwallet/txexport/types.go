@@ -0,0 +1,70 @@
+package txexport
+
+import (
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Format is the file format transactions are exported to.
+type Format string
+
+const (
+	CSVFormat  Format = "csv"
+	JSONFormat Format = "json"
+	OFXFormat  Format = "ofx"
+
+	// AllAccounts exports the transactions of every account of the wallets.
+	AllAccounts int32 = -1
+)
+
+// Formats are the supported export formats, in display order.
+var Formats = []Format{CSVFormat, JSONFormat, OFXFormat}
+
+// Options selects the transactions to export and the file format.
+type Options struct {
+	Format Format
+	// From and To bound the timestamps of the exported transactions. A zero
+	// value leaves that side of the range open.
+	From, To time.Time
+	// TxFilter is one of the utils.TxFilter* values.
+	TxFilter int32
+	// Account limits the export to the transactions that spend from or pay to
+	// the account. Only valid when a single wallet is exported.
+	Account int32
+	// FiatCurrency is the currency of the fiat value of each transaction at
+	// the time it was made. Fiat values are not filled in if it is empty.
+	FiatCurrency string
+}
+
+// Row is an exported transaction.
+type Row struct {
+	Asset       utils.AssetType `json:"asset"`
+	Wallet      string          `json:"wallet"`
+	Hash        string          `json:"hash"`
+	Timestamp   int64           `json:"timestamp"`
+	Type        string          `json:"type"`
+	Direction   string          `json:"direction"`
+	Amount      float64         `json:"amount"`
+	Fee         float64         `json:"fee"`
+	Label       string          `json:"label,omitempty"`
+	BlockHeight int32           `json:"block_height"`
+	// Addresses are the addresses paid by the wallet for sent transactions and
+	// the wallet addresses paid for received and transferred transactions.
+	Addresses []string `json:"addresses,omitempty"`
+
+	FiatValue    float64 `json:"fiat_value,omitempty"`
+	FiatCurrency string  `json:"fiat_currency,omitempty"`
+
+	// sent is true if the amount left the wallet.
+	sent bool
+}
+
+// HistoricalPrice is the cached daily price of an asset in a fiat currency.
+type HistoricalPrice struct {
+	ID       string          `storm:"id"` // asset, currency and day.
+	Asset    utils.AssetType `storm:"index"`
+	Currency string
+	Day      int64 // unix timestamp of the start of the UTC day.
+	Price    float64
+}
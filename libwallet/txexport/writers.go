@@ -0,0 +1,153 @@
+package txexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ofxTimeFormat = "20060102150405"
+	coinFormat    = 'f'
+	coinPrecision = 8
+)
+
+func formatCoin(amount float64) string {
+	return strconv.FormatFloat(amount, coinFormat, coinPrecision, 64)
+}
+
+func writeCSV(w io.Writer, rows []*Row, fiatCurrency string) error {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = runtime.GOOS == "windows"
+
+	headers := []string{"Time", "Asset", "Wallet", "Hash", "Type", "Direction", "Amount", "Fee", "Label", "Addresses", "Block height"}
+	if fiatCurrency != "" {
+		headers = append(headers, fmt.Sprintf("Value (%s)", fiatCurrency))
+	}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("csv.Writer.Write error: %w", err)
+	}
+
+	for _, row := range rows {
+		record := []string{
+			time.Unix(row.Timestamp, 0).UTC().Format(time.RFC3339),
+			string(row.Asset),
+			row.Wallet,
+			row.Hash,
+			row.Type,
+			row.Direction,
+			formatCoin(row.Amount),
+			formatCoin(row.Fee),
+			row.Label,
+			strings.Join(row.Addresses, " "),
+			strconv.Itoa(int(row.BlockHeight)),
+		}
+		if fiatCurrency != "" {
+			fiatValue := ""
+			if row.FiatCurrency != "" {
+				fiatValue = strconv.FormatFloat(row.FiatValue, 'f', 2, 64)
+			}
+			record = append(record, fiatValue)
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("csv.Writer.Write error: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("csv.Writer error: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(w io.Writer, rows []*Row) error {
+	if rows == nil {
+		rows = []*Row{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(rows); err != nil {
+		return fmt.Errorf("json.Encoder.Encode error: %w", err)
+	}
+	return nil
+}
+
+// writeOFX writes an OFX 1.0.2 statement for each wallet. The asset's ticker
+// is used as the statement currency and the wallet name as the account ID.
+func writeOFX(w io.Writer, rows []*Row) error {
+	var b strings.Builder
+	now := time.Now().UTC().Format(ofxTimeFormat)
+
+	b.WriteString("OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102\r\nSECURITY:NONE\r\n")
+	b.WriteString("ENCODING:UTF-8\r\nCHARSET:NONE\r\nCOMPRESSION:NONE\r\nOLDFILEUID:NONE\r\nNEWFILEUID:NONE\r\n\r\n")
+	b.WriteString("<OFX>\n<SIGNONMSGSRSV1><SONRS>\n<STATUS><CODE>0<SEVERITY>INFO</STATUS>\n")
+	fmt.Fprintf(&b, "<DTSERVER>%s\n<LANGUAGE>ENG\n</SONRS></SIGNONMSGSRSV1>\n<BANKMSGSRSV1>\n", now)
+
+	for start := 0; start < len(rows); {
+		// Rows are grouped by wallet.
+		end := start + 1
+		for end < len(rows) && rows[end].Wallet == rows[start].Wallet && rows[end].Asset == rows[start].Asset {
+			end++
+		}
+		statement := rows[start:end]
+		start = end
+
+		fmt.Fprintf(&b, "<STMTTRNRS>\n<TRNUID>0\n<STATUS><CODE>0<SEVERITY>INFO</STATUS>\n<STMTRS>\n<CURDEF>%s\n", statement[0].Asset)
+		fmt.Fprintf(&b, "<BANKACCTFROM><BANKID>%s<ACCTID>%s<ACCTTYPE>CHECKING</BANKACCTFROM>\n", statement[0].Asset, ofxEscape(statement[0].Wallet))
+		fmt.Fprintf(&b, "<BANKTRANLIST>\n<DTSTART>%s\n<DTEND>%s\n",
+			time.Unix(statement[len(statement)-1].Timestamp, 0).UTC().Format(ofxTimeFormat),
+			time.Unix(statement[0].Timestamp, 0).UTC().Format(ofxTimeFormat))
+
+		for _, row := range statement {
+			trnType, amount := "CREDIT", row.Amount
+			if row.sent {
+				trnType, amount = "DEBIT", -row.Amount
+			}
+
+			memo := row.Type
+			if row.Label != "" {
+				memo += " - " + row.Label
+			}
+			if row.FiatCurrency != "" {
+				memo += fmt.Sprintf(" (%.2f %s)", row.FiatValue, row.FiatCurrency)
+			}
+
+			b.WriteString("<STMTTRN>\n")
+			fmt.Fprintf(&b, "<TRNTYPE>%s\n<DTPOSTED>%s\n<TRNAMT>%s\n<FITID>%s\n",
+				trnType, time.Unix(row.Timestamp, 0).UTC().Format(ofxTimeFormat), formatCoin(amount), row.Hash)
+			if len(row.Addresses) > 0 {
+				fmt.Fprintf(&b, "<NAME>%s\n", ofxEscape(truncate(row.Addresses[0], 32)))
+			}
+			fmt.Fprintf(&b, "<MEMO>%s\n</STMTTRN>\n", ofxEscape(truncate(memo, 255)))
+		}
+
+		b.WriteString("</BANKTRANLIST>\n</STMTRS>\n</STMTTRNRS>\n")
+	}
+
+	b.WriteString("</BANKMSGSRSV1>\n</OFX>\n")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("write error: %w", err)
+	}
+	return nil
+}
+
+var ofxReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func ofxEscape(s string) string {
+	return ofxReplacer.Replace(s)
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
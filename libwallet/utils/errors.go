@@ -62,6 +62,7 @@ var (
 	ErrStakingAccountsMissing  = errors.New("Mixing and Unmixing Accounts are not set")
 
 	ErrTicketPurchaseAccMissing = errors.New("ticket purchase account is not set")
+	ErrTicketStatusUnsupported  = errors.New("ticket statuses are only supported by DCR wallets")
 )
 
 // todo, should update this method to translate more error kinds.
@@ -14,8 +14,12 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/balancehistory"
+	"github.com/crypto-power/cryptopower/libwallet/dexorders"
 	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/libwallet/portfolio"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/logger"
 	"github.com/crypto-power/cryptopower/ui"
@@ -147,6 +151,10 @@ func init() {
 	dcrw.UseLogger(dcrLog)
 	spv.UseLogger(dcrSpv)
 	instantswap.UseLogger(sharedWLog)
+	dexorders.UseLogger(sharedWLog)
+	portfolio.UseLogger(sharedWLog)
+	txexport.UseLogger(sharedWLog)
+	balancehistory.UseLogger(sharedWLog)
 	dcrdex.UseLogger(winLog)
 	account.UseLogger(winLog)
 	wallet.UseLogger(winLog)
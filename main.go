@@ -17,6 +17,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui"
 	_ "github.com/crypto-power/cryptopower/ui/assets"
 	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/values"
 )
 
 const (
@@ -101,11 +102,29 @@ func main() {
 		return
 	}
 
+	// Translation files in the app data dir override the built in strings.
+	if err := values.LoadTranslations(filepath.Join(cfg.HomeDir, "locales")); err != nil {
+		log.Errorf("Error loading translation files: %v", err)
+	}
+	for _, lang := range values.Languages {
+		if missing := values.MissingKeys(lang); len(missing) > 0 {
+			log.Debugf("%d strings are not translated in %q", len(missing), lang)
+		}
+	}
+
+	// Palette files in the app data dir add to the built in theme palettes.
+	if err := values.LoadPalettes(filepath.Join(cfg.HomeDir, "themes")); err != nil {
+		log.Errorf("Error loading theme palettes: %v", err)
+	}
+
 	win, err := ui.CreateWindow(appInfo)
 	if err != nil {
 		log.Errorf("Could not initialize window: %s\ns", err)
 		return
 	}
+	if cfg.AuditSemantics {
+		win.EnableSemanticsAudit()
+	}
 
 	go func() {
 		// Wait until we receive the shutdown request.
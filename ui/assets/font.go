@@ -15,6 +15,9 @@ import (
 	"golang.org/x/image/font/gofont/goregular"
 )
 
+// arabicTypeface is the typeface of the font of the Arabic script.
+const arabicTypeface = "Amiri"
+
 var (
 	once       sync.Once
 	collection []font.FontFace
@@ -52,6 +55,10 @@ func FontCollection() []font.FontFace {
 		boldItalic = gobolditalic.TTF
 	}
 
+	// Amiri covers the Arabic script of the right-to-left languages. The
+	// shaper uses it for the glyphs missing in the other fonts.
+	arabic, arabicErr := getFontByte("fonts/amiri_regular.ttf")
+
 	once.Do(func() {
 		register(font.Font{}, regular)
 		register(font.Font{Style: font.Italic}, regularItalic)
@@ -59,6 +66,9 @@ func FontCollection() []font.FontFace {
 		register(font.Font{Style: font.Italic, Weight: font.Bold}, boldItalic)
 		register(font.Font{Weight: font.Medium}, semibold)
 		register(font.Font{Weight: font.Medium, Style: font.Italic}, semiboldItalic)
+		if arabicErr == nil {
+			registerTypeface(arabicTypeface, font.Font{}, arabic)
+		}
 		// Ensure that any outside appends will not reuse the backing store.
 		n := len(collection)
 		collection = collection[:n:n]
@@ -67,11 +77,15 @@ func FontCollection() []font.FontFace {
 }
 
 func register(fnt font.Font, fontByte []byte) {
+	registerTypeface("Go", fnt, fontByte)
+}
+
+func registerTypeface(typeface font.Typeface, fnt font.Font, fontByte []byte) {
 	face, err := opentype.Parse(fontByte)
 	if err != nil {
 		panic(fmt.Errorf("failed to parse font: %v", err))
 	}
-	fnt.Typeface = "Go"
+	fnt.Typeface = typeface
 	collection = append(collection, font.FontFace{Font: fnt, Face: face})
 }
 
@@ -44,6 +44,8 @@ type IconButtonStyle struct {
 	Size   unit.Dp
 	Inset  layout.Inset
 	Button *widget.Clickable
+	// Description is read by screen readers in place of the icon.
+	Description string
 }
 
 type IconButton struct {
@@ -203,7 +205,7 @@ func (b Button) buttonStyleLayout(gtx layout.Context, w layout.Widget) layout.Di
 			if !b.Enabled() {
 				b.setDisabledColors()
 				background = b.disabledBackground
-			} else if b.clickable.Hovered() && !b.isDisableHoverColor {
+			} else if (b.clickable.Hovered() || gtx.Focused(b.clickable)) && !b.isDisableHoverColor {
 				background = Hovered(b.HighlightColor)
 			}
 
@@ -225,6 +227,7 @@ func (b Button) buttonStyleLayout(gtx layout.Context, w layout.Widget) layout.Di
 
 			return b.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 				semantic.Button.Add(gtx.Ops)
+				semantic.DescriptionOp(b.Text).Add(gtx.Ops)
 				return layout.Dimensions{Size: gtx.Constraints.Min}
 			})
 		}),
@@ -245,12 +248,13 @@ func (ib IconButton) ChangeColorStyle(colorStyle *values.ColorStyle) {
 
 func (ib IconButton) Layout(gtx layout.Context) layout.Dimensions {
 	ibs := material.IconButtonStyle{
-		Background: ib.colorStyle.Background,
-		Color:      ib.colorStyle.Foreground,
-		Icon:       ib.Icon,
-		Size:       ib.Size,
-		Inset:      ib.Inset,
-		Button:     ib.Button,
+		Background:  ib.colorStyle.Background,
+		Color:       ib.colorStyle.Foreground,
+		Icon:        ib.Icon,
+		Size:        ib.Size,
+		Inset:       ib.Inset,
+		Button:      ib.Button,
+		Description: ib.Description,
 	}
 	return ibs.Layout(gtx)
 }
@@ -0,0 +1,404 @@
+package cryptomaterial
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const (
+	// minCandleSlotWidth is the smallest width in dp of a candle and the
+	// space around it, older candles that don't fit are not drawn.
+	minCandleSlotWidth = 6
+	// volumeAreaRatio is the share of the candle area height used to draw
+	// the volume bars.
+	volumeAreaRatio = 0.2
+)
+
+// ChartCandle is an OHLCV candlestick of a CandleChart.
+type ChartCandle struct {
+	Start, End             time.Time
+	Open, High, Low, Close float64
+	Volume                 float64
+}
+
+// DepthPoint is a price level of a depth chart, Volume is the total volume
+// of the orders from the best price up to this price.
+type DepthPoint struct {
+	Price, Volume float64
+}
+
+// chartCursor tracks the pointer over an area of the chart.
+type chartCursor struct {
+	pos    f32.Point
+	active bool
+}
+
+func (cc *chartCursor) update(gtx C) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{
+			Target: cc,
+			Kinds:  pointer.Move | pointer.Enter | pointer.Leave,
+		})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok {
+			switch e.Kind {
+			case pointer.Move, pointer.Enter:
+				cc.active = true
+				cc.pos = e.Position
+			case pointer.Leave, pointer.Cancel:
+				cc.active = false
+			}
+			gtx.Execute(op.InvalidateCmd{})
+		}
+	}
+}
+
+func (cc *chartCursor) layout(gtx C, size image.Point) {
+	cc.update(gtx)
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	event.Op(gtx.Ops, cc)
+}
+
+// CandleChart draws a candlestick chart of a market's trades next to the
+// depth chart of its order book. Hovering over either chart shows a
+// crosshair and the values under the pointer. The chart data may be set from
+// any goroutine.
+type CandleChart struct {
+	t *Theme
+
+	mtx         sync.Mutex
+	candles     []ChartCandle
+	buys, sells []DepthPoint
+
+	candleCursor chartCursor
+	depthCursor  chartCursor
+
+	// Height is the height of the chart.
+	Height unit.Dp
+	// Vertical draws the depth chart below the candles rather than next to
+	// them.
+	Vertical bool
+	// FormatValue formats the prices and volumes of the readouts.
+	FormatValue func(float64) string
+}
+
+func (t *Theme) CandleChart() *CandleChart {
+	return &CandleChart{
+		t:      t,
+		Height: values.MarginPadding300,
+		FormatValue: func(v float64) string {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		},
+	}
+}
+
+// SetCandles sets the candles of the chart, oldest first.
+func (c *CandleChart) SetCandles(candles []ChartCandle) {
+	c.mtx.Lock()
+	c.candles = candles
+	c.mtx.Unlock()
+}
+
+// SetDepth sets the depth chart points. buys are sorted by decreasing price
+// and sells by increasing price.
+func (c *CandleChart) SetDepth(buys, sells []DepthPoint) {
+	c.mtx.Lock()
+	c.buys, c.sells = buys, sells
+	c.mtx.Unlock()
+}
+
+func (c *CandleChart) upColor() color.NRGBA {
+	return c.t.Color.GreenText
+}
+
+func (c *CandleChart) downColor() color.NRGBA {
+	return c.t.Color.OrangeRipple
+}
+
+func (c *CandleChart) Layout(gtx C) D {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height := gtx.Dp(c.Height)
+	width := gtx.Constraints.Max.X
+	gap := gtx.Dp(values.MarginPadding10)
+
+	if c.Vertical {
+		candleHeight := (height - gap) * 2 / 3
+		candleSize := image.Pt(width, candleHeight)
+		depthSize := image.Pt(width, height-gap-candleHeight)
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D { return c.layoutCandles(gtx, candleSize) }),
+			layout.Rigid(layout.Spacer{Height: values.MarginPadding10}.Layout),
+			layout.Rigid(func(gtx C) D { return c.layoutDepth(gtx, depthSize) }),
+		)
+	}
+
+	depthWidth := (width - gap) * 3 / 10
+	candleSize := image.Pt(width-gap-depthWidth, height)
+	depthSize := image.Pt(depthWidth, height)
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		layout.Rigid(func(gtx C) D { return c.layoutCandles(gtx, candleSize) }),
+		layout.Rigid(layout.Spacer{Width: values.MarginPadding10}.Layout),
+		layout.Rigid(func(gtx C) D { return c.layoutDepth(gtx, depthSize) }),
+	)
+}
+
+// visibleCandles returns the most recent candles that fit the width and the
+// width of the slot of each candle.
+func (c *CandleChart) visibleCandles(gtx C, width int) ([]ChartCandle, float32) {
+	maxCandles := width / gtx.Dp(minCandleSlotWidth)
+	candles := c.candles
+	if maxCandles > 0 && len(candles) > maxCandles {
+		candles = candles[len(candles)-maxCandles:]
+	}
+	if len(candles) == 0 {
+		return nil, 0
+	}
+	return candles, float32(width) / float32(len(candles))
+}
+
+func (c *CandleChart) layoutCandles(gtx C, size image.Point) D {
+	candles, slotWidth := c.visibleCandles(gtx, size.X)
+	if len(candles) == 0 {
+		return c.layoutNoData(gtx, size)
+	}
+
+	high, low, maxVolume := candles[0].High, candles[0].Low, 0.0
+	for _, candle := range candles {
+		high = math.Max(high, candle.High)
+		low = math.Min(low, candle.Low)
+		maxVolume = math.Max(maxVolume, candle.Volume)
+	}
+	if high == low {
+		high, low = high*1.01, low*0.99
+	}
+
+	priceHeight := float32(size.Y) * (1 - volumeAreaRatio)
+	volumeHeight := float32(size.Y) * volumeAreaRatio
+	priceY := func(price float64) float32 {
+		return float32((high-price)/(high-low)) * priceHeight
+	}
+
+	bodyWidth := float32(math.Max(float64(slotWidth)*0.7, 1))
+	wickWidth := float32(gtx.Dp(values.MarginPadding1))
+	for i, candle := range candles {
+		col := c.upColor()
+		if candle.Close < candle.Open {
+			col = c.downColor()
+		}
+		center := slotWidth*float32(i) + slotWidth/2
+
+		fillRect(gtx, col, center-wickWidth/2, priceY(candle.High), center+wickWidth/2, priceY(candle.Low))
+		top, bottom := priceY(math.Max(candle.Open, candle.Close)), priceY(math.Min(candle.Open, candle.Close))
+		fillRect(gtx, col, center-bodyWidth/2, top, center+bodyWidth/2, float32(math.Max(float64(bottom), float64(top+1))))
+
+		if maxVolume > 0 {
+			barHeight := float32(candle.Volume/maxVolume) * volumeHeight
+			volCol := col
+			volCol.A = 80
+			fillRect(gtx, volCol, center-bodyWidth/2, float32(size.Y)-barHeight, center+bodyWidth/2, float32(size.Y))
+		}
+	}
+
+	c.layoutLabel(gtx, c.FormatValue(high), image.Pt(size.X, 0), layout.NE)
+	c.layoutLabel(gtx, c.FormatValue(low), image.Pt(size.X, int(priceHeight)), layout.SE)
+
+	c.candleCursor.layout(gtx, size)
+	if pos := c.candleCursor.pos; c.candleCursor.active && pos.X >= 0 && pos.X < float32(size.X) {
+		idx := min(int(pos.X/slotWidth), len(candles)-1)
+		candle := candles[idx]
+		center := slotWidth*float32(idx) + slotWidth/2
+		c.drawCrosshair(gtx, size, center, pos.Y)
+
+		readout := fmt.Sprintf("%s  O %s  H %s  L %s  C %s  V %s", candle.Start.Format("2006-01-02 15:04"),
+			c.FormatValue(candle.Open), c.FormatValue(candle.High), c.FormatValue(candle.Low),
+			c.FormatValue(candle.Close), c.FormatValue(candle.Volume))
+		c.layoutLabel(gtx, readout, image.Point{}, layout.NW)
+		if pos.Y < priceHeight {
+			cursorPrice := high - float64(pos.Y/priceHeight)*(high-low)
+			c.layoutLabel(gtx, c.FormatValue(cursorPrice), image.Pt(size.X, int(pos.Y)), layout.E)
+		}
+	}
+
+	return D{Size: size}
+}
+
+func (c *CandleChart) layoutDepth(gtx C, size image.Point) D {
+	if len(c.buys) == 0 && len(c.sells) == 0 {
+		return c.layoutNoData(gtx, size)
+	}
+
+	var minPrice, maxPrice, maxVolume float64
+	if len(c.buys) > 0 {
+		minPrice, maxPrice = c.buys[len(c.buys)-1].Price, c.buys[0].Price
+		maxVolume = c.buys[len(c.buys)-1].Volume
+	}
+	if len(c.sells) > 0 {
+		if len(c.buys) == 0 {
+			minPrice = c.sells[0].Price
+		}
+		maxPrice = c.sells[len(c.sells)-1].Price
+		maxVolume = math.Max(maxVolume, c.sells[len(c.sells)-1].Volume)
+	}
+	if maxPrice == minPrice {
+		maxPrice, minPrice = maxPrice*1.01, minPrice*0.99
+	}
+	if maxVolume == 0 {
+		maxVolume = 1
+	}
+
+	priceX := func(price float64) float32 {
+		return float32((price-minPrice)/(maxPrice-minPrice)) * float32(size.X)
+	}
+	volumeY := func(volume float64) float32 {
+		return float32(size.Y) - float32(volume/maxVolume)*float32(size.Y)
+	}
+
+	c.drawDepthSide(gtx, c.buys, priceX, volumeY, 0, float32(size.Y), c.upColor())
+	c.drawDepthSide(gtx, c.sells, priceX, volumeY, float32(size.X), float32(size.Y), c.downColor())
+
+	c.layoutLabel(gtx, c.FormatValue(minPrice), image.Pt(0, size.Y), layout.SW)
+	c.layoutLabel(gtx, c.FormatValue(maxPrice), image.Pt(size.X, size.Y), layout.SE)
+
+	c.depthCursor.layout(gtx, size)
+	if pos := c.depthCursor.pos; c.depthCursor.active && pos.X >= 0 && pos.X < float32(size.X) {
+		c.drawCrosshair(gtx, size, pos.X, pos.Y)
+		price := minPrice + float64(pos.X/float32(size.X))*(maxPrice-minPrice)
+		readout := fmt.Sprintf("%s %s  %s %s", values.String(values.StrPrice), c.FormatValue(price),
+			values.String(values.StrVolume), c.FormatValue(depthVolumeAt(c.buys, c.sells, price)))
+		c.layoutLabel(gtx, readout, image.Point{}, layout.NW)
+	}
+
+	return D{Size: size}
+}
+
+// depthVolumeAt returns the volume of the orders needed to move the price of
+// the market to the provided price.
+func depthVolumeAt(buys, sells []DepthPoint, price float64) float64 {
+	var volume float64
+	for _, point := range buys {
+		if point.Price < price {
+			break
+		}
+		volume = point.Volume
+	}
+	for _, point := range sells {
+		if point.Price > price {
+			break
+		}
+		volume = point.Volume
+	}
+	return volume
+}
+
+// drawDepthSide draws the step area of one side of the order book. The area
+// is extended horizontally to edgeX, the chart edge past the worst price.
+func (c *CandleChart) drawDepthSide(gtx C, points []DepthPoint, priceX func(float64) float32,
+	volumeY func(float64) float32, edgeX, baseY float32, col color.NRGBA) {
+	if len(points) == 0 {
+		return
+	}
+
+	stepPath := func(path *clip.Path) {
+		prevY := baseY
+		for i, point := range points {
+			x := priceX(point.Price)
+			if i == 0 {
+				path.MoveTo(f32.Pt(x, baseY))
+			} else {
+				path.LineTo(f32.Pt(x, prevY))
+			}
+			prevY = volumeY(point.Volume)
+			path.LineTo(f32.Pt(x, prevY))
+		}
+		path.LineTo(f32.Pt(edgeX, prevY))
+	}
+
+	var area clip.Path
+	area.Begin(gtx.Ops)
+	stepPath(&area)
+	area.LineTo(f32.Pt(edgeX, baseY))
+	area.Close()
+	areaCol := col
+	areaCol.A = 60
+	paint.FillShape(gtx.Ops, areaCol, clip.Outline{Path: area.End()}.Op())
+
+	var line clip.Path
+	line.Begin(gtx.Ops)
+	stepPath(&line)
+	paint.FillShape(gtx.Ops, col, clip.Stroke{Path: line.End(), Width: float32(gtx.Dp(values.MarginPadding1))}.Op())
+}
+
+func (c *CandleChart) drawCrosshair(gtx C, size image.Point, x, y float32) {
+	col := c.t.Color.GrayText3
+	fillRect(gtx, col, x, 0, x+1, float32(size.Y))
+	fillRect(gtx, col, 0, y, float32(size.X), y+1)
+}
+
+func (c *CandleChart) layoutLabel(gtx C, txt string, anchor image.Point, direction layout.Direction) {
+	layoutChartLabel(gtx, c.t, txt, anchor, direction)
+}
+
+func (c *CandleChart) layoutNoData(gtx C, size image.Point) D {
+	return layoutChartNoData(gtx, c.t, size)
+}
+
+// layoutChartLabel draws a label at the anchor point, aligned with the
+// direction the label extends from it.
+func layoutChartLabel(gtx C, t *Theme, txt string, anchor image.Point, direction layout.Direction) {
+	lbl := t.Label(values.TextSize12, txt)
+	lbl.Color = t.Color.GrayText2
+
+	macro := op.Record(gtx.Ops)
+	gtx.Constraints.Min = image.Point{}
+	dims := lbl.Layout(gtx)
+	call := macro.Stop()
+
+	offset := anchor
+	switch direction {
+	case layout.NE, layout.E, layout.SE:
+		offset.X -= dims.Size.X
+	}
+	switch direction {
+	case layout.SW, layout.SE:
+		offset.Y -= dims.Size.Y
+	case layout.E:
+		offset.Y -= dims.Size.Y / 2
+	}
+
+	defer op.Offset(offset).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+}
+
+func layoutChartNoData(gtx C, t *Theme, size image.Point) D {
+	gtx.Constraints = layout.Exact(size)
+	return layout.Center.Layout(gtx, func(gtx C) D {
+		lbl := t.Label(values.TextSize14, values.String(values.StrNoChartData))
+		lbl.Color = t.Color.GrayText3
+		return lbl.Layout(gtx)
+	})
+}
+
+func fillRect(gtx C, col color.NRGBA, x0, y0, x1, y1 float32) {
+	rect := image.Rect(int(x0), int(y0), int(x1+0.5), int(y1+0.5))
+	paint.FillShape(gtx.Ops, col, clip.Rect(rect).Op())
+}
@@ -3,6 +3,7 @@ package cryptomaterial
 import (
 	"image"
 
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -17,6 +18,9 @@ type Clickable struct {
 	Hoverable bool
 	Radius    CornerRadius
 	isEnabled bool
+	// Description is read by screen readers if the clickable has no text,
+	// such as a clickable icon.
+	Description string
 }
 
 func (t *Theme) NewClickable(hoverable bool) *Clickable {
@@ -66,6 +70,10 @@ func (cl *Clickable) Enabled() bool {
 // was applied to w.
 func (cl *Clickable) LayoutWithInset(gtx C, w layout.Widget, rightInset, bottomInset unit.Dp) D {
 	return cl.button.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		semantic.Button.Add(gtx.Ops)
+		if cl.Description != "" {
+			semantic.DescriptionOp(cl.Description).Add(gtx.Ops)
+		}
 		return layout.Stack{}.Layout(gtx,
 			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
 				// Only hover on a widget, ignore inset or margin applied.
@@ -86,7 +94,7 @@ func (cl *Clickable) LayoutWithInset(gtx C, w layout.Widget, rightInset, bottomI
 				}.Push(gtx.Ops).Pop()
 				clip.Rect{Max: gtx.Constraints.Min}.Push(gtx.Ops).Pop()
 
-				if cl.Hoverable && cl.button.Hovered() {
+				if cl.Hoverable && cl.button.Hovered() || gtx.Focused(cl.button) {
 					paint.Fill(gtx.Ops, cl.style.HoverColor)
 				}
 
@@ -0,0 +1,110 @@
+package cryptomaterial
+
+import (
+	"gioui.org/f32"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// SetLanguage sets the locale of the theme. The layouts are mirrored if the
+// language is written right-to-left. SwitchDarkMode or SwitchPalette must be
+// called after it to update the icons.
+func (t *Theme) SetLanguage(lang string) {
+	t.locale = system.Locale{Language: lang, Direction: system.LTR}
+	if values.IsRTL(lang) {
+		t.locale.Direction = system.RTL
+	}
+}
+
+// Locale returns the locale of the theme. It is set on the layout context of
+// each frame, so that text is aligned in the direction of the language.
+func (t *Theme) Locale() system.Locale {
+	return t.locale
+}
+
+// IsRTL returns true if the theme language is written right-to-left.
+func (t *Theme) IsRTL() bool {
+	return t.locale.Direction.Progression() == system.TowardOrigin
+}
+
+// IsRTL returns true if gtx lays out right-to-left.
+func IsRTL(gtx C) bool {
+	return gtx.Locale.Direction.Progression() == system.TowardOrigin
+}
+
+// MirrorDirection swaps the left and right sides of the direction if gtx lays
+// out right-to-left.
+func MirrorDirection(gtx C, direction layout.Direction) layout.Direction {
+	if !IsRTL(gtx) {
+		return direction
+	}
+	switch direction {
+	case layout.W:
+		return layout.E
+	case layout.E:
+		return layout.W
+	case layout.NW:
+		return layout.NE
+	case layout.NE:
+		return layout.NW
+	case layout.SW:
+		return layout.SE
+	case layout.SE:
+		return layout.SW
+	}
+	return direction
+}
+
+// MirrorInset swaps the left and right insets if gtx lays out right-to-left.
+func MirrorInset(gtx C, inset layout.Inset) layout.Inset {
+	if IsRTL(gtx) {
+		inset.Left, inset.Right = inset.Right, inset.Left
+	}
+	return inset
+}
+
+// MirrorFlex returns the children of a horizontal flex in reverse order and
+// swaps its start and end spacing if gtx lays out right-to-left.
+func MirrorFlex(gtx C, flex layout.Flex, children []layout.FlexChild) (layout.Flex, []layout.FlexChild) {
+	if !IsRTL(gtx) || flex.Axis != layout.Horizontal {
+		return flex, children
+	}
+
+	switch flex.Spacing {
+	case layout.SpaceStart:
+		flex.Spacing = layout.SpaceEnd
+	case layout.SpaceEnd:
+		flex.Spacing = layout.SpaceStart
+	}
+	mirrored := make([]layout.FlexChild, len(children))
+	for i, child := range children {
+		mirrored[len(children)-1-i] = child
+	}
+	return flex, mirrored
+}
+
+// Mirror flips the widget horizontally if gtx lays out right-to-left. It is
+// used for the images that point to the start or the end of a line.
+func Mirror(gtx C, w layout.Widget) D {
+	if !IsRTL(gtx) {
+		return w(gtx)
+	}
+
+	macro := op.Record(gtx.Ops)
+	dims := w(gtx)
+	call := macro.Stop()
+
+	center := f32.Pt(float32(dims.Size.X)/2, 0)
+	defer op.Affine(f32.Affine2D{}.Scale(center, f32.Pt(-1, 1))).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+	return dims
+}
+
+// LayoutFlex lays out the flex with the children mirrored by MirrorFlex.
+func LayoutFlex(gtx C, flex layout.Flex, children ...layout.FlexChild) D {
+	flex, children = MirrorFlex(gtx, flex, children)
+	return flex.Layout(gtx, children...)
+}
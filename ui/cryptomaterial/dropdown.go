@@ -387,6 +387,9 @@ func (d *DropDown) itemLayout(gtx C, index int, clickable *Clickable, item *Drop
 	if item.Icon != nil {
 		padding = values.MarginPadding8
 	}
+	// The label may be shortened with an ellipsis, screen readers read the
+	// whole text.
+	clickable.Description = item.Text
 
 	return LinearLayout{
 		Width:     MatchParent,
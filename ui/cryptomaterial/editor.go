@@ -50,6 +50,9 @@ type Editor struct {
 
 	// Set ExtraText to show a custom text in the editor.
 	ExtraText string
+	// Description is read by screen readers in place of the hint and the
+	// title, for the editors described by a label of their own.
+	Description string
 	// Bordered if true makes the adds a border around the editor.
 	Bordered bool
 	// isPassword if true, displays the show and hide button.
@@ -102,6 +105,7 @@ func (t *Theme) RestoreEditor(editor *widget.Editor, hint string, title string)
 	e := t.Editor(editor, hint)
 	e.Bordered = false
 	e.SelectionColor = color.NRGBA{}
+	e.Description = values.StringF(values.StrSeedWordN, title)
 	return &RestoreEditor{
 		t:          t,
 		Edit:       &e,
@@ -119,6 +123,7 @@ func (t *Theme) IconEditor(editor *widget.Editor, hint string, icon *widget.Icon
 	e.editorIcon = NewIcon(icon)
 	e.editorIcon.Color = t.Color.Gray1
 	e.editorIconButton.IconButtonStyle.Icon = icon
+	e.editorIconButton.Description = hint
 	return e
 }
 
@@ -452,7 +457,18 @@ func (e *Editor) editor(gtx C) D {
 			return D{}
 		}),
 		layout.Flexed(1, func(gtx C) D {
-			return layout.Inset{Top: e.m5, Bottom: e.m5}.Layout(gtx, e.EditorStyle.Layout)
+			return layout.Inset{Top: e.m5, Bottom: e.m5}.Layout(gtx, func(gtx C) D {
+				// The hint is cleared while the editor is focused, the title
+				// label keeps it.
+				description := e.Hint
+				if e.TitleLabel.Text != "" {
+					description = e.TitleLabel.Text
+				}
+				if e.Description != "" {
+					description = e.Description
+				}
+				return Describe(gtx, description, e.EditorStyle.Layout)
+			})
 		}),
 		layout.Rigid(func(gtx C) D {
 			if e.ExtraText == "" {
@@ -470,8 +486,10 @@ func (e *Editor) editor(gtx C) D {
 				}
 				return inset.Layout(gtx, func(gtx C) D {
 					icon := MustIcon(widget.NewIcon(icons.ActionVisibilityOff))
+					e.showHidePassword.Description = values.String(values.StrHidePassword)
 					if e.Editor.Mask == '*' {
 						icon = MustIcon(widget.NewIcon(icons.ActionVisibility))
+						e.showHidePassword.Description = values.String(values.StrShowPassword)
 					}
 					e.showHidePassword.Icon = icon
 					return e.showHidePassword.Layout(gtx)
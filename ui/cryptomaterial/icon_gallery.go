@@ -76,6 +76,13 @@ func (i *Icons) StandardMaterialIcons() *Icons {
 	return i
 }
 
+// MirrorIcons swaps the icons that point to the start or the end of a line,
+// for the languages written right-to-left.
+func (i *Icons) MirrorIcons() {
+	i.ChevronLeft, i.ChevronRight = i.ChevronRight, i.ChevronLeft
+	i.NavigationArrowBack, i.NavigationArrowForward = i.NavigationArrowForward, i.NavigationArrowBack
+}
+
 func (i *Icons) DefaultIcons() *Icons {
 	decredIcons := assets.DecredIcons
 
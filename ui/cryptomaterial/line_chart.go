@@ -0,0 +1,149 @@
+package cryptomaterial
+
+import (
+	"image"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// ChartPoint is a value of a LineChart at a point in time.
+type ChartPoint struct {
+	Time  time.Time
+	Value float64
+	// Note is shown after the value when the point is hovered.
+	Note string
+}
+
+// LineChart draws the area under a series of values over time. Hovering over
+// the chart shows the value of the closest point. The points may be set from
+// any goroutine.
+type LineChart struct {
+	t *Theme
+
+	mtx    sync.Mutex
+	points []ChartPoint
+
+	cursor chartCursor
+
+	// Height is the height of the chart.
+	Height unit.Dp
+	// TimeFormat is the layout of the time shown for the hovered point.
+	TimeFormat string
+	// FormatValue formats the values of the labels.
+	FormatValue func(float64) string
+}
+
+func (t *Theme) LineChart() *LineChart {
+	return &LineChart{
+		t:          t,
+		Height:     values.MarginPadding200,
+		TimeFormat: "2006-01-02",
+		FormatValue: func(v float64) string {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		},
+	}
+}
+
+// SetPoints sets the points of the chart, oldest first.
+func (c *LineChart) SetPoints(points []ChartPoint) {
+	c.mtx.Lock()
+	c.points = points
+	c.mtx.Unlock()
+}
+
+func (c *LineChart) Layout(gtx C) D {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(c.Height))
+	if len(c.points) == 0 {
+		return layoutChartNoData(gtx, c.t, size)
+	}
+
+	high, low := c.points[0].Value, c.points[0].Value
+	for _, point := range c.points {
+		high = math.Max(high, point.Value)
+		low = math.Min(low, point.Value)
+	}
+	// The area is drawn from zero unless all values are negative.
+	low = math.Min(low, 0)
+	if high == low {
+		high = low + 1
+	}
+
+	// Leave room at the top for the hovered point's readout.
+	top := float32(gtx.Dp(values.MarginPadding20))
+	plotHeight := float32(size.Y) - top
+	x := func(i int) float32 {
+		if len(c.points) == 1 {
+			return float32(size.X) / 2
+		}
+		return float32(i) * float32(size.X) / float32(len(c.points)-1)
+	}
+	y := func(v float64) float32 {
+		return top + float32((high-v)/(high-low))*plotHeight
+	}
+
+	col := c.t.Color.Primary
+	linePath := func(path *clip.Path) {
+		for i, point := range c.points {
+			if i == 0 {
+				path.MoveTo(f32.Pt(x(i), y(point.Value)))
+				continue
+			}
+			path.LineTo(f32.Pt(x(i), y(point.Value)))
+		}
+	}
+
+	var area clip.Path
+	area.Begin(gtx.Ops)
+	linePath(&area)
+	area.LineTo(f32.Pt(x(len(c.points)-1), y(low)))
+	area.LineTo(f32.Pt(x(0), y(low)))
+	area.Close()
+	areaCol := col
+	areaCol.A = 40
+	paint.FillShape(gtx.Ops, areaCol, clip.Outline{Path: area.End()}.Op())
+
+	var line clip.Path
+	line.Begin(gtx.Ops)
+	linePath(&line)
+	paint.FillShape(gtx.Ops, col, clip.Stroke{Path: line.End(), Width: float32(gtx.Dp(values.MarginPadding2))}.Op())
+
+	layoutChartLabel(gtx, c.t, c.FormatValue(high), image.Pt(size.X, int(top)), layout.NE)
+	layoutChartLabel(gtx, c.t, c.FormatValue(low), image.Pt(size.X, size.Y), layout.SE)
+
+	c.cursor.layout(gtx, size)
+	if pos := c.cursor.pos; c.cursor.active && pos.X >= 0 && pos.X < float32(size.X) {
+		idx := 0
+		if len(c.points) > 1 {
+			idx = int(math.Round(float64(pos.X / float32(size.X) * float32(len(c.points)-1))))
+			idx = max(0, min(idx, len(c.points)-1))
+		}
+		point := c.points[idx]
+		fillRect(gtx, c.t.Color.GrayText3, x(idx), top, x(idx)+1, float32(size.Y))
+
+		dot := float32(gtx.Dp(values.MarginPadding4))
+		center := f32.Pt(x(idx), y(point.Value))
+		dotRect := image.Rect(int(center.X-dot), int(center.Y-dot), int(center.X+dot), int(center.Y+dot))
+		paint.FillShape(gtx.Ops, col, clip.Ellipse(dotRect).Op(gtx.Ops))
+
+		readout := point.Time.Format(c.TimeFormat) + "  " + c.FormatValue(point.Value)
+		if point.Note != "" {
+			readout += " (" + point.Note + ")"
+		}
+		layoutChartLabel(gtx, c.t, readout, image.Point{}, layout.NW)
+	}
+
+	return D{Size: size}
+}
@@ -38,6 +38,7 @@ func (ll LinearLayout) Layout2(gtx C, wdg layout.Widget) D {
 }
 
 func (ll LinearLayout) Layout(gtx C, children ...layout.FlexChild) D {
+	ll, children = ll.mirror(gtx, children)
 	// draw layout direction
 	dims := ll.Direction.Layout(gtx, func(gtx C) D {
 		// draw margin
@@ -64,7 +65,7 @@ func (ll LinearLayout) Layout(gtx C, children ...layout.FlexChild) D {
 							return fill(gtx, background)
 						}
 
-						if ll.Clickable.Hoverable && ll.Clickable.IsHovered() {
+						if ll.Clickable.Hoverable && ll.Clickable.IsHovered() || gtx.Focused(ll.Clickable.button) {
 							background = ll.Clickable.style.HoverColor
 						}
 						fill(gtx, background)
@@ -75,20 +76,15 @@ func (ll LinearLayout) Layout(gtx C, children ...layout.FlexChild) D {
 
 						return ll.Clickable.button.Layout(gtx, func(gtx C) D {
 							semantic.Button.Add(gtx.Ops)
+							if ll.Clickable.Description != "" {
+								semantic.DescriptionOp(ll.Clickable.Description).Add(gtx.Ops)
+							}
 							return D{Size: gtx.Constraints.Min}
 						})
 					}),
 					layout.Stacked(func(gtx C) D {
 						ll.applyDimension(&gtx)
-						return ll.Border.Layout(gtx, func(gtx C) D {
-							// draw padding
-							return ll.Padding.Layout(gtx, func(gtx C) D {
-								// draw layout direction
-								return ll.Direction.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: ll.Orientation, Alignment: ll.Alignment, Spacing: ll.Spacing}.Layout(gtx, children...)
-								})
-							})
-						})
+						return ll.contentLayout(gtx, children...)
 					}),
 				)
 			}
@@ -112,7 +108,30 @@ func (ll LinearLayout) Layout(gtx C, children ...layout.FlexChild) D {
 	return dims
 }
 
+// contentLayout draws the children with the border and padding of the layout.
+// The children of a clickable layout are drawn in an area of their own, like
+// the content of a Clickable, so that their labels describe the clickable to
+// screen readers.
+func (ll LinearLayout) contentLayout(gtx C, children ...layout.FlexChild) D {
+	content := func(gtx C) D {
+		return ll.Border.Layout(gtx, func(gtx C) D {
+			// draw padding
+			return ll.Padding.Layout(gtx, func(gtx C) D {
+				// draw layout direction
+				return ll.Direction.Layout(gtx, func(gtx C) D {
+					return layout.Flex{Axis: ll.Orientation, Alignment: ll.Alignment, Spacing: ll.Spacing}.Layout(gtx, children...)
+				})
+			})
+		})
+	}
+	if ll.Clickable == nil {
+		return content(gtx)
+	}
+	return Describe(gtx, "", content)
+}
+
 func (ll LinearLayout) GradientLayout(gtx C, assetType utils.AssetType, children ...layout.FlexChild) D {
+	ll, children = ll.mirror(gtx, children)
 	// draw layout direction
 	return ll.Direction.Layout(gtx, func(gtx C) D {
 		// draw margin
@@ -164,7 +183,7 @@ func (ll LinearLayout) GradientLayout(gtx C, assetType utils.AssetType, children
 							}
 						}
 
-						if ll.Clickable.Hoverable && ll.Clickable.button.Hovered() {
+						if ll.Clickable.Hoverable && ll.Clickable.button.Hovered() || gtx.Focused(ll.Clickable.button) {
 							fill(gtx, ll.Background)
 						}
 
@@ -174,6 +193,9 @@ func (ll LinearLayout) GradientLayout(gtx C, assetType utils.AssetType, children
 
 						return ll.Clickable.button.Layout(gtx, func(gtx C) D {
 							semantic.Button.Add(gtx.Ops)
+							if ll.Clickable.Description != "" {
+								semantic.DescriptionOp(ll.Clickable.Description).Add(gtx.Ops)
+							}
 
 							return D{
 								Size: gtx.Constraints.Min,
@@ -182,15 +204,7 @@ func (ll LinearLayout) GradientLayout(gtx C, assetType utils.AssetType, children
 					}),
 					layout.Stacked(func(gtx C) D {
 						ll.applyDimension(&gtx)
-						return ll.Border.Layout(gtx, func(gtx C) D {
-							// draw padding
-							return ll.Padding.Layout(gtx, func(gtx C) D {
-								// draw layout direction
-								return ll.Direction.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: ll.Orientation, Alignment: ll.Alignment, Spacing: ll.Spacing}.Layout(gtx, children...)
-								})
-							})
-						})
+						return ll.contentLayout(gtx, children...)
 					}),
 				)
 			}
@@ -209,6 +223,20 @@ func (ll LinearLayout) GradientLayout(gtx C, assetType utils.AssetType, children
 	})
 }
 
+// mirror mirrors the layout and its children if gtx lays out right-to-left.
+func (ll LinearLayout) mirror(gtx C, children []layout.FlexChild) (LinearLayout, []layout.FlexChild) {
+	if !IsRTL(gtx) {
+		return ll, children
+	}
+
+	ll.Direction = MirrorDirection(gtx, ll.Direction)
+	ll.Margin = MirrorInset(gtx, ll.Margin)
+	ll.Padding = MirrorInset(gtx, ll.Padding)
+	flex, children := MirrorFlex(gtx, layout.Flex{Axis: ll.Orientation, Spacing: ll.Spacing}, children)
+	ll.Spacing = flex.Spacing
+	return ll, children
+}
+
 func (ll LinearLayout) applyDimension(gtx *C) {
 	if ll.Width == MatchParent {
 		gtx.Constraints.Min.X = gtx.Constraints.Max.X
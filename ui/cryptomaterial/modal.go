@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 
+	"gioui.org/io/key"
 	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/unit"
@@ -111,6 +112,7 @@ func (m *Modal) Layout(gtx C, widgets []layout.Widget, width ...float32) D {
 
 			return m.button.Layout(gtx, func(gtx C) D {
 				semantic.Button.Add(gtx.Ops)
+				semantic.DescriptionOp(values.String(values.StrClose)).Add(gtx.Ops)
 				return D{Size: gtx.Constraints.Min}
 			})
 		}),
@@ -212,14 +214,32 @@ func (m *Modal) Layout(gtx C, widgets []layout.Widget, width ...float32) D {
 	return dims
 }
 
+// BackdropClicked returns true if the modal is minimizable and the backdrop
+// was clicked or the escape key was pressed.
 func (m *Modal) BackdropClicked(gtx C, minimizable bool) bool {
 	if minimizable {
-		return m.button.Clicked(gtx)
+		return m.button.Clicked(gtx) || m.Escaped(gtx)
 	}
 
 	return false
 }
 
+// Escaped returns true if the escape key was pressed. Modals that can only be
+// closed with a button close on it as well.
+func (m *Modal) Escaped(gtx C) bool {
+	escaped := false
+	for {
+		e, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+		if !ok {
+			break
+		}
+		if e, ok := e.(key.Event); ok && e.State == key.Press {
+			escaped = true
+		}
+	}
+	return escaped
+}
+
 func (m *Modal) SetPadding(padding unit.Dp) {
 	m.padding = padding
 }
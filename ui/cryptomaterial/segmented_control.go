@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"gioui.org/font"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/text"
 
@@ -172,6 +173,7 @@ func (sc *SegmentedControl) GroupTileLayout(gtx C) D {
 			return sc.slideActionTitle.DragLayout(gtx, func(gtx C) D {
 				return sc.list.Layout(gtx, len(sc.segmentTitles), func(gtx C, i int) D {
 					isSelectedSegment := sc.SelectedIndex() == i
+					semantic.SelectedOp(isSelectedSegment).Add(gtx.Ops)
 					textSize16 := values.TextSizeTransform(sc.isMobileView, values.TextSize16)
 					return layout.Center.Layout(gtx, func(gtx C) D {
 						bg := sc.theme.Color.SurfaceHighlight
@@ -220,11 +222,13 @@ func (sc *SegmentedControl) splitTileLayout(gtx C) D {
 					Baseline: 0,
 				}
 			}
+			sc.leftNavBtn.Description = values.String(values.StrPrevious)
 			return sc.leftNavBtn.Layout(gtx, sc.theme.NewIcon(sc.theme.Icons.ChevronLeft).Layout24dp)
 		}),
 		layout.Flexed(flexWidthCenter, func(gtx C) D {
 			return sc.list.Layout(gtx, len(sc.segmentTitles), func(gtx C, i int) D {
 				isSelectedSegment := sc.SelectedIndex() == i
+				semantic.SelectedOp(isSelectedSegment).Add(gtx.Ops)
 				return layout.Center.Layout(gtx, func(gtx C) D {
 					bg := sc.theme.Color.Gray2
 					txt := sc.theme.DecoratedText(values.TextSize14, sc.segmentTitles[i], sc.theme.Color.GrayText2, font.SemiBold)
@@ -265,6 +269,7 @@ func (sc *SegmentedControl) splitTileLayout(gtx C) D {
 					Baseline: 0,
 				}
 			}
+			sc.rightNavBtn.Description = values.String(values.StrNext)
 			return sc.rightNavBtn.Layout(gtx, sc.theme.NewIcon(sc.theme.Icons.ChevronRight).Layout24dp)
 		}),
 	)
@@ -285,6 +290,7 @@ func (sc *SegmentedControl) groupTileMaxLayout(gtx C) D {
 			return sc.slideActionTitle.DragLayout(gtx, func(gtx C) D {
 				return sc.list.Layout(gtx, len(sc.segmentTitles), func(gtx C, i int) D {
 					isSelectedSegment := sc.SelectedIndex() == i
+					semantic.SelectedOp(isSelectedSegment).Add(gtx.Ops)
 					return layout.Center.Layout(gtx, func(gtx C) D {
 						bg := sc.theme.Color.SurfaceHighlight
 						txt := sc.theme.DecoratedText(textSize16, sc.segmentTitles[i], sc.theme.Color.GrayText1, font.SemiBold)
@@ -319,6 +325,7 @@ func (sc *SegmentedControl) dynamicSplitTileLayout(gtx C) D {
 	}.Layout2(gtx, func(gtx C) D {
 		return sc.list.Layout(gtx, len(sc.segmentTitles), func(gtx C, i int) D {
 			isSelectedSegment := sc.SelectedIndex() == i
+			semantic.SelectedOp(isSelectedSegment).Add(gtx.Ops)
 			return layout.Center.Layout(gtx, func(gtx C) D {
 				bg := sc.theme.Color.Surface
 				txt := sc.theme.DecoratedText(values.TextSizeTransform(sc.isMobileView, values.TextSize14), sc.segmentTitles[i], sc.theme.Color.GrayText2, font.SemiBold)
@@ -0,0 +1,118 @@
+package cryptomaterial
+
+import (
+	"image"
+
+	"gioui.org/io/input"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// Describe lays out w in an area with the description read by screen
+// readers, for the widgets that draw no text of their own such as editors.
+// The area has the size of w and clips it. If description is empty, the
+// labels of w describe the area.
+func Describe(gtx C, description string, w layout.Widget) D {
+	macro := op.Record(gtx.Ops)
+	dims := w(gtx)
+	call := macro.Stop()
+
+	defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
+	// Areas without semantics are left out of the semantic tree, the
+	// labels of w would then describe the area w is laid out in.
+	semantic.EnabledOp(gtx.Enabled()).Add(gtx.Ops)
+	if description != "" {
+		semantic.DescriptionOp(description).Add(gtx.Ops)
+	}
+	call.Add(gtx.Ops)
+	return dims
+}
+
+// UnlabeledControl is an interactive widget screen readers can't describe
+// because it has no label or description.
+type UnlabeledControl struct {
+	Class  semantic.ClassOp
+	Bounds image.Rectangle
+}
+
+// AuditSemantics lays out w with the metric and constraints of gtx and
+// returns its unlabeled controls. A control is labeled by its own label or
+// description, by those of the widgets it contains or of the area it fills,
+// or by those of the widgets laid out over it such as the content of a
+// clickable LinearLayout.
+//
+// The widget receives no input events while it is audited, so it may be laid
+// out in addition to the frame it is displayed in.
+func AuditSemantics(gtx C, w layout.Widget) []UnlabeledControl {
+	// The widgets only describe the areas of the handlers registered on the
+	// router they are laid out with.
+	var router input.Router
+	gtx.Ops = new(op.Ops)
+	gtx.Source = router.Source()
+	w(gtx)
+	router.Frame(gtx.Ops)
+	nodes := router.AppendSemantics(nil)
+
+	var unlabeled []UnlabeledControl
+	var walk func(node, parent input.SemanticNode)
+	walk = func(node, parent input.SemanticNode) {
+		if isControl(node) && !isLabeled(node) && !isLabeledByParent(node, parent) && !isLabeledBySiblings(node, parent.Children) {
+			unlabeled = append(unlabeled, UnlabeledControl{
+				Class:  node.Desc.Class,
+				Bounds: node.Desc.Bounds,
+			})
+		}
+		for _, child := range node.Children {
+			walk(child, node)
+		}
+	}
+	if len(nodes) > 0 {
+		walk(nodes[0], input.SemanticNode{})
+	}
+	return unlabeled
+}
+
+// isControl returns true if the node is a control screen readers announce.
+func isControl(node input.SemanticNode) bool {
+	switch node.Desc.Class {
+	case semantic.Button, semantic.CheckBox, semantic.Editor, semantic.RadioButton, semantic.Switch:
+		return !node.Desc.Disabled
+	}
+	return false
+}
+
+// isLabeled returns true if the node or one of its descendants has a label
+// or a description.
+func isLabeled(node input.SemanticNode) bool {
+	if node.Desc.Label != "" || node.Desc.Description != "" {
+		return true
+	}
+	for _, child := range node.Children {
+		if isLabeled(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLabeledByParent returns true if the node fills a parent with a label or
+// a description, such as an editor laid out with Describe.
+func isLabeledByParent(node, parent input.SemanticNode) bool {
+	return parent.Desc.Bounds == node.Desc.Bounds && (parent.Desc.Label != "" || parent.Desc.Description != "")
+}
+
+// isLabeledBySiblings returns true if a labeled sibling of the node lies
+// within its bounds.
+func isLabeledBySiblings(node input.SemanticNode, siblings []input.SemanticNode) bool {
+	for _, sibling := range siblings {
+		if sibling.ID == node.ID || isControl(sibling) {
+			continue
+		}
+		if sibling.Desc.Bounds.In(node.Desc.Bounds) && isLabeled(sibling) {
+			return true
+		}
+	}
+	return false
+}
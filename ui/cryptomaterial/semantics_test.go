@@ -0,0 +1,109 @@
+package cryptomaterial_test
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/ui/assets"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+)
+
+type (
+	C = layout.Context
+	D = layout.Dimensions
+)
+
+func TestAuditSemantics(t *testing.T) {
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+
+	iconButton := th.IconButton(th.Icons.NavigationArrowBack)
+	describedIconButton := th.IconButton(th.Icons.NavigationArrowBack)
+	describedIconButton.Description = "back"
+	sw := th.Switch()
+	describedSwitch := th.Switch()
+	describedSwitch.Description = "dark mode"
+	clickable := th.NewClickable(true)
+	describedClickable := th.NewClickable(true)
+	describedClickable.Description = "next"
+	labeledLayout := th.NewClickable(true)
+	iconLayout := th.NewClickable(true)
+	editor := th.Editor(new(widget.Editor), "wallet name")
+	button := th.Button("send")
+
+	tests := []struct {
+		name      string
+		widget    layout.Widget
+		unlabeled int
+	}{{
+		name:   "button",
+		widget: button.Layout,
+	}, {
+		name:      "icon button",
+		widget:    iconButton.Layout,
+		unlabeled: 1,
+	}, {
+		name:   "described icon button",
+		widget: describedIconButton.Layout,
+	}, {
+		name:      "switch",
+		widget:    sw.Layout,
+		unlabeled: 1,
+	}, {
+		name:   "described switch",
+		widget: describedSwitch.Layout,
+	}, {
+		name: "clickable icon",
+		widget: func(gtx C) D {
+			return clickable.Layout(gtx, th.NewIcon(th.Icons.ChevronRight).Layout24dp)
+		},
+		unlabeled: 1,
+	}, {
+		name: "clickable label",
+		widget: func(gtx C) D {
+			return clickable.Layout(gtx, th.Label(th.TextSize, "receive").Layout)
+		},
+	}, {
+		name: "described clickable",
+		widget: func(gtx C) D {
+			return describedClickable.Layout(gtx, th.NewIcon(th.Icons.ChevronRight).Layout24dp)
+		},
+	}, {
+		name: "clickable linear layout",
+		widget: func(gtx C) D {
+			return cryptomaterial.LinearLayout{
+				Width:     cryptomaterial.WrapContent,
+				Height:    cryptomaterial.WrapContent,
+				Clickable: labeledLayout,
+			}.Layout2(gtx, th.Label(th.TextSize, "wallets").Layout)
+		},
+	}, {
+		name: "clickable icon linear layout",
+		widget: func(gtx C) D {
+			return cryptomaterial.LinearLayout{
+				Width:     cryptomaterial.WrapContent,
+				Height:    cryptomaterial.WrapContent,
+				Clickable: iconLayout,
+			}.Layout2(gtx, th.NewIcon(th.Icons.ChevronRight).Layout24dp)
+		},
+		unlabeled: 1,
+	}, {
+		name:   "editor",
+		widget: editor.Layout,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gtx := C{
+				Constraints: layout.Constraints{Max: image.Pt(400, 400)},
+				Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+			}
+			unlabeled := cryptomaterial.AuditSemantics(gtx, test.widget)
+			if len(unlabeled) != test.unlabeled {
+				t.Errorf("expected %d unlabeled controls, got %d: %v", test.unlabeled, len(unlabeled), unlabeled)
+			}
+		})
+	}
+}
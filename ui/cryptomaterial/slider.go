@@ -3,6 +3,7 @@
 package cryptomaterial
 
 import (
+	"fmt"
 	"image"
 	"image/color"
 
@@ -101,6 +102,9 @@ func (s *Slider) sliderItems(items []layout.Widget) []*sliderItem {
 			button:     s.t.NewClickable(false),
 		})
 	}
+	for i, item := range slideItems {
+		item.button.Description = fmt.Sprintf("%d/%d", i+1, len(slideItems))
+	}
 
 	return slideItems
 }
@@ -171,9 +175,11 @@ func (s *Slider) buttonLayout(gtx C) D {
 				Orientation: layout.Horizontal,
 			}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
+					s.prevButton.Description = values.String(values.StrPrevious)
 					return s.prevButton.Layout(gtx, s.t.NewIcon(s.t.Icons.ChevronLeft).Layout20dp)
 				}),
 				layout.Rigid(func(gtx C) D {
+					s.nextButton.Description = values.String(values.StrNext)
 					return s.nextButton.Layout(gtx, s.t.NewIcon(s.t.Icons.ChevronRight).Layout20dp)
 				}),
 			)
@@ -21,6 +21,9 @@ type Switch struct {
 	style    *values.SwitchStyle
 	disabled bool
 	clk      *widget.Bool
+	// Description is read by screen readers, it should name the setting
+	// the switch turns on or off.
+	Description string
 }
 
 type SwitchItem struct {
@@ -116,6 +119,11 @@ func (s *Switch) Layout(gtx layout.Context) layout.Dimensions {
 		return clip.Ellipse(b).Op(dGtx.Ops)
 	}
 
+	// Draw a ring around the thumb while it has the keyboard focus.
+	if dGtx.Focused(s.clk) {
+		paint.FillShape(dGtx.Ops, Hovered(activeColor), circle(thumbRadius, thumbRadius, thumbRadius+dGtx.Dp(4)))
+	}
+
 	// Draw thumb shadow, a translucent disc slightly larger than the
 	// thumb itself.
 	// Center shadow horizontally and slightly adjust its Y.
@@ -135,6 +143,9 @@ func (s *Switch) Layout(gtx layout.Context) layout.Dimensions {
 	defer clip.Ellipse(image.Rectangle{Max: sz}).Push(dGtx.Ops).Pop()
 	s.clk.Layout(dGtx, func(dGtx layout.Context) layout.Dimensions {
 		semantic.Switch.Add(dGtx.Ops)
+		if s.Description != "" {
+			semantic.DescriptionOp(s.Description).Add(dGtx.Ops)
+		}
 		return layout.Dimensions{Size: sz}
 	})
 
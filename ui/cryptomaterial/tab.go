@@ -3,6 +3,7 @@ package cryptomaterial
 import (
 	"image"
 
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -35,6 +36,7 @@ func (tn *Tab) Layout(gtx C) D {
 
 	return tn.list.Layout(gtx, len(tn.tabItems), func(gtx C, i int) D {
 		isSelectedTab := tn.SelectedIndex() == i
+		semantic.SelectedOp(isSelectedTab).Add(gtx.Ops)
 		padding := values.MarginPadding24
 		return layout.Stack{Alignment: layout.Center}.Layout(gtx,
 			layout.Stacked(func(gtx C) D {
@@ -91,6 +93,11 @@ func (tn *Tab) SelectedIndex() int {
 	return tn.selectedIndex
 }
 
+// Count returns the number of tabs.
+func (tn *Tab) Count() int {
+	return len(tn.tabItems)
+}
+
 func (tn *Tab) SelectedTab() string {
 	return tn.tabItems[tn.selectedIndex]
 }
@@ -101,6 +108,18 @@ func (tn *Tab) Changed() bool {
 	return changed
 }
 
+// Select selects the tab at index as if it was clicked. It does nothing if
+// there is no such tab.
+func (tn *Tab) Select(index int) {
+	if index < 0 || index >= len(tn.tabItems) {
+		return
+	}
+	if tn.selectedIndex != index {
+		tn.changed = true
+	}
+	tn.selectedIndex = index
+}
+
 func (tn *Tab) SetSelectedTab(tab string) {
 	for i, item := range tn.tabItems {
 		if item == tab {
@@ -8,6 +8,7 @@ import (
 
 	"gioui.org/io/key"
 	"gioui.org/io/pointer"
+	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -52,6 +53,7 @@ type Theme struct {
 	allEditors   []*Editor
 	backButtons  []*widget.Clickable
 	isDarkModeOn bool
+	locale       system.Locale
 }
 
 func NewTheme(fontCollection []text.FontFace, decredIcons map[string]image.Image, isDarkModeOn bool) *Theme {
@@ -84,6 +86,9 @@ func NewTheme(fontCollection []text.FontFace, decredIcons map[string]image.Image
 func (t *Theme) SwitchDarkMode(isDarkModeOn bool, decredIcons map[string]image.Image) {
 	t.Color = t.Color.DefaultThemeColors()
 	t.Icons.DefaultIcons()
+	if t.IsRTL() {
+		t.Icons.MirrorIcons()
+	}
 	t.isDarkModeOn = isDarkModeOn
 	expandIcon := "ic_expand"
 	collapseIcon := "collapse_icon"
@@ -100,6 +105,14 @@ func (t *Theme) SwitchDarkMode(isDarkModeOn bool, decredIcons map[string]image.I
 	t.updateStyles(isDarkModeOn)
 }
 
+// SwitchPalette switches the theme colors to the palette. The icons are the
+// dark mode icons if the palette is dark.
+func (t *Theme) SwitchPalette(palette *values.Palette, decredIcons map[string]image.Image) {
+	t.SwitchDarkMode(palette.Dark, decredIcons)
+	t.Color = palette.Colors()
+	t.updateStyles(palette.Dark)
+}
+
 // UpdateStyles update the style definition for different widgets. This should
 // be done whenever the base theme changes to ensure that the style definitions
 // use the values for the latest theme.
@@ -15,6 +15,8 @@ import (
 	"github.com/crypto-power/cryptopower/ui/assets"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/notification"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
 )
 
 type NeedUnlockRestore func(bool)
@@ -55,9 +57,23 @@ func NewLoad(appInfo *AppInfo, window *giouiApp.Window) *Load {
 
 func (l *Load) RefreshTheme(window app.WindowNavigator) {
 	isDarkModeOn := l.AssetsManager.IsDarkModeOn()
-	l.Theme.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
+	// The start page shows the selected language before it is saved.
+	l.Theme.SetLanguage(values.UserLanguages[0])
+	l.Theme.SwitchPalette(l.ThemePalette(), assets.DecredIcons)
 	l.DarkModeSettingChanged(isDarkModeOn)
 	l.LanguageSettingChanged()
 	l.CurrencySettingChanged()
 	window.Reload()
 }
+
+// ThemePalette returns the palette of the theme selected by the user.
+func (l *Load) ThemePalette() *values.Palette {
+	return values.ThemePalette(l.AssetsManager.GetTheme(), l.AssetsManager.IsDarkModeOn())
+}
+
+// FormatFiat converts the USD amount to the user's fiat currency and formats it
+// for display.
+func (l *Load) FormatFiat(usdAmt float64) string {
+	amt, currency := l.AssetsManager.ToFiat(usdAmt)
+	return utils.FormatAsFiatString(l.Printer, currency, amt)
+}
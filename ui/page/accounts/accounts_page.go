@@ -149,7 +149,7 @@ func (pg *Page) headerLayout(gtx C) D {
 	return layout.Inset{
 		Top: values.MarginPadding24,
 	}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 			layout.Rigid(func(gtx C) D {
 				txt := pg.Theme.Label(values.TextSize20, values.String(values.StrAccounts))
 				txt.Font.Weight = font.SemiBold
@@ -231,18 +231,18 @@ func (pg *Page) accountBalanceLayout(title string, bal sharedW.AssetAmount, balA
 	balanceTxt := pg.Theme.Label(pg.ConvertTextSize(values.TextSize16), bal.String())
 	balanceTxt.Font.Weight = font.SemiBold
 	return func(gtx C) D {
-		return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 			layout.Rigid(label.Layout), // Title
 			layout.Flexed(1, func(gtx C) D { // Balances
 				return layout.E.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: balAxis, Alignment: layout.End}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: balAxis, Alignment: layout.End},
 						layout.Rigid(balanceTxt.Layout),
 						layout.Rigid(func(gtx C) D {
 							if !pg.usdExchangeSet || pg.exchangeRate <= 0 || bal.ToCoin() == 0 {
 								return D{}
 							}
 
-							balanceUSD := fmt.Sprintf(" (%v)", utils.FormatAsUSDString(pg.Printer, utils.CryptoToUSD(pg.exchangeRate, bal.ToCoin())))
+							balanceUSD := fmt.Sprintf(" (%v)", pg.FormatFiat(utils.CryptoToUSD(pg.exchangeRate, bal.ToCoin())))
 							usdAmtLabel := pg.Theme.Label(pg.ConvertTextSize(values.TextSize16), balanceUSD)
 							usdAmtLabel.Font.Weight = font.SemiBold
 							return usdAmtLabel.Layout(gtx)
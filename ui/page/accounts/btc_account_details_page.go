@@ -49,6 +49,7 @@ type BTCAcctDetailsPage struct {
 	showExtendedKeyButton   *cryptomaterial.Clickable
 	isHiddenExtendedxPubkey bool
 	infoButton              cryptomaterial.IconButton
+	descriptors             *accountDescriptors
 }
 
 func NewBTCAcctDetailsPage(l *load.Load, wallet sharedW.Asset, account *sharedW.Account) *BTCAcctDetailsPage {
@@ -71,6 +72,7 @@ func NewBTCAcctDetailsPage(l *load.Load, wallet sharedW.Asset, account *sharedW.
 	}
 
 	pg.backButton = components.GetBackButton(l)
+	pg.descriptors = newAccountDescriptors(l, wallet, int32(account.Number))
 
 	return pg
 }
@@ -118,6 +120,13 @@ func (pg *BTCAcctDetailsPage) Layout(gtx C) D {
 			return layout.Inset{Bottom: m}.Layout(gtx, pg.extendedPubkey)
 		},
 	}
+	if pg.descriptors.supported() {
+		widgets = append(widgets, func(gtx C) D {
+			return layout.Inset{Bottom: m}.Layout(gtx, func(gtx C) D {
+				return pg.pageSections(gtx, pg.descriptors.layout)
+			})
+		})
+	}
 	if pg.Load.IsMobileView() {
 		return pg.layoutMobile(gtx, widgets)
 	}
@@ -126,7 +135,7 @@ func (pg *BTCAcctDetailsPage) Layout(gtx C) D {
 
 func (pg *BTCAcctDetailsPage) extendedPubkey(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				leftTextLabel := pg.theme.Label(values.TextSize14, values.String(values.StrExtendedKey))
 				leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -140,7 +149,7 @@ func (pg *BTCAcctDetailsPage) extendedPubkey(gtx C) D {
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
 					return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start},
 							layout.Rigid(func(gtx C) D {
 								icon := pg.Theme.Icons.VisibilityOffIcon
 								if pg.isHiddenExtendedxPubkey {
@@ -256,7 +265,7 @@ func (pg *BTCAcctDetailsPage) accountBalanceLayout(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 
 						accountIcon := pg.Theme.Icons.AccountIcon
@@ -345,9 +354,9 @@ func (pg *BTCAcctDetailsPage) accountInfoLayout(gtx C) D {
 }
 
 func (pg *BTCAcctDetailsPage) acctInfoLayout(gtx C, leftText, rightText string) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					leftTextLabel := pg.theme.Label(values.TextSize14, leftText)
 					leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -408,6 +417,8 @@ func (pg *BTCAcctDetailsPage) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	pg.descriptors.handleUserInteractions(gtx, pg.ParentWindow())
+
 }
 
 func (pg *BTCAcctDetailsPage) loadExtendedPubKey() {
@@ -208,7 +208,7 @@ func (pg *AcctDetailsPage) accountBalanceLayout(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 
 						accountIcon := pg.Theme.Icons.AccountIcon
@@ -310,9 +310,9 @@ func (pg *AcctDetailsPage) accountInfoLayout(gtx C) D {
 }
 
 func (pg *AcctDetailsPage) acctInfoLayout(gtx C, leftText, rightText string) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					leftTextLabel := pg.theme.Label(values.TextSize14, leftText)
 					leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -328,7 +328,7 @@ func (pg *AcctDetailsPage) acctInfoLayout(gtx C, leftText, rightText string) D {
 
 func (pg *AcctDetailsPage) extendedPubkey(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				leftTextLabel := pg.theme.Label(values.TextSize14, values.String(values.StrExtendedKey))
 				leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -342,7 +342,7 @@ func (pg *AcctDetailsPage) extendedPubkey(gtx C) D {
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
 					return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start},
 							layout.Rigid(func(gtx C) D {
 								icon := pg.Theme.Icons.VisibilityOffIcon
 								if pg.isHiddenExtendedxPubkey {
@@ -0,0 +1,132 @@
+package accounts
+
+import (
+	"io"
+	"strings"
+
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/app"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// accountDescriptors lists the output descriptors of an account on the BTC
+// and LTC account details pages. The descriptors hold the master key
+// fingerprint of spending wallets, the wallet's private passphrase is
+// requested before they are shown.
+type accountDescriptors struct {
+	*load.Load
+
+	wallet  sharedW.Asset
+	account int32
+
+	showButton  *cryptomaterial.Clickable
+	descriptors []string
+	clickables  []*cryptomaterial.Clickable
+}
+
+func newAccountDescriptors(l *load.Load, wallet sharedW.Asset, account int32) *accountDescriptors {
+	return &accountDescriptors{
+		Load:       l,
+		wallet:     wallet,
+		account:    account,
+		showButton: l.Theme.NewClickable(false),
+	}
+}
+
+// supported returns true if the wallet can export output descriptors.
+func (ad *accountDescriptors) supported() bool {
+	_, ok := ad.wallet.(sharedW.DescriptorAsset)
+	return ok
+}
+
+func (ad *accountDescriptors) load(privatePassphrase string) error {
+	descriptors, err := ad.wallet.(sharedW.DescriptorAsset).AccountDescriptors(ad.account, privatePassphrase)
+	if err != nil {
+		return err
+	}
+
+	ad.descriptors = descriptors
+	ad.clickables = make([]*cryptomaterial.Clickable, len(descriptors))
+	for i := range descriptors {
+		ad.clickables[i] = ad.Theme.NewClickable(true)
+	}
+	return nil
+}
+
+func (ad *accountDescriptors) handleUserInteractions(gtx C, window app.WindowNavigator) {
+	if ad.showButton.Clicked(gtx) {
+		if ad.descriptors != nil {
+			ad.descriptors, ad.clickables = nil, nil
+			return
+		}
+
+		if ad.wallet.IsWatchingOnlyWallet() {
+			if err := ad.load(""); err != nil {
+				ad.Toast.NotifyError(err.Error())
+			}
+			return
+		}
+
+		passwordModal := modal.NewCreatePasswordModal(ad.Load).
+			EnableName(false).
+			EnableConfirmPassword(false).
+			Title(values.String(values.StrOutputDescriptors)).
+			SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+				if err := ad.load(password); err != nil {
+					pm.SetError(err.Error())
+					return false
+				}
+				return true
+			})
+		window.ShowModal(passwordModal)
+	}
+
+	for i, clickable := range ad.clickables {
+		if clickable.Clicked(gtx) {
+			gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(ad.descriptors[i]))})
+			ad.Toast.Notify(values.String(values.StrDescriptorCopied))
+		}
+	}
+}
+
+func (ad *accountDescriptors) layout(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+				layout.Rigid(func(gtx C) D {
+					lbl := ad.Theme.Label(values.TextSize14, values.String(values.StrOutputDescriptors))
+					lbl.Color = ad.Theme.Color.GrayText2
+					return lbl.Layout(gtx)
+				}),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.E.Layout(gtx, func(gtx C) D {
+						icon := ad.Theme.Icons.VisibilityIcon
+						if ad.descriptors != nil {
+							icon = ad.Theme.Icons.VisibilityOffIcon
+						}
+						return ad.showButton.Layout(gtx, ad.Theme.NewIcon(icon).Layout20dp)
+					})
+				}),
+			)
+		}),
+	}
+
+	for i, desc := range ad.descriptors {
+		clickable := ad.clickables[i]
+		desc := desc
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				lbl := ad.Theme.Label(values.TextSize12, desc)
+				lbl.Color = ad.Theme.Color.Primary
+				return clickable.Layout(gtx, lbl.Layout)
+			})
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
@@ -49,6 +49,7 @@ type LTCAcctDetailsPage struct {
 	showExtendedKeyButton   *cryptomaterial.Clickable
 	isHiddenExtendedxPubkey bool
 	infoButton              cryptomaterial.IconButton
+	descriptors             *accountDescriptors
 }
 
 func NewLTCAcctDetailsPage(l *load.Load, wallet sharedW.Asset, account *sharedW.Account) *LTCAcctDetailsPage {
@@ -71,6 +72,7 @@ func NewLTCAcctDetailsPage(l *load.Load, wallet sharedW.Asset, account *sharedW.
 	}
 
 	pg.backButton = components.GetBackButton(l)
+	pg.descriptors = newAccountDescriptors(l, wallet, int32(account.Number))
 
 	return pg
 }
@@ -118,6 +120,13 @@ func (pg *LTCAcctDetailsPage) Layout(gtx C) D {
 			return layout.Inset{Bottom: m}.Layout(gtx, pg.extendedPubkey)
 		},
 	}
+	if pg.descriptors.supported() {
+		widgets = append(widgets, func(gtx C) D {
+			return layout.Inset{Bottom: m}.Layout(gtx, func(gtx C) D {
+				return pg.pageSections(gtx, pg.descriptors.layout)
+			})
+		})
+	}
 	if pg.Load.IsMobileView() {
 		return pg.layoutMobile(gtx, widgets)
 	}
@@ -126,7 +135,7 @@ func (pg *LTCAcctDetailsPage) Layout(gtx C) D {
 
 func (pg *LTCAcctDetailsPage) extendedPubkey(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				leftTextLabel := pg.theme.Label(values.TextSize14, values.String(values.StrExtendedKey))
 				leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -140,7 +149,7 @@ func (pg *LTCAcctDetailsPage) extendedPubkey(gtx C) D {
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
 					return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Start},
 							layout.Rigid(func(gtx C) D {
 								icon := pg.Theme.Icons.VisibilityOffIcon
 								if pg.isHiddenExtendedxPubkey {
@@ -253,7 +262,7 @@ func (pg *LTCAcctDetailsPage) accountBalanceLayout(gtx C) D {
 	return pg.pageSections(gtx, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 
 						accountIcon := pg.Theme.Icons.AccountIcon
@@ -342,9 +351,9 @@ func (pg *LTCAcctDetailsPage) accountInfoLayout(gtx C) D {
 }
 
 func (pg *LTCAcctDetailsPage) acctInfoLayout(gtx C, leftText, rightText string) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					leftTextLabel := pg.theme.Label(values.TextSize14, leftText)
 					leftTextLabel.Color = pg.theme.Color.GrayText2
@@ -405,6 +414,8 @@ func (pg *LTCAcctDetailsPage) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	pg.descriptors.handleUserInteractions(gtx, pg.ParentWindow())
+
 }
 
 func (pg *LTCAcctDetailsPage) loadExtendedPubKey() {
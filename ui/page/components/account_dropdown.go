@@ -100,7 +100,7 @@ func (d *AccountDropdown) getAccountItemLayout(account *sharedW.Account) layout.
 	return func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 					layout.Rigid(func(gtx C) D {
 						lbl := d.Theme.SemiBoldLabel(account.AccountName)
 						lbl.MaxLines = 1
@@ -113,7 +113,7 @@ func (d *AccountDropdown) getAccountItemLayout(account *sharedW.Account) layout.
 				)
 			}),
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 					layout.Rigid(func(gtx C) D {
 						spendableText := d.Theme.Label(values.TextSize14, values.String(values.StrLabelSpendable))
 						spendableText.Color = d.Theme.Color.GrayText2
@@ -0,0 +1,78 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/libwallet/activity"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// LayoutActivityRow draws an item of the activity feed with the row of its
+// kind.
+func LayoutActivityRow(gtx C, l *load.Load, item *activity.Item) D {
+	switch item.Kind {
+	case activity.InstantSwap:
+		return VerticalInset(values.MarginPadding6).Layout(gtx, func(gtx C) D {
+			return OrderItemWidget(gtx, l, item.Order)
+		})
+	case activity.DEXMatch:
+		return layoutDEXMatchRow(gtx, l, item)
+	}
+	return LayoutTransactionRow(gtx, l, l.AssetsManager.WalletWithID(item.WalletID), item.Tx, false)
+}
+
+// layoutDEXMatchRow draws the side, amount and status of a DEX match.
+func layoutDEXMatchRow(gtx C, l *load.Load, item *activity.Item) D {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	order, match := item.DEXOrder, item.Match
+
+	side := values.String(values.StrBuy)
+	if order.Sell {
+		side = values.String(values.StrSell)
+	}
+	// The quantity of a match is in atoms of the base asset.
+	qty := float64(match.Qty) / 1e8
+	title := fmt.Sprintf("%s %s %s", side, formatChartAmount(qty), strings.ToUpper(order.BaseSymbol))
+	baseAsset := libutils.AssetType(strings.ToUpper(order.BaseSymbol))
+
+	dp16 := values.MarginPaddingTransform(l.IsMobileView(), values.MarginPadding16)
+	return layout.Inset{Top: dp16, Bottom: dp16}.Layout(gtx, func(gtx C) D {
+		leftWidget := func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+						return SetWalletLogo(l, gtx, baseAsset, values.MarginPaddingTransform(l.IsMobileView(), values.MarginPadding24))
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(l.Theme.Label(l.ConvertTextSize(values.TextSize16), title).Layout),
+						layout.Rigid(func(gtx C) D {
+							lbl := l.Theme.Label(l.ConvertTextSize(values.TextSize14), order.Host)
+							lbl.Color = l.Theme.Color.GrayText2
+							return lbl.Layout(gtx)
+						}),
+					)
+				}),
+			)
+		}
+		rightWidget := func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
+				layout.Rigid(l.Theme.Label(l.ConvertTextSize(values.TextSize14), match.Status.String()).Layout),
+				layout.Rigid(func(gtx C) D {
+					lbl := l.Theme.Label(l.ConvertTextSize(values.TextSize14), pageutils.TimeAgo(item.Timestamp))
+					lbl.Color = l.Theme.Color.GrayText2
+					return lbl.Layout(gtx)
+				}),
+			)
+		}
+		return EndToEndRow(gtx, leftWidget, rightWidget)
+	})
+}
@@ -186,7 +186,7 @@ func (ats *AssetTypeSelector) Layout(window app.WindowNavigator, gtx C) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						ic := cryptomaterial.NewIcon(ats.Theme.Icons.DropDownIcon)
 						ic.Color = ats.Theme.Color.Gray1
@@ -0,0 +1,122 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/balancehistory"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// BalanceChart shows the balance history of a wallet, or the fiat value of
+// all the wallets, over the range picked in its range selector.
+type BalanceChart struct {
+	*load.Load
+
+	// wallet is nil if the chart shows the total of all the wallets.
+	wallet        wallet.Asset
+	rangeSelector *cryptomaterial.SegmentedControl
+	chart         *cryptomaterial.LineChart
+	reload        func()
+}
+
+// NewBalanceChart returns the balance chart of the wallet, or of all the
+// wallets if wallet is nil. reload is called when the chart is updated.
+func NewBalanceChart(l *load.Load, wallet wallet.Asset, reload func()) *BalanceChart {
+	titles := make([]string, 0, len(balancehistory.Ranges))
+	for _, rng := range balancehistory.Ranges {
+		if rng == balancehistory.All {
+			titles = append(titles, values.String(values.StrAll))
+			continue
+		}
+		titles = append(titles, string(rng))
+	}
+
+	bc := &BalanceChart{
+		Load:          l,
+		wallet:        wallet,
+		rangeSelector: l.Theme.SegmentedControl(titles, cryptomaterial.SegmentTypeGroup),
+		chart:         l.Theme.LineChart(),
+		reload:        reload,
+	}
+	bc.rangeSelector.SetSelectedSegment(string(balancehistory.Month))
+	return bc
+}
+
+// Refresh loads the history of the selected range. It MUST be called from a
+// goroutine.
+func (bc *BalanceChart) Refresh() {
+	rng := balancehistory.Ranges[bc.rangeSelector.SelectedIndex()]
+
+	var history *balancehistory.History
+	var err error
+	if bc.wallet == nil {
+		history, err = bc.AssetsManager.TotalBalanceHistory(context.Background(), rng)
+	} else {
+		history, err = bc.AssetsManager.WalletBalanceHistory(context.Background(), bc.wallet, rng)
+	}
+	if err != nil {
+		log.Errorf("Error loading balance history: %v", err)
+		return
+	}
+
+	if bc.wallet == nil {
+		bc.chart.FormatValue = func(v float64) string {
+			return fmt.Sprintf("%.2f %s", v, history.Currency)
+		}
+	} else {
+		assetType := bc.wallet.GetAssetType()
+		bc.chart.FormatValue = func(v float64) string {
+			return fmt.Sprintf("%s %s", formatChartAmount(v), assetType)
+		}
+	}
+
+	points := make([]cryptomaterial.ChartPoint, 0, len(history.Points))
+	for _, point := range history.Points {
+		chartPoint := cryptomaterial.ChartPoint{
+			Time:  time.Unix(point.Day, 0).UTC(),
+			Value: point.Balance,
+		}
+		if bc.wallet == nil {
+			// The total is only known in fiat.
+			if !point.HasValue {
+				continue
+			}
+			chartPoint.Value = point.Value
+		} else if point.HasValue && history.Currency != "" {
+			chartPoint.Note = fmt.Sprintf("%.2f %s", point.Value, history.Currency)
+		}
+		points = append(points, chartPoint)
+	}
+	bc.chart.SetPoints(points)
+	bc.reload()
+}
+
+// formatChartAmount formats a coin amount with at most 8 decimal places
+// and without trailing zeros.
+func formatChartAmount(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e8)/1e8, 'f', -1, 64)
+}
+
+// HandleUserInteractions refreshes the chart when another range is
+// selected.
+func (bc *BalanceChart) HandleUserInteractions() {
+	if bc.rangeSelector.Changed() {
+		go bc.Refresh()
+	}
+}
+
+// RangeSelectorLayout draws the range selector of the chart.
+func (bc *BalanceChart) RangeSelectorLayout(gtx C) D {
+	return bc.rangeSelector.GroupTileLayout(gtx)
+}
+
+func (bc *BalanceChart) Layout(gtx C) D {
+	return bc.chart.Layout(gtx)
+}
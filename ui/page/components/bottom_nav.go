@@ -133,7 +133,7 @@ func (bottomNavigationbar *BottomNavigationBar) LayoutSendReceive(gtx layout.Con
 							Padding:     layout.UniformInset(values.MarginPadding8),
 						}.Layout(gtx,
 							layout.Rigid(func(gtx C) D {
-								return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 									layout.Rigid(func(gtx C) D {
 										return cryptomaterial.LinearLayout{
 											Width:       cryptomaterial.WrapContent,
@@ -8,7 +8,9 @@ import (
 	"gioui.org/font"
 	"gioui.org/layout"
 	"gioui.org/unit"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
@@ -62,7 +64,7 @@ func formatBalance(gtx C, l *load.Load, amount string, mainTextSize unit.Sp, col
 		return lbl.Layout(gtx)
 	}
 
-	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Baseline}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Baseline},
 		layout.Rigid(func(_ C) D {
 			return lblWidget(mainTextSize, mainText)
 		}),
@@ -83,7 +85,7 @@ func formatBalanceWithHidden(gtx C, l *load.Load, amount string, mainTextSize un
 	txt := l.Theme.Label(mainTextSize, amount)
 	if isUSD {
 		if !l.AssetsManager.ExchangeRateFetchingEnabled() {
-			txt.Text = "$ --"
+			txt.Text = ext.FiatSymbol(l.AssetsManager.GetFiatCurrency()) + " --"
 		}
 	}
 	if isBalanceHidden {
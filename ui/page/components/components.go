@@ -388,7 +388,7 @@ func LayoutTransactionRow(gtx C, l *load.Load, wal sharedW.Asset, tx *sharedW.Tr
 			}
 
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Alignment: layout.Baseline}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Baseline},
 					layout.Rigid(func(gtx C) D {
 						statusIcon := l.Theme.Icons.ConfirmIcon
 						if TxConfirmations(wal, tx) < wal.RequiredConfirmations() {
@@ -405,7 +405,7 @@ func LayoutTransactionRow(gtx C, l *load.Load, wal sharedW.Asset, tx *sharedW.Tr
 						}
 						return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
 							layout.Rigid(func(gtx C) D {
-								return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 									layout.Rigid(func(gtx C) D {
 										if !isStaking {
 											return status.Layout(gtx)
@@ -445,7 +445,7 @@ func LayoutTransactionRow(gtx C, l *load.Load, wal sharedW.Asset, tx *sharedW.Tr
 }
 
 func walletIconAndName(gtx C, icon *cryptomaterial.Image, name cryptomaterial.Label) D {
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(icon.Layout12dp),
 		layout.Rigid(func(gtx C) D {
 			gtx.Constraints.Max.X = gtx.Constraints.Max.X / 2
@@ -536,12 +536,13 @@ func TxConfirmations(wallet sharedW.Asset, transaction *sharedW.Transaction) int
 
 // EndToEndRow layouts out its content on both ends of its horizontal layout.
 func EndToEndRow(gtx C, leftWidget, rightWidget func(C) D) D {
-	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+	flex, children := cryptomaterial.MirrorFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}, []layout.FlexChild{
 		layout.Rigid(leftWidget),
 		layout.Flexed(1, func(gtx C) D {
-			return layout.E.Layout(gtx, rightWidget)
+			return cryptomaterial.MirrorDirection(gtx, layout.E).Layout(gtx, rightWidget)
 		}),
-	)
+	})
+	return flex.Layout(gtx, children...)
 }
 
 func TruncateString(str string, num int) string {
@@ -756,7 +757,7 @@ func BrowserURLWidget(gtx C, l *load.Load, url string, copyRedirect *cryptomater
 			return border.Layout(gtx, func(gtx C) D {
 				return wrapper.Layout(gtx, func(gtx C) D {
 					return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Flexed(0.9, l.Theme.Body1(url).Layout),
 							layout.Flexed(0.1, func(gtx C) D {
 								return layout.E.Layout(gtx, func(gtx C) D {
@@ -848,10 +849,10 @@ func FlexLayout(gtx C, options FlexOptions, widgets []func(gtx C) D) D {
 		flexChildren[i] = layout.Rigid(widget)
 	}
 
-	return layout.Flex{
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 		Axis:      options.Axis,
 		Alignment: options.Alignment,
-	}.Layout(gtx, flexChildren...)
+	}, flexChildren...)
 }
 
 // IconButton creates the display for an icon button. The default icon and text
@@ -25,7 +25,7 @@ func AgendaItemWidget(gtx C, l *load.Load, consensusItem *ConsensusItem, hasVoti
 		}),
 		layout.Rigid(layoutAgendaDetails(l, consensusItem.Agenda.Description)),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(layoutAgendaDetails(l, values.String(values.StrVotingPreference), font.SemiBold)),
 				layout.Rigid(layoutAgendaDetails(l, " "+consensusItem.Agenda.VotingPreference)),
 			)
@@ -70,7 +70,7 @@ func layoutAgendaStatus(gtx C, l *load.Load, agenda *dcr.Agenda) D {
 		backgroundColor = l.Theme.Color.Gray2
 	}
 
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			lbl := l.Theme.Label(l.ConvertTextSize(values.TextSize20), agenda.AgendaID)
 			lbl.Font.Weight = font.SemiBold
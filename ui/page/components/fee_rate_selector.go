@@ -125,7 +125,7 @@ func (fs *FeeRateSelector) Layout(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
 					layoutBody := func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Flexed(.8, func(gtx C) D {
 								border := cryptomaterial.Border{
 									Color: fs.Load.Theme.Color.Gray2,
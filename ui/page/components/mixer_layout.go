@@ -40,10 +40,10 @@ func (mc MixerComponent) MixerLayout(gtx C) D {
 }
 
 func (mc MixerComponent) topMixerLayout(gtx C) D {
-	return layout.Flex{
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 		Axis:      layout.Horizontal,
 		Alignment: layout.Middle,
-	}.Layout(gtx,
+	},
 		layout.Rigid(mc.Theme.Icons.Mixer.Layout24dp),
 		layout.Rigid(func(gtx C) D {
 			lbl := mc.Theme.Body1(values.String(values.StrMixerRunning))
@@ -106,10 +106,10 @@ func (mc MixerComponent) bottomMixerLayout(gtx C) D {
 			return lbl.Layout(gtx)
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 				Axis:      layout.Horizontal,
 				Alignment: layout.Middle,
-			}.Layout(gtx,
+			},
 				layout.Rigid(mc.Theme.Body1(values.String(values.StrUnmixedBalance)).Layout),
 				layout.Flexed(1, func(gtx C) D {
 					return layout.E.Layout(gtx, func(gtx C) D {
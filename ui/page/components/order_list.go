@@ -26,7 +26,7 @@ func OrderItemWidget(gtx C, l *load.Load, orderItem *instantswap.Order) D {
 					return layout.Inset{
 						Bottom: values.MarginPadding10,
 					}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{
 									Right: values.MarginPadding8,
@@ -40,10 +40,10 @@ func OrderItemWidget(gtx C, l *load.Load, orderItem *instantswap.Order) D {
 							}),
 							layout.Flexed(1, func(gtx C) D {
 								return layout.E.Layout(gtx, func(gtx C) D {
-									return layout.Flex{
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 										Axis:      layout.Horizontal,
 										Alignment: layout.Middle,
-									}.Layout(gtx,
+									},
 										layout.Rigid(func(gtx C) D {
 											return layout.Inset{
 												Right: values.MarginPadding10,
@@ -62,9 +62,9 @@ func OrderItemWidget(gtx C, l *load.Load, orderItem *instantswap.Order) D {
 					})
 				}),
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
-							return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 								layout.Rigid(func(gtx C) D {
 									return layout.Inset{
 										Right: values.MarginPadding5,
@@ -99,10 +99,10 @@ func OrderItemWidget(gtx C, l *load.Load, orderItem *instantswap.Order) D {
 						}),
 						layout.Flexed(1, func(gtx C) D {
 							return layout.E.Layout(gtx, func(gtx C) D {
-								return layout.Flex{
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 									Axis:      layout.Horizontal,
 									Alignment: layout.Middle,
-								}.Layout(gtx,
+								},
 									layout.Rigid(func(gtx C) D {
 										date := time.Unix(orderItem.CreatedAt, 0).Format("Jan 2, 2006")
 										timeSplit := time.Unix(orderItem.CreatedAt, 0).Format("03:04:05 PM")
@@ -95,9 +95,9 @@ func layoutTitleAndDate(gtx C, l *load.Load, item *ProposalItem) D {
 	categoryLabel.TextSize = l.ConvertTextSize(values.TextSize14)
 	timeAgoLabel.TextSize = l.ConvertTextSize(values.TextSize14)
 
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Flexed(0.7, func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					if proposal.Type != libwallet.ProposalTypeRFPProposal {
 						return D{}
@@ -132,7 +132,7 @@ func layoutTitleAndDate(gtx C, l *load.Load, item *ProposalItem) D {
 			)
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(categoryLabel.Layout),
 				layout.Rigid(func(gtx C) D {
 					if proposal.Category == libwallet.ProposalCategoryPre {
@@ -144,7 +144,7 @@ func layoutTitleAndDate(gtx C, l *load.Load, item *ProposalItem) D {
 					if proposal.Category == libwallet.ProposalCategoryPre {
 						return D{}
 					}
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Rigid(func(gtx C) D {
 							if proposal.Category == libwallet.ProposalCategoryPre {
 								return layout.Inset{
@@ -173,9 +173,9 @@ func layoutProposalSubmission(gtx C, l *load.Load, title string, click *cryptoma
 			Right:  values.MarginPadding16,
 		}
 		return inset.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
 							lb := l.Theme.Label(l.ConvertTextSize(values.TextSize14), values.String(values.StrProposedFor))
 							lb.Color = l.Theme.Color.GrayText1
@@ -229,9 +229,9 @@ func layoutAuthor(gtx C, l *load.Load, item *ProposalItem) D {
 	timeAgoLabel.TextSize = l.ConvertTextSize(values.TextSize14)
 	nameLabel.TextSize = l.ConvertTextSize(values.TextSize14)
 
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(nameLabel.Layout),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Top: values.MarginPaddingMinus22}.Layout(gtx, dotLabel.Layout)
@@ -243,7 +243,7 @@ func layoutAuthor(gtx C, l *load.Load, item *ProposalItem) D {
 			if proposal.Category != libwallet.ProposalCategoryPre {
 				return D{}
 			}
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(stateLabel.Layout),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Top: values.MarginPaddingMinus22}.Layout(gtx, dotLabel.Layout)
@@ -63,6 +63,7 @@ func NewRestorePage(l *load.Load, walletName string, walletType libutils.AssetTy
 
 	pg.backButton = GetBackButton(l)
 	pg.backButton.Icon = pg.Theme.Icons.ContentClear
+	pg.toggleSeedInput.Description = values.String(values.StrPasteSeedWords)
 	textSize16 := values.TextSizeTransform(l.IsMobileView(), values.TextSize16)
 
 	pg.seedInputEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrEnterWalletSeed))
@@ -125,9 +126,9 @@ func (pg *Restore) Layout(gtx C) D {
 }
 
 func (pg *Restore) headerLayout(gtx C) D {
-	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{
 						Right: values.MarginPadding4,
@@ -177,11 +178,11 @@ func (pg *Restore) seedWordsLayout(gtx C) D {
 			})
 		}),
 		layout.Expanded(func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween},
 				layout.Rigid(func(gtx C) D {
 					inset := layout.Inset{Top: values.MarginPadding8}
 					return inset.Layout(gtx, func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.toggleSeedInput.Layout)
 							}),
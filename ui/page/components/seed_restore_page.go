@@ -252,7 +252,7 @@ func (pg *SeedRestore) seedEditorViewDesktop(gtx C) D {
 		j := i
 		row := rows[j]
 		columnFlexChilds = append(columnFlexChilds, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			return layout.Flex{}.Layout(gtx, row...)
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{}, row...)
 		}))
 		if len(rows)-1 != j {
 			columnFlexChilds = append(columnFlexChilds, layout.Rigid(layout.Spacer{Height: values.MarginPadding5}.Layout))
@@ -32,6 +32,8 @@ func SubpageHeaderButtons(l *load.Load) (cryptomaterial.IconButton, cryptomateri
 	backClickable := new(widget.Clickable)
 	backButton := l.Theme.NewIconButton(l.Theme.Icons.NavigationArrowBack, backClickable)
 	infoButton := l.Theme.IconButton(l.Theme.Icons.ActionInfo)
+	backButton.Description = values.String(values.StrBack)
+	infoButton.Description = values.String(values.StrInfo)
 
 	size := values.MarginPadding24
 	if l.IsMobileView() {
@@ -54,6 +56,7 @@ func GetBackButton(l *load.Load) cryptomaterial.IconButton {
 	}
 	backButton.Size = size
 	backButton.Inset = layout.UniformInset(values.MarginPadding0)
+	backButton.Description = values.String(values.StrBack)
 	l.Theme.AddBackClick(backClickable)
 	return backButton
 }
@@ -97,7 +100,7 @@ func (sp *SubPage) Header(window app.WindowNavigator, gtx C) D {
 
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{
 						Right: values.MarginPadding4,
@@ -124,7 +127,7 @@ func (sp *SubPage) Header(window app.WindowNavigator, gtx C) D {
 						} else if sp.ExtraItem == nil {
 							return D{}
 						}
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								if sp.ExtraText != "" {
 									return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, sp.Theme.Caption(sp.ExtraText).Layout)
@@ -30,7 +30,7 @@ func TreasuryItemWidget(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
 	if l.IsMobileView() {
 		axis = layout.Vertical
 	}
-	return layout.Flex{Axis: axis, Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis, Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			lbl := l.Theme.Label(l.ConvertTextSize(values.TextSize18), values.String(values.StrSetTreasuryPolicy))
 			lbl.Font.Weight = font.SemiBold
@@ -38,10 +38,10 @@ func TreasuryItemWidget(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
 		}),
 		layout.Rigid(func(gtx C) D {
 			gtx.Constraints.Min.X = gtx.Constraints.Max.X
-			return layout.Flex{Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween, Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, layoutItems(l, treasuryItem)...)
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal}, layoutItems(l, treasuryItem)...)
 					})
 				}),
 				layout.Rigid(func(gtx C) D {
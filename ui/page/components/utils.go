@@ -101,7 +101,7 @@ func checksumByte(data []byte) byte {
 
 func LayoutIconAndTextWithSize(l *load.Load, gtx C, text string, col color.NRGBA, size unit.Sp, iconSize unit.Dp) D {
 	return layout.Inset{Right: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{
 					Right: values.MarginPadding5,
@@ -163,7 +163,7 @@ func (v *VoteBar) votebarLayout(gtx C) D {
 			return progressScale(progressBarWidth, v.Theme.Color.Gray2, 1)
 		}),
 		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(func(_ C) D {
 					if yesWidth == 0 {
 						return D{}
@@ -217,7 +217,7 @@ func (v *VoteBar) Layout(gtx C) D {
 			return layout.Inset{Top: values.MarginPadding5, Bottom: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Rigid(func(gtx C) D {
 								yesLabel := v.Theme.Body1(values.String(values.StrYes) + values.String(values.StrColon))
 								yesLabel.TextSize = v.ConvertTextSize(values.TextSize14)
@@ -271,7 +271,7 @@ func (v *VoteBar) Layout(gtx C) D {
 
 func (v *VoteBar) layoutIconAndText(gtx C, lbl cryptomaterial.Label, count int, col color.NRGBA) D {
 	return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{Right: values.MarginPadding5, Top: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 					v.legendIcon.Color = col
@@ -88,7 +88,7 @@ func (v *VSPSelector) Layout(window app.WindowNavigator, gtx C) D {
 		textSize16 := values.TextSizeTransform(v.IsMobileView(), values.TextSize16)
 		return layout.UniformInset(values.MarginPadding12).Layout(gtx, func(gtx C) D {
 			return v.showVSPModal.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						if v.selectedVSP == nil {
 							txt := v.Theme.Label(textSize16, values.String(values.StrSelectVSP))
@@ -99,7 +99,7 @@ func (v *VSPSelector) Layout(window app.WindowNavigator, gtx C) D {
 					}),
 					layout.Flexed(1, func(gtx C) D {
 						return layout.E.Layout(gtx, func(gtx C) D {
-							return layout.Flex{}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 								layout.Rigid(func(gtx C) D {
 									if v.selectedVSP == nil {
 										return D{}
@@ -261,7 +261,7 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 					return v.vspList.Layout(gtx, len(vsps), func(gtx C, i int) D {
 						// Show scrollbar on VSP selector modal
 						v.Modal.ShowScrollbar(true)
-						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 							layout.Flexed(0.8, func(gtx C) D {
 								return layout.Inset{Top: values.MarginPadding12, Bottom: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
 									txt := v.Theme.Label(textSize14, fmt.Sprintf("%v%%", vsps[i].FeePercentage))
@@ -287,7 +287,7 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 				return D{}
 			}
 
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Flexed(1, v.inputVSP.Layout),
 				layout.Rigid(v.addVSP.Layout),
 			)
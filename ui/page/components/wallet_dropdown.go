@@ -114,7 +114,7 @@ func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset) layout.Widget
 		totalBal, spendable := d.walletBalance(wallet)
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 					layout.Rigid(func(gtx C) D {
 						lbl := d.Theme.SemiBoldLabel(wallet.GetWalletName())
 						lbl.MaxLines = 1
@@ -127,7 +127,7 @@ func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset) layout.Widget
 				)
 			}),
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 					layout.Rigid(func(gtx C) D {
 						spendableText := d.Theme.Label(values.TextSize14, values.String(values.StrLabelSpendable))
 						spendableText.Color = d.Theme.Color.GrayText2
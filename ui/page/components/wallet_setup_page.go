@@ -2,6 +2,7 @@ package components
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"gioui.org/font"
@@ -52,6 +53,9 @@ type CreateWallet struct {
 	passwordEditor        cryptomaterial.Editor
 	confirmPasswordEditor cryptomaterial.Editor
 	watchOnlyCheckBox     cryptomaterial.CheckBoxStyle
+	multisigCheckBox      cryptomaterial.CheckBoxStyle
+	multisigThreshold     cryptomaterial.Editor
+	cosignerKeysEditor    cryptomaterial.Editor
 	materialLoader        material.LoaderStyle
 	seedTypeDropdown      *cryptomaterial.DropDown
 
@@ -84,6 +88,7 @@ func NewCreateWallet(l *load.Load, walletCreationSuccessCallback func(newWallet
 		restoreBtn:           l.Theme.Button(values.String(values.StrRestore)),
 		importBtn:            l.Theme.Button(values.String(values.StrImport)),
 		watchOnlyCheckBox:    l.Theme.CheckBox(new(widget.Bool), values.String(values.StrImportWatchingOnlyWallet)),
+		multisigCheckBox:     l.Theme.CheckBox(new(widget.Bool), values.String(values.StrMultisigWallet)),
 		selectedWalletAction: -1,
 		assetTypeError:       l.Theme.Body1(""),
 
@@ -107,6 +112,12 @@ func NewCreateWallet(l *load.Load, walletCreationSuccessCallback func(newWallet
 	pg.watchOnlyWalletHex = l.Theme.Editor(new(widget.Editor), values.String(values.StrExtendedPubKey))
 	pg.watchOnlyWalletHex.Editor.SingleLine, pg.watchOnlyWalletHex.Editor.Submit, pg.watchOnlyWalletHex.IsTitleLabel = false, true, false
 
+	pg.multisigThreshold = l.Theme.Editor(new(widget.Editor), values.String(values.StrRequiredSignatures))
+	pg.multisigThreshold.Editor.SingleLine, pg.multisigThreshold.Editor.Submit = true, true
+
+	pg.cosignerKeysEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrCosignerKeys))
+	pg.cosignerKeysEditor.Editor.SingleLine, pg.cosignerKeysEditor.IsTitleLabel = false, false
+
 	pg.passwordEditor = l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrSpendingPassword))
 	pg.passwordEditor.Editor.SingleLine, pg.passwordEditor.Editor.Submit = true, true
 
@@ -217,7 +228,7 @@ func (pg *CreateWallet) Layout(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{Top: values.MarginPadding20}.Layout(gtx, func(gtx C) D {
-							return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 								layout.Rigid(pg.backButton.Layout),
 								layout.Rigid(layout.Spacer{Width: values.MarginPadding10}.Layout),
 								layout.Rigid(func(gtx C) D {
@@ -344,8 +355,9 @@ func (pg *CreateWallet) createNewWallet(gtx C) D {
 				layout.Rigid(layout.Spacer{Height: values.MarginPadding24}.Layout),
 				layout.Rigid(pg.confirmPasswordEditor.Layout),
 				layout.Rigid(layout.Spacer{Height: values.MarginPadding24}.Layout),
+				layout.Rigid(pg.multisigSection),
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Flexed(1, func(gtx C) D {
 							return layout.E.Layout(gtx, func(gtx C) D {
 								if pg.isLoading {
@@ -362,8 +374,8 @@ func (pg *CreateWallet) createNewWallet(gtx C) D {
 		}),
 		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
 			textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
-			return layout.Flex{Alignment: layout.Middle,
-				Spacing: layout.SpaceBetween}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle,
+				Spacing: layout.SpaceBetween},
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 					return layout.Inset{Top: values.MarginPadding15}.Layout(gtx, pg.Theme.Label(textSize16, values.String(values.StrWordSeedType)).Layout)
 				}),
@@ -373,6 +385,61 @@ func (pg *CreateWallet) createNewWallet(gtx C) D {
 	)
 }
 
+// supportsMultisig returns true if multisig wallets can be created for the
+// selected asset type. Watch-only wallets of these assets can also be created
+// from output descriptors.
+func (pg *CreateWallet) supportsMultisig() bool {
+	switch strings.ToLower(pg.assetTypeDropdown.Selected()) {
+	case libutils.BTCWalletAsset.ToStringLower(), libutils.LTCWalletAsset.ToStringLower():
+		return true
+	}
+	return false
+}
+
+func (pg *CreateWallet) isMultisig() bool {
+	return pg.supportsMultisig() && pg.multisigCheckBox.CheckBox.Value
+}
+
+func (pg *CreateWallet) multisigSection(gtx C) D {
+	if !pg.supportsMultisig() {
+		return D{}
+	}
+
+	textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
+	return layout.Inset{Bottom: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(pg.multisigCheckBox.Layout),
+			layout.Rigid(func(gtx C) D {
+				if !pg.multisigCheckBox.CheckBox.Value {
+					return D{}
+				}
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(layout.Spacer{Height: values.MarginPadding14}.Layout),
+					layout.Rigid(pg.multisigThreshold.Layout),
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{
+							Top:    values.MarginPadding10,
+							Bottom: values.MarginPadding8,
+						}.Layout(gtx, pg.Theme.Label(textSize16, values.String(values.StrCosignerKeysHint)).Layout)
+					}),
+					layout.Rigid(pg.cosignerKeysEditor.Layout),
+				)
+			}),
+		)
+	})
+}
+
+// cosignerKeys returns the non-empty lines of the cosigner keys editor.
+func (pg *CreateWallet) cosignerKeys() []string {
+	var keys []string
+	for _, line := range strings.Split(pg.cosignerKeysEditor.Editor.Text(), "\n") {
+		if key := strings.TrimSpace(line); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 func (pg *CreateWallet) restoreWallet(gtx C) D {
 	textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
@@ -391,10 +458,14 @@ func (pg *CreateWallet) restoreWallet(gtx C) D {
 						}
 						return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 							layout.Rigid(func(gtx C) D {
+								label := values.String(values.StrExtendedPubKey)
+								if pg.supportsMultisig() {
+									label = values.String(values.StrExtendedPubKeyOrDescriptor)
+								}
 								return layout.Inset{
 									Top:    values.MarginPadding10,
 									Bottom: values.MarginPadding8,
-								}.Layout(gtx, pg.Theme.Label(textSize16, values.String(values.StrExtendedPubKey)).Layout)
+								}.Layout(gtx, pg.Theme.Label(textSize16, label).Layout)
 							}),
 							layout.Rigid(pg.watchOnlyWalletHex.Layout),
 						)
@@ -403,7 +474,7 @@ func (pg *CreateWallet) restoreWallet(gtx C) D {
 			})
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Flexed(1, func(gtx C) D {
 					return layout.E.Layout(gtx, func(gtx C) D {
 						if pg.showLoader {
@@ -422,11 +493,25 @@ func (pg *CreateWallet) restoreWallet(gtx C) D {
 	)
 }
 
+// watchOnlyXPub returns the xpub the watch-only wallet is created from. It
+// is the first key of output descriptors.
+func (pg *CreateWallet) watchOnlyXPub() string {
+	text := pg.watchOnlyWalletHex.Editor.Text()
+	if sharedW.IsDescriptor(text) {
+		if desc, err := sharedW.ParseDescriptor(text); err == nil && len(desc.Keys) > 0 {
+			return desc.Keys[0].XPub
+		}
+	}
+	return text
+}
+
 func (pg *CreateWallet) handleEditorEvents(gtx C) {
-	isSubmit, isChanged := cryptomaterial.HandleEditorEvents(gtx, &pg.watchOnlyWalletHex, &pg.walletName, &pg.passwordEditor, &pg.confirmPasswordEditor)
+	isSubmit, isChanged := cryptomaterial.HandleEditorEvents(gtx, &pg.watchOnlyWalletHex, &pg.walletName, &pg.passwordEditor, &pg.confirmPasswordEditor, &pg.multisigThreshold, &pg.cosignerKeysEditor)
 	if isChanged {
 		// reset error when any editor is modified
 		pg.walletName.SetError("")
+		pg.multisigThreshold.SetError("")
+		pg.cosignerKeysEditor.SetError("")
 		pg.passwordEditor.SetError("")
 		pg.confirmPasswordEditor.SetError("")
 		pg.watchOnlyWalletHex.SetError("")
@@ -457,7 +542,7 @@ func (pg *CreateWallet) handleEditorEvents(gtx C) {
 				}
 			case libutils.BTCWalletAsset.ToStringLower():
 				var walletWithXPub int
-				walletWithXPub, err = pg.AssetsManager.BTCWalletWithXPub(pg.watchOnlyWalletHex.Editor.Text())
+				walletWithXPub, err = pg.AssetsManager.BTCWalletWithXPub(pg.watchOnlyXPub())
 				if walletWithXPub == -1 {
 					newWallet, err = pg.AssetsManager.CreateNewBTCWatchOnlyWallet(pg.walletName.Editor.Text(), pg.watchOnlyWalletHex.Editor.Text())
 				} else {
@@ -465,7 +550,7 @@ func (pg *CreateWallet) handleEditorEvents(gtx C) {
 				}
 			case libutils.LTCWalletAsset.ToStringLower():
 				var walletWithXPub int
-				walletWithXPub, err = pg.AssetsManager.LTCWalletWithXPub(pg.watchOnlyWalletHex.Editor.Text())
+				walletWithXPub, err = pg.AssetsManager.LTCWalletWithXPub(pg.watchOnlyXPub())
 				if walletWithXPub == -1 {
 					newWallet, err = pg.AssetsManager.CreateNewLTCWatchOnlyWallet(pg.walletName.Editor.Text(), pg.watchOnlyWalletHex.Editor.Text())
 				} else {
@@ -512,7 +597,12 @@ func (pg *CreateWallet) createWallet() {
 		}
 
 	case libutils.BTCWalletAsset.ToStringLower():
-		newWallet, err = pg.AssetsManager.CreateNewBTCWallet(walletName, pass, sharedW.PassphraseTypePass, seedType)
+		if pg.isMultisig() {
+			threshold, _ := strconv.Atoi(pg.multisigThreshold.Editor.Text())
+			newWallet, err = pg.AssetsManager.CreateNewBTCMultisigWallet(walletName, pass, sharedW.PassphraseTypePass, seedType, threshold, pg.cosignerKeys())
+		} else {
+			newWallet, err = pg.AssetsManager.CreateNewBTCWallet(walletName, pass, sharedW.PassphraseTypePass, seedType)
+		}
 		if err != nil {
 			if err.Error() == libutils.ErrExist {
 				pg.walletName.SetError(values.StringF(values.StrWalletExist, walletName))
@@ -525,7 +615,12 @@ func (pg *CreateWallet) createWallet() {
 		}
 
 	case libutils.LTCWalletAsset.ToStringLower():
-		newWallet, err = pg.AssetsManager.CreateNewLTCWallet(walletName, pass, sharedW.PassphraseTypePass, seedType)
+		if pg.isMultisig() {
+			threshold, _ := strconv.Atoi(pg.multisigThreshold.Editor.Text())
+			newWallet, err = pg.AssetsManager.CreateNewLTCMultisigWallet(walletName, pass, sharedW.PassphraseTypePass, seedType, threshold, pg.cosignerKeys())
+		} else {
+			newWallet, err = pg.AssetsManager.CreateNewLTCWallet(walletName, pass, sharedW.PassphraseTypePass, seedType)
+		}
 		if err != nil {
 			if err.Error() == libutils.ErrExist {
 				pg.walletName.SetError(values.StringF(values.StrWalletExist, walletName))
@@ -626,6 +721,21 @@ func (pg *CreateWallet) validCreateWalletInputs() bool {
 		return false
 	}
 
+	if pg.isMultisig() {
+		pg.multisigThreshold.SetError("")
+		pg.cosignerKeysEditor.SetError("")
+		threshold, err := strconv.Atoi(pg.multisigThreshold.Editor.Text())
+		if err != nil {
+			pg.multisigThreshold.SetError(values.String(values.StrEnterRequiredSignatures))
+			return false
+		}
+		// The wallet's own key is one of the multisig keys.
+		if err := sharedW.ValidateMultisigParams(threshold, len(pg.cosignerKeys())+1); err != nil {
+			pg.cosignerKeysEditor.SetError(err.Error())
+			return false
+		}
+	}
+
 	validPassword := utils.EditorsNotEmpty(pg.confirmPasswordEditor.Editor)
 	if len(pg.passwordEditor.Editor.Text()) > 0 {
 		passwordsMatch := pg.passwordsMatch(pg.passwordEditor.Editor, pg.confirmPasswordEditor.Editor)
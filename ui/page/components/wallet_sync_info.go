@@ -58,6 +58,7 @@ func NewWalletSyncInfo(l *load.Load, wallet sharedW.Asset, reload func(), backup
 		backup:           backup,
 	}
 
+	wsi.syncSwitch.Description = values.String(values.StrSync)
 	wsi.ForwardButton, _ = SubpageHeaderButtons(l)
 	wsi.ForwardButton.Icon = wsi.Theme.Icons.NavigationArrowForward
 	wsi.ForwardButton.Size = values.MarginPadding20
@@ -120,7 +121,7 @@ func (wsi *WalletSyncInfo) pageContentWrapper(gtx C, sectionTitle string, redire
 					return layout.Inset{
 						Bottom: values.MarginPadding16,
 					}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								if sectionTitle == "" {
 									return D{}
@@ -171,7 +172,7 @@ func (wsi *WalletSyncInfo) walletNameAndBackupInfo(gtx C) D {
 
 	if !wsi.wallet.IsWalletBackedUp() {
 		items = append(items, layout.Rigid(func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Rigid(wsi.Theme.Icons.RedAlert.Layout20dp),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{
@@ -214,7 +215,7 @@ func (wsi *WalletSyncInfo) walletNameAndBackupInfo(gtx C) D {
 		}))
 	}
 
-	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, items...)
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}, items...)
 }
 
 // syncStatusSection lays out content for displaying sync status.
@@ -239,7 +240,7 @@ func (wsi *WalletSyncInfo) syncStatusSection(gtx C) D {
 			if syncing || rescanning {
 				items = append(items, layout.Rigid(func(gtx C) D {
 					return layout.Inset{Bottom: values.MarginPadding20}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Flexed(.93, wsi.progressBarRow),
 							layout.Rigid(wsi.syncStatusIcon),
 						)
@@ -355,7 +356,7 @@ func (wsi *WalletSyncInfo) syncContent(gtx C, uniform layout.Inset) D {
 	}
 
 	return uniform.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
@@ -445,7 +446,7 @@ func (wsi *WalletSyncInfo) syncBoxTitleRow(gtx C) D {
 	}
 
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
-	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 		layout.Rigid(func(gtx C) D {
 			if wsi.IsMobileView() {
 				return D{} // not enough space
@@ -459,7 +460,7 @@ func (wsi *WalletSyncInfo) syncBoxTitleRow(gtx C) D {
 					Left: values.MarginPadding4,
 				}.Layout(gtx, wsi.labelSize(textSize14, values.String(values.StrCanceling)).Layout)
 			}
-			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					inset := layout.Inset{Right: values.MarginPadding4}
 					if !wsi.IsMobileView() {
@@ -537,7 +538,7 @@ func (wsi *WalletSyncInfo) progressStatusDetails() (progress int, timeLeft strin
 }
 
 func (wsi *WalletSyncInfo) layoutAutoSyncSection(gtx C) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
 			wsi.syncSwitch.SetChecked(wsi.wallet.IsSyncing() || wsi.wallet.IsSynced())
 			return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, wsi.syncSwitch.Layout)
@@ -623,6 +624,10 @@ func (wsi *WalletSyncInfo) ListenForNotifications() {
 				t.HeadersFetchProgress, t.TotalSyncProgress)
 		},
 		OnAddressDiscoveryProgress: func(t *sharedW.AddressDiscoveryProgressReport) {
+			if t.KeyScope != nil || t.DeepRecoveryFinished {
+				// Deep recovery progress isn't part of the sync progress.
+				return
+			}
 			updateSyncProgress(t.TotalTimeRemaining, t.AddressDiscoveryProgress,
 				t.AddressDiscoveryProgress, t.TotalSyncProgress)
 		},
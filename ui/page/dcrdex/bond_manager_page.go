@@ -0,0 +1,785 @@
+package dcrdex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex"
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/dexc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const DEXBondManagerPageID = "dex_bond_manager"
+
+// DEXBondManagerPage shows the bonds, tier and reputation of the user's
+// account on each DEX server and lets the user configure the automatic
+// renewal of bonds and post additional bonds.
+type DEXBondManagerPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+
+	host string
+
+	mtx sync.RWMutex
+	xc  *core.Exchange
+	// bondAssets are the bond assets of the selected server that are
+	// supported by cryptopower.
+	bondAssets map[libutils.AssetType]*core.BondAsset
+
+	pageContainer  *widget.List
+	backButton     cryptomaterial.IconButton
+	serverSelector *cryptomaterial.DropDown
+
+	autoRenewSwitch     *cryptomaterial.Switch
+	targetTierEditor    cryptomaterial.Editor
+	bondWalletSelector  *components.WalletDropdown
+	bondAccountSelector *components.AccountDropdown
+	saveSettingsBtn     cryptomaterial.Button
+
+	bondStrengthEditor      cryptomaterial.Editor
+	postBondWalletSelector  *components.WalletDropdown
+	postBondAccountSelector *components.AccountDropdown
+	postBondBtn             cryptomaterial.Button
+
+	materialLoader material.LoaderStyle
+	isLoading      atomic.Bool
+}
+
+func NewDEXBondManagerPage(l *load.Load, host string) *DEXBondManagerPage {
+	th := l.Theme
+	pg := &DEXBondManagerPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(DEXBondManagerPageID),
+		host:             host,
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		autoRenewSwitch:    th.Switch(),
+		targetTierEditor:   newTextEditor(th, values.String(values.StrTargetTier), "1", false),
+		saveSettingsBtn:    th.Button(values.String(values.StrSaveBondSettings)),
+		bondStrengthEditor: newTextEditor(th, values.String(values.StrBondStrength), "1", false),
+		postBondBtn:        th.Button(values.String(values.StrPostBond)),
+		materialLoader:     material.Loader(th.Base),
+	}
+
+	pg.autoRenewSwitch.Description = values.String(values.StrAutoRenewBonds)
+
+	pg.backButton = components.GetBackButton(l)
+	pg.targetTierEditor.IsTitleLabel = false
+	pg.bondStrengthEditor.IsTitleLabel = false
+	pg.bondStrengthEditor.Editor.SetText(fmt.Sprintf("%d", minimumBondStrength))
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *DEXBondManagerPage) OnNavigatedTo() {
+	pg.ctx, pg.cancelCtx = context.WithCancel(context.Background())
+
+	var servers []cryptomaterial.DropDownItem
+	for host := range pg.AssetsManager.DexClient().Exchanges() {
+		servers = append(servers, cryptomaterial.DropDownItem{Text: host})
+	}
+	pg.serverSelector = pg.Theme.NewCommonDropDown(servers, &cryptomaterial.DropDownItem{Text: pg.host}, cryptomaterial.MatchParent, values.DEXServerDropdownGroup, false)
+	pg.host = pg.serverSelector.Selected()
+
+	pg.setServer()
+	go pg.listenForBondUpdates()
+}
+
+// listenForBondUpdates refreshes the bonds of the selected server when the
+// DEX client notifies of bond or reputation changes. It MUST be called from
+// a goroutine.
+func (pg *DEXBondManagerPage) listenForBondUpdates() {
+	noteFeed := pg.AssetsManager.DexClient().NotificationFeed()
+	defer noteFeed.ReturnFeed()
+
+	for {
+		select {
+		case <-pg.ctx.Done():
+			return
+		case n := <-noteFeed.C:
+			if n == nil || !pg.AssetsManager.DEXCInitialized() {
+				return
+			}
+
+			switch n.Type() {
+			case core.NoteTypeBondPost, core.NoteTypeBondRefund, core.NoteTypeReputation,
+				core.NoteTypeDEXAuth, core.NoteTypeConnEvent:
+				pg.refreshExchange()
+				pg.ParentWindow().Reload()
+			}
+		}
+	}
+}
+
+// setServer loads the selected server and resets the bond settings to its
+// current bond options.
+func (pg *DEXBondManagerPage) setServer() {
+	pg.refreshExchange()
+
+	pg.mtx.RLock()
+	xc, bondAssets := pg.xc, pg.bondAssets
+	pg.mtx.RUnlock()
+
+	var supportedBondAssets []libutils.AssetType
+	for assetType := range bondAssets {
+		supportedBondAssets = append(supportedBondAssets, assetType)
+	}
+
+	var targetTier uint64
+	var bondWallet sharedW.Asset
+	if xc != nil {
+		targetTier = xc.Auth.TargetTier
+		bondWallet = pg.dexWallet(xc.Auth.BondAssetID)
+	}
+	pg.autoRenewSwitch.SetChecked(targetTier > 0)
+	if targetTier == 0 {
+		targetTier = minimumBondStrength
+	}
+	pg.targetTierEditor.Editor.SetText(fmt.Sprintf("%d", targetTier))
+
+	pg.bondWalletSelector, pg.bondAccountSelector = pg.bondSourceSelectors(supportedBondAssets, bondWallet)
+	pg.postBondWalletSelector, pg.postBondAccountSelector = pg.bondSourceSelectors(supportedBondAssets, nil)
+}
+
+// refreshExchange reloads the selected server from the DEX client.
+func (pg *DEXBondManagerPage) refreshExchange() {
+	xc, err := pg.AssetsManager.DexClient().Exchange(pg.host)
+	if err != nil {
+		log.Errorf("Error retrieving DEX server %s: %v", pg.host, err)
+		xc = nil
+	}
+
+	bondAssets := make(map[libutils.AssetType]*core.BondAsset)
+	if xc != nil {
+		for _, bondAsset := range xc.BondAssets {
+			if assetType := convertAssetIDToAssetType(bondAsset.ID); assetType != assetTypeNoAsset {
+				bondAssets[assetType] = bondAsset
+			}
+		}
+	}
+
+	pg.mtx.Lock()
+	pg.xc, pg.bondAssets = xc, bondAssets
+	pg.mtx.Unlock()
+}
+
+// bondSourceSelectors returns the wallet and account selectors of the wallet
+// accounts that can fund bonds. If the DEX client already uses a wallet for
+// an asset, only that wallet account is selectable for the asset.
+func (pg *DEXBondManagerPage) bondSourceSelectors(assetTypes []libutils.AssetType, selectedWallet sharedW.Asset) (*components.WalletDropdown, *components.AccountDropdown) {
+	dexClient := pg.AssetsManager.DexClient()
+	walletSelector := components.NewWalletDropdown(pg.Load, assetTypes...).
+		WalletValidator(func(a sharedW.Asset) bool {
+			return !a.IsWatchingOnlyWallet() && validateBondWalletOrAccount(dexClient, a.GetAssetType(), dexc.WalletIDConfigKey, fmt.Sprint(a.GetWalletID()))
+		})
+	if selectedWallet != nil {
+		walletSelector.Setup(selectedWallet)
+	} else {
+		walletSelector.Setup()
+	}
+
+	accountSelector := components.NewAccountDropdown(pg.Load).
+		AccountValidator(func(a *sharedW.Account) bool {
+			assetType := walletSelector.SelectedWallet().GetAssetType()
+			return !a.IsWatchOnly && validateBondWalletOrAccount(dexClient, assetType, dexc.WalletAccountNumberConfigKey, fmt.Sprint(a.AccountNumber)) && !utils.IsImportedAccount(assetType, a)
+		}).
+		Setup(walletSelector.SelectedWallet())
+	walletSelector.SetChangedCallback(func(asset sharedW.Asset) {
+		_ = accountSelector.Setup(asset)
+	})
+
+	return walletSelector, accountSelector
+}
+
+// dexWallet returns the wallet used by the DEX client for the asset, or nil
+// if the DEX client has no wallet for the asset.
+func (pg *DEXBondManagerPage) dexWallet(assetID uint32) sharedW.Asset {
+	walletID, err := pg.AssetsManager.DexClient().WalletIDForAsset(assetID)
+	if err != nil || walletID == nil {
+		return nil
+	}
+	return pg.AssetsManager.WalletWithID(*walletID)
+}
+
+// formatBondAmount formats the bond amount in the units of its asset.
+func (pg *DEXBondManagerPage) formatBondAmount(xc *core.Exchange, assetID uint32, amt uint64) string {
+	if asset, ok := xc.Assets[assetID]; ok {
+		return asset.UnitInfo.FormatAtoms(amt)
+	}
+	return fmt.Sprintf("%d %s", amt, strings.ToUpper(dex.BipIDSymbol(assetID)))
+}
+
+// renewalFunds returns the amount the bond wallet account must hold for the
+// bonds of the target tier to be posted, including the bonds fee buffer, and
+// the fee buffer. Tiers that are covered by active bonds that are not about
+// to expire, or by pending bonds, need no funds.
+func (pg *DEXBondManagerPage) renewalFunds(xc *core.Exchange, bondAsset *core.BondAsset, targetTier uint64) (required, feeBuffer uint64) {
+	feeBuffer = pg.AssetsManager.DexClient().BondsFeeBuffer(bondAsset.ID)
+	coveredTiers := xc.Auth.LiveStrength - xc.Auth.WeakStrength + xc.Auth.PendingStrength
+	if tiers := int64(targetTier) - coveredTiers; tiers > 0 {
+		required = uint64(tiers) * bondAsset.Amt
+	}
+	return required + feeBuffer, feeBuffer
+}
+
+// accountHasEnough checks that the spendable balance of the account is at
+// least amt.
+func accountHasEnough(asset sharedW.Asset, account *sharedW.Account, amt uint64) bool {
+	return account != nil && account.Balance.Spendable.ToInt() >= asset.ToAmount(int64(amt)).ToInt()
+}
+
+// saveBondSettings validates the bond settings and updates the bond options
+// of the selected server. The selected wallet account is added to the DEX
+// client if the DEX client has no wallet for the bond asset.
+func (pg *DEXBondManagerPage) saveBondSettings() {
+	pg.mtx.RLock()
+	xc, bondAssets := pg.xc, pg.bondAssets
+	pg.mtx.RUnlock()
+
+	if xc == nil {
+		return
+	}
+
+	asset := pg.bondWalletSelector.SelectedWallet()
+	account := pg.bondAccountSelector.SelectedAccount()
+	var targetTier uint64
+	var bondAsset *core.BondAsset
+	if pg.autoRenewSwitch.IsChecked() {
+		if asset == nil || account == nil {
+			pg.Toast.NotifyError(values.String(values.StrNoWalletsAvailable))
+			return
+		}
+		bondAsset = bondAssets[asset.GetAssetType()]
+
+		tier, err := strconv.ParseUint(pg.targetTierEditor.Editor.Text(), 10, 64)
+		if err != nil || tier < minimumBondStrength {
+			pg.targetTierEditor.SetError(values.String(values.StrTargetTierErrMsg))
+			return
+		}
+		targetTier = tier
+
+		// Renewing bonds requires enough funds in the bond account for the
+		// new bonds and their fees.
+		if required, _ := pg.renewalFunds(xc, bondAsset, targetTier); !accountHasEnough(asset, account, required) {
+			pg.Toast.NotifyError(values.StringF(values.StrInsufficientBondAmount, pg.formatBondAmount(xc, bondAsset.ID, required)))
+			return
+		}
+	}
+
+	updateBondOptions := func() {
+		form := &core.BondOptionsForm{
+			Host:       xc.Host,
+			TargetTier: &targetTier,
+		}
+		if targetTier > 0 {
+			form.BondAssetID = &bondAsset.ID
+		}
+		if err := pg.AssetsManager.DexClient().UpdateBondOptions(form); err != nil {
+			pg.Toast.NotifyError(err.Error())
+			return
+		}
+		pg.Toast.Notify(values.String(values.StrBondSettingsSaved))
+		pg.refreshExchange()
+		pg.ParentWindow().Reload()
+	}
+
+	if targetTier == 0 { // Disabling renewals needs no bond wallet.
+		updateBondOptions()
+		return
+	}
+
+	pg.withBondWallet(bondAsset.ID, asset, account, "", func(_ string) {
+		updateBondOptions()
+	})
+}
+
+// postBond posts a bond for the entered bond strength from the selected
+// wallet account. The bond may be in any asset supported by the server, not
+// just the bond asset used for renewals.
+func (pg *DEXBondManagerPage) postBond() {
+	pg.mtx.RLock()
+	xc, bondAssets := pg.xc, pg.bondAssets
+	pg.mtx.RUnlock()
+
+	asset := pg.postBondWalletSelector.SelectedWallet()
+	account := pg.postBondAccountSelector.SelectedAccount()
+	if xc == nil || asset == nil || account == nil {
+		pg.Toast.NotifyError(values.String(values.StrNoWalletsAvailable))
+		return
+	}
+	bondAsset := bondAssets[asset.GetAssetType()]
+
+	strength, err := strconv.Atoi(pg.bondStrengthEditor.Editor.Text())
+	if err != nil {
+		pg.bondStrengthEditor.SetError(values.String(values.StrBondStrengthErrMsg))
+		return
+	} else if strength < minimumBondStrength {
+		pg.bondStrengthEditor.SetError(values.StringF(values.StrMinimumBondStrength, minimumBondStrength))
+		return
+	}
+
+	dexClient := pg.AssetsManager.DexClient()
+	feeBuffer := dexClient.BondsFeeBuffer(bondAsset.ID)
+	bondAmt := uint64(strength) * bondAsset.Amt
+	if !accountHasEnough(asset, account, bondAmt+feeBuffer) {
+		pg.Toast.NotifyError(values.StringF(values.StrInsufficientBondAmount, pg.formatBondAmount(xc, bondAsset.ID, bondAmt+feeBuffer)))
+		return
+	}
+
+	if !asset.IsSynced() { // Only fully synced wallets should post bonds.
+		pg.Toast.NotifyError(values.String(values.StrWalletNotSynced))
+		return
+	}
+
+	postBondFn := func(dexPass string) {
+		pg.isLoading.Store(true)
+		go func() {
+			defer func() {
+				pg.isLoading.Store(false)
+				pg.ParentWindow().Reload()
+			}()
+
+			res, err := dexClient.PostBond(&core.PostBondForm{
+				Addr:      xc.Host,
+				AppPass:   []byte(dexPass),
+				Asset:     &bondAsset.ID,
+				Bond:      bondAmt,
+				FeeBuffer: feeBuffer,
+			})
+			if err != nil {
+				pg.Toast.NotifyError(err.Error())
+				return
+			}
+
+			pg.Toast.Notify(values.StringF(values.StrBondPosted, res.ReqConfirms))
+			pg.refreshExchange()
+		}()
+	}
+
+	// Posting bonds requires the DEX password.
+	dexPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrDexPassword)).
+		PasswordHint(values.String(values.StrDexPassword)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := dexClient.Login([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			pg.withBondWallet(bondAsset.ID, asset, account, password, postBondFn)
+			return true
+		})
+	dexPasswordModal.SetPasswordTitleVisibility(false)
+	pg.ParentWindow().ShowModal(dexPasswordModal)
+}
+
+// withBondWallet calls fn once the DEX client has a wallet for the bond
+// asset. If it has none, the DEX password (if dexPass is empty) and the
+// wallet's spending password are requested to add the wallet account to the
+// DEX client first.
+func (pg *DEXBondManagerPage) withBondWallet(bondAssetID uint32, asset sharedW.Asset, account *sharedW.Account, dexPass string, fn func(dexPass string)) {
+	dexClient := pg.AssetsManager.DexClient()
+	if dexClient.HasWallet(int32(bondAssetID)) {
+		fn(dexPass)
+		return
+	}
+
+	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrEnterSpendingPassword)).
+		SetPositiveButtonCallback(func(_, walletPass string, pm *modal.CreatePasswordModal) bool {
+			// Validate wallet password.
+			if err := asset.UnlockWallet(walletPass); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			cfg := map[string]string{
+				dexc.WalletIDConfigKey:            fmt.Sprintf("%d", asset.GetWalletID()),
+				dexc.WalletAccountNumberConfigKey: fmt.Sprint(account.AccountNumber),
+			}
+			if err := dexClient.AddWallet(bondAssetID, cfg, []byte(dexPass), []byte(walletPass)); err != nil {
+				pm.SetError(fmt.Sprintf("Failed to prepare bond wallet: %v", err))
+				return false
+			}
+
+			fn(dexPass)
+			return true
+		})
+
+	if dexPass != "" {
+		pg.ParentWindow().ShowModal(walletPasswordModal)
+		return
+	}
+
+	dexPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrDexPassword)).
+		PasswordHint(values.String(values.StrDexPassword)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := dexClient.Login([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			dexPass = password
+			pg.ParentWindow().ShowModal(walletPasswordModal)
+			return true
+		})
+	dexPasswordModal.SetPasswordTitleVisibility(false)
+	pg.ParentWindow().ShowModal(dexPasswordModal)
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *DEXBondManagerPage) Layout(gtx C) D {
+	pg.mtx.RLock()
+	xc := pg.xc
+	pg.mtx.RUnlock()
+
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrBondManager),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				sections := []layout.Widget{pg.serverSection}
+				if xc != nil {
+					sections = append(sections,
+						func(gtx C) D { return pg.tierSection(gtx, xc) },
+						func(gtx C) D { return pg.bondsSection(gtx, xc) },
+						func(gtx C) D { return pg.bondSettingsSection(gtx, xc) },
+						func(gtx C) D { return pg.postBondSection(gtx, xc) },
+					)
+				}
+
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *DEXBondManagerPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *DEXBondManagerPage) detailRow(gtx C, title, value string) D {
+	titleLbl := pg.Theme.Body2(title)
+	titleLbl.Color = pg.Theme.Color.GrayText2
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLbl.Layout, pg.Theme.Body2(value).Layout)
+	})
+}
+
+func (pg *DEXBondManagerPage) serverSection(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrServer))
+		}),
+		layout.Rigid(pg.serverSelector.Layout),
+	)
+}
+
+func (pg *DEXBondManagerPage) tierSection(gtx C, xc *core.Exchange) D {
+	auth := xc.Auth
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrTierAndReputation))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrEffectiveTier), fmt.Sprintf("%d", auth.EffectiveTier))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrTargetTier), fmt.Sprintf("%d", auth.TargetTier))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrBondedTier), fmt.Sprintf("%d", auth.Rep.BondedTier))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrReputationScore), fmt.Sprintf("%d / %d", auth.Rep.Score, xc.MaxScore))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrPenalties), fmt.Sprintf("%d", auth.Rep.Penalties))
+		}),
+	)
+}
+
+func (pg *DEXBondManagerPage) bondsSection(gtx C, xc *core.Exchange) D {
+	auth := xc.Auth
+	tierAmt := func(tiers int64) string {
+		if bondAsset, ok := xc.BondAssets[dex.BipIDSymbol(auth.BondAssetID)]; ok && tiers > 0 {
+			return fmt.Sprintf("%d (%s)", tiers, pg.formatBondAmount(xc, bondAsset.ID, uint64(tiers)*bondAsset.Amt))
+		}
+		return fmt.Sprintf("%d", tiers)
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrBonds))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrActiveTiers), tierAmt(auth.LiveStrength-auth.WeakStrength))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrExpiringTiers), tierAmt(auth.WeakStrength))
+		}),
+		layout.Rigid(func(gtx C) D {
+			lifetime := utils.SecondsToDays(int64(xc.BondExpiry))
+			return pg.detailRow(gtx, values.String(values.StrBondLifetime), lifetime)
+		}),
+	}
+
+	if len(auth.PendingBonds) > 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return pg.sectionTitle(gtx, values.String(values.StrPendingBonds))
+			})
+		}))
+	}
+	for _, bond := range auth.PendingBonds {
+		bond := bond
+		children = append(children, layout.Rigid(func(gtx C) D {
+			confs := fmt.Sprintf("%d", bond.Confs)
+			if bondAsset, ok := xc.BondAssets[bond.Symbol]; ok {
+				confs = fmt.Sprintf("%d/%d", bond.Confs, bondAsset.Confs)
+			}
+			title := fmt.Sprintf("%s (%s)", bond.CoinID, strings.ToUpper(bond.Symbol))
+			return pg.detailRow(gtx, title, values.StringF(values.StrConfirmationsCount, confs))
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrRefundableBonds))
+		})
+	}))
+	if len(auth.ExpiredBonds) == 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrNoRefundableBonds))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}))
+	}
+	for _, bond := range auth.ExpiredBonds {
+		bond := bond
+		children = append(children, layout.Rigid(func(gtx C) D {
+			refund := values.String(values.StrRefundableNow)
+			if bond.Refunded {
+				refund = values.String(values.StrBondRefunded)
+			} else if remaining := time.Until(time.Unix(int64(bond.LockTime), 0)); remaining > 0 {
+				refund = values.StringF(values.StrRefundableIn, utils.SecondsToDays(int64(remaining.Seconds())))
+				gtx.Execute(op.InvalidateCmd{At: gtx.Now.Add(time.Second)})
+			}
+			return pg.detailRow(gtx, pg.formatBondAmount(xc, bond.AssetID, bond.Amount), refund)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXBondManagerPage) bondSettingsSection(gtx C, xc *core.Exchange) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrBondSettings))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(values.String(values.StrAutoRenewBonds)).Layout, pg.autoRenewSwitch.Layout)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(values.String(values.StrAutoRenewBondsMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}),
+	}
+
+	if pg.autoRenewSwitch.IsChecked() {
+		children = append(children,
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, pg.Theme.Body2(values.String(values.StrTargetTier)).Layout)
+			}),
+			layout.Rigid(pg.targetTierEditor.Layout),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+					return pg.bondWalletSelector.Layout(gtx, values.StrSelectWallet)
+				})
+			}),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+					return pg.bondAccountSelector.Layout(gtx, values.String(values.StrSelectAcc))
+				})
+			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.renewalFundsLayout(gtx, xc)
+			}),
+		)
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return layout.E.Layout(gtx, pg.saveSettingsBtn.Layout)
+		})
+	}))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// renewalFundsLayout shows the funds the bond account needs to renew bonds
+// up to the entered target tier.
+func (pg *DEXBondManagerPage) renewalFundsLayout(gtx C, xc *core.Exchange) D {
+	asset := pg.bondWalletSelector.SelectedWallet()
+	targetTier, err := strconv.ParseUint(pg.targetTierEditor.Editor.Text(), 10, 64)
+	if asset == nil || err != nil {
+		return D{}
+	}
+
+	pg.mtx.RLock()
+	bondAsset := pg.bondAssets[asset.GetAssetType()]
+	pg.mtx.RUnlock()
+	if bondAsset == nil {
+		return D{}
+	}
+
+	required, feeBuffer := pg.renewalFunds(xc, bondAsset, targetTier)
+	lbl := pg.Theme.Caption(values.StringF(values.StrRenewalFundsRequired, pg.formatBondAmount(xc, bondAsset.ID, required), pg.formatBondAmount(xc, bondAsset.ID, feeBuffer)))
+	lbl.Color = pg.Theme.Color.GrayText2
+	if !accountHasEnough(asset, pg.bondAccountSelector.SelectedAccount(), required) {
+		lbl.Color = pg.Theme.Color.Danger
+	}
+	return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *DEXBondManagerPage) postBondSection(gtx C, _ *core.Exchange) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrPostAdditionalBond))
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(values.String(values.StrPostAdditionalBondMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.postBondWalletSelector.Layout(gtx, values.StrSelectWallet)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return pg.postBondAccountSelector.Layout(gtx, values.String(values.StrSelectAcc))
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10, Bottom: values.MarginPadding4}.Layout(gtx, pg.Theme.Body2(values.String(values.StrBondStrength)).Layout)
+		}),
+		layout.Rigid(pg.bondStrengthEditor.Layout),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				if pg.isLoading.Load() {
+					return layout.E.Layout(gtx, func(gtx C) D {
+						gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding20)
+						gtx.Constraints.Min.X = gtx.Constraints.Max.X
+						return pg.materialLoader.Layout(gtx)
+					})
+				}
+				return layout.E.Layout(gtx, pg.postBondBtn.Layout)
+			})
+		}),
+	)
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *DEXBondManagerPage) HandleUserInteractions(gtx C) {
+	if pg.serverSelector.Changed(gtx) {
+		pg.host = pg.serverSelector.Selected()
+		pg.setServer()
+	}
+
+	pg.bondWalletSelector.Handle(gtx)
+	pg.bondAccountSelector.Handle(gtx)
+	pg.postBondWalletSelector.Handle(gtx)
+	pg.postBondAccountSelector.Handle(gtx)
+
+	if pg.autoRenewSwitch.Changed(gtx) {
+		pg.targetTierEditor.SetError("")
+	}
+
+	if pg.saveSettingsBtn.Clicked(gtx) {
+		pg.targetTierEditor.SetError("")
+		pg.saveBondSettings()
+	}
+
+	if pg.postBondBtn.Clicked(gtx) && !pg.isLoading.Load() {
+		pg.bondStrengthEditor.SetError("")
+		pg.postBond()
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *DEXBondManagerPage) OnNavigatedFrom() {
+	pg.cancelCtx()
+}
@@ -7,6 +7,15 @@
 
 package dcrdex
 
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
 const (
 	decredDEXServerMainnet = "dex.decred.org:7232"
 	decredDEXServerTestnet = "bison.exchange:27232"
@@ -74,3 +83,39 @@ var CertStore = map[string][]byte{
 	decredDEXServerTestnet: dexTestSSGenCert,
 	decredDEXServerSimnet:  dexSimSSGenCert,
 }
+
+// knownServerCert returns the certificate of a known DEX server.
+func knownServerCert(host string) ([]byte, bool) {
+	cert, ok := CertStore[host]
+	return cert, ok
+}
+
+// parseServerCert validates the custom certificate of a DEX server. certInput
+// may be the PEM encoded certificate or the path to the certificate file. An
+// empty certInput returns the certificate of a known server, or nil for
+// servers with certificates signed by a trusted authority.
+func parseServerCert(host, certInput string) ([]byte, error) {
+	certInput = strings.TrimSpace(certInput)
+	if certInput == "" {
+		cert, _ := knownServerCert(host)
+		return cert, nil
+	}
+
+	cert := []byte(certInput)
+	if !strings.HasPrefix(certInput, "-----BEGIN") {
+		var err error
+		cert, err = os.ReadFile(certInput)
+		if err != nil {
+			return nil, fmt.Errorf("error reading certificate file: %w", err)
+		}
+	}
+
+	block, _ := pem.Decode(cert)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid PEM certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	return cert, nil
+}
@@ -0,0 +1,625 @@
+package dcrdex
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex/calc"
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/dexorders"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const DEXConditionalOrdersPageID = "dex_conditional_orders"
+
+var (
+	conditionalOrderTypes = []cryptomaterial.DropDownItem{
+		{Text: values.String(values.StrStopLimit)},
+		{Text: values.String(values.StrTakeProfit)},
+		{Text: values.String(values.StrRecurringBuy)},
+	}
+
+	buyIntervals = []cryptomaterial.DropDownItem{
+		{Text: values.String(values.StrEveryHour)},
+		{Text: values.String(values.StrEveryDay)},
+		{Text: values.String(values.StrEveryWeek)},
+	}
+
+	buyIntervalDurations = map[string]time.Duration{
+		values.String(values.StrEveryHour): time.Hour,
+		values.String(values.StrEveryDay):  24 * time.Hour,
+		values.String(values.StrEveryWeek): 7 * 24 * time.Hour,
+	}
+)
+
+// conditionalOrderItem is a conditional order of the market with its latest
+// event.
+type conditionalOrderItem struct {
+	*dexorders.ConditionalOrder
+	lastEvent *dexorders.Event
+	cancelBtn cryptomaterial.Button
+}
+
+// DEXConditionalOrdersPage lets the user create stop-limit, take-profit and
+// recurring buy orders for a DEX market and shows the conditional orders of
+// the market.
+type DEXConditionalOrdersPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	host string
+	mkt  *core.Market
+	xc   *core.Exchange
+
+	ordersMtx sync.RWMutex
+	orders    []*conditionalOrderItem
+
+	pageContainer *widget.List
+	backButton    cryptomaterial.IconButton
+
+	orderTypeDropdown   *cryptomaterial.DropDown
+	toggleBuyAndSellBtn *cryptomaterial.SegmentedControl
+	triggerPriceEditor  cryptomaterial.Editor
+	limitPriceEditor    cryptomaterial.Editor
+	lotsEditor          cryptomaterial.Editor
+	amountPerBuyEditor  cryptomaterial.Editor
+	buyIntervalDropdown *cryptomaterial.DropDown
+	numberOfBuysEditor  cryptomaterial.Editor
+	createOrderBtn      cryptomaterial.Button
+}
+
+func NewDEXConditionalOrdersPage(l *load.Load, host string, mkt *core.Market) *DEXConditionalOrdersPage {
+	th := l.Theme
+	pg := &DEXConditionalOrdersPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(DEXConditionalOrdersPageID),
+		host:             host,
+		mkt:              mkt,
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		orderTypeDropdown:   th.NewCommonDropDown(conditionalOrderTypes, nil, values.MarginPadding180, values.DEXConditionalOrderTypes, false),
+		toggleBuyAndSellBtn: th.SegmentedControl(buyAndSellBtnStrings, cryptomaterial.SegmentTypeGroup),
+		triggerPriceEditor:  newTextEditor(th, values.String(values.StrTriggerPrice), "", false),
+		limitPriceEditor:    newTextEditor(th, values.String(values.StrLimitPriceOptional), "", false),
+		lotsEditor:          newTextEditor(th, values.String(values.StrLots), "", false),
+		amountPerBuyEditor:  newTextEditor(th, values.StringF(values.StrAmountPerBuy, strings.ToUpper(mkt.QuoteSymbol)), "", false),
+		buyIntervalDropdown: th.NewCommonDropDown(buyIntervals, nil, cryptomaterial.MatchParent, values.DEXBuyIntervalDropdownGroup, false),
+		numberOfBuysEditor:  newTextEditor(th, values.String(values.StrNumberOfBuys), "0", false),
+		createOrderBtn:      th.Button(values.String(values.StrCreateOrder)),
+	}
+
+	pg.backButton = components.GetBackButton(l)
+	pg.numberOfBuysEditor.Editor.SetText("0")
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *DEXConditionalOrdersPage) OnNavigatedTo() {
+	xc, err := pg.AssetsManager.DexClient().Exchange(pg.host)
+	if err != nil {
+		log.Errorf("Error retrieving DEX server %s: %v", pg.host, err)
+	}
+	pg.xc = xc
+
+	pg.refreshOrders()
+
+	// Listen for order updates. The listener is removed when the page is
+	// navigated away from.
+	listener := &dexorders.OrderNotificationListener{
+		OnOrderUpdated: func(_ *dexorders.ConditionalOrder, _ *dexorders.Event) {
+			pg.refreshOrders()
+			pg.ParentWindow().Reload()
+		},
+	}
+	if err := pg.AssetsManager.ConditionalOrders.AddNotificationListener(listener, DEXConditionalOrdersPageID); err != nil {
+		log.Errorf("Error adding conditional orders listener: %v", err)
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *DEXConditionalOrdersPage) OnNavigatedFrom() {
+	pg.AssetsManager.ConditionalOrders.RemoveNotificationListener(DEXConditionalOrdersPageID)
+}
+
+// refreshOrders reloads the conditional orders of the market.
+func (pg *DEXConditionalOrdersPage) refreshOrders() {
+	engine := pg.AssetsManager.ConditionalOrders
+	orders, err := engine.Orders()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	pg.ordersMtx.RLock()
+	cancelBtns := make(map[int]cryptomaterial.Button, len(pg.orders))
+	for _, item := range pg.orders {
+		cancelBtns[item.ID] = item.cancelBtn
+	}
+	pg.ordersMtx.RUnlock()
+
+	var items []*conditionalOrderItem
+	for _, order := range orders {
+		if order.Host != pg.host || order.Base != pg.mkt.BaseID || order.Quote != pg.mkt.QuoteID {
+			continue
+		}
+
+		item := &conditionalOrderItem{ConditionalOrder: order}
+		if events, err := engine.Events(order.ID); err == nil && len(events) > 0 {
+			item.lastEvent = events[len(events)-1]
+		}
+		if btn, ok := cancelBtns[order.ID]; ok {
+			item.cancelBtn = btn
+		} else {
+			item.cancelBtn = pg.Theme.OutlineButton(values.String(values.StrCancel))
+		}
+		items = append(items, item)
+	}
+
+	pg.ordersMtx.Lock()
+	pg.orders = items
+	pg.ordersMtx.Unlock()
+}
+
+func (pg *DEXConditionalOrdersPage) selectedOrderType() dexorders.OrderType {
+	switch pg.orderTypeDropdown.Selected() {
+	case values.String(values.StrTakeProfit):
+		return dexorders.TakeProfit
+	case values.String(values.StrRecurringBuy):
+		return dexorders.DCA
+	default:
+		return dexorders.StopLimit
+	}
+}
+
+func (pg *DEXConditionalOrdersPage) isSellOrder() bool {
+	return pg.selectedOrderType() != dexorders.DCA && pg.toggleBuyAndSellBtn.SelectedSegment() == values.String(values.StrSell)
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *DEXConditionalOrdersPage) HandleUserInteractions(gtx C) {
+	if pg.createOrderBtn.Clicked(gtx) {
+		pg.createOrder()
+	}
+
+	pg.ordersMtx.RLock()
+	orders := pg.orders
+	pg.ordersMtx.RUnlock()
+	for _, item := range orders {
+		if item.IsActive() && item.cancelBtn.Clicked(gtx) {
+			if err := pg.AssetsManager.ConditionalOrders.CancelOrder(item.ID); err != nil {
+				pg.Toast.NotifyError(err.Error())
+				continue
+			}
+			pg.Toast.Notify(values.String(values.StrConditionalOrderCanceled))
+		}
+	}
+}
+
+// validatedOrder creates a conditional order from the form. Nil is returned
+// if the form has invalid values.
+func (pg *DEXConditionalOrdersPage) validatedOrder() *dexorders.ConditionalOrder {
+	mkt := pg.mkt
+	order := &dexorders.ConditionalOrder{
+		Type:  pg.selectedOrderType(),
+		Host:  pg.host,
+		Base:  mkt.BaseID,
+		Quote: mkt.QuoteID,
+		Sell:  pg.isSellOrder(),
+	}
+
+	if order.Type == dexorders.DCA {
+		amt, err := strconv.ParseFloat(pg.amountPerBuyEditor.Editor.Text(), 64)
+		quoteAsset, ok := pg.xc.Assets[mkt.QuoteID]
+		if err != nil || amt <= 0 || !ok {
+			pg.amountPerBuyEditor.SetError(values.String(values.StrInvalidAmount))
+			return nil
+		}
+		order.Qty = uint64(math.Round(amt * float64(quoteAsset.UnitInfo.Conventional.ConversionFactor)))
+
+		runs, err := strconv.Atoi(pg.numberOfBuysEditor.Editor.Text())
+		if err != nil || runs < 0 {
+			pg.numberOfBuysEditor.SetError(values.String(values.StrInvalidNumberOfBuys))
+			return nil
+		}
+		order.MaxRuns = runs
+		order.Interval = buyIntervalDurations[pg.buyIntervalDropdown.Selected()]
+		return order
+	}
+
+	triggerPrice, err := strconv.ParseFloat(pg.triggerPriceEditor.Editor.Text(), 64)
+	if err != nil || triggerPrice <= 0 {
+		pg.triggerPriceEditor.SetError(values.String(values.StrInvalidTriggerPrice))
+		return nil
+	}
+	order.TriggerRate = mkt.ConventionalRateToMsg(triggerPrice)
+
+	if limitPriceStr := pg.limitPriceEditor.Editor.Text(); limitPriceStr != "" {
+		limitPrice, err := strconv.ParseFloat(limitPriceStr, 64)
+		rate := mkt.ConventionalRateToMsg(limitPrice)
+		if err != nil || rate < mkt.MinimumRate {
+			pg.limitPriceEditor.SetError(values.StringF(values.StrInvalidRateFmt, limitPriceStr, trimmedConventionalAmtString(mkt.MsgRateToConventional(mkt.MinimumRate))))
+			return nil
+		}
+		order.Rate = rate - rate%mkt.RateStep
+	}
+
+	lots, err := strconv.ParseUint(pg.lotsEditor.Editor.Text(), 10, 64)
+	if err != nil || lots == 0 {
+		pg.lotsEditor.SetError(values.String(values.StrInvalidLot))
+		return nil
+	}
+	order.Qty = lots * mkt.LotSize
+	if !order.Sell && !order.IsLimit() {
+		// Market buys are in units of the quote asset, estimate them at the
+		// trigger rate.
+		order.Qty = calc.BaseToQuote(order.TriggerRate, order.Qty)
+	}
+
+	return order
+}
+
+// createOrder saves the conditional order of the form. The DEX password is
+// requested to start the conditional orders if they are not running yet.
+func (pg *DEXConditionalOrdersPage) createOrder() {
+	if pg.xc == nil {
+		return
+	}
+
+	order := pg.validatedOrder()
+	if order == nil {
+		return
+	}
+
+	addOrder := func() {
+		if err := pg.AssetsManager.ConditionalOrders.AddOrder(order); err != nil {
+			pg.Toast.NotifyError(err.Error())
+			return
+		}
+		pg.Toast.Notify(values.String(values.StrConditionalOrderCreated))
+		pg.triggerPriceEditor.Editor.SetText("")
+		pg.limitPriceEditor.Editor.SetText("")
+		pg.lotsEditor.Editor.SetText("")
+		pg.amountPerBuyEditor.Editor.SetText("")
+	}
+
+	if pg.AssetsManager.ConditionalOrders.IsRunning() {
+		addOrder()
+		return
+	}
+
+	dexClient := pg.AssetsManager.DexClient()
+	dexPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrDexPassword)).
+		PasswordHint(values.String(values.StrDexPassword)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := dexClient.Login([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			if err := pg.AssetsManager.StartConditionalOrders([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			addOrder()
+			return true
+		})
+	dexPasswordModal.SetPasswordTitleVisibility(false)
+	pg.ParentWindow().ShowModal(dexPasswordModal)
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *DEXConditionalOrdersPage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      fmt.Sprintf("%s - %s", values.String(values.StrConditionalOrders), pg.mkt.Name),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				sections := []layout.Widget{pg.newOrderSection, pg.ordersSection}
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *DEXConditionalOrdersPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *DEXConditionalOrdersPage) detailRow(gtx C, title, value string) D {
+	titleLbl := pg.Theme.Body2(title)
+	titleLbl.Color = pg.Theme.Color.GrayText2
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLbl.Layout, pg.Theme.Body2(value).Layout)
+	})
+}
+
+func (pg *DEXConditionalOrdersPage) newOrderSection(gtx C) D {
+	editorRow := func(editor *cryptomaterial.Editor) layout.FlexChild {
+		return layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, editor.Layout)
+		})
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrNewConditionalOrder))
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(values.String(values.StrConditionalOrdersMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
+				layout.Rigid(func(gtx C) D {
+					if pg.selectedOrderType() == dexorders.DCA {
+						return D{} // Recurring orders are buys.
+					}
+					return pg.toggleBuyAndSellBtn.GroupTileLayout(gtx)
+				}),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.E.Layout(gtx, pg.orderTypeDropdown.Layout)
+				}),
+			)
+		}),
+	}
+
+	if pg.selectedOrderType() == dexorders.DCA {
+		children = append(children,
+			editorRow(&pg.amountPerBuyEditor),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(pg.Theme.Body2(values.String(values.StrBuyInterval)).Layout),
+						layout.Rigid(pg.buyIntervalDropdown.Layout),
+					)
+				})
+			}),
+			editorRow(&pg.numberOfBuysEditor),
+		)
+	} else {
+		children = append(children,
+			editorRow(&pg.triggerPriceEditor),
+			layout.Rigid(func(gtx C) D {
+				lbl := pg.Theme.Caption(values.String(values.StrTriggerPriceMsg))
+				lbl.Color = pg.Theme.Color.GrayText2
+				return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+			}),
+			editorRow(&pg.limitPriceEditor),
+			editorRow(&pg.lotsEditor),
+		)
+	}
+
+	if !pg.AssetsManager.ConditionalOrders.IsRunning() {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(values.String(values.StrConditionalOrdersPaused))
+			lbl.Color = pg.Theme.Color.Danger
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return layout.E.Layout(gtx, pg.createOrderBtn.Layout)
+		})
+	}))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXConditionalOrdersPage) ordersSection(gtx C) D {
+	pg.ordersMtx.RLock()
+	orders := pg.orders
+	pg.ordersMtx.RUnlock()
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrConditionalOrders))
+		}),
+	}
+
+	if len(orders) == 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrNoConditionalOrders))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}))
+	}
+
+	for i, item := range orders {
+		item := item
+		if i > 0 {
+			children = append(children, layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding16}.Layout(gtx, pg.Theme.Separator().Layout)
+			}))
+		}
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return pg.orderLayout(gtx, item)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXConditionalOrdersPage) orderLayout(gtx C, item *conditionalOrderItem) D {
+	mkt := pg.mkt
+	rateStr := func(rate uint64) string {
+		return fmt.Sprintf("%s %s/%s", trimmedConventionalAmtString(mkt.MsgRateToConventional(rate)), strings.ToUpper(mkt.QuoteSymbol), strings.ToUpper(mkt.BaseSymbol))
+	}
+
+	side := values.String(values.StrBuy)
+	if item.Sell {
+		side = values.String(values.StrSell)
+	}
+
+	qtyAssetID := mkt.BaseID
+	if !item.Sell && !item.IsLimit() {
+		qtyAssetID = mkt.QuoteID // market buys are in the quote asset
+	}
+
+	rows := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			title := fmt.Sprintf("%s %s", conditionalOrderTypeString(item.Type), side)
+			return components.EndToEndRow(gtx, pg.semiBoldLabel(title).Layout, func(gtx C) D {
+				if !item.IsActive() {
+					return D{}
+				}
+				return item.cancelBtn.Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrStatus), conditionalOrderStatusString(item.Status))
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrAmount), pg.formatAmount(qtyAssetID, item.Qty))
+		}),
+	}
+
+	if item.Type == dexorders.DCA {
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			runs := fmt.Sprintf("%d", item.Runs)
+			if item.MaxRuns > 0 {
+				runs = fmt.Sprintf("%d/%d", item.Runs, item.MaxRuns)
+			}
+			return pg.detailRow(gtx, values.String(values.StrBuysPlaced), runs)
+		}))
+		if item.IsActive() {
+			rows = append(rows, layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrNextBuy), time.Unix(item.NextRun, 0).Format("Jan 2, 2006 15:04"))
+			}))
+		}
+	} else {
+		rows = append(rows,
+			layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrTriggerPrice), rateStr(item.TriggerRate))
+			}),
+			layout.Rigid(func(gtx C) D {
+				price := values.String(values.StrMarket)
+				if item.IsLimit() {
+					price = rateStr(item.Rate)
+				}
+				return pg.detailRow(gtx, values.String(values.StrPrice), price)
+			}),
+		)
+	}
+
+	if item.lastEvent != nil {
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			stamp := time.Unix(item.lastEvent.Stamp, 0).Format("Jan 2, 2006 15:04")
+			return pg.detailRow(gtx, values.String(values.StrLastEvent), fmt.Sprintf("%s (%s)", item.lastEvent.Kind, stamp))
+		}))
+	}
+
+	if item.LastError != "" {
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(fmt.Sprintf("%s: %s", values.String(values.StrLastError), item.LastError))
+			lbl.Color = pg.Theme.Color.Danger
+			return lbl.Layout(gtx)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+func (pg *DEXConditionalOrdersPage) semiBoldLabel(txt string) cryptomaterial.Label {
+	lbl := pg.Theme.Body1(txt)
+	lbl.Font.Weight = font.SemiBold
+	return lbl
+}
+
+// formatAmount formats the amount in the units of its asset.
+func (pg *DEXConditionalOrdersPage) formatAmount(assetID uint32, amt uint64) string {
+	if pg.xc != nil {
+		if asset, ok := pg.xc.Assets[assetID]; ok {
+			return asset.UnitInfo.FormatAtoms(amt)
+		}
+	}
+	return fmt.Sprintf("%d", amt)
+}
+
+func conditionalOrderTypeString(orderType dexorders.OrderType) string {
+	switch orderType {
+	case dexorders.StopLimit:
+		return values.String(values.StrStopLimit)
+	case dexorders.TakeProfit:
+		return values.String(values.StrTakeProfit)
+	case dexorders.DCA:
+		return values.String(values.StrRecurringBuy)
+	}
+	return string(orderType)
+}
+
+func conditionalOrderStatusString(status dexorders.Status) string {
+	switch status {
+	case dexorders.StatusActive:
+		return values.String(values.StrStatusActive)
+	case dexorders.StatusPlaced:
+		return values.String(values.StrStatusPlaced)
+	case dexorders.StatusCompleted:
+		return values.String(values.StrComplete)
+	case dexorders.StatusCanceled:
+		return values.String(values.StrStatusCanceled)
+	case dexorders.StatusFailed:
+		return values.String(values.StrFailed)
+	}
+	return string(status)
+}
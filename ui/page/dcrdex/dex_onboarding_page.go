@@ -20,6 +20,7 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	"github.com/crypto-power/cryptopower/dexc"
+	"github.com/crypto-power/cryptopower/libwallet"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -333,11 +334,11 @@ func (pg *DEXOnboarding) onBoardingStepRow(gtx C) D {
 					return layout.Inset{Bottom: values.MarginPadding35, Right: dp30, Left: dp30}.Layout(gtx, sep.Layout)
 				}),
 				layout.Expanded(func(gtx C) D {
-					return layout.Flex{
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 						Axis:      layout.Horizontal,
 						Spacing:   layout.SpaceBetween,
 						Alignment: layout.Middle,
-					}.Layout(gtx,
+					},
 						layout.Rigid(func(gtx C) D {
 							return pg.onBoardingStep(gtx, onboardingSetPassword, values.String(values.StrSetPassword))
 						}),
@@ -624,12 +625,12 @@ func (pg *DEXOnboarding) stepPostBond(gtx C) D {
 			)
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Flexed(0.5, func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Top: dp16, Right: dp10}.Layout(gtx, func(gtx C) D {
-								return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 									layout.Rigid(pg.semiBoldLabel(values.String(values.StrBondStrength)).Layout),
 									layout.Rigid(func(gtx C) D {
 										return cryptomaterial.LinearLayout{
@@ -660,7 +661,7 @@ func (pg *DEXOnboarding) stepPostBond(gtx C) D {
 			)
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Flexed(0.3, func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
@@ -670,7 +671,7 @@ func (pg *DEXOnboarding) stepPostBond(gtx C) D {
 							return pg.viewOnlyCard(&pg.Theme.Color.Gray2, func(gtx C) D {
 								assetType := pg.bondSourceWalletSelector.SelectedWallet().GetAssetType()
 								icon := pg.Theme.AssetIcon(assetType)
-								return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 									layout.Rigid(func(gtx C) D {
 										if icon == nil {
 											return D{}
@@ -784,7 +785,7 @@ func (pg *DEXOnboarding) stepWaitForBondConfirmation(gtx C) D {
 				Padding: layout.UniformInset(dp16),
 			}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Top: dp2}.Layout(gtx, pg.Theme.Icons.TimerIcon.Layout16dp)
 						}),
@@ -797,7 +798,7 @@ func (pg *DEXOnboarding) stepWaitForBondConfirmation(gtx C) D {
 					return layout.Inset{Top: 10, Bottom: dp10}.Layout(gtx, pg.Theme.Body1(values.StringF(values.StrDEXBondConfirmationMsg, pg.bondServer.url, pg.bondConfirmationInfo.requiredBondConf)).Layout)
 				}),
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(pg.semiBoldLabel(fmt.Sprintf("%s: ", values.String(values.StrConfirmationStatus))).Layout),
 						layout.Rigid(pg.Theme.Label(values.TextSize16, values.StringF(values.StrConfirmationProgressMsg, pg.bondConfirmationInfo.currentBondConf, pg.bondConfirmationInfo.requiredBondConf)).Layout),
 					)
@@ -809,7 +810,7 @@ func (pg *DEXOnboarding) stepWaitForBondConfirmation(gtx C) D {
 		}),
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{Bottom: values.MarginPadding60}.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 					layout.Flexed(0.33, func(gtx C) D {
 						return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 							layout.Rigid(func(gtx C) D {
@@ -852,7 +853,7 @@ func (pg *DEXOnboarding) bondAmountInfoDisplay(gtx C) D {
 	}
 
 	icon := pg.Theme.AssetIcon(assetType)
-	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 		layout.Rigid(func(gtx C) D {
 			if icon == nil {
 				return D{}
@@ -938,8 +939,13 @@ func (pg *DEXOnboarding) handleEditorEvents(gtx C) {
 						pg.serverURLEditor.SetError(values.String(values.StrDEXServerAddrWarning))
 						return
 					}
+					cert, err := parseServerCert(serverURL, pg.serverCertEditor.Editor.Text())
+					if err != nil {
+						pg.serverCertEditor.SetError(err.Error())
+						return
+					}
 					serverInfo.url = serverURL
-					serverInfo.cert = []byte(pg.serverCertEditor.Editor.Text())
+					serverInfo.cert = cert
 				} else {
 					pg.serverURLEditor.SetError(values.String(values.StrDEXServerAddrWarning))
 					return
@@ -1190,12 +1196,12 @@ func (pg *DEXOnboarding) connectServerAndPrepareForBonding() {
 			_ = pg.bondSourceAccountSelector.Setup(asset)
 		}).
 		WalletValidator(func(a sharedW.Asset) bool {
-			return !a.IsWatchingOnlyWallet() && pg.validateBondWalletOrAccount(a.GetAssetType(), dexc.WalletIDConfigKey, fmt.Sprint(a.GetWalletID()))
+			return !a.IsWatchingOnlyWallet() && validateBondWalletOrAccount(pg.AssetsManager.DexClient(), a.GetAssetType(), dexc.WalletIDConfigKey, fmt.Sprint(a.GetWalletID()))
 		}).
 		Setup()
 	pg.bondSourceAccountSelector = components.NewAccountDropdown(pg.Load).
 		AccountValidator(func(a *sharedW.Account) bool {
-			return !a.IsWatchOnly && pg.validateBondWalletOrAccount(pg.bondSourceWalletSelector.SelectedWallet().GetAssetType(), dexc.WalletAccountNumberConfigKey, fmt.Sprint(a.AccountNumber)) && !utils.IsImportedAccount(pg.bondSourceWalletSelector.SelectedWallet().GetAssetType(), a)
+			return !a.IsWatchOnly && validateBondWalletOrAccount(pg.AssetsManager.DexClient(), pg.bondSourceWalletSelector.SelectedWallet().GetAssetType(), dexc.WalletAccountNumberConfigKey, fmt.Sprint(a.AccountNumber)) && !utils.IsImportedAccount(pg.bondSourceWalletSelector.SelectedWallet().GetAssetType(), a)
 		}).
 		SetChangedCallback(func(_ *sharedW.Account) {
 			pg.bondAccountHasEnough()
@@ -1306,8 +1312,7 @@ func (pg *DEXOnboarding) postBond() {
 // validateBondWalletOrAccount validates wallets and accounts available for bond
 // posting. If user has previously added a wallet/account to the dex client,
 // only that wallet/account should be available when re-posting bonds.
-func (pg *DEXOnboarding) validateBondWalletOrAccount(assetType libutils.AssetType, configKey, walletIDOrAccountNumber string) bool {
-	dexc := pg.AssetsManager.DexClient()
+func validateBondWalletOrAccount(dexc libwallet.DEXClient, assetType libutils.AssetType, configKey, walletIDOrAccountNumber string) bool {
 	if assetID, ok := bip(assetType.ToStringLower()); ok && dexc.HasWallet(int32(assetID)) {
 		if walletSettings, err := dexc.WalletSettings(assetID); err != nil {
 			log.Errorf("dexc.WalletSettings error: %v", err)
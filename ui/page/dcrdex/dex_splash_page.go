@@ -56,7 +56,7 @@ func (pg *DEXPage) splashPage(gtx C) D {
 						return pg.materialLoader.Layout(gtx)
 					})
 				}
-				return layout.Flex{}.Layout(gtx, layout.Flexed(1, pg.startTradingBtn.Layout))
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{}, layout.Flexed(1, pg.startTradingBtn.Layout))
 			})
 		}),
 	)
@@ -53,6 +53,9 @@ var (
 	// this to ensure they (order form and orderbook) have the same height as
 	// they are displayed sided by side.
 	orderFormAndOrderBookHeight = values.MarginPadding620
+	// wideMarketLayoutWidth is the page width from which the price chart and
+	// the order form are displayed side by side.
+	wideMarketLayoutWidth = unit.Dp(1100)
 
 	orderTypes = []cryptomaterial.DropDownItem{
 		{
@@ -108,9 +111,14 @@ type DEXMarketPage struct {
 
 	loginBtn               cryptomaterial.Button
 	postBondBtn            cryptomaterial.Button
+	manageBondsBtn         cryptomaterial.Button
+	conditionalOrdersBtn   cryptomaterial.Button
+	manageServersBtn       cryptomaterial.Button
 	createOrderBtn         cryptomaterial.Button
 	immediateOrderCheckbox cryptomaterial.CheckBoxStyle
 	immediateOrderInfoBtn  *cryptomaterial.Clickable
+	bestServerCheckbox     cryptomaterial.CheckBoxStyle
+	bestServerInfoBtn      *cryptomaterial.Clickable
 
 	addWalletToDEX  cryptomaterial.Button
 	walletSelector  *components.WalletDropdown
@@ -120,6 +128,10 @@ type DEXMarketPage struct {
 	selectedMarketOrderBook orderbookInfo
 	closeOrderBookListener  func()
 
+	candleChart     *cryptomaterial.CandleChart
+	binSizeSelector *cryptomaterial.SegmentedControl
+	marketCandles   *dexc.MarketCandles
+
 	orders                      []*clickableOrder
 	openOrdersBtn               cryptomaterial.Button
 	orderHistoryBtn             cryptomaterial.Button
@@ -138,7 +150,8 @@ type orderbookInfo struct {
 
 type clickableOrder struct {
 	*core.Order
-	cancelBtn *cryptomaterial.Clickable
+	cancelBtn  *cryptomaterial.Clickable
+	detailsBtn *cryptomaterial.Clickable
 }
 
 // NewDEXMarketPage prepares and initializes a *DEXMarketPage. Specify
@@ -162,11 +175,17 @@ func NewDEXMarketPage(l *load.Load, selectServer string) *DEXMarketPage {
 		orderFeeEstimateStr:                "------",
 		loginBtn:                           th.Button(values.String(values.StrLogin)),
 		postBondBtn:                        th.Button(values.String(values.StrPostBond)),
+		manageBondsBtn:                     th.Button(values.String(values.StrManageBonds)),
+		conditionalOrdersBtn:               th.Button(values.String(values.StrConditionalOrders)),
+		manageServersBtn:                   th.Button(values.String(values.StrManageServers)),
 		addWalletToDEX:                     th.Button(values.String(values.StrAddWallet)),
 		createOrderBtn:                     th.Button(values.String(values.StrBuy)),
 		immediateOrderCheckbox:             th.CheckBox(new(widget.Bool), values.String(values.StrImmediate)),
 		immediateOrderInfoBtn:              th.NewClickable(false),
+		bestServerCheckbox:                 th.CheckBox(new(widget.Bool), values.String(values.StrRouteToBestServer)),
+		bestServerInfoBtn:                  th.NewClickable(false),
 		seeFullOrderBookBtn:                th.Button(values.String(values.StrSeeMore)),
+		candleChart:                        th.CandleChart(),
 		openOrdersBtn:                      th.Button(values.String(values.StrOpenOrders)),
 		orderHistoryBtn:                    th.Button(values.String(values.StrTradeHistory)),
 		ordersTableHorizontalScroll:        &widget.List{List: layout.List{Axis: horizontal, Alignment: layout.Middle}},
@@ -191,7 +210,23 @@ func NewDEXMarketPage(l *load.Load, selectServer string) *DEXMarketPage {
 	pg.seeFullOrderBookBtn.Font.Weight = font.SemiBold
 	pg.seeFullOrderBookBtn.Inset = layout.Inset{}
 
+	pg.manageBondsBtn.HighlightColor, pg.manageBondsBtn.Background = color.NRGBA{}, color.NRGBA{}
+	pg.manageBondsBtn.Color = th.Color.Primary
+	pg.manageBondsBtn.Font.Weight = font.SemiBold
+	pg.manageBondsBtn.Inset = layout.Inset{}
+	pg.conditionalOrdersBtn.HighlightColor, pg.conditionalOrdersBtn.Background = color.NRGBA{}, color.NRGBA{}
+	pg.conditionalOrdersBtn.Color = th.Color.Primary
+	pg.conditionalOrdersBtn.Font.Weight = font.SemiBold
+	pg.conditionalOrdersBtn.Inset = layout.Inset{}
+	pg.manageServersBtn.HighlightColor, pg.manageServersBtn.Background = color.NRGBA{}, color.NRGBA{}
+	pg.manageServersBtn.Color = th.Color.Primary
+	pg.manageServersBtn.Font.Weight = font.SemiBold
+	pg.manageServersBtn.Inset = layout.Inset{}
+
 	pg.immediateOrderCheckbox.Font.Weight = font.SemiBold
+	pg.bestServerCheckbox.Font.Weight = font.SemiBold
+
+	pg.candleChart.FormatValue = trimmedConventionalAmtString
 
 	pg.refreshOrderForm()
 
@@ -300,6 +335,11 @@ func dexLoginModal(load *load.Load, dexClient libwallet.DEXClient, positiveBtnCa
 				return false
 			}
 
+			// Resume the conditional orders now that they can be placed.
+			if err := load.AssetsManager.StartConditionalOrders([]byte(password)); err != nil {
+				log.Errorf("StartConditionalOrders error: %v", err)
+			}
+
 			if positiveBtnCallback != nil {
 				positiveBtnCallback(password)
 			}
@@ -318,14 +358,15 @@ func (pg *DEXMarketPage) resetServerAndMarkets() {
 	xcs := pg.AssetsManager.DexClient().Exchanges()
 	var servers []cryptomaterial.DropDownItem
 	for _, xc := range xcs {
+		if xc.Disabled {
+			continue // disabled servers have no markets
+		}
 		servers = append(servers, cryptomaterial.DropDownItem{
 			Text: xc.Host,
 		})
 	}
 
 	// Include the "Add Server" button as part of pg.serverSelector items.
-	// TODO: The pg.addServerBtn should open a modal or page to add a new server
-	// to DEX when clicked.
 	servers = append(servers, cryptomaterial.DropDownItem{
 		Text:             values.String(values.StrAddServer),
 		DisplayFn:        components.IconButton(pg.Theme.Icons.ContentAdd, values.String(values.StrAddServer), layout.Inset{}, pg.Theme, pg.addServerBtn),
@@ -415,6 +456,9 @@ func (pg *DEXMarketPage) fetchOrderBook() {
 	pg.totalEditor.ExtraText = pg.selectedMarketOrderBook.quoteSymbol
 	pg.amountEditor.ExtraText = pg.selectedMarketOrderBook.baseSymbol
 
+	mc := pg.newMarketCandles()
+	pg.marketCandles = mc
+
 	pg.showLoader = true
 	go func() {
 		// Fetch order book and only update if we're still on the same market.
@@ -423,8 +467,10 @@ func (pg *DEXMarketPage) fetchOrderBook() {
 			pg.selectedMarketOrderBook.book = book
 			pg.closeOrderBookListener = feed.Close
 			pg.showLoader = false
+			pg.refreshChartDepth()
 			pg.ParentWindow().Reload()
-			pg.listenForOrderbookNotifications(feed)
+			go pg.subscribeMarketCandles(mc, feed)
+			pg.listenForOrderbookNotifications(feed, mc)
 		} else if err != nil {
 			log.Errorf("dexc.Book %v", err)
 		}
@@ -432,9 +478,9 @@ func (pg *DEXMarketPage) fetchOrderBook() {
 	}()
 }
 
-// listenForOrderbookNotifications listens for orderbook updates and MUST be
-// called from a goroutine.
-func (pg *DEXMarketPage) listenForOrderbookNotifications(feed core.BookFeed) {
+// listenForOrderbookNotifications listens for orderbook and candle updates and
+// MUST be called from a goroutine.
+func (pg *DEXMarketPage) listenForOrderbookNotifications(feed core.BookFeed, mc *dexc.MarketCandles) {
 	defer func() {
 		pg.closeAndResetOrderbookListener()
 	}()
@@ -458,6 +504,11 @@ func (pg *DEXMarketPage) listenForOrderbookNotifications(feed core.BookFeed) {
 			}
 
 			if pg.serverSelector.Selected() == bookUpdate.Host && sameMarket {
+				if mc.HandleBookUpdate(bookUpdate) {
+					pg.refreshChartCandles(mc)
+				} else {
+					pg.refreshChartDepth()
+				}
 				pg.ParentWindow().Reload()
 			}
 		}
@@ -474,9 +525,9 @@ func (pg *DEXMarketPage) closeAndResetOrderbookListener() {
 func (pg *DEXMarketPage) marketDropdownListItem(baseAsset, quoteAsset libutils.AssetType) func(gtx C) D {
 	baseIcon, quoteIcon := assetIcon(pg.Theme, baseAsset), assetIcon(pg.Theme, quoteAsset)
 	return func(gtx cryptomaterial.C) cryptomaterial.D {
-		return layout.Flex{Axis: horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 					layout.Rigid(func(gtx C) D {
 						if baseIcon == nil {
 							return D{}
@@ -492,7 +543,7 @@ func (pg *DEXMarketPage) marketDropdownListItem(baseAsset, quoteAsset libutils.A
 				return layout.Inset{Right: dp2, Left: dp2}.Layout(gtx, pg.Theme.Label(values.TextSize16, "/").Layout)
 			}),
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 					layout.Rigid(func(gtx C) D {
 						if quoteIcon == nil {
 							return D{}
@@ -544,7 +595,7 @@ func (pg *DEXMarketPage) Layout(gtx C) D {
 	pageContent := []layout.FlexChild{
 		layout.Rigid(pg.serverAndCurrencySelection),
 		layout.Rigid(pg.priceAndVolumeDetail),
-		layout.Rigid(pg.orderFormAndOrderBook),
+		layout.Rigid(pg.chartAndOrderForm),
 		layout.Rigid(pg.openOrdersAndHistory),
 	}
 
@@ -578,7 +629,24 @@ func (pg *DEXMarketPage) serverAndCurrencySelection(gtx C) D {
 		layout.Flexed(0.5, func(gtx C) D {
 			return layout.Inset{Left: values.MarginPadding10, Right: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
 				return layout.Flex{Axis: vertical}.Layout(gtx,
-					layout.Rigid(pg.semiBoldLabelText(values.String(values.StrServer)).Layout),
+					layout.Rigid(func(gtx C) D {
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Alignment: layout.Middle},
+							layout.Rigid(pg.semiBoldLabelText(values.String(values.StrServer)).Layout),
+							layout.Flexed(1, func(gtx C) D {
+								if pg.xc == nil {
+									return D{}
+								}
+								return layout.E.Layout(gtx, func(gtx C) D {
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
+										layout.Rigid(pg.manageServersBtn.Layout),
+										layout.Rigid(func(gtx C) D {
+											return layout.Inset{Left: dp10}.Layout(gtx, pg.manageBondsBtn.Layout)
+										}),
+									)
+								})
+							}),
+						)
+					}),
 					layout.Rigid(func(gtx C) D {
 						pg.serverSelector.Background = &pg.Theme.Color.Surface
 						pg.serverSelector.BorderColor = &pg.Theme.Color.Gray5
@@ -611,7 +679,7 @@ func (pg *DEXMarketPage) priceAndVolumeDetail(gtx C) D {
 		if ticker == nil {
 			marketRate = pg.Printer.Sprintf("%f", rate)
 		} else {
-			marketRate = pg.Printer.Sprintf("%f (~ %s)", rate, pageutils.FormatAsUSDString(pg.Printer, rate*ticker.LastTradePrice))
+			marketRate = pg.Printer.Sprintf("%f (~ %s)", rate, pg.FormatFiat(rate*ticker.LastTradePrice))
 		}
 
 		change24 = mkt.SpotPrice.Change24
@@ -716,6 +784,34 @@ func (pg *DEXMarketPage) semiBoldLabelSize14(txt string) cryptomaterial.Label {
 	return lb
 }
 
+// chartAndOrderForm lays the price chart and the order form side by side
+// when the window is wide enough, the order book is then laid below them.
+// Narrower windows stack the chart above the order form and the order book.
+func (pg *DEXMarketPage) chartAndOrderForm(gtx C) D {
+	if pg.IsMobileView() || gtx.Constraints.Max.X < gtx.Dp(wideMarketLayoutWidth) {
+		return layout.Flex{Axis: vertical}.Layout(gtx,
+			layout.Rigid(pg.marketChart),
+			layout.Rigid(pg.orderFormAndOrderBook),
+		)
+	}
+
+	orderFormWidth := (gtx.Constraints.Max.X - gtx.Dp(values.MarginPadding10)) * 2 / 5
+	return layout.Flex{Axis: vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
+				layout.Flexed(1, pg.marketChart),
+				layout.Rigid(layout.Spacer{Width: values.MarginPadding10}.Layout),
+				layout.Rigid(func(gtx C) D {
+					gtx.Constraints.Max.X = orderFormWidth
+					gtx.Constraints.Min.X = orderFormWidth
+					return pg.orderForm(gtx)
+				}),
+			)
+		}),
+		layout.Rigid(pg.orderbook),
+	)
+}
+
 func (pg *DEXMarketPage) orderFormAndOrderBook(gtx C) D {
 	elementWidth := (gtx.Constraints.Max.X - 20) / 2
 	orientation := horizontal
@@ -839,7 +935,7 @@ func (pg *DEXMarketPage) orderForm(gtx C) D {
 										if mkt := pg.selectedMarketInfo(); mkt != nil {
 											lotSize = values.StringF(values.StrLotSizeFmt, fmt.Sprintf("%s %s", trimmedConventionalAmtString(mkt.MsgRateToConventional(mkt.LotSize)), convertAssetIDToAssetType(pg.selectedMarketOrderBook.base)))
 										}
-										return layout.Flex{Axis: horizontal}.Layout(gtx,
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 											layout.Rigid(pg.semiBoldLabelText(labelText).Layout),
 											layout.Rigid(func(gtx C) D {
 												return layout.Inset{Top: dp5, Left: dp2}.Layout(gtx, func(gtx C) D {
@@ -858,7 +954,7 @@ func (pg *DEXMarketPage) orderForm(gtx C) D {
 								}),
 								layout.Rigid(pg.lotsEditor.Layout),
 								layout.Rigid(func(gtx C) D {
-									return layout.Flex{Axis: horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 										layout.Rigid(func(gtx C) D {
 											if !sell {
 												return D{}
@@ -893,7 +989,7 @@ func (pg *DEXMarketPage) orderForm(gtx C) D {
 									}
 
 									// Show quote asset balance
-									return layout.Flex{Axis: horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 										layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
 											return layout.E.Layout(gtx, pg.Theme.Label(values.TextSize12, values.StringF(values.StrAvailableBalance, balStr)).Layout)
 										}),
@@ -902,7 +998,7 @@ func (pg *DEXMarketPage) orderForm(gtx C) D {
 							})
 						}),
 						layout.Rigid(func(gtx C) D {
-							return layout.Flex{Axis: horizontal, Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Alignment: layout.Middle},
 								layout.Rigid(semiBoldLabelGrey3(pg.Theme, values.String(values.StrEstimatedFee)).Layout),
 								layout.Rigid(func(gtx C) D {
 									feeEstimatedLabel := pg.Theme.Label(values.TextSize12, pg.orderFeeEstimateStr)
@@ -919,19 +1015,33 @@ func (pg *DEXMarketPage) orderForm(gtx C) D {
 									return layout.Inset{Top: dp10, Left: dp2}.Layout(gtx, func(gtx C) D {
 										return pg.immediateOrderInfoBtn.Layout(gtx, pg.Theme.Icons.InfoAction.Layout16dp)
 									})
+								}),
+								layout.Rigid(func(gtx C) D {
+									pg.bestServerCheckbox.Color = pg.Theme.Color.Text
+									return layout.Inset{Left: dp10}.Layout(gtx, pg.bestServerCheckbox.Layout)
+								}),
+								layout.Rigid(func(gtx C) D {
+									return layout.Inset{Top: dp10, Left: dp2}.Layout(gtx, func(gtx C) D {
+										return pg.bestServerInfoBtn.Layout(gtx, pg.Theme.Icons.InfoAction.Layout16dp)
+									})
 								})},
 							)
 						}),
 						layout.Rigid(func(gtx C) D {
 							pg.createOrderBtn.SetEnabled(pg.hasValidOrderInfo())
-							return layout.Flex{Axis: horizontal, Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Alignment: layout.Middle},
 								layout.Flexed(1, pg.createOrderBtn.Layout),
 							)
 						}),
+						layout.Rigid(func(gtx C) D {
+							return layout.Inset{Top: dp10}.Layout(gtx, func(gtx C) D {
+								return layout.Center.Layout(gtx, pg.conditionalOrdersBtn.Layout)
+							})
+						}),
 					)
 				}),
 				layout.Stacked(func(gtx C) D {
-					return layout.Flex{Axis: horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 						layout.Rigid(func(gtx C) D {
 							return pg.toggleBuyAndSellBtn.GroupTileLayout(gtx)
 						}),
@@ -1189,7 +1299,7 @@ func (pg *DEXMarketPage) orderbook(gtx C) D {
 								marketRate := mkt.MsgRateToConventional(mkt.SpotPrice.Rate)
 								marketRateStr = fmt.Sprintf("%f %s", marketRate, quoteAsset)
 								if ticker := pg.selectedMarketUSDRateTicker(); ticker != nil {
-									marketRateStr = fmt.Sprintf("%f %s (~ %s)", marketRate, quoteAsset, pageutils.FormatAsUSDString(pg.Printer, marketRate*ticker.LastTradePrice))
+									marketRateStr = fmt.Sprintf("%f %s (~ %s)", marketRate, quoteAsset, pg.FormatFiat(marketRate*ticker.LastTradePrice))
 								}
 							}
 							lb := pg.Theme.Label(values.TextSize16, marketRateStr)
@@ -1283,7 +1393,7 @@ func (pg *DEXMarketPage) openOrdersAndHistory(gtx C) D {
 				pg.orderHistoryBtn.Background = gr2
 				pg.orderHistoryBtn.Color = pg.Theme.Color.GrayText1
 			}
-			return layout.Flex{Axis: horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Left: dp5, Right: dp10}.Layout(gtx, pg.openOrdersBtn.Layout)
 				}),
@@ -1298,7 +1408,7 @@ func (pg *DEXMarketPage) openOrdersAndHistory(gtx C) D {
 				gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
 				return layout.Flex{Axis: vertical, Alignment: layout.Middle}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{Axis: horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx, headersFn...)
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}, headersFn...)
 					}),
 					layout.Rigid(func(gtx C) D {
 						if len(pg.orders) == 0 {
@@ -1323,18 +1433,20 @@ func (pg *DEXMarketPage) openOrdersAndHistory(gtx C) D {
 									return D{}
 								}),
 								layout.Rigid(func(gtx C) D {
-									orderReader := pg.orderReader(ord.Order)
-									return layout.Flex{Axis: horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
-										pg.orderColumn(false, fmt.Sprintf("%s %s", values.String(ord.Type.String()), values.String(orderReader.SideString())), columnWidth, index),
-										pg.orderColumn(false, ord.MarketID, columnWidth, index),
-										pg.orderColumn(false, pageutils.TimeAgo(int64(ord.SubmitTime/1000)), columnWidth, index),
-										pg.orderColumn(false, orderReader.RateString(), columnWidth, index),
-										pg.orderColumn(false, fmt.Sprintf("%s %s", orderReader.BaseQtyString(), strings.ToTitle(orderReader.BaseSymbol)), columnWidth, index),
-										pg.orderColumn(false, fmt.Sprintf("%s%%", orderReader.FilledPercent()), columnWidth, index),
-										pg.orderColumn(false, fmt.Sprintf("%s%%", orderReader.SettledPercent()), columnWidth, index),
-										pg.orderColumn(false, orderReader.StatusString(), columnWidth, index), // TODO: Add possible values to translation
-										pg.orderColumn(false, "", columnWidth, index),                         // for cancel btn
-									)
+									orderReader := orderReader(pg.AssetsManager.DexClient(), ord.Order)
+									return ord.detailsBtn.Layout(gtx, func(gtx C) D {
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle},
+											pg.orderColumn(false, fmt.Sprintf("%s %s", values.String(ord.Type.String()), values.String(orderReader.SideString())), columnWidth, index),
+											pg.orderColumn(false, ord.MarketID, columnWidth, index),
+											pg.orderColumn(false, pageutils.TimeAgo(int64(ord.SubmitTime/1000)), columnWidth, index),
+											pg.orderColumn(false, orderReader.RateString(), columnWidth, index),
+											pg.orderColumn(false, fmt.Sprintf("%s %s", orderReader.BaseQtyString(), strings.ToTitle(orderReader.BaseSymbol)), columnWidth, index),
+											pg.orderColumn(false, fmt.Sprintf("%s%%", orderReader.FilledPercent()), columnWidth, index),
+											pg.orderColumn(false, fmt.Sprintf("%s%%", orderReader.SettledPercent()), columnWidth, index),
+											pg.orderColumn(false, orderReader.StatusString(), columnWidth, index), // TODO: Add possible values to translation
+											pg.orderColumn(false, "", columnWidth, index),                         // for cancel btn
+										)
+									})
 								}),
 								layout.Rigid(func(gtx C) D {
 									// No divider for last row
@@ -1526,6 +1638,10 @@ func (pg *DEXMarketPage) HandleUserInteractions(gtx C) {
 		return
 	}
 
+	if pg.binSizeSelector != nil && pg.binSizeSelector.Changed() {
+		pg.refreshChartCandles(pg.marketCandles)
+	}
+
 	dexc := pg.AssetsManager.DexClient()
 	if pg.serverSelector.Changed(gtx) {
 		selectedServer := pg.serverSelector.Selected()
@@ -1539,9 +1655,7 @@ func (pg *DEXMarketPage) HandleUserInteractions(gtx C) {
 	}
 
 	if pg.addServerBtn.Clicked(gtx) {
-		pg.ParentNavigator().ClearStackAndDisplay(NewDEXOnboarding(pg.Load, "", func() {
-			pg.ParentNavigator().ClearStackAndDisplay(NewDEXMarketPage(pg.Load, ""))
-		}))
+		pg.ParentNavigator().Display(NewDEXServersPage(pg.Load, pg.selectedMarketInfo()))
 	}
 
 	if pg.openOrdersBtn.Clicked(gtx) {
@@ -1591,9 +1705,30 @@ func (pg *DEXMarketPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(infoModal)
 	}
 
-	// TODO: postBondBtn should open a separate page when its design is ready.
-	if pg.postBondBtn.Clicked(gtx) {
-		pg.ParentNavigator().ClearStackAndDisplay(NewDEXOnboarding(pg.Load, pg.serverSelector.Selected(), nil))
+	if pg.bestServerInfoBtn.Clicked(gtx) {
+		infoModal := modal.NewCustomModal(pg.Load).
+			Title(values.String(values.StrRouteToBestServer)).
+			UseCustomWidget(func(gtx layout.Context) layout.Dimensions {
+				return pg.Theme.Body2(values.String(values.StrRouteToBestServerInfo)).Layout(gtx)
+			}).
+			SetCancelable(true).
+			SetContentAlignment(layout.W, layout.W, layout.Center).
+			SetPositiveButtonText(values.String(values.StrOk))
+		pg.ParentWindow().ShowModal(infoModal)
+	}
+
+	if pg.manageServersBtn.Clicked(gtx) {
+		pg.ParentNavigator().Display(NewDEXServersPage(pg.Load, pg.selectedMarketInfo()))
+	}
+
+	if pg.postBondBtn.Clicked(gtx) || pg.manageBondsBtn.Clicked(gtx) {
+		pg.ParentNavigator().Display(NewDEXBondManagerPage(pg.Load, pg.serverSelector.Selected()))
+	}
+
+	if pg.conditionalOrdersBtn.Clicked(gtx) {
+		if mkt := pg.selectedMarketInfo(); mkt != nil {
+			pg.ParentNavigator().Display(NewDEXConditionalOrdersPage(pg.Load, pg.serverSelector.Selected(), mkt))
+		}
 	}
 
 	if pg.loginBtn.Clicked(gtx) {
@@ -1608,6 +1743,9 @@ func (pg *DEXMarketPage) HandleUserInteractions(gtx C) {
 	}
 
 	for _, ord := range pg.orders {
+		if ord.detailsBtn.Clicked(gtx) {
+			pg.ParentNavigator().Display(NewDEXOrderDetailsPage(pg.Load, ord.Order))
+		}
 		if ord.cancelBtn != nil && ord.cancelBtn.Clicked(gtx) {
 			go func(ordID dex.Bytes) {
 				err := dexc.Cancel(ordID)
@@ -1645,11 +1783,24 @@ func (pg *DEXMarketPage) HandleUserInteractions(gtx C) {
 					return false
 				}
 
+				if pg.bestServerCheckbox.CheckBox.Value {
+					var best *libwallet.DEXMarketBook
+					best, err = pg.AssetsManager.BestDEXServer(orderForm.Base, orderForm.Quote, orderForm.Qty, orderForm.Rate, orderForm.Sell)
+					if err != nil {
+						return false
+					}
+					orderForm.Host = best.Host
+				}
+
 				_, err = dexc.Trade([]byte(password), orderForm)
 				if err != nil {
 					return false
 				}
 
+				if orderForm.Host != pg.serverSelector.Selected() {
+					pg.Toast.Notify(values.StringF(values.StrOrderRoutedTo, orderForm.Host))
+				}
+
 				// Clear the trade form to allow for another trade entry
 				pg.refreshOrderForm()
 
@@ -1866,7 +2017,7 @@ func (pg *DEXMarketPage) refreshOrders() {
 
 	pg.orders = nil
 	for i := range orders {
-		ord := &clickableOrder{Order: orders[i]}
+		ord := &clickableOrder{Order: orders[i], detailsBtn: pg.Theme.NewClickable(true)}
 		if ord.Status == order.OrderStatusExecuted && anyMatchActive(ord.Matches) != pg.openOrdersDisplayed /* display active orders on open order view */ {
 			continue // skip order
 		}
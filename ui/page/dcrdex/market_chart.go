@@ -0,0 +1,132 @@
+package dcrdex
+
+import (
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/client/orderbook"
+	"decred.org/dcrdex/dex/candles"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/dexc"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// defaultCandleBinSize is the candle duration selected when a market is
+// opened if the DEX server provides it.
+const defaultCandleBinSize = "1h"
+
+// newMarketCandles prepares the candles and the bin size selector of the
+// selected market. It must be called before the market's book feed is
+// listened to.
+func (pg *DEXMarketPage) newMarketCandles() *dexc.MarketCandles {
+	binSizes := candles.BinSizes
+	if pg.xc != nil && len(pg.xc.CandleDurs) > 0 {
+		binSizes = pg.xc.CandleDurs
+	}
+
+	pg.binSizeSelector = pg.Theme.SegmentedControl(binSizes, cryptomaterial.SegmentTypeGroup)
+	pg.binSizeSelector.SetSelectedSegment(defaultCandleBinSize)
+	pg.candleChart.SetCandles(nil)
+	pg.candleChart.SetDepth(nil, nil)
+
+	return dexc.NewMarketCandles(pg.serverSelector.Selected(), pg.selectedMarketOrderBook.marketID, binSizes)
+}
+
+// subscribeMarketCandles fetches the candles of the market and MUST be called
+// from a goroutine.
+func (pg *DEXMarketPage) subscribeMarketCandles(mc *dexc.MarketCandles, feed core.BookFeed) {
+	if err := mc.Subscribe(feed); err != nil {
+		log.Errorf("Error fetching %s candles: %v", pg.selectedMarketOrderBook.marketID, err)
+	}
+}
+
+// refreshChartCandles updates the candle chart with the candles of the
+// selected bin size.
+func (pg *DEXMarketPage) refreshChartCandles(mc *dexc.MarketCandles) {
+	selector := pg.binSizeSelector
+	if mc == nil || selector == nil {
+		return
+	}
+
+	mkt := pg.selectedMarketInfo()
+	marketCandles := mc.Candles(selector.SelectedSegment())
+	chartCandles := make([]cryptomaterial.ChartCandle, 0, len(marketCandles))
+	for _, candle := range marketCandles {
+		chartCandles = append(chartCandles, cryptomaterial.ChartCandle{
+			Start:  time.UnixMilli(int64(candle.StartStamp)),
+			End:    time.UnixMilli(int64(candle.EndStamp)),
+			Open:   msgRateToConventional(mkt, candle.StartRate),
+			High:   msgRateToConventional(mkt, candle.HighRate),
+			Low:    msgRateToConventional(mkt, candle.LowRate),
+			Close:  msgRateToConventional(mkt, candle.EndRate),
+			Volume: conventionalAmt(candle.MatchVolume),
+		})
+	}
+	pg.candleChart.SetCandles(chartCandles)
+}
+
+// refreshChartDepth updates the depth chart with the booked orders of the
+// selected market.
+func (pg *DEXMarketPage) refreshChartDepth() {
+	book := pg.selectedMarketOrderBook.book
+	if book == nil {
+		return
+	}
+
+	mkt := pg.selectedMarketInfo()
+	buyOrders, sellOrders, _ := book.Orders()
+	depthPoints := func(orders []*orderbook.Order) []cryptomaterial.DepthPoint {
+		points := make([]cryptomaterial.DepthPoint, 0, len(orders))
+		var volume float64
+		for _, ord := range orders {
+			volume += conventionalAmt(ord.Quantity)
+			price := msgRateToConventional(mkt, ord.Rate)
+			if n := len(points); n > 0 && points[n-1].Price == price {
+				points[n-1].Volume = volume
+				continue
+			}
+			points = append(points, cryptomaterial.DepthPoint{Price: price, Volume: volume})
+		}
+		return points
+	}
+	pg.candleChart.SetDepth(depthPoints(buyOrders), depthPoints(sellOrders))
+}
+
+// msgRateToConventional converts the message rate to a conventional rate,
+// using the market's conversion factor if its config is known.
+func msgRateToConventional(mkt *core.Market, rate uint64) float64 {
+	if mkt == nil {
+		return conventionalAmt(rate)
+	}
+	return mkt.MsgRateToConventional(rate)
+}
+
+func (pg *DEXMarketPage) marketChart(gtx C) D {
+	pg.candleChart.Vertical = pg.IsMobileView()
+	return cryptomaterial.LinearLayout{
+		Width:       cryptomaterial.MatchParent,
+		Height:      cryptomaterial.WrapContent,
+		Background:  pg.Theme.Color.Surface,
+		Margin:      layout.Inset{Top: dp5, Bottom: dp5},
+		Padding:     layout.UniformInset(dp16),
+		Border:      cryptomaterial.Border{Radius: cryptomaterial.Radius(8)},
+		Orientation: vertical,
+	}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: horizontal, Alignment: layout.Middle},
+				layout.Rigid(pg.semiBoldLabelText(values.String(values.StrPriceChart)).Layout),
+				layout.Flexed(1, func(gtx C) D {
+					if pg.binSizeSelector == nil {
+						return D{}
+					}
+					return layout.E.Layout(gtx, pg.binSizeSelector.GroupTileLayout)
+				}),
+			)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: dp10}.Layout(gtx, pg.candleChart.Layout)
+		}),
+	)
+}
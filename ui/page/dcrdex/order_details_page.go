@@ -0,0 +1,523 @@
+package dcrdex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex/order"
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/dexc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/page/transaction"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const (
+	DEXOrderDetailsPageID = "dex_order_details"
+
+	// orderRefreshInterval is how often the order is reloaded from the DEX
+	// client to update the confirmations of its swap txs.
+	orderRefreshInterval = 30 * time.Second
+)
+
+// matchCoin is a tx of a swap step of a match.
+type matchCoin struct {
+	step   string
+	coin   *core.Coin
+	txHash string
+	confs  string
+
+	// wallet and tx are set if the tx is in the history of the wallet used
+	// by the DEX for the coin's asset.
+	wallet sharedW.Asset
+	tx     *sharedW.Transaction
+	viewTx *cryptomaterial.Clickable
+}
+
+type matchDetails struct {
+	*core.Match
+	coins []*matchCoin
+}
+
+// DEXOrderDetailsPage shows the matches of a DEX order, the progress of their
+// swaps and the txs of each swap step.
+type DEXOrderDetailsPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+
+	mtx     sync.RWMutex
+	order   *core.Order
+	matches []*matchDetails
+
+	pageContainer *widget.List
+	backButton    cryptomaterial.IconButton
+}
+
+func NewDEXOrderDetailsPage(l *load.Load, ord *core.Order) *DEXOrderDetailsPage {
+	pg := &DEXOrderDetailsPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(DEXOrderDetailsPageID),
+		order:            ord,
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+
+	pg.backButton = components.GetBackButton(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *DEXOrderDetailsPage) OnNavigatedTo() {
+	pg.ctx, pg.cancelCtx = context.WithCancel(context.Background())
+
+	pg.setOrder(pg.order)
+	go pg.listenForOrderUpdates()
+}
+
+// listenForOrderUpdates updates the order from the DEX client notifications
+// and reloads it periodically. It MUST be called from a goroutine.
+func (pg *DEXOrderDetailsPage) listenForOrderUpdates() {
+	dexClient := pg.AssetsManager.DexClient()
+	noteFeed := dexClient.NotificationFeed()
+	defer noteFeed.ReturnFeed()
+
+	ticker := time.NewTicker(orderRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pg.ctx.Done():
+			return
+		case <-ticker.C:
+			pg.reloadOrder()
+		case n := <-noteFeed.C:
+			if n == nil || !pg.AssetsManager.DEXCInitialized() {
+				return
+			}
+
+			switch note := n.(type) {
+			case *core.OrderNote:
+				if note.Order != nil && bytes.Equal(note.Order.ID, pg.orderID()) {
+					pg.setOrder(note.Order)
+				}
+			case *core.MatchNote:
+				if note.Match != nil && bytes.Equal(note.OrderID, pg.orderID()) {
+					pg.updateMatch(note.Match)
+				}
+			}
+		}
+	}
+}
+
+func (pg *DEXOrderDetailsPage) orderID() []byte {
+	pg.mtx.RLock()
+	defer pg.mtx.RUnlock()
+	return pg.order.ID
+}
+
+// reloadOrder fetches the order from the DEX client's orders.
+func (pg *DEXOrderDetailsPage) reloadOrder() {
+	pg.mtx.RLock()
+	ord := pg.order
+	pg.mtx.RUnlock()
+
+	filter := &core.OrderFilter{Hosts: []string{ord.Host}}
+	filter.Market = &struct {
+		Base  uint32 `json:"baseID"`
+		Quote uint32 `json:"quoteID"`
+	}{Base: ord.BaseID, Quote: ord.QuoteID}
+	orders, err := pg.AssetsManager.DexClient().Orders(filter)
+	if err != nil {
+		log.Errorf("Error reloading order %s: %v", ord.ID, err)
+		return
+	}
+
+	for _, o := range orders {
+		if bytes.Equal(o.ID, ord.ID) {
+			pg.setOrder(o)
+			return
+		}
+	}
+}
+
+// updateMatch replaces the match of the order.
+func (pg *DEXOrderDetailsPage) updateMatch(match *core.Match) {
+	pg.mtx.RLock()
+	ord := *pg.order
+	pg.mtx.RUnlock()
+
+	matches := make([]*core.Match, 0, len(ord.Matches)+1)
+	var found bool
+	for _, m := range ord.Matches {
+		if bytes.Equal(m.MatchID, match.MatchID) {
+			m, found = match, true
+		}
+		matches = append(matches, m)
+	}
+	if !found {
+		matches = append(matches, match)
+	}
+	ord.Matches = matches
+
+	pg.setOrder(&ord)
+}
+
+// setOrder sets the order and looks up the txs of its matches in the wallets.
+func (pg *DEXOrderDetailsPage) setOrder(ord *core.Order) {
+	wallets := make(map[uint32]sharedW.Asset)
+	dexWallet := func(assetID uint32) sharedW.Asset {
+		if wallet, ok := wallets[assetID]; ok {
+			return wallet
+		}
+		var wallet sharedW.Asset
+		if walletID, err := pg.AssetsManager.DexClient().WalletIDForAsset(assetID); err == nil && walletID != nil {
+			wallet = pg.AssetsManager.WalletWithID(*walletID)
+		}
+		wallets[assetID] = wallet
+		return wallet
+	}
+
+	var matches []*matchDetails
+	for _, match := range ord.Matches {
+		if match.IsCancel {
+			continue
+		}
+
+		details := &matchDetails{Match: match}
+		steps := []struct {
+			step string
+			coin *core.Coin
+		}{
+			{values.String(values.StrSwapStepInit), match.Swap},
+			{values.String(values.StrSwapStepAudit), match.CounterSwap},
+			{values.String(values.StrSwapStepRedeem), match.Redeem},
+			{values.String(values.StrSwapStepCounterRedeem), match.CounterRedeem},
+			{values.String(values.StrSwapStepRefund), match.Refund},
+		}
+		for _, s := range steps {
+			if s.coin == nil {
+				continue
+			}
+			details.coins = append(details.coins, pg.matchCoin(s.step, s.coin, dexWallet(s.coin.AssetID)))
+		}
+		matches = append(matches, details)
+	}
+
+	pg.mtx.Lock()
+	pg.order, pg.matches = ord, matches
+	pg.mtx.Unlock()
+
+	pg.ParentWindow().Reload()
+}
+
+func (pg *DEXOrderDetailsPage) matchCoin(step string, coin *core.Coin, wallet sharedW.Asset) *matchCoin {
+	// The coin IDs of UTXO assets are the outpoints of the txs.
+	txHash, _, _ := strings.Cut(coin.StringID, ":")
+	mc := &matchCoin{
+		step:   step,
+		coin:   coin,
+		txHash: txHash,
+		confs:  "---",
+	}
+
+	if wallet != nil {
+		if tx, err := wallet.GetTransactionRaw(txHash); err == nil {
+			mc.wallet, mc.tx = wallet, tx
+			mc.viewTx = pg.Theme.NewClickable(true)
+			mc.confs = fmt.Sprintf("%d", components.TxConfirmations(wallet, tx))
+		}
+	}
+
+	// The DEX client tracks the confirmations of swaps awaiting them.
+	if coin.Confs != nil {
+		mc.confs = fmt.Sprintf("%d/%d", coin.Confs.Count, coin.Confs.Required)
+	}
+	return mc
+}
+
+// matchStep describes the swap step the match is waiting for.
+func matchStep(match *core.Match) string {
+	maker := match.Side == order.Maker
+	switch {
+	case match.Refund != nil:
+		return values.String(values.StrMatchRefunded)
+	case match.Revoked && match.Active:
+		return values.String(values.StrMatchRevokedAwaitingRefund)
+	case match.Revoked:
+		return values.String(values.StrMatchRevoked)
+	}
+
+	switch match.Status {
+	case order.NewlyMatched:
+		if maker {
+			return values.String(values.StrMatchStepSendSwap)
+		}
+		return values.String(values.StrMatchStepAwaitCounterSwap)
+	case order.MakerSwapCast:
+		if maker {
+			return values.String(values.StrMatchStepAwaitCounterSwap)
+		}
+		return values.String(values.StrMatchStepAuditSendSwap)
+	case order.TakerSwapCast:
+		if maker {
+			return values.String(values.StrMatchStepAuditRedeem)
+		}
+		return values.String(values.StrMatchStepAwaitCounterRedeem)
+	case order.MakerRedeemed:
+		if maker {
+			return values.String(values.StrMatchStepRedeemConfirming)
+		}
+		return values.String(values.StrMatchStepRedeem)
+	case order.MatchComplete:
+		return values.String(values.StrMatchStepRedeemConfirming)
+	}
+	return values.String(values.StrMatchStepComplete)
+}
+
+// refundTime returns the time the swap of the match can be refunded, if the
+// swap may still need to be refunded.
+func (pg *DEXOrderDetailsPage) refundTime(match *core.Match) (time.Time, bool) {
+	if !match.Active || match.Swap == nil || match.Refund != nil || match.Redeem != nil || match.CounterRedeem != nil {
+		return time.Time{}, false
+	}
+
+	lockTime, err := dexc.SwapLockTime(pg.AssetsManager.NetType(), match.Side)
+	if err != nil {
+		return time.Time{}, false
+	}
+	// The swap contract locktime starts from the match time.
+	return time.UnixMilli(int64(match.Stamp)).Add(lockTime), true
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *DEXOrderDetailsPage) Layout(gtx C) D {
+	pg.mtx.RLock()
+	ord, matches := pg.order, pg.matches
+	pg.mtx.RUnlock()
+
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrOrderDetails),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				sections := []layout.Widget{
+					func(gtx C) D { return pg.orderSection(gtx, ord) },
+				}
+				for _, match := range matches {
+					match := match
+					sections = append(sections, func(gtx C) D { return pg.matchSection(gtx, match) })
+				}
+				if len(matches) == 0 {
+					sections = append(sections, func(gtx C) D {
+						lbl := pg.Theme.Body2(values.String(values.StrNoMatches))
+						lbl.Color = pg.Theme.Color.GrayText2
+						return lbl.Layout(gtx)
+					})
+				}
+
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *DEXOrderDetailsPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *DEXOrderDetailsPage) detailRow(gtx C, title, value string) D {
+	titleLbl := pg.Theme.Body2(title)
+	titleLbl.Color = pg.Theme.Color.GrayText2
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLbl.Layout, pg.Theme.Body2(value).Layout)
+	})
+}
+
+func (pg *DEXOrderDetailsPage) orderSection(gtx C, ord *core.Order) D {
+	reader := orderReader(pg.AssetsManager.DexClient(), ord)
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, fmt.Sprintf("%s %s", values.String(ord.Type.String()), values.String(reader.SideString())))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrOrderID), ord.ID.String())
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrPair), ord.MarketID)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrPrice), reader.RateString())
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrAmount), fmt.Sprintf("%s %s", reader.BaseQtyString(), strings.ToUpper(reader.BaseSymbol)))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrFilled), fmt.Sprintf("%s%%", reader.FilledPercent()))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrSettled), fmt.Sprintf("%s%%", reader.SettledPercent()))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrStatus), reader.StatusString())
+		}),
+	)
+}
+
+func (pg *DEXOrderDetailsPage) matchSection(gtx C, match *matchDetails) D {
+	side := values.String(values.StrTaker)
+	if match.Side == order.Maker {
+		side = values.String(values.StrMaker)
+	}
+	mkt := pg.marketInfo()
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.StringF(values.StrMatchTitle, match.MatchID.String()[:8]))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrStatus), matchStep(match.Match))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrSide), side)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrPrice), trimmedConventionalAmtString(msgRateToConventional(mkt, match.Rate)))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrAmount), trimmedConventionalAmtString(conventionalAmt(match.Qty)))
+		}),
+	}
+
+	if refundTime, ok := pg.refundTime(match.Match); ok {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			remaining := time.Until(refundTime)
+			refund := values.String(values.StrRefundableNow)
+			if remaining > 0 {
+				refund = values.StringF(values.StrRefundableIn, pageutils.SecondsToDays(int64(remaining.Seconds())))
+				gtx.Execute(op.InvalidateCmd{At: gtx.Now.Add(time.Second)})
+			}
+			return pg.detailRow(gtx, values.String(values.StrRefundLocktime), refund)
+		}))
+	}
+
+	for _, coin := range match.coins {
+		coin := coin
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return pg.coinRow(gtx, coin)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXOrderDetailsPage) coinRow(gtx C, coin *matchCoin) D {
+	return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				stepLbl := pg.Theme.Body2(fmt.Sprintf("%s (%s)", coin.step, strings.ToUpper(coin.coin.Symbol)))
+				stepLbl.Font.Weight = font.SemiBold
+				confsLbl := pg.Theme.Body2(values.StringF(values.StrConfirmationsCount, coin.confs))
+				confsLbl.Color = pg.Theme.Color.GrayText2
+				return components.EndToEndRow(gtx, stepLbl.Layout, confsLbl.Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				txLbl := pg.Theme.Caption(coin.coin.StringID)
+				txLbl.Color = pg.Theme.Color.GrayText2
+				if coin.viewTx == nil {
+					return txLbl.Layout(gtx)
+				}
+				txLbl.Color = pg.Theme.Color.Primary
+				return coin.viewTx.Layout(gtx, txLbl.Layout)
+			}),
+		)
+	})
+}
+
+func (pg *DEXOrderDetailsPage) marketInfo() *core.Market {
+	pg.mtx.RLock()
+	ord := pg.order
+	pg.mtx.RUnlock()
+
+	xc, err := pg.AssetsManager.DexClient().Exchange(ord.Host)
+	if err != nil {
+		return nil
+	}
+	return xc.Markets[ord.MarketID]
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *DEXOrderDetailsPage) HandleUserInteractions(gtx C) {
+	pg.mtx.RLock()
+	matches := pg.matches
+	pg.mtx.RUnlock()
+
+	for _, match := range matches {
+		for _, coin := range match.coins {
+			if coin.viewTx != nil && coin.viewTx.Clicked(gtx) {
+				pg.ParentNavigator().Display(transaction.NewTransactionDetailsPage(pg.Load, coin.wallet, coin.tx))
+			}
+		}
+	}
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *DEXOrderDetailsPage) OnNavigatedFrom() {
+	pg.cancelCtx()
+}
@@ -0,0 +1,557 @@
+package dcrdex
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"decred.org/dcrdex/client/comms"
+	"decred.org/dcrdex/client/core"
+	"decred.org/dcrdex/dex"
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const DEXServersPageID = "dex_servers"
+
+// serverItem is a DEX server with its actions.
+type serverItem struct {
+	*core.Exchange
+	manageBondsBtn cryptomaterial.Button
+	toggleBtn      cryptomaterial.Button
+	updateCertBtn  cryptomaterial.Button
+}
+
+// DEXServersPage lists the DEX servers with their account status and bonds,
+// lets the user add, disable and enable servers and compares a market across
+// the servers.
+type DEXServersPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	// mkt is the market compared across the servers, may be nil.
+	mkt *core.Market
+
+	mtx         sync.RWMutex
+	servers     []*serverItem
+	marketBooks []*libwallet.DEXMarketBook
+
+	pageContainer *widget.List
+	backButton    cryptomaterial.IconButton
+
+	serverURLEditor  cryptomaterial.Editor
+	serverCertEditor cryptomaterial.Editor
+	addServerBtn     cryptomaterial.Button
+	refreshBooksBtn  cryptomaterial.Button
+
+	materialLoader material.LoaderStyle
+	isLoading      atomic.Bool
+}
+
+// NewDEXServersPage creates a DEXServersPage. mkt is the market to compare
+// across the servers, if any.
+func NewDEXServersPage(l *load.Load, mkt *core.Market) *DEXServersPage {
+	th := l.Theme
+	pg := &DEXServersPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(DEXServersPageID),
+		mkt:              mkt,
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		serverURLEditor:  newTextEditor(th, values.String(values.StrServerAddress), values.String(values.StrServerAddress), false),
+		serverCertEditor: newTextEditor(th, values.String(values.StrCertificateOPtional), values.String(values.StrCertificatePemOrPath), true),
+		addServerBtn:     th.Button(values.String(values.StrAddServer)),
+		refreshBooksBtn:  th.OutlineButton(values.String(values.StrRefresh)),
+		materialLoader:   material.Loader(th.Base),
+	}
+
+	pg.backButton = components.GetBackButton(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *DEXServersPage) OnNavigatedTo() {
+	pg.refreshServers()
+	go pg.refreshMarketBooks()
+}
+
+// refreshServers reloads the servers from the DEX client.
+func (pg *DEXServersPage) refreshServers() {
+	pg.mtx.RLock()
+	existing := make(map[string]*serverItem, len(pg.servers))
+	for _, item := range pg.servers {
+		existing[item.Host] = item
+	}
+	pg.mtx.RUnlock()
+
+	var servers []*serverItem
+	for host, xc := range pg.AssetsManager.DexClient().Exchanges() {
+		item, ok := existing[host]
+		if !ok {
+			item = &serverItem{
+				manageBondsBtn: pg.Theme.OutlineButton(values.String(values.StrManageBonds)),
+				toggleBtn:      pg.Theme.OutlineButton(values.String(values.StrDisable)),
+				updateCertBtn:  pg.Theme.OutlineButton(values.String(values.StrUpdateCertificate)),
+			}
+		}
+		item.Exchange = xc
+		if xc.Disabled {
+			item.toggleBtn.Text = values.String(values.StrEnableServer)
+		} else {
+			item.toggleBtn.Text = values.String(values.StrDisable)
+		}
+		servers = append(servers, item)
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Host < servers[j].Host
+	})
+
+	pg.mtx.Lock()
+	pg.servers = servers
+	pg.mtx.Unlock()
+}
+
+// refreshMarketBooks compares the order books of the market across the
+// servers. It MUST be called from a goroutine.
+func (pg *DEXServersPage) refreshMarketBooks() {
+	if pg.mkt == nil {
+		return
+	}
+
+	books, err := pg.AssetsManager.CompareDEXMarket(pg.mkt.BaseID, pg.mkt.QuoteID, 0, false)
+	if err != nil {
+		log.Errorf("Error comparing %s market: %v", pg.mkt.Name, err)
+	}
+
+	pg.mtx.Lock()
+	pg.marketBooks = books
+	pg.mtx.Unlock()
+	pg.ParentWindow().Reload()
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *DEXServersPage) HandleUserInteractions(gtx C) {
+	if pg.addServerBtn.Clicked(gtx) {
+		pg.addServer()
+	}
+
+	if pg.refreshBooksBtn.Clicked(gtx) {
+		go pg.refreshMarketBooks()
+	}
+
+	pg.mtx.RLock()
+	servers := pg.servers
+	pg.mtx.RUnlock()
+	for _, item := range servers {
+		if item.manageBondsBtn.Clicked(gtx) {
+			pg.ParentNavigator().Display(NewDEXBondManagerPage(pg.Load, item.Host))
+		}
+		if item.toggleBtn.Clicked(gtx) {
+			pg.toggleServer(item.Exchange)
+		}
+		if item.updateCertBtn.Clicked(gtx) {
+			pg.showUpdateCertModal(item.Host)
+		}
+	}
+}
+
+// withDEXPassword requests the DEX password and calls fn with it once it is
+// verified. fn returns an error to show on the password modal.
+func (pg *DEXServersPage) withDEXPassword(fn func(dexPass []byte) error) {
+	dexClient := pg.AssetsManager.DexClient()
+	dexPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrDexPassword)).
+		PasswordHint(values.String(values.StrDexPassword)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := dexClient.Login([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			if err := fn([]byte(password)); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+			return true
+		})
+	dexPasswordModal.SetPasswordTitleVisibility(false)
+	pg.ParentWindow().ShowModal(dexPasswordModal)
+}
+
+// addServer adds the entered server to the DEX client. An existing account
+// on the server is restored, otherwise the server is added as a view-only
+// server until a bond is posted.
+func (pg *DEXServersPage) addServer() {
+	serverURL := strings.TrimSpace(pg.serverURLEditor.Editor.Text())
+	if _, err := url.ParseRequestURI(serverURL); !utils.EditorsNotEmpty(pg.serverURLEditor.Editor) || err != nil {
+		pg.serverURLEditor.SetError(values.String(values.StrDEXServerAddrWarning))
+		return
+	}
+
+	cert, err := parseServerCert(serverURL, pg.serverCertEditor.Editor.Text())
+	if err != nil {
+		pg.serverCertEditor.SetError(err.Error())
+		return
+	}
+
+	dexClient := pg.AssetsManager.DexClient()
+	pg.withDEXPassword(func(dexPass []byte) error {
+		pg.isLoading.Store(true)
+		go func() {
+			defer func() {
+				pg.isLoading.Store(false)
+				pg.ParentWindow().Reload()
+			}()
+
+			xc, paid, err := dexClient.DiscoverAccount(serverURL, dexPass, cert)
+			if err == nil && !paid {
+				err = dexClient.AddDEX(dexPass, serverURL, cert)
+			}
+			if err != nil {
+				pg.Toast.NotifyError(err.Error())
+				return
+			}
+
+			if paid {
+				pg.Toast.Notify(values.StringF(values.StrServerAccountRestored, xc.Host))
+			} else {
+				pg.Toast.Notify(values.StringF(values.StrServerAdded, xc.Host))
+			}
+
+			pg.serverURLEditor.Editor.SetText("")
+			pg.serverCertEditor.Editor.SetText("")
+			pg.refreshServers()
+			pg.refreshMarketBooks()
+		}()
+		return nil
+	})
+}
+
+// toggleServer disables or enables the server account after the user
+// confirms the change with the DEX password.
+func (pg *DEXServersPage) toggleServer(xc *core.Exchange) {
+	toggle := func() {
+		pg.withDEXPassword(func(dexPass []byte) error {
+			err := pg.AssetsManager.DexClient().ToggleAccountStatus(dexPass, xc.Host, !xc.Disabled)
+			if err != nil {
+				return err
+			}
+			pg.Toast.Notify(values.String(values.StrServerStatusUpdated))
+			pg.refreshServers()
+			return nil
+		})
+	}
+
+	if xc.Disabled {
+		toggle()
+		return
+	}
+
+	confirmModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrDisable)).
+		Body(values.StringF(values.StrDisableServerMsg, xc.Host)).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrDisable)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			toggle()
+			return true
+		})
+	pg.ParentWindow().ShowModal(confirmModal)
+}
+
+// showUpdateCertModal requests a new certificate for a disconnected server.
+func (pg *DEXServersPage) showUpdateCertModal(host string) {
+	certEditor := newTextEditor(pg.Theme, values.String(values.StrInputCertificate), values.String(values.StrCertificatePemOrPath), true)
+	certModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrUpdateCertificate)).
+		UseCustomWidget(certEditor.Layout).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrUpdateCertificate)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			certEditor.SetError("")
+			cert, err := parseServerCert(host, certEditor.Editor.Text())
+			if err == nil && cert == nil {
+				err = errors.New(values.String(values.StrInputCertificate))
+			}
+			if err == nil {
+				err = pg.AssetsManager.DexClient().UpdateCert(host, cert)
+			}
+			if err != nil {
+				certEditor.SetError(err.Error())
+				return false
+			}
+
+			pg.Toast.Notify(values.String(values.StrCertificateUpdated))
+			pg.refreshServers()
+			return true
+		})
+	pg.ParentWindow().ShowModal(certModal)
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *DEXServersPage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrDEXServers),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				pg.mtx.RLock()
+				servers := pg.servers
+				pg.mtx.RUnlock()
+
+				var sections []layout.Widget
+				for _, item := range servers {
+					item := item
+					sections = append(sections, func(gtx C) D { return pg.serverSection(gtx, item) })
+				}
+				if pg.mkt != nil {
+					sections = append(sections, pg.compareMarketSection)
+				}
+				sections = append(sections, pg.addServerSection)
+
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *DEXServersPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *DEXServersPage) detailRow(gtx C, title, value string) D {
+	titleLbl := pg.Theme.Body2(title)
+	titleLbl.Color = pg.Theme.Color.GrayText2
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLbl.Layout, pg.Theme.Body2(value).Layout)
+	})
+}
+
+func (pg *DEXServersPage) serverSection(gtx C, item *serverItem) D {
+	xc := item.Exchange
+	status := values.String(values.StrServerConnected)
+	switch {
+	case xc.Disabled:
+		status = values.String(values.StrServerDisabled)
+	case xc.ConnectionStatus != comms.Connected:
+		status = values.String(values.StrServerDisconnected)
+	}
+
+	account := values.String(values.StrViewOnlyAccount)
+	if !xc.ViewOnly {
+		account = values.StringF(values.StrTierFmt, xc.Auth.EffectiveTier)
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, xc.Host)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrServerStatus), status)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrAccountStatus), account)
+		}),
+	}
+
+	if !xc.ViewOnly {
+		children = append(children,
+			layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrActiveTiers), fmt.Sprintf("%d", xc.Auth.LiveStrength-xc.Auth.WeakStrength))
+			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrExpiringTiers), fmt.Sprintf("%d", xc.Auth.WeakStrength))
+			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrPendingBonds), fmt.Sprintf("%d", len(xc.Auth.PendingBonds)))
+			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.detailRow(gtx, values.String(values.StrRefundableBonds), fmt.Sprintf("%d", len(xc.Auth.ExpiredBonds)))
+			}),
+		)
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				btns := []layout.FlexChild{layout.Rigid(item.manageBondsBtn.Layout)}
+				if !xc.Disabled && xc.ConnectionStatus != comms.Connected {
+					btns = append(btns, layout.Rigid(func(gtx C) D {
+						return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, item.updateCertBtn.Layout)
+					}))
+				}
+				btns = append(btns, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, item.toggleBtn.Layout)
+				}))
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal}, btns...)
+			})
+		})
+	}))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXServersPage) compareMarketSection(gtx C) D {
+	pg.mtx.RLock()
+	books := pg.marketBooks
+	pg.mtx.RUnlock()
+
+	mkt := pg.mkt
+	mktName := fmt.Sprintf("%s/%s", strings.ToUpper(mkt.BaseSymbol), strings.ToUpper(mkt.QuoteSymbol))
+	rateStr := func(rate uint64) string {
+		if rate == 0 {
+			return "-"
+		}
+		return trimmedConventionalAmtString(mkt.MsgRateToConventional(rate))
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				return pg.sectionTitle(gtx, values.StringF(values.StrCompareMarket, mktName))
+			}, pg.refreshBooksBtn.Layout)
+		}),
+	}
+
+	if len(books) < 2 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrNoOtherServersMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		}))
+	}
+
+	formatLotSize := func(host string, lotSize uint64) string {
+		if xc, err := pg.AssetsManager.DexClient().Exchange(host); err == nil {
+			if asset, ok := xc.Assets[mkt.BaseID]; ok {
+				return asset.UnitInfo.FormatAtoms(lotSize)
+			}
+		}
+		return fmt.Sprintf("%d %s", lotSize, strings.ToUpper(dex.BipIDSymbol(mkt.BaseID)))
+	}
+
+	for _, book := range books {
+		book := book
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				rows := []layout.FlexChild{
+					layout.Rigid(func(gtx C) D {
+						lbl := pg.Theme.Body2(book.Host)
+						lbl.Font.Weight = font.SemiBold
+						return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+					}),
+				}
+				if book.Err != nil {
+					rows = append(rows, layout.Rigid(func(gtx C) D {
+						lbl := pg.Theme.Caption(book.Err.Error())
+						lbl.Color = pg.Theme.Color.Danger
+						return lbl.Layout(gtx)
+					}))
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+				}
+				rows = append(rows,
+					layout.Rigid(func(gtx C) D {
+						return pg.detailRow(gtx, values.String(values.StrMidGap), rateStr(book.MidGap))
+					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.detailRow(gtx, values.String(values.StrBestBuy), rateStr(book.BestBuy))
+					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.detailRow(gtx, values.String(values.StrBestSell), rateStr(book.BestSell))
+					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.detailRow(gtx, values.String(values.StrLotSize), formatLotSize(book.Host, book.LotSize))
+					}),
+				)
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+			})
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *DEXServersPage) addServerSection(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrAddServer))
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(values.String(values.StrAddServerMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Rigid(pg.serverURLEditor.Layout),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, pg.serverCertEditor.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				if pg.isLoading.Load() {
+					return layout.E.Layout(gtx, pg.materialLoader.Layout)
+				}
+				return layout.E.Layout(gtx, pg.addServerBtn.Layout)
+			})
+		}),
+	)
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *DEXServersPage) OnNavigatedFrom() {}
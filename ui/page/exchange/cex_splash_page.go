@@ -55,7 +55,7 @@ func (pg *CreateOrderPage) splashPage(gtx C) D {
 		}),
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{Right: values.MarginPadding15, Left: values.MarginPadding15}.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx, layout.Flexed(1, pg.startTradingBtn.Layout))
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{}, layout.Flexed(1, pg.startTradingBtn.Layout))
 			})
 		}),
 	)
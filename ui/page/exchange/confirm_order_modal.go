@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"fmt"
+	"strconv"
 
 	"gioui.org/font"
 	"gioui.org/io/key"
@@ -11,9 +12,6 @@ import (
 	"gioui.org/widget/material"
 
 	"decred.org/dcrwallet/v4/errors"
-	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
-	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
-	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -31,6 +29,7 @@ type confirmOrderModal struct {
 	closeConfirmationModalButton cryptomaterial.Button
 	confirmButton                cryptomaterial.Button
 	passwordEditor               cryptomaterial.Editor
+	feeRateEditor                cryptomaterial.Editor
 
 	onOrderCompleted func(order *instantswap.Order)
 	onCancel         func()
@@ -61,6 +60,10 @@ func newConfirmOrderModal(l *load.Load, data *orderData) *confirmOrderModal {
 	com.passwordEditor.Editor.SingleLine = true
 	com.passwordEditor.Editor.Submit = true
 
+	com.feeRateEditor = l.Theme.Editor(new(widget.Editor), values.StringF(values.StrFeeRateOptional, com.feeRateUnit()))
+	com.feeRateEditor.Editor.SingleLine = true
+	com.feeRateEditor.Editor.Filter = "0123456789"
+
 	com.pageContainer = &widget.List{
 		List: layout.List{
 			Axis:      layout.Vertical,
@@ -104,6 +107,17 @@ func (com *confirmOrderModal) confirmOrder() {
 		return
 	}
 
+	var feeRate int64
+	if com.hasFeeRate() && com.feeRateEditor.Editor.Text() != "" {
+		rate, err := strconv.ParseInt(com.feeRateEditor.Editor.Text(), 10, 64)
+		if err != nil || rate <= 0 {
+			com.feeRateEditor.SetError(values.String(values.StrInvalidFeeRate))
+			return
+		}
+		feeRate = rate
+	}
+	com.feeRateEditor.SetError("")
+
 	com.setLoading(true)
 	go func() {
 		var err error
@@ -138,15 +152,8 @@ func (com *confirmOrderModal) confirmOrder() {
 			return
 		}
 
-		err = com.constructTx(order.DepositAddress, order.InvoicedAmount)
-		if err != nil {
-			_ = com.AssetsManager.InstantSwap.DeleteOrder(order)
-			com.SetError(err.Error())
-			return
-		}
-
 		// FOR DEVELOPMENT: Comment this block to prevent debit of account
-		_, err = com.sourceWalletSelector.SelectedWallet().Broadcast(password, "")
+		_, err = com.AssetsManager.FundInstantSwapOrder(order, password, feeRate)
 		if err != nil {
 			_ = com.AssetsManager.InstantSwap.DeleteOrder(order)
 			com.SetError(err.Error())
@@ -158,6 +165,22 @@ func (com *confirmOrderModal) confirmOrder() {
 	}()
 }
 
+// hasFeeRate is true if the fee rate of the deposit tx can be chosen for the
+// source wallet.
+func (com *confirmOrderModal) hasFeeRate() bool {
+	assetType := com.sourceWalletSelector.SelectedWallet().GetAssetType()
+	return assetType == utils.BTCWalletAsset || assetType == utils.LTCWalletAsset
+}
+
+// feeRateUnit returns the unit of the fee rate, the rate is passed to the
+// wallet per kilo virtual byte.
+func (com *confirmOrderModal) feeRateUnit() string {
+	if com.sourceWalletSelector.SelectedWallet().GetAssetType() == utils.LTCWalletAsset {
+		return "Lit/kvB"
+	}
+	return "Sat/kvB"
+}
+
 func (com *confirmOrderModal) Handle(gtx C) {
 	for {
 		event, ok := com.passwordEditor.Editor.Update(gtx)
@@ -178,7 +201,7 @@ func (com *confirmOrderModal) Handle(gtx C) {
 		com.confirmOrder()
 	}
 
-	if com.closeConfirmationModalButton.Clicked(gtx) {
+	if com.closeConfirmationModalButton.Clicked(gtx) || com.Modal.Escaped(gtx) {
 		if !com.isCreating {
 			com.Dismiss()
 		}
@@ -204,7 +227,7 @@ func (com *confirmOrderModal) Layout(gtx layout.Context) D {
 									return com.Theme.List(com.pageContainer).Layout(gtx, 1, func(gtx C, _ int) D {
 										return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 											layout.Rigid(func(gtx C) D {
-												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+												return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 													layout.Rigid(func(gtx C) D {
 														return components.SetWalletLogo(com.Load, gtx, com.orderData.fromCurrency, values.MarginPadding30)
 													}),
@@ -240,7 +263,7 @@ func (com *confirmOrderModal) Layout(gtx layout.Context) D {
 												})
 											}),
 											layout.Rigid(func(gtx C) D {
-												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+												return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 													layout.Rigid(func(gtx C) D {
 														return components.SetWalletLogo(com.Load, gtx, com.orderData.toCurrency, values.MarginPadding30)
 													}),
@@ -270,6 +293,12 @@ func (com *confirmOrderModal) Layout(gtx layout.Context) D {
 													}),
 												)
 											}),
+											layout.Rigid(func(gtx C) D {
+												if !com.hasFeeRate() {
+													return D{}
+												}
+												return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, com.feeRateEditor.Layout)
+											}),
 											layout.Rigid(func(gtx C) D {
 												return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, com.passwordEditor.Layout)
 											}),
@@ -294,10 +323,10 @@ func (com *confirmOrderModal) Layout(gtx layout.Context) D {
 									Top: values.MarginPadding16,
 								}
 								return inset.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Flexed(1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
-												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+												return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 													layout.Rigid(func(gtx C) D {
 														return layout.Inset{
 															Right: values.MarginPadding8,
@@ -359,29 +388,3 @@ func (com *confirmOrderModal) createOrder() (*instantswap.Order, error) {
 
 	return order, nil
 }
-
-func (com *confirmOrderModal) constructTx(depositAddress string, unitAmount float64) error {
-	destinationAddress := depositAddress
-
-	sourceAccount := com.sourceAccountSelector.SelectedAccount()
-	err := com.sourceWalletSelector.SelectedWallet().NewUnsignedTx(sourceAccount.Number, nil)
-	if err != nil {
-		return err
-	}
-
-	var amount int64
-	switch com.sourceWalletSelector.SelectedWallet().GetAssetType() {
-	case utils.BTCWalletAsset:
-		amount = btc.AmountSatoshi(unitAmount)
-	case utils.DCRWalletAsset:
-		amount = dcr.AmountAtom(unitAmount)
-	case utils.LTCWalletAsset:
-		amount = ltc.AmountLitoshi(unitAmount)
-	}
-	err = com.sourceWalletSelector.SelectedWallet().AddSendDestination(0, destinationAddress, amount, false)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -59,6 +59,7 @@ type CreateOrderPage struct {
 	toAmountEditor   components.SelectAssetEditor
 
 	createOrderBtn                           cryptomaterial.Button
+	compareQuotesBtn                         cryptomaterial.Button
 	horizontalSwapButton, verticalSwapButton cryptomaterial.IconButton
 	refreshExchangeRateBtn                   cryptomaterial.IconButton
 	infoButton                               cryptomaterial.IconButton
@@ -112,7 +113,8 @@ type orderData struct {
 	refundAddress      string
 	destinationAddress string
 
-	scheduler *cryptomaterial.Switch
+	scheduler          *cryptomaterial.Switch
+	schedulesClickable *cryptomaterial.Clickable
 }
 
 func NewCreateOrderPage(l *load.Load) *CreateOrderPage {
@@ -147,13 +149,19 @@ func NewCreateOrderPage(l *load.Load) *CreateOrderPage {
 	pg.scroll = components.NewScroll(l, pageSize, pg.fetchOrders)
 
 	pg.scheduler = pg.Theme.Switch()
+	pg.scheduler.Description = values.String(values.StrScheduler)
+	pg.schedulesClickable = pg.Theme.NewClickable(false)
 	pg.horizontalSwapButton = l.Theme.IconButton(l.Theme.Icons.ActionSwapHoriz)
 	pg.verticalSwapButton = l.Theme.IconButton(l.Theme.Icons.ActionSwapVertical)
 	pg.refreshExchangeRateBtn = l.Theme.IconButton(l.Theme.Icons.NavigationRefresh)
 	pg.refreshExchangeRateBtn.Size = values.MarginPaddingTransform(l.IsMobileView(), values.MarginPadding18)
+	pg.horizontalSwapButton.Description = values.String(values.StrSwapCurrencies)
+	pg.verticalSwapButton.Description = values.String(values.StrSwapCurrencies)
+	pg.refreshExchangeRateBtn.Description = values.String(values.StrRefresh)
 
 	pg.settingsButton = l.Theme.IconButton(l.Theme.Icons.ActionSettings)
 	pg.settingsButton.Size = values.MarginPaddingTransform(l.IsMobileView(), values.MarginPadding18)
+	pg.settingsButton.Description = values.String(values.StrSettings)
 
 	pg.viewAllButton = l.Theme.Button(values.String(values.StrViewAllOrders))
 	pg.viewAllButton.Font.Weight = font.SemiBold
@@ -165,6 +173,7 @@ func NewCreateOrderPage(l *load.Load) *CreateOrderPage {
 
 	pg.infoButton = l.Theme.IconButton(l.Theme.Icons.ActionInfo)
 	pg.infoButton.Size = values.MarginPaddingTransform(l.IsMobileView(), values.MarginPadding18)
+	pg.infoButton.Description = values.String(values.StrInfo)
 	buttonInset := layout.UniformInset(values.MarginPadding0)
 	pg.settingsButton.Inset,
 		pg.infoButton.Inset,
@@ -209,6 +218,9 @@ func NewCreateOrderPage(l *load.Load) *CreateOrderPage {
 	pg.createOrderBtn = pg.Theme.Button(values.String(values.StrCreateOrder))
 	pg.createOrderBtn.SetEnabled(false)
 
+	pg.compareQuotesBtn = pg.Theme.OutlineButton(values.String(values.StrCompareProviders))
+	pg.compareQuotesBtn.SetEnabled(false)
+
 	pg.navToSettingsBtn = pg.Theme.Button(values.StringF(values.StrEnableAPI, values.String(values.StrExchange)))
 
 	pg.exchangeSelector.ExchangeSelected(func(es *Exchange) {
@@ -300,7 +312,7 @@ func (pg *CreateOrderPage) isExchangeAPIAllowed() bool {
 // once after it has been displayed.
 func (pg *CreateOrderPage) initPage() {
 	pg.inited = true
-	pg.scheduler.SetChecked(pg.AssetsManager.IsOrderSchedulerRunning())
+	pg.scheduler.SetChecked(pg.AssetsManager.InstantSwap.RunningSchedulesCount() > 0)
 	pg.listenForNotifications()
 	pg.loadOrderConfig()
 	go pg.scroll.FetchScrollData(false, pg.ParentWindow(), false)
@@ -312,6 +324,7 @@ func (pg *CreateOrderPage) OnNavigatedFrom() {
 
 func (pg *CreateOrderPage) HandleUserInteractions(gtx C) {
 	pg.createOrderBtn.SetEnabled(pg.canCreateOrder())
+	pg.compareQuotesBtn.SetEnabled(pg.canCompareQuotes())
 
 	if pg.horizontalSwapButton.Button.Clicked(gtx) || pg.verticalSwapButton.Button.Clicked(gtx) {
 		pg.swapCurrency()
@@ -343,6 +356,10 @@ func (pg *CreateOrderPage) HandleUserInteractions(gtx C) {
 		pg.showConfirmOrderModal()
 	}
 
+	if pg.compareQuotesBtn.Clicked(gtx) {
+		pg.showQuotesModal()
+	}
+
 	if pg.settingsButton.Button.Clicked(gtx) {
 		orderSettingsModal := newOrderSettingsModalModal(pg.Load, pg.orderData).
 			OnSettingsSaved(func(params *callbackParams) {
@@ -386,34 +403,23 @@ func (pg *CreateOrderPage) HandleUserInteractions(gtx C) {
 
 	if pg.scheduler.Changed(gtx) {
 		if pg.scheduler.IsChecked() {
-
 			orderSettingsModal := newOrderSettingsModalModal(pg.Load, pg.orderData).
 				OnSettingsSaved(func(_ *callbackParams) {
-					refundAddress, _ := pg.sourceWalletSelector.SelectedWallet().CurrentAddress(pg.sourceAccountSelector.SelectedAccount().Number)
-					destinationAddress, _ := pg.destinationWalletSelector.SelectedWallet().CurrentAddress(pg.destinationAccountSelector.SelectedAccount().Number)
-					pg.sourceWalletID = pg.sourceWalletSelector.SelectedWallet().GetWalletID()
-					pg.sourceAccountNumber = pg.sourceAccountSelector.SelectedAccount().Number
-					pg.destinationWalletID = pg.destinationWalletSelector.SelectedWallet().GetWalletID()
-					pg.destinationAccountNumber = pg.destinationAccountSelector.SelectedAccount().Number
-
-					pg.refundAddress = refundAddress
-					pg.destinationAddress = destinationAddress
-
-					orderSchedulerModal := newOrderSchedulerModalModal(pg.Load, pg.orderData).
-						OnCancel(func() { // needed to satisfy the modal instance
-							pg.scheduler.SetChecked(false)
-						})
-					pg.ParentWindow().ShowModal(orderSchedulerModal)
+					pg.showOrderSchedulerModal()
 				}).
 				OnCancel(func() { // needed to satisfy the modal instance
-					pg.scheduler.SetChecked(false)
+					pg.scheduler.SetChecked(pg.AssetsManager.InstantSwap.RunningSchedulesCount() > 0)
 				})
 			pg.ParentWindow().ShowModal(orderSettingsModal)
 		} else {
-			pg.AssetsManager.StopScheduler()
+			pg.AssetsManager.PauseSchedules()
 		}
 	}
 
+	if pg.schedulesClickable.Clicked(gtx) {
+		pg.showOrderSchedulerModal()
+	}
+
 	if pg.navToSettingsBtn.Button.Clicked(gtx) {
 		pg.ParentWindow().Display(settings.NewAppSettingsPage(pg.Load))
 	}
@@ -550,6 +556,17 @@ func (pg *CreateOrderPage) canCreateOrder() bool {
 	return true
 }
 
+// canCompareQuotes is true if the swap amount and currencies required to
+// request quotes from the exchange servers are set.
+func (pg *CreateOrderPage) canCompareQuotes() bool {
+	if pg.fromCurrency == libutils.NilAsset || pg.toCurrency == libutils.NilAsset || pg.fromCurrency == pg.toCurrency {
+		return false
+	}
+
+	fromAmt, err := strconv.ParseFloat(pg.fromAmountEditor.Edit.Editor.Text(), 64)
+	return err == nil && fromAmt > 0
+}
+
 func (pg *CreateOrderPage) inputsNotEmpty(editors ...*widget.Editor) bool {
 	for _, e := range editors {
 		if e.Text() == "" {
@@ -775,13 +792,13 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 				if pg.IsMobileView() {
 					axis = layout.Vertical
 				}
-				return layout.Flex{Axis: axis}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis},
 					components.ConditionalFlexedRigidLayout(0.65, pg.IsMobileView(), func(gtx C) D {
 						return layout.E.Layout(gtx, func(gtx C) D {
-							return layout.Flex{
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 								Axis:      layout.Horizontal,
 								Alignment: layout.Middle,
-							}.Layout(gtx,
+							},
 								layout.Rigid(func(gtx C) D {
 									return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 										layout.Rigid(func(gtx C) D {
@@ -826,10 +843,10 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 				axis = layout.Vertical
 			}
 
-			return layout.Flex{
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 				Axis:      axis,
 				Alignment: layout.Middle,
-			}.Layout(gtx,
+			},
 				components.ConditionalFlexedRigidLayout(0.45, pg.IsMobileView(), func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
@@ -892,10 +909,10 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 			return layout.Inset{
 				Bottom: values.MarginPadding16,
 			}.Layout(gtx, func(gtx C) D {
-				return layout.Flex{
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 					Axis:      layout.Horizontal,
 					Alignment: layout.Middle,
-				}.Layout(gtx,
+				},
 					layout.Flexed(0.55, func(gtx C) D {
 						return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 							layout.Rigid(func(gtx C) D {
@@ -909,7 +926,7 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 								return D{}
 							}),
 							layout.Rigid(func(gtx C) D {
-								return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 									layout.Rigid(func(gtx C) D {
 										if pg.fetchingRate {
 											gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding16)
@@ -987,13 +1004,19 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 				Top: values.MarginPadding16,
 			}.Layout(gtx, pg.createOrderBtn.Layout)
 		}),
+		layout.Rigid(func(gtx C) D {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Inset{
+				Top: values.MarginPadding8,
+			}.Layout(gtx, pg.compareQuotesBtn.Layout)
+		}),
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{
 				Top: values.MarginPadding24,
 			}.Layout(gtx, func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								size := values.TextSizeTransform(pg.IsMobileView(), values.TextSize18)
 								txt := pg.Theme.Label(size, values.StringF(values.StrRecentOrders, pg.scroll.ItemsCount()))
@@ -1005,7 +1028,7 @@ func (pg *CreateOrderPage) layoutDesktop(gtx C) D {
 									return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
 
 										layout.Rigid(func(gtx C) D {
-											return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+											return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 												layout.Rigid(func(gtx C) D {
 													var text string
 													if pg.AssetsManager.InstantSwap.IsSyncing() {
@@ -1077,44 +1100,67 @@ func (pg *CreateOrderPage) layoutMobile(gtx C) D {
 	})
 }
 
+// showOrderSchedulerModal shows the modal used to create and manage the swap
+// schedules for the selected wallets and accounts.
+func (pg *CreateOrderPage) showOrderSchedulerModal() {
+	refundAddress, _ := pg.sourceWalletSelector.SelectedWallet().CurrentAddress(pg.sourceAccountSelector.SelectedAccount().Number)
+	destinationAddress, _ := pg.destinationWalletSelector.SelectedWallet().CurrentAddress(pg.destinationAccountSelector.SelectedAccount().Number)
+	pg.sourceWalletID = pg.sourceWalletSelector.SelectedWallet().GetWalletID()
+	pg.sourceAccountNumber = pg.sourceAccountSelector.SelectedAccount().Number
+	pg.destinationWalletID = pg.destinationWalletSelector.SelectedWallet().GetWalletID()
+	pg.destinationAccountNumber = pg.destinationAccountSelector.SelectedAccount().Number
+
+	pg.refundAddress = refundAddress
+	pg.destinationAddress = destinationAddress
+
+	orderSchedulerModal := newOrderSchedulerModalModal(pg.Load, pg.orderData).
+		OnCancel(func() { // needed to satisfy the modal instance
+			pg.scheduler.SetChecked(pg.AssetsManager.InstantSwap.RunningSchedulesCount() > 0)
+		})
+	pg.ParentWindow().ShowModal(orderSchedulerModal)
+}
+
 // orderSchedulerLayout is the layout for the automatic order scheduler switch,
 // settings and indicator
 func (pg *CreateOrderPage) orderSchedulerLayout(gtx C) D {
 	textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 			Axis:      layout.Horizontal,
 			Alignment: layout.Middle,
-		}.Layout(gtx,
+		},
 			layout.Rigid(func(gtx C) D {
 				return layout.Flex{
 					Axis: layout.Vertical,
 				}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						title := pg.Theme.Label(textSize16, values.String(values.StrScheduler))
-						title.Color = pg.Theme.Color.GrayText2
-						return title.Layout(gtx)
+						return pg.schedulesClickable.Layout(gtx, func(gtx C) D {
+							title := pg.Theme.Label(textSize16, values.String(values.StrScheduler))
+							title.Color = pg.Theme.Color.GrayText2
+							return title.Layout(gtx)
+						})
 					}),
 					layout.Rigid(func(gtx C) D {
-						if pg.AssetsManager.IsOrderSchedulerRunning() {
-							return layout.Flex{
-								Axis: layout.Horizontal,
-							}.Layout(gtx,
-								layout.Rigid(func(gtx C) D {
-									return layout.Inset{
-										Top:   values.MarginPadding5,
-										Right: values.MarginPadding2,
-									}.Layout(gtx, pg.Theme.Icons.TimerIcon.Layout12dp)
-								}),
-								layout.Rigid(func(gtx C) D {
-									title := pg.Theme.Label(textSize16, pg.AssetsManager.GetSchedulerRuntime())
-									title.Color = pg.Theme.Color.GrayText2
-									return title.Layout(gtx)
-								}),
-							)
+						runningSchedules := pg.AssetsManager.InstantSwap.RunningSchedulesCount()
+						if runningSchedules == 0 {
+							return D{}
 						}
 
-						return D{}
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{
+							Axis: layout.Horizontal,
+						},
+							layout.Rigid(func(gtx C) D {
+								return layout.Inset{
+									Top:   values.MarginPadding5,
+									Right: values.MarginPadding2,
+								}.Layout(gtx, pg.Theme.Icons.TimerIcon.Layout12dp)
+							}),
+							layout.Rigid(func(gtx C) D {
+								title := pg.Theme.Label(textSize16, values.StringF(values.StrSchedulesRunning, runningSchedules))
+								title.Color = pg.Theme.Color.GrayText2
+								return title.Layout(gtx)
+							}),
+						)
 					}),
 				)
 			}),
@@ -1124,7 +1170,7 @@ func (pg *CreateOrderPage) orderSchedulerLayout(gtx C) D {
 				}.Layout(gtx, pg.scheduler.Layout)
 			}),
 			layout.Rigid(func(gtx C) D {
-				if pg.AssetsManager.IsOrderSchedulerRunning() {
+				if pg.AssetsManager.InstantSwap.RunningSchedulesCount() > 0 {
 					return layout.Inset{Left: values.MarginPadding4, Top: unit.Dp(2)}.Layout(gtx, func(gtx C) D {
 						gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding16)
 						gtx.Constraints.Min.X = gtx.Constraints.Max.X
@@ -1217,6 +1263,63 @@ func (pg *CreateOrderPage) showConfirmOrderModal() {
 	pg.ParentWindow().ShowModal(confirmOrderModal)
 }
 
+// showQuotesModal lists the quotes of all the enabled exchange servers for
+// the swap amount. The order is created from the quote the user selects.
+func (pg *CreateOrderPage) showQuotesModal() {
+	fromAmt, err := strconv.ParseFloat(pg.fromAmountEditor.Edit.Editor.Text(), 64)
+	if err != nil {
+		return
+	}
+
+	fromCur := pg.fromCurrency.String()
+	toCur := pg.toCurrency.String()
+	params := api.ExchangeRateRequest{
+		From:        fromCur,
+		FromNetwork: instantswap.CurrencyNetwork(fromCur, pg.instantExchangeCurrencies),
+		To:          toCur,
+		ToNetwork:   instantswap.CurrencyNetwork(toCur, pg.instantExchangeCurrencies),
+		Amount:      fromAmt,
+	}
+
+	quotesModal := newQuotesModal(pg.Load, params).
+		OnQuoteSelected(pg.createOrderFromQuote)
+	pg.ParentWindow().ShowModal(quotesModal)
+}
+
+// createOrderFromQuote selects the exchange server of the quote, applies the
+// quoted rate and limits and proceeds to confirm the order.
+func (pg *CreateOrderPage) createOrderFromQuote(quote *instantswap.Quote, params api.ExchangeRateRequest) {
+	exchange, err := pg.AssetsManager.InstantSwap.NewExchangeServer(quote.ExchangeServer)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	pg.exchangeSelector.SetSelectedExchangeName(quote.ExchangeServer.Server.CapFirstLetter())
+	pg.selectedExchange = pg.exchangeSelector.SelectedExchange()
+	pg.exchange = exchange
+
+	pg.orderData.fromNetwork = params.FromNetwork
+	pg.orderData.toNetwork = params.ToNetwork
+	pg.orderData.provider = quote.Provider
+	pg.orderData.signature = quote.Signature
+
+	pg.exchangeRate = quote.Rate
+	pg.min = quote.Min
+	pg.max = quote.Max
+	pg.exchangeRateInfo = fmt.Sprintf(values.String(values.StrMinMax), pg.min, pg.max)
+	pg.rateError = false
+	pg.updateAmount()
+
+	pg.showConfirmOrderModal()
+
+	go func() {
+		if err := pg.fetchInstantExchangeCurrencies(); err != nil {
+			log.Error(err)
+		}
+	}()
+}
+
 func (pg *CreateOrderPage) updateExchangeRate() {
 	if pg.fromCurrency == pg.toCurrency {
 		return
@@ -1238,9 +1341,9 @@ func (pg *CreateOrderPage) getExchangeRateInfo() error {
 	toCur := pg.toCurrency.String()
 	params := api.ExchangeRateRequest{
 		From:        fromCur,
-		FromNetwork: getNetwork(fromCur, pg.instantExchangeCurrencies),
+		FromNetwork: instantswap.CurrencyNetwork(fromCur, pg.instantExchangeCurrencies),
 		To:          toCur,
-		ToNetwork:   getNetwork(toCur, pg.instantExchangeCurrencies),
+		ToNetwork:   instantswap.CurrencyNetwork(toCur, pg.instantExchangeCurrencies),
 		Amount:      libwallet.DefaultRateRequestAmt(fromCur), // amount needs to be greater than 0 to get the exchange rate
 	}
 	res, err := pg.AssetsManager.InstantSwap.GetExchangeRateInfo(pg.exchange, params)
@@ -1439,11 +1542,9 @@ func (pg *CreateOrderPage) listenForNotifications() {
 			pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
 			pg.ParentWindow().Reload()
 		},
-		OnOrderSchedulerStarted: func() {
-			pg.scheduler.SetChecked(pg.AssetsManager.IsOrderSchedulerRunning())
-		},
-		OnOrderSchedulerEnded: func() {
-			pg.scheduler.SetChecked(false)
+		OnScheduleUpdated: func(_ *instantswap.Schedule) {
+			pg.scheduler.SetChecked(pg.AssetsManager.InstantSwap.RunningSchedulesCount() > 0)
+			pg.ParentWindow().Reload()
 		},
 	}
 	err := pg.AssetsManager.InstantSwap.AddNotificationListener(orderNotificationListener, CreateOrderPageID)
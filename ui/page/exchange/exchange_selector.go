@@ -184,7 +184,7 @@ func (es *ExSelector) Layout(window app.WindowNavigator, gtx C) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						ic := cryptomaterial.NewIcon(es.Theme.Icons.DropDownIcon)
 						ic.Color = es.Theme.Color.Gray1
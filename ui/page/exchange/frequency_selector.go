@@ -102,7 +102,7 @@ func (fs *FrequencySelector) Layout(window app.WindowNavigator, gtx C) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						ic := cryptomaterial.NewIcon(fs.Theme.Icons.DropDownIcon)
 						ic.Color = fs.Theme.Color.Gray1
@@ -129,33 +129,35 @@ func newFrequencyModal(l *load.Load) *frequencyModal {
 }
 
 func (fs *FrequencySelector) buildFrequencyItems() []*frequencyItem {
-	return []*frequencyItem{
-		{
-			name:      "Fastest",
-			item:      time.Duration(1),
+	items := make([]*frequencyItem, 0, len(scheduleFrequencies))
+	for _, frequency := range scheduleFrequencies {
+		items = append(items, &frequencyItem{
+			name:      frequency.name,
+			item:      frequency.item,
 			clickable: fs.Theme.NewClickable(true),
-		},
-		{
-			name:      "1x/3 hr",
-			item:      time.Duration(3),
-			clickable: fs.Theme.NewClickable(true),
-		},
-		{
-			name:      "1x/6 hr",
-			item:      time.Duration(6),
-			clickable: fs.Theme.NewClickable(true),
-		},
-		{
-			name:      "1x/12 hr",
-			item:      time.Duration(12),
-			clickable: fs.Theme.NewClickable(true),
-		},
-		{
-			name:      "1x/day",
-			item:      time.Duration(24),
-			clickable: fs.Theme.NewClickable(true),
-		},
+		})
+	}
+	return items
+}
+
+// scheduleFrequencies are the frequencies a schedule can run at.
+var scheduleFrequencies = []frequencyItem{
+	{name: "1x/hr", item: time.Hour},
+	{name: "1x/3 hr", item: 3 * time.Hour},
+	{name: "1x/6 hr", item: 6 * time.Hour},
+	{name: "1x/12 hr", item: 12 * time.Hour},
+	{name: "1x/day", item: 24 * time.Hour},
+	{name: "1x/week", item: 7 * 24 * time.Hour},
+}
+
+// frequencyName returns the display name of the schedule frequency.
+func frequencyName(frequency time.Duration) string {
+	for _, f := range scheduleFrequencies {
+		if f.item == frequency {
+			return f.name
+		}
 	}
+	return frequency.String()
 }
 
 func (fm *frequencyModal) OnResume() {}
@@ -3,7 +3,10 @@ package exchange
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 
+	"gioui.org/io/clipboard"
 	"gioui.org/layout"
 	"gioui.org/widget/material"
 
@@ -37,6 +40,8 @@ type OrderDetailsPage struct {
 	backButton     cryptomaterial.IconButton
 	refreshBtn     cryptomaterial.Button
 	createOrderBtn cryptomaterial.Button
+	fundOrderBtn   cryptomaterial.Button
+	copyBundleBtn  cryptomaterial.Button
 
 	isRefreshing bool
 }
@@ -75,6 +80,8 @@ func NewOrderDetailsPage(l *load.Load, order *instantswap.Order) *OrderDetailsPa
 
 	pg.createOrderBtn = pg.Theme.Button(values.String(values.StrCreateNewOrder))
 	pg.refreshBtn = pg.Theme.Button(values.String(values.StrRefresh))
+	pg.fundOrderBtn = pg.Theme.Button(values.String(values.StrFundOrder))
+	pg.copyBundleBtn = pg.Theme.OutlineButton(values.String(values.StrCopySupportBundle))
 
 	go func() {
 		pg.isRefreshing = true
@@ -99,9 +106,23 @@ func (pg *OrderDetailsPage) ID() string {
 
 func (pg *OrderDetailsPage) OnNavigatedTo() {
 	pg.ctx, pg.ctxCancel = context.WithCancel(context.TODO())
+
+	orderNotificationListener := &instantswap.OrderNotificationListener{
+		OnOrderUpdated: func(order *instantswap.Order) {
+			if order.UUID == pg.orderInfo.UUID {
+				pg.orderInfo = order
+				pg.ParentWindow().Reload()
+			}
+		},
+	}
+	err := pg.AssetsManager.InstantSwap.AddNotificationListener(orderNotificationListener, OrderDetailsPageID)
+	if err != nil {
+		log.Errorf("Error adding instanswap notification listener: %v", err)
+	}
 }
 
 func (pg *OrderDetailsPage) OnNavigatedFrom() {
+	pg.AssetsManager.InstantSwap.RemoveNotificationListener(OrderDetailsPageID)
 	if pg.ctxCancel != nil {
 		pg.ctxCancel()
 	}
@@ -127,6 +148,44 @@ func (pg *OrderDetailsPage) HandleUserInteractions(gtx C) {
 	if pg.createOrderBtn.Clicked(gtx) {
 		pg.ParentNavigator().CloseCurrentPage()
 	}
+
+	if pg.fundOrderBtn.Clicked(gtx) {
+		pg.showFundOrderModal()
+	}
+
+	if pg.copyBundleBtn.Clicked(gtx) {
+		bundle := pg.orderInfo.SupportBundle().String()
+		gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(bundle))})
+		pg.Toast.Notify(values.String(values.StrCopied))
+	}
+}
+
+// canFundOrder is true if the deposit of the order can still be sent from
+// the source wallet.
+func (pg *OrderDetailsPage) canFundOrder() bool {
+	if pg.orderInfo.IsFunded() || pg.orderInfo.Status != api.OrderStatusWaitingForDeposit {
+		return false
+	}
+	sourceWallet := pg.AssetsManager.WalletWithID(pg.orderInfo.SourceWalletID)
+	return sourceWallet != nil && !sourceWallet.IsWatchingOnlyWallet()
+}
+
+func (pg *OrderDetailsPage) showFundOrderModal() {
+	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrFundOrder)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if _, err := pg.AssetsManager.FundInstantSwapOrder(pg.orderInfo, password, 0); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			pm.Dismiss()
+			pg.Toast.Notify(values.String(values.StrOrderFunded))
+			return true
+		})
+	pg.ParentWindow().ShowModal(walletPasswordModal)
 }
 
 func (pg *OrderDetailsPage) notifyError(err error) {
@@ -172,13 +231,13 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 					return layout.Inset{
 						Bottom: values.MarginPadding16,
 					}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								return layout.E.Layout(gtx, func(gtx C) D {
-									return layout.Flex{
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 										Axis:      layout.Horizontal,
 										Alignment: layout.Middle,
-									}.Layout(gtx,
+									},
 										layout.Rigid(func(gtx C) D {
 											return layout.Inset{Right: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
 												return pg.Theme.Card().Layout(gtx, func(gtx C) D {
@@ -186,7 +245,7 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 														return layout.Center.Layout(gtx, func(gtx C) D {
 															return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 																layout.Rigid(func(gtx C) D {
-																	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+																	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 																		layout.Rigid(func(gtx C) D {
 																			frmCurrency := libutils.AssetType(pg.orderInfo.FromCurrency)
 																			return components.SetWalletLogo(pg.Load, gtx, frmCurrency, values.MarginPadding30)
@@ -221,7 +280,7 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 																	})
 																}),
 																layout.Rigid(func(gtx C) D {
-																	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+																	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 																		layout.Rigid(func(gtx C) D {
 																			toCurrency := libutils.AssetType(pg.orderInfo.ToCurrency)
 																			return components.SetWalletLogo(pg.Load, gtx, toCurrency, values.MarginPadding30)
@@ -261,9 +320,10 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 					})
 				}),
 				layout.Rigid(pg.Theme.Label(values.TextSize28, pg.orderInfo.Status.String()).Layout),
+				layout.Rigid(pg.lifecycleLayout),
 				layout.Rigid(func(gtx C) D {
 					if pg.orderInfo.Status == api.OrderStatusWaitingForDeposit && pg.orderInfo.ExchangeServer.Server == instantswap.FlypMe {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(pg.Theme.Label(values.TextSize18, values.String(values.StrExpiresIn)).Layout),
 							layout.Rigid(pg.Theme.Label(values.TextSize18, fmt.Sprint(pg.orderInfo.ExpiryTime)).Layout),
 						)
@@ -275,7 +335,7 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 						return layout.Inset{
 							Top: values.MarginPadding16,
 						}.Layout(gtx, func(gtx C) D {
-							return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 								layout.Rigid(func(gtx C) D {
 									if pg.isRefreshing {
 										gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding24)
@@ -288,6 +348,19 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 										Left: values.MarginPadding10,
 									}.Layout(gtx, pg.refreshBtn.Layout)
 								}),
+								layout.Rigid(func(gtx C) D {
+									if !pg.canFundOrder() {
+										return D{}
+									}
+									return layout.Inset{
+										Left: values.MarginPadding10,
+									}.Layout(gtx, pg.fundOrderBtn.Layout)
+								}),
+								layout.Rigid(func(gtx C) D {
+									return layout.Inset{
+										Left: values.MarginPadding10,
+									}.Layout(gtx, pg.copyBundleBtn.Layout)
+								}),
 								layout.Rigid(func(gtx C) D {
 									return layout.Inset{
 										Left: values.MarginPadding10,
@@ -302,6 +375,46 @@ func (pg *OrderDetailsPage) layout(gtx C) D {
 	})
 }
 
+// lifecycleLayout shows the deposit, payout and refund txs of the order and
+// warns if the order is overdue.
+func (pg *OrderDetailsPage) lifecycleLayout(gtx C) D {
+	order := pg.orderInfo
+	rows := []struct {
+		title, txID string
+	}{
+		{values.String(values.StrDepositTx), order.TxID},
+		{values.String(values.StrPayoutTx), order.PayoutTxID},
+		{values.String(values.StrRefundTx), order.RefundTxID},
+	}
+
+	children := make([]layout.FlexChild, 0, len(rows)+1)
+	for _, row := range rows {
+		if row.txID == "" {
+			continue
+		}
+		row := row
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, func(gtx C) D {
+					txt := pg.Theme.Label(values.TextSize16, row.title)
+					txt.Color = pg.Theme.Color.GrayText2
+					return txt.Layout(gtx)
+				}, pg.Theme.Label(values.TextSize14, row.txID).Layout)
+			})
+		}))
+	}
+
+	if order.IsOverdue() {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			txt := pg.Theme.Label(values.TextSize14, values.String(values.StrOrderOverdueMsg))
+			txt.Color = pg.Theme.Color.Danger
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, txt.Layout)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
 func (pg *OrderDetailsPage) getOrderInfo(UUID string) (*instantswap.Order, error) {
 	orderInfo, err := pg.AssetsManager.InstantSwap.GetOrderInfo(pg.exchange, UUID)
 	if err != nil {
@@ -70,7 +70,9 @@ func NewOrderHistoryPage(l *load.Load) *OrderHistoryPage {
 	pg.ordersList = pg.Theme.NewClickableList(layout.Vertical)
 	pg.ordersList.IsShadowEnabled = true
 
+	pg.refreshClickable.Description = values.String(values.StrRefresh)
 	pg.filterBtn = l.Theme.NewClickable(false)
+	pg.filterBtn.Description = values.String(values.StrFilter)
 
 	pg.statusDropdown = l.Theme.DropdownWithCustomPos([]cryptomaterial.DropDownItem{
 		{Text: api.OrderStatusWaitingForDeposit.String()},
@@ -216,10 +218,10 @@ func (pg *OrderHistoryPage) layout(gtx C) D {
 				return layout.Inset{}.Layout(gtx, func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
-							return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 								layout.Flexed(1, func(gtx C) D {
 									body := func(gtx C) D {
-										return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 											layout.Rigid(pg.layoutSectionHeader),
 										)
 									}
@@ -257,7 +259,7 @@ func (pg *OrderHistoryPage) layout(gtx C) D {
 }
 
 func (pg *OrderHistoryPage) layoutSectionHeader(gtx C) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
 			lb := pg.Theme.Label(pg.ConvertTextSize(values.TextSize20), values.String(values.StrTradeHistory))
 			lb.Font.Weight = font.SemiBold
@@ -265,7 +267,7 @@ func (pg *OrderHistoryPage) layoutSectionHeader(gtx C) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			body := func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 					layout.Rigid(func(gtx C) D {
 						var text string
 						if pg.AssetsManager.InstantSwap.IsSyncing() {
@@ -323,14 +325,14 @@ func (pg *OrderHistoryPage) dropdownLayout(gtx C) D {
 		)
 	}
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(pg.leftDropdown),
 		layout.Rigid(pg.rightDropdown),
 	)
 }
 
 func (pg *OrderHistoryPage) leftDropdown(gtx C) D {
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			if pg.serverDropdown == nil {
 				return D{}
@@ -357,7 +359,7 @@ func (pg *OrderHistoryPage) rightDropdown(gtx C) D {
 		return D{}
 	}
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.statusDropdown.Layout),
 			layout.Rigid(pg.orderDropdown.Layout),
 		)
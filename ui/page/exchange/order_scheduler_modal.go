@@ -2,8 +2,10 @@ package exchange
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"gioui.org/font"
 	"gioui.org/layout"
@@ -18,10 +20,22 @@ import (
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/values"
 	api "github.com/crypto-power/instantswap/instantswap"
 )
 
+const orderSchedulerModalID = "order_scheduler_modal"
+
+// scheduleItem wraps a saved schedule with its recent runs and the buttons
+// used to manage it.
+type scheduleItem struct {
+	schedule       *instantswap.Schedule
+	runs           []*instantswap.ScheduleRun
+	pauseResumeBtn cryptomaterial.Button
+	deleteBtn      cryptomaterial.Button
+}
+
 type orderSchedulerModal struct {
 	*load.Load
 	*cryptomaterial.Modal
@@ -37,6 +51,8 @@ type orderSchedulerModal struct {
 	startBtn               cryptomaterial.Button
 	refreshExchangeRateBtn cryptomaterial.IconButton
 
+	nameEditor                 cryptomaterial.Editor
+	amountEditor               cryptomaterial.Editor
 	balanceToMaintain          cryptomaterial.Editor
 	balanceToMaintainErrorText string
 	passwordEditor             cryptomaterial.Editor
@@ -44,6 +60,9 @@ type orderSchedulerModal struct {
 
 	exchangeSelector  *ExSelector
 	frequencySelector *FrequencySelector
+	strategyDropdown  *cryptomaterial.DropDown
+
+	schedules []*scheduleItem
 
 	materialLoader material.LoaderStyle
 
@@ -77,8 +96,22 @@ func newOrderSchedulerModalModal(l *load.Load, data *orderData) *orderSchedulerM
 
 	osm.refreshExchangeRateBtn = l.Theme.IconButton(l.Theme.Icons.NavigationRefresh)
 	osm.refreshExchangeRateBtn.Size = values.MarginPadding18
+	osm.refreshExchangeRateBtn.Description = values.String(values.StrRefresh)
 	osm.refreshExchangeRateBtn.Inset = layout.UniformInset(values.MarginPadding0)
 
+	osm.nameEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrScheduleName))
+	osm.nameEditor.Editor.SingleLine = true
+
+	osm.strategyDropdown = l.Theme.NewCommonDropDown([]cryptomaterial.DropDownItem{
+		{Text: values.String(values.StrFixedAmountStrategy)},
+		{Text: values.String(values.StrBalancePercentageStrategy)},
+		{Text: values.String(values.StrTargetWeightStrategy)},
+	}, nil, cryptomaterial.MatchParent, values.ScheduleStrategyDropdownGroup, false)
+
+	osm.amountEditor = l.Theme.Editor(new(widget.Editor), "")
+	osm.amountEditor.Editor.SingleLine = true
+	osm.setAmountHint()
+
 	osm.balanceToMaintain = l.Theme.Editor(new(widget.Editor), values.StringF(values.StrBalanceToMaintain, osm.fromCurrency))
 	osm.balanceToMaintain.Editor.SingleLine, osm.balanceToMaintain.Editor.Submit = true, true
 
@@ -129,6 +162,77 @@ func (osm *orderSchedulerModal) OnCancel(cancel func()) *orderSchedulerModal {
 
 func (osm *orderSchedulerModal) OnResume() {
 	osm.ctx, osm.ctxCancel = context.WithCancel(context.TODO())
+	osm.loadSchedules()
+
+	scheduleListener := &instantswap.OrderNotificationListener{
+		OnScheduleUpdated: func(_ *instantswap.Schedule) {
+			osm.loadSchedules()
+			osm.ParentWindow().Reload()
+		},
+		OnScheduleRun: func(_ *instantswap.Schedule, _ *instantswap.ScheduleRun) {
+			osm.loadSchedules()
+			osm.ParentWindow().Reload()
+		},
+	}
+	if err := osm.AssetsManager.InstantSwap.AddNotificationListener(scheduleListener, orderSchedulerModalID); err != nil {
+		log.Errorf("orderSchedulerModal.OnResume error: %v", err)
+	}
+}
+
+// strategy returns the strategy selected in the strategy dropdown.
+func (osm *orderSchedulerModal) strategy() instantswap.ScheduleStrategy {
+	switch osm.strategyDropdown.SelectedIndex() {
+	case 1:
+		return instantswap.BalancePercentageStrategy
+	case 2:
+		return instantswap.TargetWeightStrategy
+	}
+	return instantswap.FixedAmountStrategy
+}
+
+func (osm *orderSchedulerModal) setAmountHint() {
+	switch osm.strategy() {
+	case instantswap.BalancePercentageStrategy:
+		osm.amountEditor.Hint = values.String(values.StrBalancePercentageHint)
+	case instantswap.TargetWeightStrategy:
+		osm.amountEditor.Hint = values.String(values.StrTargetWeightHint)
+	default:
+		osm.amountEditor.Hint = values.StringF(values.StrAmountToSwap, osm.fromCurrency)
+	}
+}
+
+// loadSchedules loads the saved schedules and their recent runs.
+func (osm *orderSchedulerModal) loadSchedules() {
+	schedules, err := osm.AssetsManager.InstantSwap.Schedules()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	items := make([]*scheduleItem, 0, len(schedules))
+	for _, schedule := range schedules {
+		runs, err := osm.AssetsManager.InstantSwap.ScheduleRuns(schedule.ID, 3)
+		if err != nil {
+			log.Error(err)
+		}
+
+		item := &scheduleItem{
+			schedule:       schedule,
+			runs:           runs,
+			pauseResumeBtn: osm.Theme.OutlineButton(values.String(values.StrResume)),
+			deleteBtn:      osm.Theme.DangerButton(values.String(values.StrDelete)),
+		}
+		if osm.AssetsManager.InstantSwap.IsScheduleRunning(schedule.ID) {
+			item.pauseResumeBtn.Text = values.String(values.StrPause)
+		}
+		for _, btn := range []*cryptomaterial.Button{&item.pauseResumeBtn, &item.deleteBtn} {
+			btn.Font.Weight = font.Medium
+			btn.TextSize = values.TextSize14
+			btn.Inset = layout.UniformInset(values.MarginPadding8)
+		}
+		items = append(items, item)
+	}
+	osm.schedules = items
 }
 
 func (osm *orderSchedulerModal) setLoading(loading bool) {
@@ -137,6 +241,7 @@ func (osm *orderSchedulerModal) setLoading(loading bool) {
 
 func (osm *orderSchedulerModal) OnDismiss() {
 	osm.ctxCancel()
+	osm.AssetsManager.InstantSwap.RemoveNotificationListener(orderSchedulerModalID)
 }
 
 func (osm *orderSchedulerModal) SetError(err string) {
@@ -150,6 +255,22 @@ func (osm *orderSchedulerModal) Handle(gtx C) {
 		osm.startOrderScheduler()
 	}
 
+	if osm.strategyDropdown.Changed(gtx) {
+		osm.setAmountHint()
+	}
+
+	for _, item := range osm.schedules {
+		if item.pauseResumeBtn.Clicked(gtx) {
+			osm.toggleSchedule(item.schedule)
+		}
+		if item.deleteBtn.Clicked(gtx) {
+			if err := osm.AssetsManager.InstantSwap.DeleteSchedule(item.schedule.ID); err != nil {
+				log.Error(err)
+			}
+			osm.loadSchedules()
+		}
+	}
+
 	if osm.cancelBtn.Clicked(gtx) || osm.Modal.BackdropClicked(gtx, true) {
 		osm.onCancel()
 		osm.Dismiss()
@@ -187,10 +308,18 @@ func (osm *orderSchedulerModal) Handle(gtx C) {
 }
 
 func (osm *orderSchedulerModal) canStart() bool {
+	if strings.TrimSpace(osm.nameEditor.Editor.Text()) == "" {
+		return false
+	}
+
 	if osm.exchangeSelector.selectedExchange == nil {
 		return false
 	}
 
+	if amount, err := strconv.ParseFloat(osm.amountEditor.Editor.Text(), 64); err != nil || amount <= 0 {
+		return false
+	}
+
 	if osm.frequencySelector.selectedFrequency == nil {
 		return false
 	}
@@ -238,6 +367,9 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 													Height: cryptomaterial.WrapContent,
 												}.Layout2(gtx, func(gtx C) D {
 													return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+														layout.Rigid(func(gtx C) D {
+															return osm.formRow(gtx, osm.nameEditor.Layout)
+														}),
 														layout.Rigid(func(gtx C) D {
 															return layout.Inset{
 																Bottom: values.MarginPadding16,
@@ -252,7 +384,7 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 																		return osm.exchangeSelector.Layout(osm.ParentWindow(), gtx)
 																	}),
 																	layout.Rigid(func(gtx C) D {
-																		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+																		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 																			layout.Rigid(func(gtx C) D {
 																				if osm.rateError {
 																					txt := osm.Theme.Label(values.TextSize14, values.String(values.StrFetchRateError))
@@ -334,6 +466,12 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 																)
 															})
 														}),
+														layout.Rigid(func(gtx C) D {
+															return osm.formRow(gtx, osm.strategyDropdown.Layout)
+														}),
+														layout.Rigid(func(gtx C) D {
+															return osm.formRow(gtx, osm.amountEditor.Layout)
+														}),
 														layout.Rigid(func(gtx C) D {
 															return layout.Inset{
 																Bottom: values.MarginPadding16,
@@ -393,6 +531,7 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 																})
 															})
 														}),
+														layout.Rigid(osm.schedulesLayout),
 													)
 												})
 											})
@@ -417,10 +556,10 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 									Top: values.MarginPadding16,
 								}
 								return inset.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Flexed(1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
-												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+												return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 													layout.Rigid(func(gtx C) D {
 														return layout.Inset{
 															Right: values.MarginPadding4,
@@ -452,15 +591,18 @@ func (osm *orderSchedulerModal) Layout(gtx layout.Context) D {
 func (osm *orderSchedulerModal) startOrderScheduler() {
 	go func() {
 		osm.setLoading(true)
+		defer osm.setLoading(false)
+
 		err := osm.sourceWalletSelector.SelectedWallet().UnlockWallet(osm.passwordEditor.Editor.Text())
 		if err != nil {
 			osm.SetError(err.Error())
-			osm.setLoading(false)
 			return
 		}
 
-		balanceToMaintain, _ := strconv.ParseFloat(osm.balanceToMaintain.Editor.Text(), 32)
-		params := instantswap.SchedulerParams{
+		amount, _ := strconv.ParseFloat(osm.amountEditor.Editor.Text(), 64)
+		balanceToMaintain, _ := strconv.ParseFloat(osm.balanceToMaintain.Editor.Text(), 64)
+		schedule := &instantswap.Schedule{
+			Name: strings.TrimSpace(osm.nameEditor.Editor.Text()),
 			Order: instantswap.Order{
 				ExchangeServer:           osm.exchangeSelector.selectedExchange.Server,
 				SourceWalletID:           osm.orderData.sourceWalletID,
@@ -479,30 +621,67 @@ func (osm *orderSchedulerModal) startOrderScheduler() {
 				RefundAddress:      osm.orderData.refundAddress,
 			},
 
-			Frequency:          osm.frequencySelector.selectedFrequency.item,
-			BalanceToMaintain:  balanceToMaintain,
-			SpendingPassphrase: osm.passwordEditor.Editor.Text(),
+			Frequency:         osm.frequencySelector.selectedFrequency.item,
+			Strategy:          osm.strategy(),
+			Amount:            amount,
+			BalanceToMaintain: balanceToMaintain,
 		}
 
-		successModal := modal.NewSuccessModal(osm.Load, values.String(values.StrSchedulerRunning), modal.DefaultClickFunc())
-		go func() {
-			err = osm.AssetsManager.StartScheduler(context.Background(), params)
-			if err != nil {
-				// Dismiss the success modal if still displayed before showing
-				// the error modal.
-				successModal.Dismiss()
+		if err := osm.AssetsManager.InstantSwap.SaveSchedule(schedule); err != nil {
+			osm.nameEditor.SetError(values.TranslateErr(err.Error()))
+			return
+		}
 
-				errModal := modal.NewErrorModal(osm.Load, values.String(values.StrUnexpectedError), modal.DefaultClickFunc()).
-					Body(values.StringF(values.StrUnexpectedErrorMsgFmt, err.Error()))
-				osm.ParentWindow().ShowModal(errModal)
-			}
-		}()
+		if err := osm.AssetsManager.StartSchedule(schedule.ID, osm.passwordEditor.Editor.Text()); err != nil {
+			errModal := modal.NewErrorModal(osm.Load, values.String(values.StrUnexpectedError), modal.DefaultClickFunc()).
+				Body(values.StringF(values.StrUnexpectedErrorMsgFmt, err.Error()))
+			osm.ParentWindow().ShowModal(errModal)
+			return
+		}
 
 		osm.Dismiss()
+		successModal := modal.NewSuccessModal(osm.Load, values.String(values.StrSchedulerRunning), modal.DefaultClickFunc())
 		osm.ParentWindow().ShowModal(successModal)
 	}()
 }
 
+// toggleSchedule pauses the schedule if it is running and otherwise resumes
+// it with the spending passphrase entered in the password editor.
+func (osm *orderSchedulerModal) toggleSchedule(schedule *instantswap.Schedule) {
+	if osm.AssetsManager.InstantSwap.IsScheduleRunning(schedule.ID) {
+		if err := osm.AssetsManager.PauseSchedule(schedule.ID); err != nil {
+			log.Error(err)
+		}
+		osm.loadSchedules()
+		return
+	}
+
+	passphrase := osm.passwordEditor.Editor.Text()
+	if passphrase == "" {
+		osm.passwordEditor.SetError(values.String(values.StrEnterSpendingPassword))
+		return
+	}
+
+	go func() {
+		sourceWallet := osm.AssetsManager.WalletWithID(schedule.Order.SourceWalletID)
+		if sourceWallet == nil {
+			log.Errorf("source wallet of schedule %q not found", schedule.Name)
+			return
+		}
+
+		if err := sourceWallet.UnlockWallet(passphrase); err != nil {
+			osm.SetError(err.Error())
+			return
+		}
+
+		if err := osm.AssetsManager.StartSchedule(schedule.ID, passphrase); err != nil {
+			log.Error(err)
+		}
+		osm.loadSchedules()
+		osm.ParentWindow().Reload()
+	}()
+}
+
 func (osm *orderSchedulerModal) fetchInstantExchangeCurrencies() error {
 	osm.fetchingRate = true
 	currencies, err := osm.exchange.GetCurrencies()
@@ -512,30 +691,6 @@ func (osm *orderSchedulerModal) fetchInstantExchangeCurrencies() error {
 	return err
 }
 
-func getNetwork(coinName string, currencies []api.Currency) string {
-	var lowerName = strings.ToLower(coinName)
-	var currency *api.Currency
-	for _, c := range currencies {
-		if strings.ToLower(c.Symbol) == lowerName {
-			currency = &c
-			break
-		}
-	}
-	if currency == nil || len(currency.Networks) == 0 {
-		return ""
-	}
-	for _, network := range currency.Networks {
-		var lowerNetwork = strings.ToLower(network)
-		if lowerNetwork == string(libutils.Mainnet) {
-			return network
-		}
-		if lowerNetwork == lowerName {
-			return network
-		}
-	}
-	return currency.Networks[0]
-}
-
 func (osm *orderSchedulerModal) getExchangeRateInfo() error {
 	osm.exchangeRate = -1
 	osm.fetchingRate = true
@@ -544,9 +699,9 @@ func (osm *orderSchedulerModal) getExchangeRateInfo() error {
 	toCur := osm.toCurrency.String()
 	params := api.ExchangeRateRequest{
 		From:        fromCur,
-		FromNetwork: getNetwork(fromCur, osm.instantCurrencies),
+		FromNetwork: instantswap.CurrencyNetwork(fromCur, osm.instantCurrencies),
 		To:          toCur,
-		ToNetwork:   getNetwork(toCur, osm.instantCurrencies),
+		ToNetwork:   instantswap.CurrencyNetwork(toCur, osm.instantCurrencies),
 		Amount:      libwallet.DefaultRateRequestAmt(fromCur), // amount needs to be greater than 0 to get the exchange rate
 	}
 	res, err := osm.AssetsManager.InstantSwap.GetExchangeRateInfo(osm.exchange, params)
@@ -564,3 +719,141 @@ func (osm *orderSchedulerModal) getExchangeRateInfo() error {
 	osm.rateError = false
 	return nil
 }
+
+func (osm *orderSchedulerModal) formRow(gtx C, w layout.Widget) D {
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, w)
+}
+
+// schedulesLayout lists the saved schedules.
+func (osm *orderSchedulerModal) schedulesLayout(gtx C) D {
+	return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				txt := osm.Theme.Label(values.TextSize16, values.String(values.StrSchedules))
+				txt.Font.Weight = font.SemiBold
+				return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, txt.Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				if len(osm.schedules) == 0 {
+					txt := osm.Theme.Label(values.TextSize14, values.String(values.StrNoSchedules))
+					txt.Color = osm.Theme.Color.GrayText2
+					return txt.Layout(gtx)
+				}
+
+				children := make([]layout.FlexChild, 0, len(osm.schedules))
+				for _, item := range osm.schedules {
+					item := item
+					children = append(children, layout.Rigid(func(gtx C) D {
+						return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+							return osm.scheduleLayout(gtx, item)
+						})
+					}))
+				}
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+			}),
+		)
+	})
+}
+
+func (osm *orderSchedulerModal) scheduleLayout(gtx C, item *scheduleItem) D {
+	schedule := item.schedule
+	fromCur, toCur := strings.ToUpper(schedule.Order.FromCurrency), strings.ToUpper(schedule.Order.ToCurrency)
+
+	status, statusColor := values.String(values.StrScheduleAwaitingPassword), osm.Theme.Color.Danger
+	switch {
+	case osm.AssetsManager.InstantSwap.IsScheduleRunning(schedule.ID):
+		status, statusColor = values.String(values.StrScheduleRunning), osm.Theme.Color.Success
+	case schedule.Paused:
+		status, statusColor = values.String(values.StrSchedulePaused), osm.Theme.Color.GrayText2
+	}
+
+	var strategy string
+	switch schedule.Strategy {
+	case instantswap.BalancePercentageStrategy:
+		strategy = fmt.Sprintf("%s: %.2f%%", values.String(values.StrBalancePercentageStrategy), schedule.Amount)
+	case instantswap.TargetWeightStrategy:
+		strategy = fmt.Sprintf("%s: %.2f%%", values.String(values.StrTargetWeightStrategy), schedule.Amount)
+	default:
+		strategy = fmt.Sprintf("%s: %.8f %s", values.String(values.StrFixedAmountStrategy), schedule.Amount, fromCur)
+	}
+
+	return cryptomaterial.LinearLayout{
+		Width:       cryptomaterial.MatchParent,
+		Height:      cryptomaterial.WrapContent,
+		Orientation: layout.Vertical,
+		Padding:     layout.UniformInset(values.MarginPadding12),
+		Border: cryptomaterial.Border{
+			Radius: cryptomaterial.Radius(8),
+			Color:  osm.Theme.Color.Gray2,
+			Width:  values.MarginPadding1,
+		},
+	}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				txt := osm.Theme.Label(values.TextSize16, fmt.Sprintf("%s (%s → %s)", schedule.Name, fromCur, toCur))
+				txt.Font.Weight = font.SemiBold
+				return txt.Layout(gtx)
+			}, func(gtx C) D {
+				txt := osm.Theme.Label(values.TextSize14, status)
+				txt.Color = statusColor
+				return txt.Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			txt := osm.Theme.Label(values.TextSize14, fmt.Sprintf("%s · %s", strategy, frequencyName(schedule.Frequency)))
+			txt.Color = osm.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, txt.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if schedule.NextRunAt == 0 || !osm.AssetsManager.InstantSwap.IsScheduleRunning(schedule.ID) {
+				return D{}
+			}
+			nextRun := time.Unix(schedule.NextRunAt, 0).Format("Jan 2, 15:04")
+			txt := osm.Theme.Label(values.TextSize14, values.StringF(values.StrNextRun, nextRun))
+			txt.Color = osm.Theme.Color.GrayText2
+			return txt.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if len(item.runs) == 0 {
+				return D{}
+			}
+
+			children := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					txt := osm.Theme.Label(values.TextSize14, values.String(values.StrRecentRuns))
+					txt.Font.Weight = font.SemiBold
+					return txt.Layout(gtx)
+				}),
+			}
+			for _, run := range item.runs {
+				outcome := values.StringF(values.StrScheduleRunSkipped, run.Message)
+				color := osm.Theme.Color.Danger
+				if run.Success {
+					outcome = values.StringF(values.StrScheduleRunSwapped, run.Amount, fromCur)
+					color = osm.Theme.Color.Success
+				}
+				runTime := time.Unix(run.Timestamp, 0).Format("Jan 2, 15:04")
+				children = append(children, layout.Rigid(func(gtx C) D {
+					txt := osm.Theme.Label(values.TextSize12, fmt.Sprintf("%s · %s", runTime, outcome))
+					txt.Color = color
+					return txt.Layout(gtx)
+				}))
+			}
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return layout.E.Layout(gtx, func(gtx C) D {
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+						layout.Rigid(func(gtx C) D {
+							return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, item.deleteBtn.Layout)
+						}),
+						layout.Rigid(item.pauseResumeBtn.Layout),
+					)
+				})
+			})
+		}),
+	)
+}
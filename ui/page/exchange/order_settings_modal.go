@@ -76,6 +76,8 @@ func newOrderSettingsModalModal(l *load.Load, data *orderData) *orderSettingsMod
 	osm.sourceInfoButton = l.Theme.IconButton(l.Theme.Icons.ActionInfo)
 	osm.destinationInfoButton = l.Theme.IconButton(l.Theme.Icons.ActionInfo)
 	osm.sourceInfoButton.Size, osm.destinationInfoButton.Size = values.MarginPadding14, values.MarginPadding14
+	osm.sourceInfoButton.Description = values.String(values.StrInfo)
+	osm.destinationInfoButton.Description = values.String(values.StrInfo)
 	buttonInset := layout.UniformInset(values.MarginPadding0)
 	osm.sourceInfoButton.Inset, osm.destinationInfoButton.Inset = buttonInset, buttonInset
 
@@ -298,7 +300,7 @@ func (osm *orderSettingsModal) Layout(gtx layout.Context) D {
 																	Margin:      layout.Inset{Bottom: values.MarginPadding16},
 																}.Layout(gtx,
 																	layout.Rigid(func(gtx C) D {
-																		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+																		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 																			layout.Rigid(func(gtx C) D {
 																				txt := osm.Theme.Label(values.TextSize16, values.String(values.StrSource))
 																				txt.Font.Weight = font.SemiBold
@@ -345,7 +347,7 @@ func (osm *orderSettingsModal) Layout(gtx layout.Context) D {
 																	Margin:      layout.Inset{Bottom: values.MarginPadding16},
 																}.Layout(gtx,
 																	layout.Rigid(func(gtx C) D {
-																		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+																		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 																			layout.Rigid(func(gtx C) D {
 																				txt := osm.Theme.Label(values.TextSize16, values.String(values.StrDestination))
 																				txt.Font.Weight = font.SemiBold
@@ -380,7 +382,7 @@ func (osm *orderSettingsModal) Layout(gtx layout.Context) D {
 																		return border.Layout(gtx, func(gtx C) D {
 																			return wrapper.Layout(gtx, func(gtx C) D {
 																				return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-																					return layout.Flex{}.Layout(gtx,
+																					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 																						layout.Flexed(0.9, osm.Load.Theme.Body1(osm.addressEditor.Editor.Text()).Layout),
 																						layout.Flexed(0.1, func(gtx C) D {
 																							return layout.E.Layout(gtx, func(gtx C) D {
@@ -446,10 +448,10 @@ func (osm *orderSettingsModal) Layout(gtx layout.Context) D {
 									Top: values.MarginPadding16,
 								}
 								return inset.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Flexed(1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
-												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+												return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 													layout.Rigid(func(gtx C) D {
 														return layout.Inset{
 															Right: values.MarginPadding4,
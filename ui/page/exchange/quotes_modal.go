@@ -0,0 +1,281 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+
+	api "github.com/crypto-power/instantswap/instantswap"
+)
+
+// quoteItem wraps a quote with the button used to create an order from it.
+type quoteItem struct {
+	quote     *instantswap.Quote
+	selectBtn cryptomaterial.Button
+}
+
+// quotesModal requests quotes for a swap from all the enabled exchange
+// servers and lists them best first.
+type quotesModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	params api.ExchangeRateRequest
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	quotes          []*quoteItem
+	fetching        bool
+	cancelBtn       cryptomaterial.Button
+	refreshBtn      cryptomaterial.Button
+	quotesContainer *widget.List
+	materialLoader  material.LoaderStyle
+
+	onQuoteSelected func(*instantswap.Quote, api.ExchangeRateRequest)
+}
+
+func newQuotesModal(l *load.Load, params api.ExchangeRateRequest) *quotesModal {
+	qm := &quotesModal{
+		Load:           l,
+		Modal:          l.Theme.ModalFloatTitle(values.String(values.StrCompareProviders), l.IsMobileView(), nil),
+		params:         params,
+		cancelBtn:      l.Theme.OutlineButton(values.String(values.StrCancel)),
+		refreshBtn:     l.Theme.Button(values.String(values.StrRefresh)),
+		materialLoader: material.Loader(l.Theme.Base),
+		quotesContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+	qm.cancelBtn.Font.Weight = font.Medium
+	qm.refreshBtn.Font.Weight = font.Medium
+	return qm
+}
+
+// OnQuoteSelected sets the callback executed when the user creates an order
+// from a quote.
+func (qm *quotesModal) OnQuoteSelected(callback func(*instantswap.Quote, api.ExchangeRateRequest)) *quotesModal {
+	qm.onQuoteSelected = callback
+	return qm
+}
+
+func (qm *quotesModal) OnResume() {
+	qm.ctx, qm.ctxCancel = context.WithCancel(context.Background())
+	qm.fetchQuotes()
+}
+
+func (qm *quotesModal) OnDismiss() {
+	if qm.ctxCancel != nil {
+		qm.ctxCancel()
+	}
+}
+
+func (qm *quotesModal) fetchQuotes() {
+	if qm.fetching {
+		return
+	}
+
+	qm.fetching = true
+	go func() {
+		quotes := qm.AssetsManager.GetInstantSwapQuotes(qm.ctx, qm.params)
+		items := make([]*quoteItem, 0, len(quotes))
+		for _, quote := range quotes {
+			btn := qm.Theme.Button(values.String(values.StrCreateOrder))
+			btn.Font.Weight = font.Medium
+			btn.TextSize = values.TextSize14
+			btn.Inset = layout.UniformInset(values.MarginPadding8)
+			btn.SetEnabled(quote.Err == nil && quote.WithinLimits())
+			items = append(items, &quoteItem{quote: quote, selectBtn: btn})
+		}
+		qm.quotes = items
+		qm.fetching = false
+		qm.ParentWindow().Reload()
+	}()
+}
+
+func (qm *quotesModal) Handle(gtx C) {
+	if qm.cancelBtn.Clicked(gtx) || qm.Modal.Escaped(gtx) {
+		qm.Dismiss()
+	}
+
+	if qm.refreshBtn.Clicked(gtx) {
+		qm.fetchQuotes()
+	}
+
+	if qm.fetching {
+		return
+	}
+
+	for _, item := range qm.quotes {
+		if item.selectBtn.Clicked(gtx) {
+			qm.Dismiss()
+			if qm.onQuoteSelected != nil {
+				qm.onQuoteSelected(item.quote, qm.params)
+			}
+			return
+		}
+	}
+}
+
+func (qm *quotesModal) Layout(gtx C) D {
+	w := []layout.Widget{
+		func(gtx C) D {
+			txt := qm.Theme.Label(values.TextSize20, values.String(values.StrCompareProviders))
+			txt.Font.Weight = font.SemiBold
+			return txt.Layout(gtx)
+		},
+		func(gtx C) D {
+			fromCur, toCur := strings.ToUpper(qm.params.From), strings.ToUpper(qm.params.To)
+			txt := qm.Theme.Label(values.TextSize14, values.StringF(values.StrCompareProvidersMsg, qm.params.Amount, fromCur, toCur))
+			txt.Color = qm.Theme.Color.GrayText2
+			return txt.Layout(gtx)
+		},
+		func(gtx C) D {
+			if qm.fetching {
+				return layout.Center.Layout(gtx, func(gtx C) D {
+					return layout.UniformInset(values.MarginPadding16).Layout(gtx, qm.materialLoader.Layout)
+				})
+			}
+
+			gtx.Constraints.Max.Y = gtx.Dp(values.MarginPadding350)
+			return qm.Theme.List(qm.quotesContainer).Layout(gtx, len(qm.quotes), func(gtx C, i int) D {
+				return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+					return qm.quoteLayout(gtx, qm.quotes[i], i == 0)
+				})
+			})
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, qm.cancelBtn.Layout)
+					}),
+					layout.Rigid(func(gtx C) D {
+						if qm.fetching {
+							return D{}
+						}
+						return qm.refreshBtn.Layout(gtx)
+					}),
+				)
+			})
+		},
+	}
+	return qm.Modal.Layout(gtx, w)
+}
+
+func (qm *quotesModal) quoteLayout(gtx C, item *quoteItem, isBest bool) D {
+	quote := item.quote
+	fromCur, toCur := strings.ToUpper(qm.params.From), strings.ToUpper(qm.params.To)
+	textSize14 := values.TextSizeTransform(qm.IsMobileView(), values.TextSize14)
+
+	return cryptomaterial.LinearLayout{
+		Width:       cryptomaterial.MatchParent,
+		Height:      cryptomaterial.WrapContent,
+		Orientation: layout.Vertical,
+		Padding:     layout.UniformInset(values.MarginPadding12),
+		Border: cryptomaterial.Border{
+			Radius: cryptomaterial.Radius(8),
+			Color:  qm.Theme.Color.Gray2,
+			Width:  values.MarginPadding1,
+		},
+	}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
+					layout.Rigid(func(gtx C) D {
+						icon := components.GetServerIcon(qm.Theme, quote.ExchangeServer.Server.ToString())
+						if icon == nil {
+							return D{}
+						}
+						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+							return icon.LayoutSize(gtx, values.MarginPadding24)
+						})
+					}),
+					layout.Rigid(func(gtx C) D {
+						txt := qm.Theme.Label(values.TextSize16, quote.ExchangeServer.Server.CapFirstLetter())
+						txt.Font.Weight = font.SemiBold
+						return txt.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						if !isBest || quote.Err != nil || !quote.WithinLimits() {
+							return D{}
+						}
+						txt := qm.Theme.Label(values.TextSize12, values.String(values.StrBestRate))
+						txt.Color = qm.Theme.Color.Success
+						return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, txt.Layout)
+					}),
+				)
+			}, func(gtx C) D {
+				if quote.Err != nil {
+					return D{}
+				}
+				return item.selectBtn.Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			if quote.Err != nil {
+				txt := qm.Theme.Label(textSize14, quote.Err.Error())
+				txt.Color = qm.Theme.Color.Danger
+				return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, txt.Layout)
+			}
+
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						return qm.detailRow(gtx, values.String(values.StrReceiving), fmt.Sprintf("%.8f %s", quote.ReceiveAmount, toCur))
+					}),
+					layout.Rigid(func(gtx C) D {
+						return qm.detailRow(gtx, values.String(values.StrRate), fmt.Sprintf("1 %s = %.8f %s", fromCur, quote.Rate, toCur))
+					}),
+					layout.Rigid(func(gtx C) D {
+						return qm.detailRow(gtx, values.String(values.StrLimits), fmt.Sprintf(values.String(values.StrMinMax), quote.Min, quote.Max))
+					}),
+					layout.Rigid(func(gtx C) D {
+						if quote.MarketRate <= 0 {
+							return D{}
+						}
+						return qm.detailRow(gtx, values.String(values.StrMarketDeviation), fmt.Sprintf("%.2f%%", quote.Deviation))
+					}),
+					layout.Rigid(func(gtx C) D {
+						var warnings []string
+						if !quote.WithinLimits() {
+							warnings = append(warnings, values.String(values.StrAmountOutsideLimits))
+						}
+						if quote.MarketRate > 0 && quote.Deviation > libwallet.DefaultMarketDeviation {
+							warnings = append(warnings, values.StringF(values.StrRateDeviationWarning, libwallet.DefaultMarketDeviation))
+						}
+						if len(warnings) == 0 {
+							return D{}
+						}
+						txt := qm.Theme.Label(values.TextSize12, strings.Join(warnings, "\n"))
+						txt.Color = qm.Theme.Color.Danger
+						return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, txt.Layout)
+					}),
+				)
+			})
+		}),
+	)
+}
+
+func (qm *quotesModal) detailRow(gtx C, title, value string) D {
+	textSize14 := values.TextSizeTransform(qm.IsMobileView(), values.TextSize14)
+	return components.EndToEndRow(gtx, func(gtx C) D {
+		txt := qm.Theme.Label(textSize14, title)
+		txt.Color = qm.Theme.Color.GrayText2
+		return txt.Layout(gtx)
+	}, func(gtx C) D {
+		return qm.Theme.Label(textSize14, value).Layout(gtx)
+	})
+}
@@ -305,7 +305,7 @@ func (pg *ConsensusPage) HandleUserInteractions(gtx C) {
 						return border.Layout(gtx, func(gtx C) D {
 							return wrapper.Layout(gtx, func(gtx C) D {
 								return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 										layout.Flexed(0.9, pg.Theme.Body1(host).Layout),
 										layout.Flexed(0.1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
@@ -401,9 +401,9 @@ func (pg *ConsensusPage) layout(gtx C) D {
 		}.Layout(gtx, func(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Alignment: layout.Baseline}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Baseline},
 						layout.Rigid(func(gtx C) D {
-							return layout.Flex{}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 								layout.Rigid(func(gtx C) D {
 									lb := pg.Theme.Label(pg.ConvertTextSize(values.TextSize20), values.String(values.StrConsensusChange))
 									lb.Font.Weight = font.SemiBold
@@ -454,14 +454,14 @@ func (pg *ConsensusPage) dropdownLayout(gtx C) D {
 		)
 	}
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(pg.leftDropdown),
 		layout.Rigid(pg.rightDropdown),
 	)
 }
 
 func (pg *ConsensusPage) leftDropdown(gtx C) D {
-	return layout.Flex{Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween, Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			if pg.walletDropDown == nil {
 				return D{}
@@ -491,7 +491,7 @@ func (pg *ConsensusPage) rightDropdown(gtx C) D {
 		return D{}
 	}
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.statusDropDown.Layout),
 			layout.Rigid(pg.orderDropDown.Layout),
 		)
@@ -502,7 +502,7 @@ func (pg *ConsensusPage) layoutRedirectVoting(gtx C) D {
 	return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
 			return pg.viewVotingDashboard.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{
 							Right: values.MarginPadding10,
@@ -517,7 +517,7 @@ func (pg *ConsensusPage) layoutRedirectVoting(gtx C) D {
 			})
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 				layout.Rigid(func(gtx C) D {
 					var text string
 					if pg.isSyncing {
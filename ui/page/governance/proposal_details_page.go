@@ -245,7 +245,7 @@ func (pg *ProposalDetails) HandleUserInteractions(gtx C) {
 						return border.Layout(gtx, func(gtx C) D {
 							return wrapper.Layout(gtx, func(gtx C) D {
 								return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 										layout.Flexed(0.9, pg.Theme.Body1(host).Layout),
 										layout.Flexed(0.1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
@@ -344,7 +344,7 @@ func (pg *ProposalDetails) statusAndTimeLayout(gtx C) D {
 	if pg.IsMobileView() {
 		spacing = layout.SpaceBetween
 	}
-	return layout.Flex{Spacing: spacing}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: spacing},
 		layout.Rigid(categoryLabel.Layout),
 		layout.Rigid(func(gtx C) D {
 			if pg.IsMobileView() {
@@ -364,7 +364,7 @@ func (pg *ProposalDetails) sumaryInfo(gtx C) D {
 	token := pg.proposal.Token
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(func(gtx C) D {
 					lbl := pg.Theme.Body1(values.String(values.StrTotalVotesTit))
 					lbl.Font.Weight = font.SemiBold
@@ -380,7 +380,7 @@ func (pg *ProposalDetails) sumaryInfo(gtx C) D {
 			)
 		}),
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(func(gtx C) D {
 					lbl := pg.Theme.Body1(values.String(values.StrQuorumRequite))
 					lbl.Font.Weight = font.SemiBold
@@ -408,7 +408,7 @@ func (pg *ProposalDetails) sumaryInfo(gtx C) D {
 }
 
 func (pg *ProposalDetails) summaryRow(title, content string, gtx C) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
 			lbl := pg.Theme.Body1(title)
 			lbl.Font.Weight = font.SemiBold
@@ -436,7 +436,7 @@ func (pg *ProposalDetails) layoutInDiscussionState(gtx C) D {
 	proposal := pg.proposal
 
 	c := func(gtx C, val int32, info string) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(func(gtx C) D {
 				if proposal.VoteStatus == val || proposal.VoteStatus < val {
 					c := pg.Theme.Card()
@@ -620,9 +620,9 @@ func (pg *ProposalDetails) layoutDescription(gtx C) D {
 			if pg.IsMobileView() {
 				axis = layout.Vertical
 			}
-			return layout.Flex{Axis: axis}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis},
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Rigid(userLabel.Layout),
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Top: values.MarginPaddingMinus22}.Layout(gtx, dotLabel.Layout)
@@ -635,7 +635,7 @@ func (pg *ProposalDetails) layoutDescription(gtx C) D {
 					)
 				}),
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Rigid(func(gtx C) D {
 							if pg.IsMobileView() {
 								return D{}
@@ -656,7 +656,7 @@ func (pg *ProposalDetails) layoutDescription(gtx C) D {
 		w = append(w, itemWidgets.widgets...)
 	} else {
 		loading := func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, layout.Flexed(1, func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal}, layout.Flexed(1, func(gtx C) D {
 				return components.VerticalInset(values.MarginPadding8).Layout(gtx, func(gtx C) D {
 					return layout.Center.Layout(gtx, material.Loader(pg.Theme.Base).Layout)
 				})
@@ -686,7 +686,7 @@ func (pg *ProposalDetails) layoutRedirect(text string, icon *cryptomaterial.Imag
 		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
 			return btn.Layout(gtx, func(gtx C) D {
 				gtx.Constraints.Min.X = gtx.Constraints.Max.X
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{Right: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 							return icon.LayoutSize(gtx, pg.ConvertIconSize(values.MarginPadding24))
@@ -169,7 +169,7 @@ func (vm *voteModal) sendVotes() {
 }
 
 func (vm *voteModal) Handle(gtx C) {
-	if vm.cancelBtn.Clicked(gtx) && !vm.isVoting {
+	if (vm.cancelBtn.Clicked(gtx) || vm.Modal.Escaped(gtx)) && !vm.isVoting {
 		vm.Dismiss()
 	}
 
@@ -232,7 +232,7 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 								if voteDetails.YesVotes == 0 {
 									return layout.Dimensions{}
@@ -253,7 +253,7 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 										Bottom: values.MarginPadding8,
 									}
 									return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-										return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 											layout.Rigid(func(gtx C) D {
 												card := vm.Theme.Card()
 												card.Color = vm.Theme.Color.Green500
@@ -294,7 +294,7 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 										Bottom: values.MarginPadding8,
 									}
 									return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-										return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 											layout.Rigid(func(gtx C) D {
 												card := vm.Theme.Card()
 												card.Color = vm.Theme.Color.Danger
@@ -364,7 +364,7 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 		},
 		func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, vm.cancelBtn.Layout)
 					}),
@@ -398,9 +398,9 @@ func (vm *voteModal) inputOptions(gtx layout.Context, wdg *inputVoteOptionsWidge
 			Right:  values.MarginPadding8,
 		}
 		return inset.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Flexed(.35, func(gtx C) D {
-					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 						layout.Rigid(func(gtx C) D {
 							card := vm.Theme.Card()
 							card.Color = dotColor
@@ -431,9 +431,9 @@ func (vm *voteModal) inputOptions(gtx layout.Context, wdg *inputVoteOptionsWidge
 						return card.Layout(gtx, func(gtx C) D {
 							var height int
 							gtx.Constraints.Min.X = gtx.Constraints.Max.X
-							return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 								layout.Flexed(1, func(gtx C) D {
-									dims := layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+									dims := cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween},
 										layout.Rigid(func(gtx C) D {
 											return wdg.decrement.Layout(gtx)
 										}),
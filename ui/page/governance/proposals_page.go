@@ -89,6 +89,7 @@ func NewProposalsPage(l *load.Load, detailData interface{}) *ProposalsPage {
 	pg.updatedIcon.Color = pg.Theme.Color.Success
 
 	pg.syncButton = l.Theme.NewClickable(false)
+	pg.syncButton.Description = values.String(values.StrRefresh)
 	pg.materialLoader = material.Loader(l.Theme.Base)
 	pg.scroll = components.NewScroll(l, pageSize, pg.fetchProposals)
 
@@ -99,6 +100,7 @@ func NewProposalsPage(l *load.Load, detailData interface{}) *ProposalsPage {
 	pg.infoButton.Size = values.MarginPadding20
 
 	pg.filterBtn = l.Theme.NewClickable(false)
+	pg.filterBtn.Description = values.String(values.StrFilter)
 
 	pg.statusDropDown = l.Theme.DropdownWithCustomPos([]cryptomaterial.DropDownItem{
 		{Text: values.String(values.StrAll)},
@@ -389,14 +391,14 @@ func (pg *ProposalsPage) dropdownLayout(gtx C) D {
 		)
 	}
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(pg.leftDropdown),
 		layout.Rigid(pg.rightDropdown),
 	)
 }
 
 func (pg *ProposalsPage) leftDropdown(gtx C) D {
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			if pg.walletDropDown == nil {
 				return D{}
@@ -426,7 +428,7 @@ func (pg *ProposalsPage) rightDropdown(gtx C) D {
 		return D{}
 	}
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.statusDropDown.Layout),
 			layout.Rigid(pg.orderDropDown.Layout),
 		)
@@ -460,9 +462,9 @@ func (pg *ProposalsPage) layoutContent(gtx C) D {
 
 func (pg *ProposalsPage) layoutSectionHeader(gtx C) D {
 	isProposalSyncing := pg.AssetsManager.Politeia.IsSyncing()
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					lb := pg.Theme.Label(pg.ConvertTextSize(values.TextSize20), values.String(values.StrProposal))
 					lb.Font.Weight = font.SemiBold
@@ -475,7 +477,7 @@ func (pg *ProposalsPage) layoutSectionHeader(gtx C) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			body := func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 					layout.Rigid(func(gtx C) D {
 						var text string
 						if isProposalSyncing {
@@ -238,9 +238,9 @@ func (pg *TreasuryPage) layout(gtx C) D {
 		}.Layout(gtx, func(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
-							return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 								layout.Rigid(pg.Theme.Label(pg.ConvertTextSize(values.TextSize20), values.String(values.StrTreasurySpending)).Layout),
 								layout.Rigid(pg.infoButton.Layout),
 							)
@@ -287,7 +287,7 @@ func (pg *TreasuryPage) dropdownLayout(gtx layout.Context) layout.Dimensions {
 func (pg *TreasuryPage) layoutVerifyGovernanceKeys(gtx C) D {
 	return layout.Inset{Top: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 		return pg.viewGovernanceKeys.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{
 						Right: values.MarginPadding10,
@@ -328,7 +328,7 @@ func (pg *TreasuryPage) layoutPiKey(gtx C) D {
 	if pg.AssetsManager.IsDarkModeOn() {
 		backgroundColor = pg.Theme.Color.Background
 	}
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize18), values.String(values.StrPiKey))
 			lbl.Font.Weight = font.SemiBold
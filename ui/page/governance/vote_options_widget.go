@@ -34,6 +34,8 @@ func newInputVoteOptions(l *load.Load, label string) *inputVoteOptionsWidgets {
 		max:       l.Theme.Button(values.String(values.StrMax)),
 	}
 	i.max.Background = l.Theme.Color.Surface
+	i.increment.Description = values.String(values.StrAdd)
+	i.decrement.Description = values.String(values.StrRemove)
 	i.max.Color = l.Theme.Color.GrayText1
 	i.max.Font.Weight = font.SemiBold
 
@@ -118,7 +118,7 @@ func (as *WalletSelector) Layout(gtx layout.Context, window app.WindowNavigator)
 	return border.Layout(gtx, func(gtx C) D {
 		return layout.UniformInset(values.MarginPadding12).Layout(gtx, func(gtx C) D {
 			return as.openSelectorDialog.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						accountIcon := as.Theme.Icons.AccountIcon
 						return layout.Inset{
@@ -128,7 +128,7 @@ func (as *WalletSelector) Layout(gtx layout.Context, window app.WindowNavigator)
 					layout.Rigid(as.Theme.Body1(as.selectedWallet.GetWalletName()).Layout),
 					layout.Flexed(1, func(gtx C) D {
 						return layout.E.Layout(gtx, func(gtx C) D {
-							return layout.Flex{}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 								layout.Rigid(as.Theme.Body1(as.totalBalance).Layout),
 								layout.Rigid(func(gtx C) D {
 									inset := layout.Inset{
@@ -264,7 +264,7 @@ func (asm *WalletSelectorModal) walletAccountLayout(gtx layout.Context, wallet s
 	}.Layout(gtx, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Flexed(0.1, func(gtx C) D {
 						return layout.Inset{
 							Right: values.MarginPadding18,
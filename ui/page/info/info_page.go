@@ -54,6 +54,7 @@ type WalletInfo struct {
 	viewAllStakeButton cryptomaterial.Button
 
 	walletSyncInfo *components.WalletSyncInfo
+	balanceChart   *components.BalanceChart
 
 	materialLoader     material.LoaderStyle
 	showMaterialLoader bool
@@ -72,6 +73,7 @@ func NewInfoPage(l *load.Load, wallet sharedW.Asset, backup func(sharedW.Asset))
 		materialLoader:     material.Loader(l.Theme.Base),
 	}
 	pg.walletSyncInfo = components.NewWalletSyncInfo(l, wallet, pg.reload, backup)
+	pg.balanceChart = components.NewBalanceChart(l, wallet, pg.reload)
 	pg.recentTransactions.Radius = cryptomaterial.Radius(14)
 	pg.recentTransactions.IsShadowEnabled = true
 	pg.recentStakes.Radius = cryptomaterial.Radius(14)
@@ -105,6 +107,7 @@ func (pg *WalletInfo) OnNavigatedTo() {
 	pg.walletSyncInfo.ListenForNotifications() // stopped in OnNavigatedFrom()
 
 	go pg.loadTransactions()
+	go pg.balanceChart.Refresh()
 
 	if pg.wallet.GetAssetType() == libutils.DCRWalletAsset {
 		go pg.loadStakes()
@@ -129,6 +132,7 @@ func (pg *WalletInfo) Layout(gtx C) D {
 		items := []layout.FlexChild{layout.Rigid(pg.walletSyncInfo.WalletInfoLayout)}
 
 		items = append(items, layout.Rigid(layout.Spacer{Height: values.MarginPadding16}.Layout))
+		items = append(items, layout.Rigid(pg.balanceChartLayout))
 
 		if pg.wallet.GetAssetType() == libutils.DCRWalletAsset && pg.wallet.(*dcr.Asset).IsAccountMixerActive() {
 			items = append(items, layout.Rigid(pg.mixerLayout))
@@ -150,6 +154,10 @@ func (pg *WalletInfo) Layout(gtx C) D {
 	})
 }
 
+func (pg *WalletInfo) balanceChartLayout(gtx C) D {
+	return pg.pageContentWrapper(gtx, values.String(values.StrBalanceHistory), pg.balanceChart.RangeSelectorLayout, pg.balanceChart.Layout)
+}
+
 func (pg *WalletInfo) mixerLayout(gtx C) D {
 	return layout.Inset{
 		Bottom: values.MarginPadding16,
@@ -197,7 +205,7 @@ func (pg *WalletInfo) pageContentWrapper(gtx C, sectionTitle string, redirectBtn
 						return layout.Inset{
 							Bottom: values.MarginPadding16,
 						}.Layout(gtx, func(gtx C) D {
-							return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+							return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 								layout.Rigid(func(gtx C) D {
 									if sectionTitle == "" {
 										return D{}
@@ -251,6 +259,7 @@ func (pg *WalletInfo) walletTxWrapper(gtx C, tx *sharedW.Transaction, isHiddenSe
 func (pg *WalletInfo) HandleUserInteractions(gtx C) {
 	// Process subpage events too.
 	pg.walletSyncInfo.HandleUserInteractions(gtx)
+	pg.balanceChart.HandleUserInteractions()
 
 	if clicked, selectedItem := pg.recentTransactions.ItemClicked(); clicked {
 		pg.ParentNavigator().Display(transaction.NewTransactionDetailsPage(pg.Load, pg.wallet, pg.transactions[selectedItem]))
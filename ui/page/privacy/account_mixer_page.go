@@ -45,7 +45,7 @@ type AccountMixerPage struct {
 }
 
 func NewAccountMixerPage(l *load.Load, wallet *dcr.Asset) *AccountMixerPage {
-	return &AccountMixerPage{
+	pg := &AccountMixerPage{
 		Load:                l,
 		GenericPageModal:    app.NewGenericPageModal(AccountMixerPageID),
 		dcrWallet:           wallet,
@@ -56,6 +56,8 @@ func NewAccountMixerPage(l *load.Load, wallet *dcr.Asset) *AccountMixerPage {
 		mixedAccount:        l.Theme.NewClickable(false),
 		pageContainer:       layout.List{Axis: layout.Vertical},
 	}
+	pg.toggleMixer.Description = values.String(values.StrMix)
+	return pg
 }
 
 // OnNavigatedTo is called when the page is about to be displayed and
@@ -120,7 +122,7 @@ func (pg *AccountMixerPage) bottomSectionLabel(clickable *cryptomaterial.Clickab
 				Top:    values.MarginPadding15,
 				Bottom: values.MarginPadding4,
 			}.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(pg.Theme.Body1(title).Layout),
 					layout.Flexed(1, func(gtx C) D {
 						return layout.E.Layout(gtx, pg.Theme.NewIcon(pg.Theme.Icons.ChevronRight).Layout24dp)
@@ -150,7 +152,7 @@ func (pg *AccountMixerPage) mixerProgressBarLayout(gtx C) D {
 
 	labelWdg := func(gtx C) D {
 		return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(func(gtx C) D {
 					text := pg.mixedBalance.String()
 					return components.LayoutIconAndTextWithSize(pg.Load, gtx, text, items[0].Color, values.TextSize14, values.MarginPadding8)
@@ -174,7 +176,7 @@ func (pg *AccountMixerPage) mixerHeaderContent() layout.FlexChild {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{Bottom: values.MarginPadding15}.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 						layout.Rigid(pg.Theme.Label(values.TextSize18, values.String(values.StrBalance)).Layout),
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Left: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
@@ -183,7 +185,7 @@ func (pg *AccountMixerPage) mixerHeaderContent() layout.FlexChild {
 						}),
 						layout.Flexed(1, func(gtx C) D {
 							return layout.E.Layout(gtx, func(gtx C) D {
-								return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 									layout.Rigid(func(gtx C) D {
 										return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.Theme.Label(values.TextSize18, values.String(values.StrMix)).Layout)
 									}),
@@ -207,7 +209,7 @@ func (pg *AccountMixerPage) mixerHeaderContent() layout.FlexChild {
 					})
 				}
 				return layout.UniformInset(values.MarginPadding22).Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 						layout.Rigid(func(gtx C) D {
 							txt := pg.Theme.Label(values.TextSize18, values.String(values.StrMixer))
 							txt.Color = pg.Theme.Color.GrayText3
@@ -226,10 +228,10 @@ func (pg *AccountMixerPage) mixerHeaderContent() layout.FlexChild {
 func (pg *AccountMixerPage) balanceInfo(balanceLabel, balanceValue string, balanceIcon *cryptomaterial.Image) layout.FlexChild {
 	return layout.Rigid(func(gtx C) D {
 		leftWg := func(gtx C) D {
-			return layout.Flex{
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 				Axis:      layout.Horizontal,
 				Alignment: layout.Middle,
-			}.Layout(gtx,
+			},
 				layout.Rigid(balanceIcon.Layout12dp),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, pg.Theme.Label(values.TextSize18, balanceLabel).Layout)
@@ -245,10 +247,10 @@ func (pg *AccountMixerPage) balanceInfo(balanceLabel, balanceValue string, balan
 
 func (pg *AccountMixerPage) mixerImage() layout.FlexChild {
 	return layout.Rigid(func(gtx C) D {
-		return layout.Flex{
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 			Axis:      layout.Horizontal,
 			Alignment: layout.Middle,
-		}.Layout(gtx,
+		},
 			layout.Flexed(4, pg.Theme.Separator().Layout),
 			layout.Flexed(2, func(gtx C) D {
 				return layout.Center.Layout(gtx, pg.Theme.Icons.MixerIcon.Layout36dp)
@@ -370,7 +372,7 @@ func (pg *AccountMixerPage) HandleUserInteractions(gtx C) {
 
 		subtitle := func(gtx C) D {
 			text := values.StringF(values.StrSelectMixedAcc, `<span style="text-color: text">`, `<span style="font-weight: bold">`, `</span><span style="text-color: danger">`, `</span></span>`)
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(renderers.RenderHTML(text, pg.Theme).Layout),
 			)
 		}
@@ -397,7 +399,7 @@ func (pg *AccountMixerPage) HandleUserInteractions(gtx C) {
 
 		subtitle := func(gtx C) D {
 			text := values.StringF(values.StrSelectChangeAcc, `<span style="text-color: text">`, `<span style="font-weight: bold">`, `</span><span style="text-color: danger">`, `</span></span>`)
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(renderers.RenderHTML(text, pg.Theme).Layout),
 			)
 		}
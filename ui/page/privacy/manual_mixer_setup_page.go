@@ -189,7 +189,7 @@ func (pg *ManualMixerSetupPage) Layout(gtx C) D {
 func (pg *ManualMixerSetupPage) backButtonAndPageHeading(gtx C) D {
 	// Setting a minimum Y larger than the label allows it to be centered.
 	// gtx.Constraints.Min.Y = gtx.Dp(values.MarginPadding50)
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			return pg.backClickable.Layout(gtx, func(gtx C) D {
 				return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
@@ -222,7 +222,7 @@ func (pg *ManualMixerSetupPage) cautionCard(gtx C) D {
 			inset.Left, inset.Right = values.MarginPadding8, values.MarginPadding8
 		}
 		return inset.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					return pg.Theme.Icons.ActionInfo.Layout(gtx, pg.Theme.Color.Gray1)
 				}),
@@ -153,7 +153,7 @@ func (pg *SetupMixerAccountsPage) layoutHeadingDescAndNextIcon(gtx C, heading, d
 		spacingBtwTexts = values.MarginPadding4
 	}
 
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Flexed(1, func(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical, Alignment: layout.Start}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
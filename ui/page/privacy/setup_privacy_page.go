@@ -112,7 +112,7 @@ func (pg *SetupPrivacyPage) Layout(gtx C) D {
 						if pg.IsMobileView() {
 							imageSizeScale = unit.Dp(0.8)
 						}
-						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{Right: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
 									imageSize := values.MarginPadding48 * imageSizeScale
@@ -7,6 +7,7 @@ import (
 	"io"
 	"strings"
 
+	"gioui.org/font"
 	"gioui.org/io/clipboard"
 	"gioui.org/io/semantic"
 	"gioui.org/layout"
@@ -54,6 +55,12 @@ type Page struct {
 	accountDropdown    *components.AccountDropdown
 	hideWalletDropdown bool
 
+	// addressTypeDropdown is only set when the selected account can derive
+	// addresses of more than one type.
+	addressTypeDropdown *cryptomaterial.DropDown
+	addressScopes       []sharedW.KeyScope
+	defaultAddressType  cryptomaterial.CheckBoxStyle
+
 	isCopying         bool
 	backdrop          *widget.Clickable
 	infoButton        cryptomaterial.IconButton
@@ -86,7 +93,10 @@ func NewReceivePage(l *load.Load, wallet sharedW.Asset) *Page {
 		selectedWallet:    wallet,
 	}
 
+	pg.defaultAddressType = l.Theme.CheckBox(new(widget.Bool), values.String(values.StrDefaultAddressType))
+
 	pg.info.Inset, pg.info.Size = layout.UniformInset(values.MarginPadding5), values.MarginPadding20
+	pg.info.Description = values.String(values.StrInfo)
 
 	_, pg.infoButton = components.SubpageHeaderButtons(l)
 	if wallet == nil {
@@ -111,6 +121,7 @@ func (pg *Page) initWalletSelectors(wallet sharedW.Asset) {
 			pg.selectedWallet = wallet
 			if pg.accountDropdown != nil {
 				pg.accountDropdown.Setup(wallet)
+				pg.setupAddressTypes(pg.accountDropdown.SelectedAccount())
 			}
 		}).
 		EnableWatchOnlyWallets(true).
@@ -120,7 +131,8 @@ func (pg *Page) initWalletSelectors(wallet sharedW.Asset) {
 	}
 	pg.accountDropdown = components.NewAccountDropdown(pg.Load).
 		SetChangedCallback(func(account *sharedW.Account) {
-			currentAddress, err := pg.selectedWallet.CurrentAddress(account.Number)
+			pg.setupAddressTypes(account)
+			currentAddress, err := pg.accountCurrentAddress(account)
 			if err != nil {
 				log.Errorf("Error getting current address: %v", err)
 			} else {
@@ -170,7 +182,8 @@ func (pg *Page) OnNavigatedTo() {
 	if selectedAccount == nil {
 		return
 	}
-	currentAddress, err := pg.selectedWallet.CurrentAddress(selectedAccount.Number)
+	pg.setupAddressTypes(selectedAccount)
+	currentAddress, err := pg.accountCurrentAddress(selectedAccount)
 	if err != nil {
 		errStr := fmt.Sprintf("Error getting current address: %v", err)
 		errModal := modal.NewErrorModal(pg.Load, errStr, modal.DefaultClickFunc())
@@ -259,6 +272,7 @@ func (pg *Page) contentLayout(gtx C) D {
 								return pg.accountDropdown.Layout(gtx, values.String(values.StrAccount))
 							})
 						}),
+						layout.Rigid(pg.addressTypeLayout),
 						layout.Rigid(func(gtx C) D {
 							return components.VerticalInset(values.MarginPadding24).Layout(gtx, pg.Theme.Separator().Layout)
 						}),
@@ -357,7 +371,7 @@ func (pg *Page) contentLayout(gtx C) D {
 func (pg *Page) copyAndNewAddressLayout(gtx C) D {
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
 	return layout.Center.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(func(gtx C) D {
 				return pg.buttonIconLayout(gtx, pg.Theme.NewIcon(pg.Theme.Icons.CopyIcon), values.String(values.StrCopy), pg.copy)
 			}),
@@ -370,6 +384,8 @@ func (pg *Page) copyAndNewAddressLayout(gtx C) D {
 }
 
 func (pg *Page) buttonIconLayout(gtx C, icon *cryptomaterial.Icon, text string, clickable *cryptomaterial.Clickable) D {
+	// The text is laid out below the button, it describes the button too.
+	clickable.Description = text
 	return layout.Flex{Axis: layout.Vertical, Alignment: layout.Middle}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
 			dp40 := gtx.Dp(values.MarginPadding40)
@@ -409,7 +425,7 @@ func (pg *Page) pageBackdropLayout(gtx C) {
 }
 
 func (pg *Page) headerLayout(gtx C) D {
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			lbl := pg.Theme.H6(values.String(values.StrReceive))
 			lbl.TextSize = values.TextSizeTransform(pg.IsMobileView(), values.TextSize20)
@@ -449,6 +465,7 @@ func (pg *Page) addressLayout(gtx C) D {
 func (pg *Page) HandleUserInteractions(gtx C) {
 	pg.walletDropdown.Handle(gtx)
 	pg.accountDropdown.Handle(gtx)
+	pg.handleAddressTypeEvents(gtx)
 	if pg.backdrop.Clicked(gtx) {
 		pg.isNewAddr = false
 	}
@@ -486,7 +503,13 @@ func (pg *Page) generateNewAddress() (string, error) {
 	selectedWallet := pg.AssetsManager.WalletWithID(selectedAccount.WalletID)
 
 generateAddress:
-	newAddr, err := selectedWallet.NextAddress(selectedAccount.Number)
+	var newAddr string
+	var err error
+	if scope, ok := pg.selectedAddressScope(); ok {
+		newAddr, err = selectedWallet.(sharedW.AddressTypesAsset).NextAddressForScope(selectedAccount.Number, scope)
+	} else {
+		newAddr, err = selectedWallet.NextAddress(selectedAccount.Number)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -498,6 +521,126 @@ generateAddress:
 	return newAddr, nil
 }
 
+// setupAddressTypes lists the address types the account can receive to,
+// preselecting the account's default type. Nothing is listed if the account
+// only has one address type.
+func (pg *Page) setupAddressTypes(account *sharedW.Account) {
+	pg.addressTypeDropdown, pg.addressScopes = nil, nil
+	addressTypesAsset, ok := pg.selectedWallet.(sharedW.AddressTypesAsset)
+	if !ok || account == nil {
+		return
+	}
+
+	scopes, err := addressTypesAsset.AccountAddressKeyScopes(account.Number)
+	if err != nil {
+		log.Errorf("Error getting the account address types: %v", err)
+		return
+	}
+	if len(scopes) < 2 {
+		return
+	}
+
+	defaultScope := addressTypesAsset.AccountAddressKeyScope(account.Number)
+	items := make([]cryptomaterial.DropDownItem, len(scopes))
+	var selectedItem *cryptomaterial.DropDownItem
+	for i, scope := range scopes {
+		items[i] = cryptomaterial.DropDownItem{Text: addressTypeName(scope)}
+		if scope == defaultScope {
+			selectedItem = &items[i]
+		}
+	}
+
+	pg.addressScopes = scopes
+	pg.addressTypeDropdown = pg.Theme.NewCommonDropDown(items, selectedItem, cryptomaterial.MatchParent, values.AddressTypeDropdownGroup, false)
+	pg.defaultAddressType.CheckBox.Value = true
+}
+
+// selectedAddressScope returns the key scope of the selected address type,
+// ok is false if the account has no address types to choose from.
+func (pg *Page) selectedAddressScope() (sharedW.KeyScope, bool) {
+	if pg.addressTypeDropdown == nil {
+		return sharedW.KeyScope{}, false
+	}
+	return pg.addressScopes[pg.addressTypeDropdown.SelectedIndex()], true
+}
+
+func (pg *Page) accountCurrentAddress(account *sharedW.Account) (string, error) {
+	if scope, ok := pg.selectedAddressScope(); ok {
+		return pg.selectedWallet.(sharedW.AddressTypesAsset).CurrentAddressForScope(account.Number, scope)
+	}
+	return pg.selectedWallet.CurrentAddress(account.Number)
+}
+
+func (pg *Page) handleAddressTypeEvents(gtx C) {
+	if pg.addressTypeDropdown == nil {
+		return
+	}
+
+	account := pg.accountDropdown.SelectedAccount()
+	addressTypesAsset := pg.selectedWallet.(sharedW.AddressTypesAsset)
+	if pg.addressTypeDropdown.Changed(gtx) {
+		scope, _ := pg.selectedAddressScope()
+		pg.defaultAddressType.CheckBox.Value = scope == addressTypesAsset.AccountAddressKeyScope(account.Number)
+
+		currentAddress, err := pg.accountCurrentAddress(account)
+		if err != nil {
+			log.Errorf("Error getting current address: %v", err)
+			return
+		}
+		pg.currentAddress = currentAddress
+		pg.generateQRForAddress()
+	}
+
+	if pg.defaultAddressType.CheckBox.Update(gtx) {
+		// Unchecking the box reverts the account to the wallet's default
+		// address type, which is always listed first.
+		scope := pg.addressScopes[0]
+		if pg.defaultAddressType.CheckBox.Value {
+			scope, _ = pg.selectedAddressScope()
+		}
+		if err := addressTypesAsset.SetAccountAddressKeyScope(account.Number, scope); err != nil {
+			log.Errorf("Error setting the account address type: %v", err)
+		}
+	}
+}
+
+func (pg *Page) addressTypeLayout(gtx C) D {
+	if pg.addressTypeDropdown == nil {
+		return D{}
+	}
+
+	return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				lbl := pg.Theme.H6(values.String(values.StrAddressType))
+				lbl.TextSize = values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
+				lbl.Font.Weight = font.SemiBold
+				return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+			}),
+			layout.Rigid(pg.addressTypeDropdown.Layout),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.defaultAddressType.Layout)
+			}),
+		)
+	})
+}
+
+// addressTypeName returns the display name of the addresses derived from the
+// key scope.
+func addressTypeName(scope sharedW.KeyScope) string {
+	switch scope.Purpose {
+	case 44:
+		return values.String(values.StrLegacyAddress)
+	case 49:
+		return values.String(values.StrNestedSegwitAddress)
+	case 84:
+		return values.String(values.StrNativeSegwitAddress)
+	case 86:
+		return values.String(values.StrTaprootAddress)
+	}
+	return scope.String()
+}
+
 func (pg *Page) handleCopyEvent(gtx C) {
 	// Prevent copying again if the timer hasn't expired
 	if (pg.copy.Clicked(gtx) || pg.qrCopyButton.Clicked(gtx) || pg.addressCopyButton.Clicked(gtx)) && !pg.isCopying {
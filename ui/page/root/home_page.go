@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"decred.org/dcrdex/client/core"
 	dexdb "decred.org/dcrdex/client/db"
 	"gioui.org/font"
 	"gioui.org/io/clipboard"
@@ -18,11 +20,15 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	"github.com/crypto-power/cryptopower/appos"
+	"github.com/crypto-power/cryptopower/libwallet"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/dexorders"
+	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/notification"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/page/exchange"
 	"github.com/crypto-power/cryptopower/ui/page/governance"
@@ -31,7 +37,6 @@ import (
 	"github.com/crypto-power/cryptopower/ui/page/settings"
 	"github.com/crypto-power/cryptopower/ui/page/transaction"
 	"github.com/crypto-power/cryptopower/ui/page/wallet"
-	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -67,6 +72,8 @@ type HomePage struct {
 	isBalanceHidden,
 	isHiddenNavigation bool
 
+	swapSchedulesResumed bool
+
 	isConnected        *atomic.Bool
 	showNavigationFunc showNavigationFunc
 	startSpvSync       uint32
@@ -87,6 +94,8 @@ func NewHomePage(dexCtx context.Context, l *load.Load) *HomePage {
 
 	hp.hideBalanceButton = hp.Theme.NewClickable(false)
 	hp.appLevelSettingsButton = hp.Theme.NewClickable(false)
+	hp.appLevelSettingsButton.Description = values.String(values.StrSettings)
+	hp.copyRedirectURL.Description = values.String(values.StrCopy)
 	hp.appNotificationButton = hp.Theme.NewClickable(false)
 	_, hp.infoButton = components.SubpageHeaderButtons(l)
 	hp.infoButton.Size = values.MarginPadding15
@@ -208,6 +217,82 @@ func (hp *HomePage) OnNavigatedTo() {
 	hp.AssetsManager.WatchBalanceChange(func() {
 		go hp.CalculateAssetsUSDBalance()
 	})
+
+	hp.listenForInstantSwapOrders()
+	hp.resumeSwapSchedules()
+}
+
+// listenForInstantSwapOrders watches the funded instant swap orders for
+// their payouts and refunds and notifies the user of overdue orders.
+func (hp *HomePage) listenForInstantSwapOrders() {
+	hp.AssetsManager.WatchInstantSwapOrders()
+
+	orderNotificationListener := &instantswap.OrderNotificationListener{
+		OnOrderOverdue: func(order *instantswap.Order) {
+			hp.postNotification(values.StringF(values.StrOrderOverdueNotif, order.UUID, order.ExchangeServer.Server.CapFirstLetter()))
+		},
+	}
+	err := hp.AssetsManager.InstantSwap.AddNotificationListener(orderNotificationListener, HomePageID)
+	if err != nil {
+		log.Errorf("Error adding instanswap notification listener: %v", err)
+	}
+}
+
+// resumeSwapSchedules asks for the spending password of the source wallet of
+// the swap schedules that were running when the app was closed and resumes
+// the schedules of the wallet with it. This is done once per app launch.
+func (hp *HomePage) resumeSwapSchedules() {
+	if hp.swapSchedulesResumed {
+		return
+	}
+	hp.swapSchedulesResumed = true
+
+	schedules, err := hp.AssetsManager.InstantSwap.SchedulesAwaitingPassphrase()
+	if err != nil {
+		log.Errorf("Error loading swap schedules: %v", err)
+		return
+	}
+
+	var walletIDs []int
+	walletSchedules := make(map[int][]*instantswap.Schedule)
+	for _, schedule := range schedules {
+		walletID := schedule.Order.SourceWalletID
+		if _, ok := walletSchedules[walletID]; !ok {
+			walletIDs = append(walletIDs, walletID)
+		}
+		walletSchedules[walletID] = append(walletSchedules[walletID], schedule)
+	}
+
+	for _, walletID := range walletIDs {
+		if wal := hp.AssetsManager.WalletWithID(walletID); wal != nil {
+			hp.unlockWalletForSchedules(wal, walletSchedules[walletID])
+		}
+	}
+}
+
+func (hp *HomePage) unlockWalletForSchedules(wal sharedW.Asset, schedules []*instantswap.Schedule) {
+	spendingPasswordModal := modal.NewCreatePasswordModal(hp.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrResumeSchedules)).
+		SetDescription(values.StringF(values.StrResumeSchedulesInfo, len(schedules), wal.GetWalletName())).
+		PasswordHint(values.String(values.StrSpendingPassword)).
+		SetPositiveButtonText(values.String(values.StrUnlock)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			err := wal.UnlockWallet(password)
+			if err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+			for _, schedule := range schedules {
+				if err := hp.AssetsManager.StartSchedule(schedule.ID, password); err != nil {
+					log.Errorf("Error resuming swap schedule %q: %v", schedule.Name, err)
+				}
+			}
+			pm.Dismiss()
+			return true
+		})
+	hp.ParentWindow().ShowModal(spendingPasswordModal)
 }
 
 // initDEX initializes a new dex client if dex is not ready.
@@ -228,6 +313,8 @@ func (hp *HomePage) initDEX() {
 		// Wait until dex is ready
 		<-dexClient.Ready()
 
+		go hp.listenForExpiringBonds(dexClient)
+
 		activeOrders, _, err := dexClient.ActiveOrders() // we just initialized dexc, no inflight order expected
 		if err != nil {
 			log.Errorf("dexClient.ActiveOrders error: %w", err)
@@ -243,7 +330,13 @@ func (hp *HomePage) initDEX() {
 			expiredBonds = append(expiredBonds, xc.Auth.ExpiredBonds...)
 		}
 
-		if len(activeOrders) == 0 && len(expiredBonds) == 0 {
+		// Conditional orders are resumed after login.
+		conditionalOrders, err := hp.AssetsManager.ConditionalOrders.Orders(dexorders.StatusActive)
+		if err != nil {
+			log.Errorf("ConditionalOrders.Orders error: %v", err)
+		}
+
+		if len(activeOrders) == 0 && len(expiredBonds) == 0 && len(conditionalOrders) == 0 {
 			return // nothing to do
 		}
 
@@ -266,11 +359,15 @@ func (hp *HomePage) initDEX() {
 				SetPositiveButtonText(values.String(values.StrLogin)).
 				SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
 					dexPassEditor.SetError("")
-					err := dexClient.Login([]byte(dexPassEditor.Editor.Text()))
+					dexPass := []byte(dexPassEditor.Editor.Text())
+					err := dexClient.Login(dexPass)
 					if err != nil {
 						dexPassEditor.SetError(err.Error())
 						return false
 					}
+					if err := hp.AssetsManager.StartConditionalOrders(dexPass); err != nil {
+						log.Errorf("StartConditionalOrders error: %v", err)
+					}
 					return true
 				}).
 				SetCancelable(false)
@@ -291,6 +388,11 @@ func (hp *HomePage) initDEX() {
 			walletsToSyncMap[bond.AssetID] = &struct{}{}
 		}
 
+		for _, ord := range conditionalOrders {
+			walletsToSyncMap[ord.Base] = &struct{}{}
+			walletsToSyncMap[ord.Quote] = &struct{}{}
+		}
+
 		var namesOfWalletsToSync []string
 		var walletsToSync []sharedW.Asset
 		for assetID := range walletsToSyncMap {
@@ -349,6 +451,68 @@ func (hp *HomePage) initDEX() {
 	}()
 }
 
+// listenForExpiringBonds notifies the user when the bonds of a DEX account are
+// about to expire or have expired. It MUST be called from a goroutine.
+func (hp *HomePage) listenForExpiringBonds(dexClient libwallet.DEXClient) {
+	noteFeed := dexClient.NotificationFeed()
+	defer noteFeed.ReturnFeed()
+
+	// expiringTiers tracks the expiring tiers already notified per host so
+	// each bond is notified once.
+	expiringTiers := make(map[string]int64)
+	notifyExpiringTiers := func(host string, auth *core.ExchangeAuth) {
+		weak := auth.WeakStrength
+		if weak > expiringTiers[host] {
+			msg := values.StringF(values.StrBondsExpiringNoRenewalNotif, weak, host)
+			if auth.TargetTier > 0 {
+				msg = values.StringF(values.StrBondsExpiringNotif, weak, host)
+			}
+			hp.postNotification(msg)
+		}
+		expiringTiers[host] = weak
+	}
+
+	for host, xc := range dexClient.Exchanges() {
+		notifyExpiringTiers(host, &xc.Auth)
+	}
+
+	for {
+		select {
+		case <-hp.dexCtx.Done():
+			return
+		case n := <-noteFeed.C:
+			if n == nil {
+				return
+			}
+
+			note, ok := n.(*core.BondPostNote)
+			if !ok || note.Auth == nil {
+				continue
+			}
+
+			if note.Topic() == core.TopicBondExpired {
+				hp.postNotification(values.StringF(values.StrBondsExpiredNotif, note.Dex, note.Auth.EffectiveTier))
+			}
+			notifyExpiringTiers(note.Dex, note.Auth)
+		}
+	}
+}
+
+// postNotification shows msg in the app and as a desktop
+// notification.
+func (hp *HomePage) postNotification(msg string) {
+	hp.Toast.Notify(msg)
+
+	systemNotification, err := notification.NewSystemNotification()
+	if err != nil {
+		log.Errorf("Error creating desktop notification: %v", err)
+		return
+	}
+	if err := systemNotification.Notify(msg); err != nil {
+		log.Infof("could not initiate desktop notification, reason: %v", err)
+	}
+}
+
 // OnDarkModeChanged is triggered whenever the dark mode setting is changed
 // to enable restyling UI elements where necessary.
 // Satisfies the load.AppSettingsChangeHandler interface.
@@ -473,7 +637,7 @@ func (hp *HomePage) HandleUserInteractions(gtx C) {
 						return border.Layout(gtx, func(gtx C) D {
 							return wrapper.Layout(gtx, func(gtx C) D {
 								return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 										layout.Flexed(0.9, hp.Theme.Body1(hp.releaseResponse.URL).Layout),
 										layout.Flexed(0.1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
@@ -539,21 +703,37 @@ func (hp *HomePage) showWarningNoSpendableWallet() {
 
 // KeysToHandle returns a Filter's slice that describes a set of key combinations
 // that this modal wishes to capture. The HandleKeyPress() method will only be
-// called when any of these key combinations is pressed.
+// called when any of these key combinations is pressed. Shortcut+1 to
+// Shortcut+9 select the navigation tabs, the other keys are those of the
+// current page.
 // Satisfies the load.KeyEventHandler interface for receiving key events.
 func (hp *HomePage) KeysToHandle() []event.Filter {
+	var filters []event.Filter
+	for i := 1; i <= hp.navigationTab.Count() && i <= 9; i++ {
+		filters = append(filters, key.Filter{Name: key.Name(strconv.Itoa(i)), Required: key.ModShortcut})
+	}
 	if currentPage := hp.CurrentPage(); currentPage != nil {
 		if keyEvtHandler, ok := currentPage.(load.KeyEventHandler); ok {
-			return keyEvtHandler.KeysToHandle()
+			filters = append(filters, keyEvtHandler.KeysToHandle()...)
 		}
 	}
-	return nil
+	return filters
 }
 
 // HandleKeyPress is called when one or more keys are pressed on the current
 // window that match any of the key combinations returned by KeysToHandle().
 // Satisfies the load.KeyEventHandler interface for receiving key events.
 func (hp *HomePage) HandleKeyPress(gtx C, evt *key.Event) {
+	if evt.Modifiers.Contain(key.ModShortcut) {
+		if n, err := strconv.Atoi(string(evt.Name)); err == nil {
+			if evt.State == key.Press {
+				hp.navigationTab.Select(n - 1)
+				hp.ParentWindow().Reload()
+			}
+			return
+		}
+	}
+
 	if currentPage := hp.CurrentPage(); currentPage != nil {
 		if keyEvtHandler, ok := currentPage.(load.KeyEventHandler); ok {
 			keyEvtHandler.HandleKeyPress(gtx, evt)
@@ -579,6 +759,7 @@ func (hp *HomePage) OnNavigatedFrom() {
 	}
 
 	hp.AssetsManager.RemoveAssetChange()
+	hp.AssetsManager.InstantSwap.RemoveNotificationListener(HomePageID)
 	hp.ctxCancel()
 }
 
@@ -781,7 +962,7 @@ func (hp *HomePage) totalBalanceLayout(gtx C) D {
 				// Check if exchange rate fetching is enabled and total balance is available
 				if hp.AssetsManager.ExchangeRateFetchingEnabled() && totalBalanceUSD != "" {
 					// Render total balance text and icon button
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(hp.totalBalanceTextAndIconButtonLayout),
 						layout.Rigid(hp.notificationSettingsLayout), // Include notification layout here
 					)
@@ -808,7 +989,7 @@ func (hp *HomePage) totalBalanceLayout(gtx C) D {
 				card.Color = hp.Theme.Color.Gray2
 				padding8 := values.MarginPadding8
 				padding16 := values.MarginPadding16
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
 							return card.Layout(gtx, func(gtx C) D {
@@ -891,12 +1072,14 @@ func (hp *HomePage) totalBalanceLayout(gtx C) D {
 
 func (hp *HomePage) balanceLayout(gtx C) D {
 	if hp.AssetsManager.ExchangeRateFetchingEnabled() && totalBalanceUSD != "" {
-		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 			layout.Rigid(hp.LayoutUSDBalance),
 			layout.Rigid(func(gtx C) D {
 				icon := hp.Theme.Icons.VisibilityOffIcon
+				hp.hideBalanceButton.Description = values.String(values.StrHideBalance)
 				if hp.isBalanceHidden {
 					icon = hp.Theme.Icons.VisibilityIcon
+					hp.hideBalanceButton.Description = values.String(values.StrShowBalance)
 				}
 				return layout.Inset{}.Layout(gtx, func(gtx C) D {
 					return hp.hideBalanceButton.Layout(gtx, hp.Theme.NewIcon(icon).Layout24dp)
@@ -940,7 +1123,7 @@ func (hp *HomePage) totalBalanceTextAndIconButtonLayout(gtx C) D {
 }
 
 func (hp *HomePage) notificationSettingsLayout(gtx C) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
 				return cryptomaterial.LinearLayout{
@@ -1080,7 +1263,7 @@ func (hp *HomePage) CalculateAssetsUSDBalance() {
 			totalBalance += balance
 		}
 
-		totalBalanceUSD = utils.FormatAsUSDString(hp.Printer, totalBalance)
+		totalBalanceUSD = hp.FormatFiat(totalBalance)
 		hp.ParentWindow().Reload()
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"image/color"
 	"sort"
 	"strings"
+	"sync"
 
 	"gioui.org/layout"
 	"gioui.org/op"
@@ -17,6 +18,7 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet"
 	"github.com/decred/dcrd/dcrutil/v4"
 
+	"github.com/crypto-power/cryptopower/libwallet/activity"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/ext"
@@ -25,6 +27,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/page/dcrdex"
 	"github.com/crypto-power/cryptopower/ui/page/exchange"
 	"github.com/crypto-power/cryptopower/ui/page/governance"
 	"github.com/crypto-power/cryptopower/ui/page/privacy"
@@ -37,6 +40,10 @@ import (
 
 const (
 	OverviewPageID = "Overview"
+
+	// activityPageSize is the number of activity items shown and loaded by
+	// the see more button.
+	activityPageSize = 5
 )
 
 type multiWalletTx struct {
@@ -53,7 +60,7 @@ type OverviewPage struct {
 	mobileMarketOverviewList layout.List
 	recentProposalList       *cryptomaterial.ClickableList
 	recentTradeList          *cryptomaterial.ClickableList
-	recentTransactions       *cryptomaterial.ClickableList
+	recentActivity           *cryptomaterial.ClickableList
 	recentStakes             *cryptomaterial.ClickableList
 
 	scrollContainer               *widget.List
@@ -65,7 +72,10 @@ type OverviewPage struct {
 	infoSyncWalletsSlider     *cryptomaterial.Slider
 	proposalItems             []*components.ProposalItem
 	orders                    []*instantswap.Order
-	transactions              []*multiWalletTx
+	activityMu                sync.RWMutex
+	activityItems             []*activity.Item
+	activityNext              string // cursor of the next activity page
+	seeMoreActivity           cryptomaterial.Button
 	stakes                    []*multiWalletTx
 	mktValues                 []assetMarketData
 
@@ -79,6 +89,8 @@ type OverviewPage struct {
 
 	materialLoader    material.LoaderStyle
 	forceRefreshRates *cryptomaterial.Clickable
+	portfolioButton   cryptomaterial.Button
+	balanceChart      *components.BalanceChart
 
 	mixerSliderData      map[int]*mixerData
 	sortedMixerSlideKeys []int
@@ -155,7 +167,7 @@ func NewOverviewPage(l *load.Load, showNavigationFunc showNavigationFunc) *Overv
 		},
 		recentTradeList:    l.Theme.NewClickableList(layout.Vertical),
 		recentProposalList: l.Theme.NewClickableList(layout.Vertical),
-		recentTransactions: l.Theme.NewClickableList(layout.Vertical),
+		recentActivity:     l.Theme.NewClickableList(layout.Vertical),
 		recentStakes:       l.Theme.NewClickableList(layout.Vertical),
 
 		assetBalanceSlider:    l.Theme.Slider(),
@@ -163,6 +175,8 @@ func NewOverviewPage(l *load.Load, showNavigationFunc showNavigationFunc) *Overv
 		infoSyncWalletsSlider: l.Theme.Slider(),
 		card:                  l.Theme.Card(),
 		forceRefreshRates:     l.Theme.NewClickable(false),
+		portfolioButton:       l.Theme.OutlineButton(values.String(values.StrManagePortfolio)),
+		seeMoreActivity:       l.Theme.OutlineButton(values.String(values.StrSeeMore)),
 		showNavigationFunc:    showNavigationFunc,
 		listInfoWallets:       make([]*components.WalletSyncInfo, 0),
 	}
@@ -181,8 +195,8 @@ func NewOverviewPage(l *load.Load, showNavigationFunc showNavigationFunc) *Overv
 	pg.assetsTotalBalance = make(map[libutils.AssetType]sharedW.AssetAmount)
 
 	pg.stakes = make([]*multiWalletTx, 0)
-	pg.transactions = make([]*multiWalletTx, 0)
 	pg.initInfoWallets()
+	pg.balanceChart = components.NewBalanceChart(l, nil, pg.reload)
 
 	return pg
 }
@@ -203,13 +217,15 @@ func (pg *OverviewPage) OnNavigatedTo() {
 	if pg.AssetsManager.ExchangeRateFetchingEnabled() {
 		go pg.AssetsManager.RateSource.Refresh(false)
 		go pg.updateAssetsUSDBalance()
+		go pg.balanceChart.Refresh()
 	}
-	go pg.loadTransactions()
+	go pg.loadActivity()
 
 	pg.proposalItems = components.LoadProposals(pg.Load, libwallet.ProposalCategoryAll, 0, 3, true, "")
 	pg.orders = components.LoadOrders(pg.Load, 0, 3, true, "", "")
 
 	pg.listenForMixerNotifications() // listeners are stopped in OnNavigatedFrom().
+	pg.listenForActivity()
 
 	for _, info := range pg.listInfoWallets {
 		info.Init()
@@ -233,9 +249,14 @@ func (pg *OverviewPage) HandleUserInteractions(gtx C) {
 		go pg.AssetsManager.RateSource.Refresh(true)
 	}
 
-	if clicked, selectedTxIndex := pg.recentTransactions.ItemClicked(); clicked {
-		tx, wal := pg.txAndWallet(pg.transactions[selectedTxIndex])
-		pg.ParentNavigator().Display(transaction.NewTransactionDetailsPage(pg.Load, wal, tx))
+	if clicked, selectedIndex := pg.recentActivity.ItemClicked(); clicked {
+		if items, _ := pg.activity(); selectedIndex < len(items) {
+			pg.showActivityItem(items[selectedIndex])
+		}
+	}
+
+	if pg.seeMoreActivity.Clicked(gtx) {
+		go pg.loadMoreActivity()
 	}
 
 	if clicked, selectedTxIndex := pg.recentStakes.ItemClicked(); clicked {
@@ -251,6 +272,12 @@ func (pg *OverviewPage) HandleUserInteractions(gtx C) {
 		pg.ParentNavigator().Display(exchange.NewOrderDetailsPage(pg.Load, pg.orders[selectedTxIndex]))
 	}
 
+	pg.balanceChart.HandleUserInteractions()
+
+	if pg.portfolioButton.Clicked(gtx) {
+		pg.ParentNavigator().Display(NewPortfolioPage(pg.Load))
+	}
+
 	// Navigate to mixer page when wallet mixer slider forward button is clicked.
 	if pg.forwardButton.Button.Clicked(gtx) {
 		curSliderIndex := pg.mixerSlider.GetSelectedIndex()
@@ -300,6 +327,7 @@ func (pg *OverviewPage) OnNavigatedFrom() {
 
 func (pg *OverviewPage) OnCurrencyChanged() {
 	go pg.updateAssetsUSDBalance()
+	go pg.balanceChart.Refresh()
 }
 
 func (pg *OverviewPage) reload() {
@@ -326,11 +354,13 @@ func (pg *OverviewPage) Layout(gtx C) D {
 func (pg *OverviewPage) layoutDesktop(gtx C) D {
 	pageContent := []func(gtx C) D{
 		pg.sliderLayout,
+		pg.balanceChartLayout,
 		pg.infoWalletLayout,
 		pg.marketOverview,
 		pg.txStakingSection,
 		pg.recentTrades,
 		pg.recentProposal,
+		pg.portfolioLayout,
 	}
 
 	return cryptomaterial.UniformPaddingWithTopInset(values.MarginPadding15, gtx, func(gtx C) D {
@@ -349,21 +379,23 @@ func (pg *OverviewPage) layoutDesktop(gtx C) D {
 func (pg *OverviewPage) layoutMobile(gtx C) D {
 	pageContent := []func(gtx C) D{
 		pg.sliderLayout,
+		pg.balanceChartLayout,
 		pg.infoWalletLayout,
 		pg.mobileMarketOverview,
 		pg.txStakingSection,
 		pg.recentProposal,
+		pg.portfolioLayout,
 	}
 
 	// Do not show recent trades on iOS and macOS
 	if !appos.Current().IsIOS() || !appos.Current().IsDarwin() {
-		// Determine the insertion point, which is second to last position
-		insertionPoint := len(pageContent) - 1
+		// Determine the insertion point, which is third to last position
+		insertionPoint := len(pageContent) - 2
 		if insertionPoint < 0 {
 			insertionPoint = 0
 		}
 
-		// Append at the second to last position
+		// Append at the third to last position
 		pageContent = append(pageContent[:insertionPoint], append([]func(gtx C) D{pg.recentTrades}, pageContent[insertionPoint:]...)...)
 	}
 
@@ -419,7 +451,7 @@ func (pg *OverviewPage) sliderLayout(gtx C) D {
 			}
 
 			if pg.IsMobileView() {
-				return layout.Flex{Axis: axis}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis},
 					layout.Rigid(func(gtx C) D {
 						return pg.assetBalanceSliderLayout(gtx, 0)
 					}),
@@ -434,7 +466,7 @@ func (pg *OverviewPage) sliderLayout(gtx C) D {
 			mixerSliderDims := pg.mixerSliderLayout(cgtx)
 			call := macro.Stop()
 
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Flexed(.5, func(gtx C) D {
 					return pg.assetBalanceSliderLayout(gtx, mixerSliderDims.Size.Y)
 				}),
@@ -722,7 +754,7 @@ func (pg *OverviewPage) mobileMarketOverview(gtx C) D {
 									}),
 									layout.Rigid(func(gtx C) D {
 										return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-											return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+											return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 												layout.Rigid(func(gtx C) D {
 													txt := pg.Theme.Label(values.TextSize16, asset.assetType.ToFull())
 													txt.Color = pg.Theme.Color.Text
@@ -752,7 +784,7 @@ func (pg *OverviewPage) mobileMarketOverview(gtx C) D {
 									}),
 									layout.Rigid(func(gtx C) D {
 										return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-											txt := pg.Theme.Label(values.TextSize16, pageutils.FormatAsUSDString(pg.Printer, rate.LastTradePrice))
+											txt := pg.Theme.Label(values.TextSize16, pg.FormatFiat(rate.LastTradePrice))
 											txt.Color = pg.Theme.Color.Text
 											return txt.Layout(gtx)
 										})
@@ -831,10 +863,10 @@ func (pg *OverviewPage) marketTableHeader(gtx C) D {
 	}
 
 	rightWidget := func(gtx C) D {
-		return layout.Flex{
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 			Axis:      layout.Horizontal,
 			Alignment: layout.Middle,
-		}.Layout(gtx,
+		},
 			layout.Flexed(.8, func(gtx C) D {
 				return layout.E.Layout(gtx, pg.assetTableLabel(values.String(values.StrPrice), col))
 			}),
@@ -848,10 +880,10 @@ func (pg *OverviewPage) marketTableHeader(gtx C) D {
 
 func (pg *OverviewPage) marketTableRows(gtx C, asset assetMarketData, rate *ext.Ticker) D {
 	leftWidget := func(gtx C) D {
-		return layout.Flex{
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 			Axis:      layout.Horizontal,
 			Alignment: layout.Middle,
-		}.Layout(gtx,
+		},
 			layout.Rigid(asset.image.Layout24dp),
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{
@@ -864,12 +896,12 @@ func (pg *OverviewPage) marketTableRows(gtx C, asset assetMarketData, rate *ext.
 	}
 
 	rightWidget := func(gtx C) D {
-		return layout.Flex{
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 			Axis:      layout.Horizontal,
 			Alignment: layout.Middle,
-		}.Layout(gtx,
+		},
 			layout.Flexed(.785, func(gtx C) D {
-				return layout.E.Layout(gtx, pg.assetTableLabel(pageutils.FormatAsUSDString(pg.Printer, rate.LastTradePrice), pg.Theme.Color.Text))
+				return layout.E.Layout(gtx, pg.assetTableLabel(pg.FormatFiat(rate.LastTradePrice), pg.Theme.Color.Text))
 			}),
 			layout.Flexed(.215, func(gtx C) D {
 				hasRateChange := rate.PriceChangePercent != nil
@@ -916,52 +948,65 @@ func (pg *OverviewPage) txStakingSection(gtx C) D {
 			if pg.IsMobileView() {
 				flexChilds = []layout.FlexChild{
 					layout.Rigid(layout.Spacer{Height: values.MarginPadding16}.Layout),
-					layout.Rigid(pg.recentTransactionsLayout),
+					layout.Rigid(pg.recentActivityLayout),
 					layout.Rigid(pg.recentStakingsLayout),
 				}
 			} else {
 				flexChilds = []layout.FlexChild{
-					layout.Flexed(.5, pg.recentTransactionsLayout),
+					layout.Flexed(.5, pg.recentActivityLayout),
 					layout.Rigid(layout.Spacer{Width: values.MarginPadding10}.Layout),
 					layout.Flexed(.5, pg.recentStakingsLayout),
 				}
 			}
 
-			return layout.Flex{Axis: axis}.Layout(gtx, flexChilds...)
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis}, flexChilds...)
 		}),
 	)
 }
 
-func (pg *OverviewPage) recentTransactionsLayout(gtx C) D {
-	return pg.pageContentWrapper(gtx, values.String(values.StrRecentTransactions), nil, func(gtx C) D {
-		if len(pg.transactions) == 0 {
+func (pg *OverviewPage) recentActivityLayout(gtx C) D {
+	items, next := pg.activity()
+	return pg.pageContentWrapper(gtx, values.String(values.StrRecentActivity), nil, func(gtx C) D {
+		if len(items) == 0 {
 			gtx.Constraints.Min.X = gtx.Constraints.Max.X
 			return pg.centerLayout(gtx, values.MarginPadding10, values.MarginPadding10, func(gtx C) D {
-				return pg.Theme.Body1(values.String(values.StrNoTransactions)).Layout(gtx)
+				return pg.Theme.Body1(values.String(values.StrNoActivity)).Layout(gtx)
 			})
 		}
 
-		return pg.recentTransactions.Layout(gtx, len(pg.transactions), func(gtx C, index int) D {
-			tx, wal := pg.txAndWallet(pg.transactions[index])
-			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-				layout.Rigid(func(gtx C) D {
-					return components.LayoutTransactionRow(gtx, pg.Load, wal, tx, false)
-				}),
-				layout.Rigid(func(gtx C) D {
-					// No divider for last row
-					if index == len(pg.transactions)-1 {
-						return D{}
-					}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				return pg.recentActivity.Layout(gtx, len(items), func(gtx C, index int) D {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(func(gtx C) D {
+							return components.LayoutActivityRow(gtx, pg.Load, items[index])
+						}),
+						layout.Rigid(func(gtx C) D {
+							// No divider for last row
+							if index == len(items)-1 {
+								return D{}
+							}
 
-					gtx.Constraints.Min.X = gtx.Constraints.Max.X
-					separator := pg.Theme.Separator()
-					return layout.E.Layout(gtx, func(gtx C) D {
-						// Show bottom divider for all rows except last
-						return layout.Inset{Left: values.MarginPadding32}.Layout(gtx, separator.Layout)
-					})
-				}),
-			)
-		})
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							separator := pg.Theme.Separator()
+							return layout.E.Layout(gtx, func(gtx C) D {
+								// Show bottom divider for all rows except last
+								return layout.Inset{Left: values.MarginPadding32}.Layout(gtx, separator.Layout)
+							})
+						}),
+					)
+				})
+			}),
+			layout.Rigid(func(gtx C) D {
+				if next == "" {
+					return D{}
+				}
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.Center.Layout(gtx, func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, pg.seeMoreActivity.Layout)
+				})
+			}),
+		)
 	})
 }
 
@@ -1058,6 +1103,24 @@ func (pg *OverviewPage) recentProposal(gtx C) D {
 	})
 }
 
+// balanceChartLayout draws the fiat value of all the wallets over time, it is
+// hidden if exchange rates are disabled.
+func (pg *OverviewPage) balanceChartLayout(gtx C) D {
+	if !pg.AssetsManager.ExchangeRateFetchingEnabled() {
+		return D{}
+	}
+	titleLayout := func(gtx C) D {
+		return components.EndToEndRow(gtx, pg.Theme.Body2(values.String(values.StrPortfolioValue)).Layout, pg.balanceChart.RangeSelectorLayout)
+	}
+	return pg.pageContentWrapper(gtx, "", titleLayout, pg.balanceChart.Layout)
+}
+
+func (pg *OverviewPage) portfolioLayout(gtx C) D {
+	return pg.pageContentWrapper(gtx, values.String(values.StrPortfolio), nil, func(gtx C) D {
+		return components.EndToEndRow(gtx, pg.Theme.Body1(values.String(values.StrPortfolioMsg)).Layout, pg.portfolioButton.Layout)
+	})
+}
+
 func (pg *OverviewPage) txAndWallet(mtx *multiWalletTx) (*sharedW.Transaction, sharedW.Asset) {
 	return mtx.Transaction, pg.AssetsManager.WalletWithID(mtx.walletID)
 }
@@ -1071,7 +1134,7 @@ func (pg *OverviewPage) updateAssetsUSDBalance() {
 		}
 
 		toUSDString := func(balance float64) string {
-			return pageutils.FormatAsUSDString(pg.Printer, balance)
+			return pg.FormatFiat(balance)
 		}
 
 		for assetType, balance := range assetsTotalUSDBalance {
@@ -1105,7 +1168,7 @@ func (pg *OverviewPage) updateAssetsSliders() {
 		return &assetBalanceSliderItem{
 			assetType:       assetFullName,
 			totalBalance:    totalBalance,
-			totalBalanceUSD: "$--",
+			totalBalanceUSD: ext.FiatSymbol(pg.AssetsManager.GetFiatCurrency()) + "--",
 			image:           icon,
 			backgroundImage: bkgImage,
 		}
@@ -1256,6 +1319,7 @@ func (pg *OverviewPage) stopNtfnListeners() {
 		}
 		wal.RemoveTxAndBlockNotificationListener(OverviewPageID)
 	}
+	pg.AssetsManager.RemoveActivityListener(OverviewPageID)
 }
 
 func (pg *OverviewPage) setUnMixedBalance(id int) {
@@ -1297,7 +1361,7 @@ func (pg *OverviewPage) reloadBalances() {
 func (pg *OverviewPage) ratesRefreshComponent() func(gtx C) D {
 	return func(gtx C) D {
 		refreshing := pg.AssetsManager.RateSource.Refreshing()
-		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.End},
 			layout.Rigid(func(gtx C) D {
 				var text string
 				if refreshing {
@@ -1331,31 +1395,104 @@ func (pg *OverviewPage) ratesRefreshComponent() func(gtx C) D {
 	}
 }
 
-func (pg *OverviewPage) loadTransactions() {
-	transactions := make([]*multiWalletTx, 0)
-	wal := pg.AssetsManager.AllWallets()
-	for _, w := range wal {
-		txs, err := w.GetTransactionsRaw(0, 3, libutils.TxFilterAll, true, "")
-		if err != nil {
-			log.Errorf("error loading transactions: %v", err)
-			return
-		}
+// activity returns the loaded activity items and the cursor of the next
+// page.
+func (pg *OverviewPage) activity() ([]*activity.Item, string) {
+	pg.activityMu.RLock()
+	defer pg.activityMu.RUnlock()
+	return pg.activityItems, pg.activityNext
+}
 
-		for _, tx := range txs {
-			transactions = append(transactions, &multiWalletTx{tx, w.GetWalletID()})
+func (pg *OverviewPage) showActivityItem(item *activity.Item) {
+	switch item.Kind {
+	case activity.InstantSwap:
+		pg.ParentNavigator().Display(exchange.NewOrderDetailsPage(pg.Load, item.Order))
+	case activity.DEXMatch:
+		pg.ParentNavigator().Display(dcrdex.NewDEXOrderDetailsPage(pg.Load, item.DEXOrder))
+	default:
+		if wal := pg.AssetsManager.WalletWithID(item.WalletID); wal != nil {
+			pg.ParentNavigator().Display(transaction.NewTransactionDetailsPage(pg.Load, wal, item.Tx))
 		}
 	}
+}
 
-	sort.Slice(transactions, func(i, j int) bool {
-		return transactions[i].Timestamp > transactions[j].Timestamp
-	})
-
-	if len(transactions) > 3 {
-		transactions = transactions[:3]
+// loadActivity loads the newest activity items of all the wallets and the
+// recent stakes.
+func (pg *OverviewPage) loadActivity() {
+	page, err := pg.AssetsManager.ActivityFeed("", activityPageSize)
+	if err != nil {
+		log.Errorf("error loading activity: %v", err)
+		return
 	}
-	pg.transactions = transactions
+	pg.activityMu.Lock()
+	pg.activityItems = page.Items
+	pg.activityNext = page.Next
+	pg.activityMu.Unlock()
 
 	pg.loadStakes()
+	pg.ParentWindow().Reload()
+}
+
+// loadMoreActivity adds the next page of activity items.
+func (pg *OverviewPage) loadMoreActivity() {
+	_, next := pg.activity()
+	page, err := pg.AssetsManager.ActivityFeed(next, activityPageSize)
+	if err != nil {
+		log.Errorf("error loading activity: %v", err)
+		return
+	}
+
+	pg.activityMu.Lock()
+	// The page was already added if "see more" was clicked twice.
+	if pg.activityNext == next {
+		pg.activityItems = append(pg.activityItems[:len(pg.activityItems):len(pg.activityItems)], page.Items...)
+		pg.activityNext = page.Next
+	}
+	pg.activityMu.Unlock()
+	pg.ParentWindow().Reload()
+}
+
+// updateActivity adds or updates an item of the loaded activity, the pages
+// loaded with "see more" are kept. Items that belong to a page that isn't
+// loaded yet are left out.
+func (pg *OverviewPage) updateActivity(item *activity.Item) {
+	pg.activityMu.Lock()
+	defer pg.activityMu.Unlock()
+
+	next, err := activity.ParseCursor(pg.activityNext)
+	if err != nil || (next != nil && next.After(item)) {
+		return
+	}
+
+	// The loaded items are replaced rather than changed in place since the
+	// layout may be ranging over them.
+	items := make([]*activity.Item, 0, len(pg.activityItems)+1)
+	for _, loaded := range pg.activityItems {
+		if loaded.ID != item.ID {
+			items = append(items, loaded)
+		}
+	}
+	items = append(items, item)
+	pg.activityItems = activity.NewPage(items, nil, len(items)).Items
+}
+
+// listenForActivity updates the activity when an item is added or updated.
+// The listener is removed in stopNtfnListeners().
+func (pg *OverviewPage) listenForActivity() {
+	activityListener := &activity.FeedListener{
+		OnActivity: func(item *activity.Item) {
+			go func() {
+				pg.updateActivity(item)
+				if item.Kind == activity.Ticket {
+					pg.loadStakes()
+				}
+				pg.ParentWindow().Reload()
+			}()
+		},
+	}
+	if err := pg.AssetsManager.AddActivityListener(activityListener, OverviewPageID); err != nil {
+		log.Errorf("Error adding activity listener: %v", err)
+	}
 }
 
 func (pg *OverviewPage) loadStakes() {
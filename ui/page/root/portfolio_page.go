@@ -0,0 +1,595 @@
+package root
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/portfolio"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const PortfolioPageID = "portfolio"
+
+// portfolioFrequencies are the check frequencies of the portfolio monitor,
+// in the order of the frequency dropdown.
+var portfolioFrequencies = []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}
+
+// PortfolioPage lets the user set the target allocation of their assets,
+// shows how far the assets drifted from it and proposes the swaps that
+// rebalance them.
+type PortfolioPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	pageContainer *widget.List
+	backButton    cryptomaterial.IconButton
+
+	assetTypes        []libutils.AssetType
+	targetEditors     map[libutils.AssetType]*cryptomaterial.Editor
+	thresholdEditor   cryptomaterial.Editor
+	routeDropdown     *cryptomaterial.DropDown
+	frequencyDropdown *cryptomaterial.DropDown
+	autoExecute       *widget.Bool
+
+	saveBtn    cryptomaterial.Button
+	refreshBtn cryptomaterial.Button
+	monitorBtn cryptomaterial.Button
+
+	mtx         sync.RWMutex
+	proposal    *portfolio.Proposal
+	proposalErr error
+	executeBtns []cryptomaterial.Button
+	isLoading   bool
+
+	materialLoader material.LoaderStyle
+}
+
+func NewPortfolioPage(l *load.Load) *PortfolioPage {
+	th := l.Theme
+	pg := &PortfolioPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(PortfolioPageID),
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+		assetTypes:      l.AssetsManager.AllAssetTypes(),
+		targetEditors:   make(map[libutils.AssetType]*cryptomaterial.Editor),
+		thresholdEditor: th.Editor(new(widget.Editor), values.String(values.StrDriftThreshold)),
+		routeDropdown: th.NewCommonDropDown([]cryptomaterial.DropDownItem{
+			{Text: values.String(values.StrInstantSwap)},
+			{Text: values.String(values.StrDEX)},
+		}, nil, values.MarginPadding180, values.PortfolioRouteDropdownGroup, false),
+		frequencyDropdown: th.NewCommonDropDown([]cryptomaterial.DropDownItem{
+			{Text: values.String(values.StrHourly)},
+			{Text: values.String(values.StrDaily)},
+			{Text: values.String(values.StrWeekly)},
+		}, nil, values.MarginPadding180, values.PortfolioFrequencyDropdownGroup, false),
+		autoExecute:    new(widget.Bool),
+		saveBtn:        th.Button(values.String(values.StrSave)),
+		refreshBtn:     th.OutlineButton(values.String(values.StrRefresh)),
+		monitorBtn:     th.OutlineButton(values.String(values.StrStartMonitoring)),
+		materialLoader: material.Loader(th.Base),
+	}
+
+	for _, assetType := range pg.assetTypes {
+		editor := th.Editor(new(widget.Editor), values.StringF(values.StrTargetWeightFmt, assetType))
+		editor.Editor.SingleLine = true
+		pg.targetEditors[assetType] = &editor
+	}
+	pg.thresholdEditor.Editor.SingleLine = true
+
+	pg.backButton = components.GetBackButton(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *PortfolioPage) OnNavigatedTo() {
+	pg.ctx, pg.ctxCancel = context.WithCancel(context.Background())
+	pg.loadSettings()
+	pg.refreshProposal()
+
+	rebalanceListener := &portfolio.RebalanceNotificationListener{
+		OnRebalanceNeeded: func(proposal *portfolio.Proposal) {
+			pg.setProposal(proposal, nil)
+		},
+		OnSwapExecuted: func(_ *portfolio.Swap, orderID string, err error) {
+			if err != nil {
+				pg.Toast.NotifyError(err.Error())
+				return
+			}
+			pg.Toast.Notify(values.StringF(values.StrSwapOrderPlaced, orderID))
+		},
+	}
+	if err := pg.AssetsManager.Portfolio.AddNotificationListener(rebalanceListener, PortfolioPageID); err != nil {
+		log.Errorf("PortfolioPage.OnNavigatedTo error: %v", err)
+	}
+}
+
+// loadSettings fills the settings form with the saved settings.
+func (pg *PortfolioPage) loadSettings() {
+	settings, err := pg.AssetsManager.Portfolio.Settings()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for assetType, editor := range pg.targetEditors {
+		editor.Editor.SetText("")
+		if weight, ok := settings.Targets[assetType]; ok {
+			editor.Editor.SetText(strconv.FormatFloat(weight, 'f', -1, 64))
+		}
+	}
+	pg.thresholdEditor.Editor.SetText(strconv.FormatFloat(settings.DriftThreshold, 'f', -1, 64))
+
+	if settings.Route == portfolio.DEXRoute {
+		pg.routeDropdown.SetSelectedValue(values.String(values.StrDEX))
+	}
+	for i, frequency := range portfolioFrequencies {
+		if frequency == settings.CheckFrequency {
+			pg.frequencyDropdown.SetSelectedValue(pg.frequencyDropdown.Items()[i].Text)
+		}
+	}
+	pg.autoExecute.Value = settings.AutoExecute
+}
+
+// refreshProposal computes the allocation and the proposed swaps in the
+// background.
+func (pg *PortfolioPage) refreshProposal() {
+	pg.mtx.Lock()
+	if pg.isLoading {
+		pg.mtx.Unlock()
+		return
+	}
+	pg.isLoading = true
+	pg.mtx.Unlock()
+
+	go func() {
+		proposal, err := pg.AssetsManager.PortfolioProposal(pg.ctx)
+		pg.setProposal(proposal, err)
+	}()
+}
+
+func (pg *PortfolioPage) setProposal(proposal *portfolio.Proposal, err error) {
+	pg.mtx.Lock()
+	pg.proposal, pg.proposalErr, pg.isLoading = proposal, err, false
+	pg.executeBtns = nil
+	if proposal != nil {
+		for range proposal.Swaps {
+			btn := pg.Theme.Button(values.String(values.StrExecute))
+			btn.TextSize = values.TextSize14
+			btn.Inset = layout.UniformInset(values.MarginPadding8)
+			pg.executeBtns = append(pg.executeBtns, btn)
+		}
+	}
+	pg.mtx.Unlock()
+	pg.ParentWindow().Reload()
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *PortfolioPage) HandleUserInteractions(gtx C) {
+	if pg.saveBtn.Clicked(gtx) {
+		pg.saveSettings()
+	}
+
+	if pg.refreshBtn.Clicked(gtx) {
+		pg.refreshProposal()
+	}
+
+	if pg.monitorBtn.Clicked(gtx) {
+		pg.toggleMonitor()
+	}
+
+	pg.mtx.RLock()
+	proposal, executeBtns := pg.proposal, pg.executeBtns
+	pg.mtx.RUnlock()
+	for i := range executeBtns {
+		if executeBtns[i].Clicked(gtx) {
+			pg.executeSwap(proposal.Swaps[i])
+		}
+	}
+}
+
+// saveSettings validates and saves the settings form and refreshes the
+// proposal for the new targets.
+func (pg *PortfolioPage) saveSettings() {
+	settings, err := pg.AssetsManager.Portfolio.Settings()
+	if err != nil {
+		pg.Toast.NotifyError(err.Error())
+		return
+	}
+
+	var total float64
+	settings.Targets = make(map[libutils.AssetType]float64)
+	for assetType, editor := range pg.targetEditors {
+		editor.SetError("")
+		text := strings.TrimSpace(editor.Editor.Text())
+		if text == "" {
+			continue
+		}
+		weight, err := strconv.ParseFloat(text, 64)
+		if err != nil || weight < 0 || weight > 100 {
+			editor.SetError(values.String(values.StrInvalidAmount))
+			return
+		}
+		settings.Targets[assetType] = weight
+		total += weight
+	}
+	if total < 99.99 || total > 100.01 {
+		pg.Toast.NotifyError(values.String(values.StrInvalidTargetWeights))
+		return
+	}
+
+	pg.thresholdEditor.SetError("")
+	threshold, err := strconv.ParseFloat(pg.thresholdEditor.Editor.Text(), 64)
+	if err != nil || threshold <= 0 || threshold >= 100 {
+		pg.thresholdEditor.SetError(values.String(values.StrInvalidAmount))
+		return
+	}
+	settings.DriftThreshold = threshold
+
+	settings.Route = portfolio.InstantSwapRoute
+	if pg.routeDropdown.SelectedIndex() == 1 {
+		settings.Route = portfolio.DEXRoute
+	}
+	settings.CheckFrequency = portfolioFrequencies[pg.frequencyDropdown.SelectedIndex()]
+	settings.AutoExecute = pg.autoExecute.Value
+
+	if err := pg.AssetsManager.Portfolio.SaveSettings(settings); err != nil {
+		pg.Toast.NotifyError(err.Error())
+		return
+	}
+
+	pg.Toast.Notify(values.String(values.StrPortfolioSaved))
+	pg.refreshProposal()
+}
+
+// withSpendingPassword requests the spending password and calls fn with it.
+// fn returns an error to show on the password modal.
+func (pg *PortfolioPage) withSpendingPassword(fn func(password string) error) {
+	pg.withPassword(values.String(values.StrSpendingPassword), "", fn)
+}
+
+// withPassword requests a password with the provided title and calls fn with
+// it. fn returns an error to show on the password modal.
+func (pg *PortfolioPage) withPassword(title, description string, fn func(password string) error) {
+	passwordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(title).
+		PasswordHint(title).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := fn(password); err != nil {
+				pm.SetError(values.TranslateErr(err.Error()))
+				return false
+			}
+			return true
+		})
+	if description != "" {
+		passwordModal.SetDescription(description)
+	}
+	passwordModal.SetPasswordTitleVisibility(false)
+	pg.ParentWindow().ShowModal(passwordModal)
+}
+
+// executeSwap executes the proposed swap. Instant swaps need the spending
+// password of the wallet the swap is funded from, DEX orders the DEX
+// password.
+func (pg *PortfolioPage) executeSwap(swap *portfolio.Swap) {
+	execute := func(password string) error {
+		orderID, err := pg.AssetsManager.ExecuteRebalanceSwap(pg.ctx, swap, password)
+		if err != nil {
+			return err
+		}
+		pg.Toast.Notify(values.StringF(values.StrSwapOrderPlaced, orderID))
+		pg.refreshProposal()
+		return nil
+	}
+
+	if swap.Route == portfolio.DEXRoute {
+		pg.withPassword(values.String(values.StrDexPassword), "", execute)
+		return
+	}
+
+	var description string
+	if wal := pg.AssetsManager.WalletWithID(swap.SourceWalletID); wal != nil {
+		description = values.StringF(values.StrSwapFundedFrom, wal.GetWalletName())
+	}
+	pg.withPassword(values.String(values.StrSpendingPassword), description, execute)
+}
+
+// toggleMonitor starts or stops the scheduled drift checks. The spending
+// password is requested to start the monitor if the proposed swaps are
+// executed automatically.
+func (pg *PortfolioPage) toggleMonitor() {
+	if pg.AssetsManager.Portfolio.IsRunning() {
+		pg.AssetsManager.StopPortfolioMonitor()
+		return
+	}
+
+	settings, err := pg.AssetsManager.Portfolio.Settings()
+	if err != nil {
+		pg.Toast.NotifyError(err.Error())
+		return
+	}
+
+	if !settings.AutoExecute || settings.Route == portfolio.DEXRoute {
+		if err := pg.AssetsManager.StartPortfolioMonitor(""); err != nil {
+			pg.Toast.NotifyError(err.Error())
+		}
+		return
+	}
+	pg.withSpendingPassword(pg.AssetsManager.StartPortfolioMonitor)
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *PortfolioPage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrPortfolio),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				sections := []layout.Widget{
+					pg.allocationSection,
+					pg.proposalSection,
+					pg.settingsSection,
+				}
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *PortfolioPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *PortfolioPage) detailRow(gtx C, title, value string) D {
+	titleLbl := pg.Theme.Body2(title)
+	titleLbl.Color = pg.Theme.Color.GrayText2
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return components.EndToEndRow(gtx, titleLbl.Layout, pg.Theme.Body2(value).Layout)
+	})
+}
+
+func (pg *PortfolioPage) allocationSection(gtx C) D {
+	pg.mtx.RLock()
+	proposal, proposalErr, isLoading := pg.proposal, pg.proposalErr, pg.isLoading
+	pg.mtx.RUnlock()
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				return pg.sectionTitle(gtx, values.String(values.StrCurrentAllocation))
+			}, func(gtx C) D {
+				if isLoading {
+					return pg.materialLoader.Layout(gtx)
+				}
+				return pg.refreshBtn.Layout(gtx)
+			})
+		}),
+	}
+
+	switch {
+	case proposalErr != nil:
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(proposalErr.Error())
+			lbl.Color = pg.Theme.Color.Danger
+			return lbl.Layout(gtx)
+		}))
+	case proposal != nil:
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrTotal), pg.FormatFiat(proposal.TotalUSDValue))
+		}))
+		for _, allocation := range proposal.Allocations {
+			allocation := allocation
+			children = append(children, layout.Rigid(func(gtx C) D {
+				value := values.StringF(values.StrAllocationRowFmt, allocation.Weight, allocation.TargetWeight, allocation.Drift)
+				return pg.detailRow(gtx, allocation.Asset.String(), value)
+			}))
+		}
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *PortfolioPage) proposalSection(gtx C) D {
+	pg.mtx.RLock()
+	proposal, executeBtns := pg.proposal, pg.executeBtns
+	pg.mtx.RUnlock()
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrProposedSwaps))
+		}),
+	}
+
+	if proposal == nil {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		msg := values.String(values.StrPortfolioBalanced)
+		color := pg.Theme.Color.GrayText2
+		if proposal.NeedsRebalance {
+			msg = values.StringF(values.StrRebalanceNeededFmt, proposal.MaxDrift)
+			color = pg.Theme.Color.Danger
+		}
+		lbl := pg.Theme.Body2(msg)
+		lbl.Color = color
+		return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+	}))
+
+	for i, swap := range proposal.Swaps {
+		i, swap := i, swap
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return pg.swapLayout(gtx, swap, &executeBtns[i])
+			})
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *PortfolioPage) swapLayout(gtx C, swap *portfolio.Swap, executeBtn *cryptomaterial.Button) D {
+	title := fmt.Sprintf("%.8f %s → %s (%s)", swap.Amount, swap.From, swap.To, pg.FormatFiat(swap.USDValue))
+	rows := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(title)
+			lbl.Font.Weight = font.SemiBold
+			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+		}),
+	}
+
+	if swap.EstimateErr != nil {
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Caption(swap.EstimateErr.Error())
+			lbl.Color = pg.Theme.Color.Danger
+			return lbl.Layout(gtx)
+		}))
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+	}
+
+	rows = append(rows,
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrServer), swap.Server)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrEstimatedReceive), fmt.Sprintf("%.8f %s", swap.ReceiveAmount, swap.To))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrFee), pg.FormatFiat(swap.EstimatedFee))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.detailRow(gtx, values.String(values.StrEstimatedSlippage), fmt.Sprintf("%.2f%%", swap.EstimatedSlippage))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.E.Layout(gtx, executeBtn.Layout)
+		}),
+	)
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+func (pg *PortfolioPage) settingsSection(gtx C) D {
+	if pg.AssetsManager.Portfolio.IsRunning() {
+		pg.monitorBtn.Text = values.String(values.StrStopMonitoring)
+	} else {
+		pg.monitorBtn.Text = values.String(values.StrStartMonitoring)
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrTargetAllocation))
+		}),
+	}
+	for _, assetType := range pg.assetTypes {
+		editor := pg.targetEditors[assetType]
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, editor.Layout)
+		}))
+	}
+
+	children = append(children,
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, pg.thresholdEditor.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(values.String(values.StrRebalanceWith)).Layout, pg.routeDropdown.Layout)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(values.String(values.StrCheckDrift)).Layout, pg.frequencyDropdown.Layout)
+			})
+		}),
+		layout.Rigid(pg.Theme.CheckBox(pg.autoExecute, values.String(values.StrAutoExecuteSwaps)).Layout),
+		layout.Rigid(func(gtx C) D {
+			settings, err := pg.AssetsManager.Portfolio.Settings()
+			if err != nil || settings.LastCheckAt == 0 {
+				return D{}
+			}
+			lbl := pg.Theme.Caption(values.StringF(values.StrLastCheckFmt, utils.FormatDateOrTime(settings.LastCheckAt)))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return layout.E.Layout(gtx, func(gtx C) D {
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+						layout.Rigid(func(gtx C) D {
+							return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, pg.monitorBtn.Layout)
+						}),
+						layout.Rigid(pg.saveBtn.Layout),
+					)
+				})
+			})
+		}),
+	)
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *PortfolioPage) OnNavigatedFrom() {
+	pg.ctxCancel()
+	pg.AssetsManager.Portfolio.RemoveNotificationListener(PortfolioPageID)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/modal"
 	"github.com/crypto-power/cryptopower/ui/page/components"
-	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -171,7 +170,7 @@ func (pg *WalletSelectorPage) badWalletsWrapper(gtx C, badWalletsList []*badWall
 		return layout.Inset{Bottom: m10}.Layout(gtx, func(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Rigid(pg.Theme.Body2(badWallet.Name).Layout),
 						layout.Flexed(1, func(gtx C) D {
 							return layout.E.Layout(gtx, badWallet.deleteBtn.Layout)
@@ -257,9 +256,9 @@ func (pg *WalletSelectorPage) walletWrapper(gtx C, item *walletWithBalance) D {
 }
 
 func (pg *WalletSelectorPage) layoutNameAndBalance(gtx C, item *walletWithBalance) D {
-	return layout.Flex{
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 		Alignment: layout.Middle,
-	}.Layout(gtx,
+	},
 		layout.Rigid(func(gtx C) D {
 			gtx.Constraints.Max.X = gtx.Constraints.Max.X / 2
 			txt := pg.Theme.Label(values.TextSize18, item.wallet.GetWalletName())
@@ -305,7 +304,7 @@ func (pg *WalletSelectorPage) layoutUSDBalance(gtx C, item *walletWithBalance) D
 
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X // full-width, so we can align the usd balance text to the right
 	return layout.E.Layout(gtx, func(gtx C) D {
-		usdBalance := utils.FormatAsUSDString(pg.Printer, item.totalBalance.MulF64(pg.assetRate[item.wallet.GetAssetType()]).ToCoin())
+		usdBalance := pg.FormatFiat(item.totalBalance.MulF64(pg.assetRate[item.wallet.GetAssetType()]).ToCoin())
 		return components.LayoutBalanceWithStateUSD(gtx, pg.Load, usdBalance)
 	})
 }
@@ -339,7 +338,7 @@ func (pg *WalletSelectorPage) layoutSyncStatus(gtx C, item *walletWithBalance) D
 		)
 	}
 
-	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, widgets...)
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}, widgets...)
 }
 
 // start sync listener
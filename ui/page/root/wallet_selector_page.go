@@ -14,7 +14,6 @@ import (
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/page/wallet"
-	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -350,10 +349,10 @@ func (pg *WalletSelectorPage) dropdownTitleLayout(gtx C, asset libutils.AssetTyp
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 					Axis:      layout.Horizontal,
 					Alignment: layout.Middle,
-				}.Layout(gtx,
+				},
 					layout.Rigid(func(gtx C) D {
 						return layout.Flex{
 							Axis:      layout.Vertical,
@@ -372,7 +371,7 @@ func (pg *WalletSelectorPage) dropdownTitleLayout(gtx C, asset libutils.AssetTyp
 							layout.Rigid(func(gtx C) D {
 								usdBalance := ""
 								if pg.AssetsManager.ExchangeRateFetchingEnabled() {
-									usdBalance = utils.FormatAsUSDString(pg.Printer, pg.assetsTotalUSDBalance[asset])
+									usdBalance = pg.FormatFiat(pg.assetsTotalUSDBalance[asset])
 								}
 								return components.LayoutBalanceWithStateUSD(gtx, pg.Load, usdBalance)
 							}),
@@ -172,10 +172,10 @@ func (pg *SignMessagePage) editors(editor *cryptomaterial.Editor) layout.Widget
 
 func (pg *SignMessagePage) drawButtonsRow() layout.Widget {
 	return func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
 							inset := layout.Inset{
 								Right: values.MarginPadding5,
@@ -209,7 +209,7 @@ func (pg *SignMessagePage) drawResult() layout.Widget {
 						return border.Layout(gtx, func(gtx C) D {
 							return wrapper.Layout(gtx, func(gtx C) D {
 								return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 										layout.Flexed(0.9, pg.signedMessageLabel.Layout),
 										layout.Flexed(0.1, func(gtx C) D {
 											return layout.E.Layout(gtx, func(gtx C) D {
@@ -92,7 +92,7 @@ func (pg *ValidateAddressPage) Layout(gtx C) D {
 			Body: func(gtx C) D {
 				return pg.Theme.List(pg.pageContainer).Layout(gtx, 1, func(gtx C, _ int) D {
 					return layout.Inset{Top: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 							layout.Rigid(pg.addressSection()),
 						)
 					})
@@ -137,11 +137,11 @@ func (pg *ValidateAddressPage) description() layout.Widget {
 
 func (pg *ValidateAddressPage) actionButtons() layout.Widget {
 	return func(gtx C) D {
-		dims := layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		dims := cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
 					return layout.Inset{Top: values.MarginPadding15}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.clearBtn.Layout)
 							}),
@@ -148,10 +148,10 @@ func (pg *VerifyMessagePage) description() layout.Widget {
 
 func (pg *VerifyMessagePage) verifyAndClearButtons() layout.Widget {
 	return func(gtx C) D {
-		dims := layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		dims := cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.clearBtn.Layout)
 						}),
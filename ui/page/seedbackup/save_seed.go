@@ -303,7 +303,7 @@ func (pg *SaveSeedPage) hexLayout(gtx C) D {
 			macro := op.Record(cgtx.Ops)
 			copyLayout := pg.copyButtonLayout(cgtx)
 			call := macro.Stop()
-			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 				layout.Rigid(func(gtx C) D {
 					gtx.Constraints.Max.X = gtx.Constraints.Max.X - copyLayout.Size.X
 					card.Radius = cryptomaterial.CornerRadius{TopRight: 0, TopLeft: 8, BottomRight: 0, BottomLeft: 8}
@@ -398,7 +398,7 @@ func seedItem(theme *cryptomaterial.Theme, width, index int, word string) layout
 
 func (pg *SaveSeedPage) layoutVoteChoice() layout.Widget {
 	return func(gtx C) D {
-		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 			layout.Rigid(func(gtx C) D {
 				lbl := pg.Theme.Label(values.TextSizeTransform(pg.IsMobileView(), values.TextSize16), values.String(values.StrCopySeed))
 				lbl.Font.Weight = font.SemiBold
@@ -406,7 +406,7 @@ func (pg *SaveSeedPage) layoutVoteChoice() layout.Widget {
 			}),
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, pg.layoutItems()...)
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal}, pg.layoutItems()...)
 				})
 			}),
 		)
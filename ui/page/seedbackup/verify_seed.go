@@ -73,6 +73,7 @@ func NewVerifySeedPage(l *load.Load, wallet sharedW.Asset, seed string, wordSeed
 
 	pg.backButton = components.GetBackButton(l)
 	pg.backButton.Icon = l.Theme.Icons.ContentClear
+	pg.toggleSeedInput.Description = values.String(values.StrPasteSeedWords)
 
 	pg.seedInputEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrEnterWalletSeed))
 	pg.seedInputEditor.Editor.SingleLine = false
@@ -265,7 +266,7 @@ func (pg *VerifySeedPage) Layout(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{Right: values.MarginPadding10}.Layout(gtx, pg.toggleSeedInput.Layout)
 							}),
@@ -148,7 +148,7 @@ func (pg *Page) sendLayout(gtx C) D {
 }
 
 func (pg *Page) titleLayout(gtx C) D {
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{Right: values.MarginPadding6}.Layout(gtx, func(gtx C) D {
 				lbl := pg.Theme.Label(values.TextSizeTransform(pg.IsMobileView(), values.TextSize20), values.String(values.StrSend))
@@ -301,7 +301,7 @@ func (pg *Page) coinSelectionSection(gtx C) D {
 					Clickable:   pg.toCoinSelection,
 				}.Layout2(gtx, func(gtx C) D {
 					gtx.Constraints.Min.X = gtx.Constraints.Max.X
-					return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 						layout.Rigid(textLabel.Layout),
 						layout.Rigid(pg.Theme.NewIcon(pg.Theme.Icons.ChevronRight).Layout20dp),
 					)
@@ -360,7 +360,7 @@ func (pg *Page) balanceSection(gtx C) D {
 					gtx.Constraints.Min.X = gtx.Constraints.Max.X
 					return pg.nextButton.Layout(gtx)
 				}))
-				return layout.Flex{}.Layout(gtx, flexChilds...)
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{}, flexChilds...)
 			}),
 		)
 	})
@@ -406,7 +406,7 @@ func (pg *Page) contentWrapper(gtx C, title string, zeroBottomPadding bool, cont
 
 func (pg *Page) contentRow(gtx C, leftValue, rightValue string) D {
 	textSize := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
 			lbl := pg.Theme.Label(textSize, leftValue)
 			lbl.Color = pg.Theme.Color.GrayText2
@@ -414,7 +414,7 @@ func (pg *Page) contentRow(gtx C, leftValue, rightValue string) D {
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						lbl := pg.Theme.Label(textSize, rightValue)
 						lbl.Color = pg.Theme.Color.Text
@@ -463,7 +463,7 @@ func (pg *ManualCoinSelectionPage) summarySection(gtx C) D {
 							axis = layout.Vertical
 						}
 						gtx.Constraints.Min.X = gtx.Constraints.Max.X
-						return layout.Flex{Axis: axis, Spacing: layout.SpaceBetween}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis, Spacing: layout.SpaceBetween},
 							layout.Rigid(func(gtx C) D {
 								return pg.sumaryContent(gtx, values.String(values.StrSelectedUTXO)+": ", pg.selectedUTXOs)
 							}),
@@ -485,7 +485,7 @@ func (pg *ManualCoinSelectionPage) summarySection(gtx C) D {
 func (pg *ManualCoinSelectionPage) sumaryContent(gtx C, text string, valueLable cryptomaterial.Label) D {
 	textSize14 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize14)
 	valueLable.TextSize = textSize14
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(pg.Theme.Label(textSize14, text).Layout),
 		layout.Rigid(valueLable.Layout),
 	)
@@ -501,7 +501,7 @@ func (pg *ManualCoinSelectionPage) accountListSection(gtx C) D {
 				layout.Rigid(func(gtx C) D {
 					textLabel := pg.Theme.Label(textSize16, values.String(values.StrAccountList))
 					textLabel.Font.Weight = font.SemiBold
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(textLabel.Layout),
 						layout.Flexed(1, func(gtx C) D {
 							return layout.E.Layout(gtx, pg.clearButton.Layout)
@@ -662,7 +662,7 @@ func (pg *ManualCoinSelectionPage) rowItemsSection(gtx C, components ...interfac
 		c := pg.properties[index]
 		gtx.Constraints.Min.X = int(max * c.weight)
 		return c.direction.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Alignment: layout.End}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.End},
 				layout.Rigid(getRowItem(index)),
 			)
 		})
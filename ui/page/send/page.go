@@ -10,6 +10,7 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -62,7 +63,10 @@ type Page struct {
 
 	isFetchingExchangeRate bool
 
+	// exchangeRate is the price of the selected wallet's asset in the
+	// fiatCurrency.
 	exchangeRate   float64
+	fiatCurrency   string
 	usdExchangeSet bool
 	confirmTxModal *sendConfirmModal
 
@@ -82,6 +86,7 @@ type getPageFields func() pageFields
 
 type pageFields struct {
 	exchangeRate           float64
+	fiatCurrency           string
 	usdExchangeSet         bool
 	isFetchingExchangeRate bool
 }
@@ -164,7 +169,7 @@ func (pg *Page) addRecipient() {
 	if pg.accountDropdown != nil && pg.accountDropdown.SelectedAccount() != nil {
 		rc.initializeAccountSelectors(pg.accountDropdown.SelectedAccount())
 	}
-	rc.amount.setExchangeRate(pg.exchangeRate)
+	rc.amount.setExchangeRate(pg.exchangeRate, pg.fiatCurrency)
 	pg.recipients = append(pg.recipients, rc)
 	pg.currentIDRecipient++
 }
@@ -183,6 +188,7 @@ func (pg *Page) removeRecipient(id int) {
 func (pg *Page) pageFields() pageFields {
 	return pageFields{
 		exchangeRate:           pg.exchangeRate,
+		fiatCurrency:           pg.fiatCurrency,
 		usdExchangeSet:         pg.usdExchangeSet,
 		isFetchingExchangeRate: pg.isFetchingExchangeRate,
 	}
@@ -325,7 +331,7 @@ func (pg *Page) fetchExchangeRate() {
 		return
 	}
 
-	pg.exchangeRate = rate.LastTradePrice
+	pg.exchangeRate, pg.fiatCurrency = pg.AssetsManager.ToFiat(rate.LastTradePrice)
 	pg.updateRecipientExchangeRate()
 	pg.validateAndConstructTx() // convert estimates to usd
 
@@ -395,13 +401,13 @@ func (pg *Page) constructTx() {
 
 	if pg.exchangeRate != -1 && pg.usdExchangeSet {
 		pg.feeRateSelector.USDExchangeSet = true
-		pg.txFeeUSD = fmt.Sprintf("$%.4f", utils.CryptoToUSD(pg.exchangeRate, feeAndSize.Fee.CoinValue))
+		pg.txFeeUSD = fmt.Sprintf("%s%.4f", ext.FiatSymbol(pg.fiatCurrency), utils.CryptoToUSD(pg.exchangeRate, feeAndSize.Fee.CoinValue))
 		pg.feeRateSelector.TxFeeUSD = pg.txFeeUSD
-		pg.totalCostUSD = utils.FormatAsUSDString(pg.Printer, utils.CryptoToUSD(pg.exchangeRate, totalCost.ToCoin()))
-		pg.balanceAfterSendUSD = utils.FormatAsUSDString(pg.Printer, utils.CryptoToUSD(pg.exchangeRate, balanceAfterSend.ToCoin()))
+		pg.totalCostUSD = utils.FormatAsFiatString(pg.Printer, pg.fiatCurrency, utils.CryptoToUSD(pg.exchangeRate, totalCost.ToCoin()))
+		pg.balanceAfterSendUSD = utils.FormatAsFiatString(pg.Printer, pg.fiatCurrency, utils.CryptoToUSD(pg.exchangeRate, balanceAfterSend.ToCoin()))
 
 		usdAmount := utils.CryptoToUSD(pg.exchangeRate, wal.ToAmount(totalAmount).ToCoin())
-		pg.sendAmountUSD = utils.FormatAsUSDString(pg.Printer, usdAmount)
+		pg.sendAmountUSD = utils.FormatAsFiatString(pg.Printer, pg.fiatCurrency, usdAmount)
 	}
 }
 
@@ -473,7 +479,7 @@ func (pg *Page) cleanAllRecipientErrors() {
 func (pg *Page) updateRecipientExchangeRate() {
 	for i := range pg.recipients {
 		recipient := pg.recipients[i]
-		recipient.amount.setExchangeRate(pg.exchangeRate)
+		recipient.amount.setExchangeRate(pg.exchangeRate, pg.fiatCurrency)
 	}
 }
 
@@ -556,7 +562,7 @@ func (pg *Page) showBalanceAfterSend() {
 		}
 		balanceAfterSend := sourceAccount.Balance.Spendable
 		pg.balanceAfterSend = balanceAfterSend.String()
-		pg.balanceAfterSendUSD = utils.FormatAsUSDString(pg.Printer, utils.CryptoToUSD(pg.exchangeRate, balanceAfterSend.ToCoin()))
+		pg.balanceAfterSendUSD = utils.FormatAsFiatString(pg.Printer, pg.fiatCurrency, utils.CryptoToUSD(pg.exchangeRate, balanceAfterSend.ToCoin()))
 	}
 }
 
@@ -251,7 +251,7 @@ func (rp *recipient) topLayout(gtx C, index int) D {
 	titleTxt := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize16), txt)
 	titleTxt.Color = rp.Theme.Color.GrayText2
 
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(titleTxt.Layout),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
@@ -346,10 +346,10 @@ func (rp *recipient) addressAndAmountlayout(gtx C) D {
 					layout.Rigid(rp.amount.usdAmountEditor.Layout),
 				}
 			}
-			return layout.Flex{
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 				Axis:      axis,
 				Alignment: align,
-			}.Layout(gtx, flexChilds...)
+			}, flexChilds...)
 		}
 
 	}
@@ -82,8 +82,11 @@ func (sa *sendAmount) styleWidgets() {
 	sa.usdAmountEditor.EditorStyle.Color = sa.theme.Color.Text
 }
 
-func (sa *sendAmount) setExchangeRate(exchangeRate float64) {
+func (sa *sendAmount) setExchangeRate(exchangeRate float64, fiatCurrency string) {
 	sa.exchangeRate = exchangeRate
+	if fiatCurrency != "" {
+		sa.usdAmountEditor.Hint = fmt.Sprintf("%s (%s)", values.String(values.StrAmount), fiatCurrency)
+	}
 	sa.validateAmount() // convert dcr input to usd
 }
 
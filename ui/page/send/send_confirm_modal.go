@@ -117,7 +117,7 @@ func (scm *sendConfirmModal) Handle(gtx C) {
 		scm.broadcastTransaction()
 	}
 
-	if scm.closeConfirmationModalButton.Clicked(gtx) {
+	if scm.closeConfirmationModalButton.Clicked(gtx) || scm.Modal.Escaped(gtx) {
 		if !scm.isSending {
 			scm.Dismiss()
 		}
@@ -183,7 +183,7 @@ func (scm *sendConfirmModal) Layout(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
 						sendWallet := scm.AssetsManager.WalletWithID(scm.sourceAccount.WalletID)
-						return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 							layout.Rigid(func(gtx C) D {
 								txt := scm.Theme.Body2(values.String(values.StrFrom))
 								txt.Color = scm.Theme.Color.GrayText2
@@ -219,7 +219,7 @@ func (scm *sendConfirmModal) Layout(gtx C) D {
 						})
 					}),
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 							layout.Rigid(func(gtx C) D {
 								txt := scm.Theme.Body2(values.String(values.StrTo))
 								txt.Color = scm.Theme.Color.GrayText2
@@ -256,7 +256,7 @@ func (scm *sendConfirmModal) Layout(gtx C) D {
 		func(gtx C) D {
 			return layout.Inset{Left: dp16, Right: dp16, Bottom: dp16}.Layout(gtx, func(gtx C) D {
 				return layout.E.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{
 								Right: values.MarginPadding8,
@@ -306,7 +306,7 @@ func (scm *sendConfirmModal) toDestinationLayout(gtx C) D {
 
 func (scm *sendConfirmModal) toDestinationAccountLayout(acccount *sharedW.Account, gtx C) D {
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 			layout.Rigid(scm.setWalletLogo),
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{}.Layout(gtx, func(gtx C) D {
@@ -348,7 +348,7 @@ func (scm *sendConfirmModal) toDestinationAddressLayout(address string, gtx C) D
 }
 
 func (scm *sendConfirmModal) contentRow(gtx C, leftValue, rightValue, walletName string) D {
-	return layout.Flex{}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 		layout.Rigid(func(gtx C) D {
 			txt := scm.Theme.Body2(leftValue)
 			txt.Color = scm.Theme.Color.GrayText2
@@ -356,7 +356,7 @@ func (scm *sendConfirmModal) contentRow(gtx C, leftValue, rightValue, walletName
 		}),
 		layout.Flexed(1, func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(scm.Theme.Body1(rightValue).Layout),
 					layout.Rigid(func(gtx C) D {
 						if walletName != "" {
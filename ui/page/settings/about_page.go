@@ -95,10 +95,10 @@ func (pg *AboutPage) layoutMobile(_ layout.Context) layout.Dimensions {
 }
 
 func (pg *AboutPage) pageHeaderLayout(gtx layout.Context) layout.Dimensions {
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Flexed(1, func(gtx C) D {
 			return layout.W.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{
 							Right: values.MarginPadding16,
@@ -152,7 +152,7 @@ func (pg *AboutPage) layoutRows(gtx C) D {
 		func(gtx C) D {
 			licenseRowLayout := func(gtx C) D {
 				return pg.licenseRow.Layout(gtx, func(gtx C) D {
-					return layout.Flex{}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 						layout.Rigid(func(gtx C) D {
 							return in.Layout(gtx, pg.license.Layout)
 						}),
@@ -16,6 +16,7 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/logger"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -54,9 +55,11 @@ type AppSettingsPage struct {
 	network                 *cryptomaterial.Clickable
 	language                *cryptomaterial.Clickable
 	currency                *cryptomaterial.Clickable
+	fiatCurrency            *cryptomaterial.Clickable
 	help                    *cryptomaterial.Clickable
 	about                   *cryptomaterial.Clickable
 	appearanceMode          *cryptomaterial.Clickable
+	theme                   *cryptomaterial.Clickable
 	startupPassword         *cryptomaterial.Switch
 	transactionNotification *cryptomaterial.Switch
 	backButton              cryptomaterial.IconButton
@@ -101,9 +104,11 @@ func NewAppSettingsPage(l *load.Load) *AppSettingsPage {
 		network:           l.Theme.NewClickable(false),
 		language:          l.Theme.NewClickable(false),
 		currency:          l.Theme.NewClickable(false),
+		fiatCurrency:      l.Theme.NewClickable(false),
 		help:              l.Theme.NewClickable(false),
 		about:             l.Theme.NewClickable(false),
 		appearanceMode:    l.Theme.NewClickable(false),
+		theme:             l.Theme.NewClickable(false),
 		logLevel:          l.Theme.NewClickable(false),
 		viewLog:           l.Theme.NewClickable(false),
 		deleteDEX:         l.Theme.NewClickable(false),
@@ -172,7 +177,7 @@ func (pg *AppSettingsPage) pageHeaderLayout(gtx C) layout.Dimensions {
 			gtx.Constraints.Min.X = gtx.Constraints.Max.X
 		}
 		gtx.Constraints.Max.X = gtx.Constraints.Min.X
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(func(gtx C) D {
 				return layout.Inset{
 					Top:   values.MarginPadding2,
@@ -225,10 +230,10 @@ func (pg *AppSettingsPage) wrapSection(gtx C, title string, body layout.Widget)
 			layout.Rigid(func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 							layout.Rigid(func(gtx C) D {
 								return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Rigid(func(gtx C) D {
 											txt := pg.Theme.Label(values.TextSizeTransform(pg.Load.IsMobileView(), values.TextSize20), title)
 											txt.Color = pg.Theme.Color.DeepBlue
@@ -259,14 +264,17 @@ func (pg *AppSettingsPage) wrapSection(gtx C, title string, body layout.Widget)
 								case values.String(values.StrGeneral):
 									return layout.E.Layout(gtx, func(gtx C) D {
 										appearanceIcon := pg.Theme.Icons.DarkMode
+										pg.appearanceMode.Description = values.String(values.StrDarkMode)
 										if pg.isDarkModeOn {
 											appearanceIcon = pg.Theme.Icons.LightMode
+											pg.appearanceMode.Description = values.String(values.StrLightMode)
 										}
 										return pg.appearanceMode.Layout(gtx, func(gtx C) D {
 											return appearanceIcon.LayoutTransform(gtx, pg.Load.IsMobileView(), values.MarginPadding20)
 										})
 									})
 								case values.String(values.StrPrivacySettings):
+									pg.privacyActive.Description = title
 									return layout.E.Layout(gtx, pg.privacyActive.Layout)
 								default:
 									return D{}
@@ -304,6 +312,14 @@ func (pg *AppSettingsPage) general() layout.Widget {
 					}
 					return pg.clickableRow(gtx, languageRow)
 				}),
+				layout.Rigid(func(gtx C) D {
+					themeRow := row{
+						title:     values.String(values.StrTheme),
+						clickable: pg.theme,
+						label:     pg.Theme.Body2(values.String(pg.ThemePalette().Title)),
+					}
+					return pg.clickableRow(gtx, themeRow)
+				}),
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrTxNotification), pg.transactionNotification)
 				}),
@@ -329,6 +345,14 @@ func (pg *AppSettingsPage) networkSettings() layout.Widget {
 					}
 					return pg.clickableRow(gtx, exchangeRate)
 				}),
+				layout.Rigid(func(gtx C) D {
+					fiatCurrency := row{
+						title:     values.String(values.StrFiatCurrency),
+						clickable: pg.fiatCurrency,
+						label:     pg.Theme.Body2(pg.AssetsManager.GetFiatCurrency()),
+					}
+					return pg.clickableRow(gtx, fiatCurrency)
+				}),
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrGovernanceAPI), pg.governanceAPI)
 				}),
@@ -453,7 +477,7 @@ func (pg *AppSettingsPage) debug() layout.Widget {
 
 func (pg *AppSettingsPage) subSection(gtx C, title string, body layout.Widget) D {
 	return layout.Inset{Top: values.MarginPadding5, Bottom: values.MarginPadding15}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.subSectionLabel(title)),
 			layout.Flexed(1, func(gtx C) D {
 				return layout.E.Layout(gtx, body)
@@ -463,6 +487,7 @@ func (pg *AppSettingsPage) subSection(gtx C, title string, body layout.Widget) D
 }
 
 func (pg *AppSettingsPage) subSectionSwitch(gtx C, title string, option *cryptomaterial.Switch) D {
+	option.Description = title
 	return pg.subSection(gtx, title, option.Layout)
 }
 
@@ -470,7 +495,7 @@ func (pg *AppSettingsPage) clickableRow(gtx C, row row) D {
 	return row.clickable.Layout(gtx, func(gtx C) D {
 		return layout.Inset{Top: values.MarginPadding5, Bottom: values.MarginPaddingMinus5}.Layout(gtx, func(gtx C) D {
 			return pg.subSection(gtx, row.title, func(gtx C) D {
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(row.label.Layout),
 					layout.Rigid(func(gtx C) D {
 						return pg.Theme.NewIcon(pg.Theme.Icons.ChevronRight).LayoutTransform(gtx, pg.Load.IsMobileView(), values.MarginPadding20)
@@ -507,7 +532,7 @@ func (pg *AppSettingsPage) HandleUserInteractions(gtx C) {
 
 	if pg.language.Clicked(gtx) {
 		langSelectorModal := preference.NewListPreference(pg.Load,
-			sharedW.LanguagePreferenceKey, values.DefaultLanguage, preference.LangOptions).
+			sharedW.LanguagePreferenceKey, values.DefaultLanguage, preference.LangOptions()).
 			Title(values.StrLanguage).
 			UpdateValues(func(_ string) {
 				values.SetUserLanguage(pg.AssetsManager.GetLanguagePreference())
@@ -528,12 +553,37 @@ func (pg *AppSettingsPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(currencySelectorModal)
 	}
 
+	if pg.fiatCurrency.Clicked(gtx) {
+		fiatSelectorModal := preference.NewListPreference(pg.Load,
+			sharedW.FiatCurrencyConfigKey, ext.DefaultFiatCurrency,
+			preference.FiatOptions).
+			Title(values.StrFiatCurrency).
+			UpdateValues(func(_ string) {})
+		pg.ParentWindow().ShowModal(fiatSelectorModal)
+	}
+
 	if pg.appearanceMode.Clicked(gtx) {
 		pg.isDarkModeOn = !pg.isDarkModeOn
 		pg.AssetsManager.SetDarkMode(pg.isDarkModeOn)
+		palette := values.LightPalette
+		if pg.isDarkModeOn {
+			palette = values.DarkPalette
+		}
+		pg.AssetsManager.SetTheme(palette)
 		pg.RefreshTheme(pg.ParentWindow())
 	}
 
+	if pg.theme.Clicked(gtx) {
+		themeSelectorModal := preference.NewListPreference(pg.Load,
+			sharedW.ThemeConfigKey, values.ThemePalette("", pg.isDarkModeOn).Name,
+			preference.ThemeOptions()).
+			Title(values.StrTheme).
+			UpdateValues(func(_ string) {
+				pg.isDarkModeOn = pg.AssetsManager.IsDarkModeOn()
+			})
+		pg.ParentWindow().ShowModal(themeSelectorModal)
+	}
+
 	if pg.transactionNotification.Changed(gtx) {
 		pg.AssetsManager.SetTransactionsNotifications(pg.transactionNotification.IsChecked())
 	}
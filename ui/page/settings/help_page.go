@@ -133,7 +133,7 @@ func (pg *HelpPage) layoutDesktop(gtx layout.Context) layout.Dimensions {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(pg.pageHeaderLayout),
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					pg.pageContentLayout(),
 				)
 			}),
@@ -152,7 +152,7 @@ func (pg *HelpPage) layoutMobile(gtx layout.Context) layout.Dimensions {
 				pg.ParentNavigator().CloseCurrentPage()
 			},
 			Body: func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					pg.pageContentLayout(),
 				)
 			},
@@ -163,10 +163,10 @@ func (pg *HelpPage) layoutMobile(gtx layout.Context) layout.Dimensions {
 }
 
 func (pg *HelpPage) pageHeaderLayout(gtx layout.Context) layout.Dimensions {
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Flexed(1, func(gtx C) D {
 			return layout.W.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{
 							Right: values.MarginPadding16,
@@ -189,7 +189,7 @@ func (pg *HelpPage) pageContentLayout() layout.FlexChild {
 			if pg.IsMobileView() {
 				axis = layout.Vertical
 			}
-			return layout.Flex{Axis: axis, Alignment: layout.Middle}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis, Alignment: layout.Middle},
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 					return cryptomaterial.UniformPadding(gtx, func(gtx C) D {
 						return pg.pageContainer.Layout(gtx, len(pg.helpPageCard), func(gtx C, i int) D {
@@ -259,7 +259,7 @@ func (pg *HelpPage) HandleUserInteractions(gtx C) {
 							return border.Layout(gtx, func(gtx C) D {
 								return wrapper.Layout(gtx, func(gtx C) D {
 									return layout.UniformInset(values.MarginPadding10).Layout(gtx, func(gtx C) D {
-										return layout.Flex{}.Layout(gtx,
+										return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 											layout.Flexed(0.9, pg.Theme.Body1(decredURL).Layout),
 											layout.Flexed(0.1, func(gtx C) D {
 												return layout.E.Layout(gtx, func(gtx C) D {
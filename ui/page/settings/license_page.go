@@ -103,10 +103,10 @@ func (pg *LicensePage) layoutMobile(gtx layout.Context) layout.Dimensions {
 }
 
 func (pg *LicensePage) pageHeaderLayout(gtx layout.Context) layout.Dimensions {
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Flexed(1, func(gtx C) D {
 			return layout.W.Layout(gtx, func(gtx C) D {
-				return layout.Flex{}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{
 							Right: values.MarginPadding16,
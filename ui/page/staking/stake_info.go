@@ -14,9 +14,11 @@ import (
 
 func (pg *Page) initStakePriceWidget() *Page {
 	pg.stakeSettings = pg.Theme.NewClickable(false)
+	pg.stakeSettings.Description = values.String(values.StrSettings)
 	_, pg.infoButton = components.SubpageHeaderButtons(pg.Load)
 
 	pg.stake = pg.Theme.Switch()
+	pg.stake.Description = values.String(values.StrStake)
 	return pg
 }
 
@@ -27,13 +29,13 @@ func (pg *Page) pageHead(gtx C) D {
 	return layout.Inset{
 		Bottom: values.MarginPaddingTransform(pg.IsMobileView(), values.MarginPadding24),
 	}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 			layout.Rigid(txt.Layout),
 			layout.Rigid(func(gtx C) D {
 				if pg.dcrWallet.IsWatchingOnlyWallet() {
 					return D{}
 				}
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(func(gtx C) D {
 						title := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize16), values.String(values.StrStake))
 						title.Color = pg.Theme.Color.GrayText2
@@ -76,7 +78,7 @@ func (pg *Page) stakePriceSection(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
 							return components.VerticalInset(values.MarginPadding6).Layout(gtx, func(gtx C) D {
-								return layout.Flex{Axis: flexAxis, Alignment: alignment}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: flexAxis, Alignment: alignment},
 									layout.Rigid(func(gtx C) D {
 										title := pg.Theme.Label(textSize16, values.String(values.StrTicketPrice)+" ")
 										title.Color = grayText
@@ -118,7 +120,7 @@ func (pg *Page) stakePriceSection(gtx C) D {
 					)
 				}
 
-				return layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceBetween},
 					layout.Rigid(leftWg),
 					layout.Rigid(rightWg),
 				)
@@ -131,7 +133,7 @@ func (pg *Page) stakePriceSection(gtx C) D {
 func (pg *Page) dataRows(gtx C, title1, value1 string, axis layout.Axis, alignment layout.Alignment) D {
 	textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
 	return components.VerticalInset(values.MarginPadding6).Layout(gtx, func(gtx C) D {
-		return layout.Flex{Axis: axis, Alignment: alignment}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: axis, Alignment: alignment},
 			layout.Rigid(func(gtx C) D {
 				label := pg.Theme.Label(textSize16, title1)
 				label.Color = pg.Theme.Color.GrayText2
@@ -192,7 +194,7 @@ func (pg *Page) balanceProgressBarLayout(gtx C) D {
 	}
 
 	labelWdg := func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(func(gtx C) D {
 				text := values.String(values.StrStaked) + ": " + totalBalance.LockedByTickets.String()
 				return components.LayoutIconAndTextWithSize(pg.Load, gtx, text, items[0].Color, textSize16, values.MarginPadding10)
@@ -55,7 +55,7 @@ func (pg *Page) stakeStatisticsSection(gtx C) D {
 						pg.dataStatisticsCol(immatureItem, expiredItem, nil, isMobile),
 					}
 				}
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx, flexChilds...) // layout.Rigid(func(gtx C) D {
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}, flexChilds...) // layout.Rigid(func(gtx C) D {
 			}),
 		)
 	})
@@ -85,7 +85,7 @@ func (pg *Page) dataStatisticsCol(item1, item2, item3 *statisticsItem, isMobile
 }
 
 func (pg *Page) dataStatisticsItem(gtx C, item *statisticsItem) D {
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{
 				Right: values.MarginPadding10,
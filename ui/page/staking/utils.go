@@ -157,7 +157,7 @@ func TicketStatusDetails(gtx C, l *load.Load, dcrWallet *dcr.Asset, tx *transact
 		maturityDuration := time.Duration(maturity*int32(blockTime)) * time.Minute
 		blockRemaining := (bestBlock.Height - tx.transaction.BlockHeight)
 
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 			layout.Rigid(func(gtx C) D {
 				lbl := l.Theme.Label(textSize16, values.StringF(values.StrImmatureInfo, blockRemaining, maturity,
 					maturityDuration.String()))
@@ -197,7 +197,7 @@ func TicketStatusDetails(gtx C, l *load.Load, dcrWallet *dcr.Asset, tx *transact
 func multiContent(gtx C, l *load.Load, leftText, rightText string) D {
 	textSize16 := values.TextSizeTransform(l.IsMobileView(), values.TextSize16)
 	col := l.Theme.Color.GrayText3
-	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 		layout.Rigid(func(gtx C) D {
 			lbl := l.Theme.Label(textSize16, leftText)
 			lbl.Color = col
@@ -227,7 +227,7 @@ func ticketListLayout(gtx C, l *load.Load, wallet *dcr.Asset, ticket *transactio
 	}.Layout(gtx, func(gtx C) D {
 		return components.EndToEndRow(gtx,
 			func(gtx C) D {
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(func(gtx C) D {
 						wrapIcon := l.Theme.Card()
 						wrapIcon.Color = ticket.status.Background
@@ -152,12 +152,11 @@ func (sp *startPage) OnNavigatedTo() {
 }
 
 func (sp *startPage) initPage() {
-	sp.languageDropdown = sp.Theme.NewCommonDropDown([]cryptomaterial.DropDownItem{
-		{Text: titler.String(values.StrEnglish)},
-		{Text: titler.String(values.StrFrench)},
-		{Text: titler.String(values.StrSpanish)},
-		{Text: titler.String(values.StrChinese)},
-	}, nil, values.MarginPadding120, values.StartPageDropdownGroup, false)
+	langItems := make([]cryptomaterial.DropDownItem, 0)
+	for _, opt := range preference.LangOptions() {
+		langItems = append(langItems, cryptomaterial.DropDownItem{Text: titler.String(opt.Value)})
+	}
+	sp.languageDropdown = sp.Theme.NewCommonDropDown(langItems, nil, values.MarginPadding120, values.StartPageDropdownGroup, false)
 
 	sp.onBoardingScreens = []onBoardingScreen{
 		{
@@ -474,7 +473,7 @@ func (sp *startPage) introScreenButtons(gtx C) D {
 	if sp.introductionSlider.IsLastSlide() {
 		return sp.nextButton.Layout(gtx)
 	}
-	return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 
 			return sp.skipButton.Layout(gtx)
@@ -525,7 +524,7 @@ func (sp *startPage) onBoardingScreensLayout(gtx C) D {
 									return D{}
 								}
 								gtx.Constraints.Min.X = gtx.Constraints.Max.X
-								return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceAround}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceAround},
 									layout.Rigid(func(gtx C) D {
 										titleLabel := sp.Theme.Label(values.TextSize16, values.String(values.StrChooseSetupType))
 										titleLabel.Font.Weight = font.Bold
@@ -546,7 +545,7 @@ func (sp *startPage) onBoardingScreensLayout(gtx C) D {
 					})
 				}),
 				layout.Expanded(func(gtx C) D {
-					return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceStart, Alignment: layout.Middle}.Layout(gtx,
+					return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceStart, Alignment: layout.Middle},
 						layout.Rigid(func(gtx C) D {
 							langTitle := sp.Theme.Label(values.TextSize16, values.String(values.StrLanguage))
 							langTitle.Font.Weight = font.Bold
@@ -582,6 +581,7 @@ func (sp *startPage) settingsOptionsLayout(gtx C) D {
 				content := sp.Theme.Label(values.TextSize16, item.message)
 				content.Alignment = text.Alignment(layout.Middle)
 				item.infoButton.Size = values.MarginPaddingTransform(sp.IsMobileView(), values.MarginPadding20)
+				item.infoButton.Description = values.String(values.StrInfo)
 
 				borderWidth := values.MarginPadding2
 				borderColor := sp.Theme.Color.Primary
@@ -646,7 +646,7 @@ func (sp *startPage) pageHeaderLayout(gtx C, headerText string, hideHeaderText b
 		Padding:     layout.UniformInset(values.MarginPadding12),
 	}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Left: values.MarginPadding10, Right: values.MarginPadding10}.Layout(gtx, sp.backButton.Layout)
 				}),
@@ -708,8 +708,8 @@ func (sp *startPage) setLanguagePref(useExistingUserPreference bool) {
 
 func (sp *startPage) selectedLanguageKey() string {
 	selectedLang := sp.languageDropdown.Selected()
-	for _, opt := range preference.LangOptions {
-		if strings.ToLower(selectedLang) == opt.Value {
+	for _, opt := range preference.LangOptions() {
+		if selectedLang == titler.String(opt.Value) {
 			return opt.Key
 		}
 	}
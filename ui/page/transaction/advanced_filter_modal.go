@@ -0,0 +1,353 @@
+package transaction
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/values"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+const advancedFilterModalID = "advanced_filter_modal"
+
+// ticketStatusTitles are the titles of the sharedW.TicketStatuses.
+var ticketStatusTitles = map[sharedW.TicketStatus]string{
+	sharedW.TicketUnmined:  values.StrUmined,
+	sharedW.TicketImmature: values.StrImmature,
+	sharedW.TicketLive:     values.StrLive,
+	sharedW.TicketVoted:    values.StrVoted,
+	sharedW.TicketRevoked:  values.StrRevoked,
+	sharedW.TicketExpired:  values.StrExpired,
+}
+
+// advancedFilterModal edits the conditions of the query used to filter the
+// transactions of the wallets shown on the transactions page.
+type advancedFilterModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	// onApply is called with the new query, or nil if the filter is cleared.
+	onApply  func(*sharedW.TxQuery)
+	accounts []int32
+
+	minAmountEditor cryptomaterial.Editor
+	maxAmountEditor cryptomaterial.Editor
+	fromEditor      cryptomaterial.Editor
+	toEditor        cryptomaterial.Editor
+	addressEditor   cryptomaterial.Editor
+	labelEditor     cryptomaterial.Editor
+
+	accountDropdown      *cryptomaterial.DropDown
+	confirmationDropdown *cryptomaterial.DropDown
+	ticketStatusDropdown *cryptomaterial.DropDown
+	showTicketStatus     bool
+
+	clearBtn  cryptomaterial.Button
+	cancelBtn cryptomaterial.Button
+	applyBtn  cryptomaterial.Button
+}
+
+func newAdvancedFilterModal(l *load.Load, wallets []sharedW.Asset, query *sharedW.TxQuery, onApply func(*sharedW.TxQuery)) *advancedFilterModal {
+	fm := &advancedFilterModal{
+		Load:      l,
+		Modal:     l.Theme.ModalFloatTitle(advancedFilterModalID, l.IsMobileView(), nil),
+		onApply:   onApply,
+		clearBtn:  l.Theme.OutlineButton(values.String(values.StrClear)),
+		cancelBtn: l.Theme.OutlineButton(values.String(values.StrCancel)),
+		applyBtn:  l.Theme.Button(values.String(values.StrApply)),
+	}
+	fm.clearBtn.Font.Weight = font.Medium
+	fm.cancelBtn.Font.Weight = font.Medium
+	fm.applyBtn.Font.Weight = font.Medium
+
+	newEditor := func(hint string) cryptomaterial.Editor {
+		editor := l.Theme.Editor(new(widget.Editor), hint)
+		editor.Editor.SingleLine = true
+		return editor
+	}
+	fm.minAmountEditor = newEditor(values.String(values.StrMinAmount))
+	fm.maxAmountEditor = newEditor(values.String(values.StrMaxAmount))
+	fm.fromEditor = newEditor(values.String(values.StrFromDate))
+	fm.toEditor = newEditor(values.String(values.StrToDate))
+	fm.addressEditor = newEditor(values.String(values.StrAddress))
+	fm.labelEditor = newEditor(values.String(values.StrLabelContains))
+
+	// Accounts can only be selected when the transactions of a single
+	// wallet are shown.
+	fm.accounts = []int32{-1}
+	accountItems := []cryptomaterial.DropDownItem{{Text: values.String(values.StrAllAccounts)}}
+	if len(wallets) == 1 {
+		if accounts, err := wallets[0].GetAccountsRaw(); err == nil {
+			for _, account := range accounts.Accounts {
+				fm.accounts = append(fm.accounts, account.Number)
+				accountItems = append(accountItems, cryptomaterial.DropDownItem{Text: account.Name})
+			}
+		} else {
+			log.Errorf("Error loading accounts: %v", err)
+		}
+	}
+
+	// The items are in the order of the sharedW.ConfirmationState values.
+	confirmationItems := []cryptomaterial.DropDownItem{
+		{Text: values.String(values.StrAny)},
+		{Text: values.String(values.StrConfirmed)},
+		{Text: values.String(values.StrUnconfirmed)},
+	}
+
+	ticketStatusItems := []cryptomaterial.DropDownItem{{Text: values.String(values.StrAny)}}
+	for _, status := range sharedW.TicketStatuses {
+		ticketStatusItems = append(ticketStatusItems, cryptomaterial.DropDownItem{Text: values.String(ticketStatusTitles[status])})
+	}
+	for _, wallet := range wallets {
+		if wallet.GetAssetType() == utils.DCRWalletAsset {
+			fm.showTicketStatus = true
+		}
+	}
+
+	var accountIndex, ticketStatusIndex int
+	if query != nil {
+		fm.setAmount(&fm.minAmountEditor, query.MinAmount)
+		fm.setAmount(&fm.maxAmountEditor, query.MaxAmount)
+		fm.setDate(&fm.fromEditor, query.From)
+		fm.setDate(&fm.toEditor, query.To)
+		fm.addressEditor.Editor.SetText(query.Address)
+		fm.labelEditor.Editor.SetText(query.Label)
+		for i, account := range fm.accounts {
+			if len(query.Accounts) == 1 && query.Accounts[0] == account {
+				accountIndex = i
+			}
+		}
+		for i, status := range sharedW.TicketStatuses {
+			if query.TicketStatus == status {
+				ticketStatusIndex = i + 1
+			}
+		}
+	}
+
+	fm.accountDropdown = l.Theme.NewCommonDropDown(accountItems, nil, cryptomaterial.MatchParent, values.TxQueryAccountDropdownGroup, false)
+	fm.accountDropdown.SetSelectedValue(accountItems[accountIndex].Text)
+	fm.confirmationDropdown = l.Theme.NewCommonDropDown(confirmationItems, nil, cryptomaterial.MatchParent, values.TxQueryConfirmationDropdownGroup, false)
+	if query != nil {
+		fm.confirmationDropdown.SetSelectedValue(confirmationItems[query.Confirmation].Text)
+	}
+	fm.ticketStatusDropdown = l.Theme.NewCommonDropDown(ticketStatusItems, nil, cryptomaterial.MatchParent, values.TxQueryTicketStatusDropdownGroup, false)
+	fm.ticketStatusDropdown.SetSelectedValue(ticketStatusItems[ticketStatusIndex].Text)
+
+	return fm
+}
+
+func (fm *advancedFilterModal) OnResume() {}
+
+func (fm *advancedFilterModal) OnDismiss() {}
+
+// setAmount shows the amount in atoms in coins, zero amounts are not shown.
+func (fm *advancedFilterModal) setAmount(editor *cryptomaterial.Editor, amount int64) {
+	if amount > 0 {
+		editor.Editor.SetText(strconv.FormatFloat(dcrutil.Amount(amount).ToCoin(), 'f', -1, 64))
+	}
+}
+
+// setDate shows the date of the timestamp, zero timestamps are not shown.
+func (fm *advancedFilterModal) setDate(editor *cryptomaterial.Editor, timestamp int64) {
+	if timestamp > 0 {
+		editor.Editor.SetText(time.Unix(timestamp, 0).Format(exportDateFormat))
+	}
+}
+
+// parseAmount parses the editor's amount in coins and returns it in atoms.
+// All the supported assets have the same number of atoms per coin. The
+// second return value is false if the editor has an invalid amount, an empty
+// editor returns zero.
+func (fm *advancedFilterModal) parseAmount(editor *cryptomaterial.Editor) (int64, bool) {
+	editor.SetError("")
+	text := strings.TrimSpace(editor.Editor.Text())
+	if text == "" {
+		return 0, true
+	}
+	coins, err := strconv.ParseFloat(text, 64)
+	if err != nil || coins < 0 {
+		editor.SetError(values.String(values.StrInvalidAmount))
+		return 0, false
+	}
+	amount, err := dcrutil.NewAmount(coins)
+	if err != nil {
+		editor.SetError(values.String(values.StrInvalidAmount))
+		return 0, false
+	}
+	return int64(amount), true
+}
+
+// parseDate parses the editor's date. The second return value is false if the
+// editor has an invalid date, an empty editor returns a zero time.
+func (fm *advancedFilterModal) parseDate(editor *cryptomaterial.Editor) (time.Time, bool) {
+	editor.SetError("")
+	text := strings.TrimSpace(editor.Editor.Text())
+	if text == "" {
+		return time.Time{}, true
+	}
+	date, err := time.ParseInLocation(exportDateFormat, text, time.Local)
+	if err != nil {
+		editor.SetError(values.String(values.StrInvalidDate))
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+func (fm *advancedFilterModal) Handle(gtx C) {
+	if fm.cancelBtn.Clicked(gtx) || fm.Modal.Escaped(gtx) {
+		fm.Dismiss()
+	}
+
+	if fm.clearBtn.Clicked(gtx) {
+		fm.onApply(nil)
+		fm.Dismiss()
+	}
+
+	if fm.applyBtn.Clicked(gtx) {
+		if query, ok := fm.query(); ok {
+			fm.onApply(query)
+			fm.Dismiss()
+		}
+	}
+}
+
+// query returns the query of the entered conditions. The second return value
+// is false if a condition is invalid.
+func (fm *advancedFilterModal) query() (*sharedW.TxQuery, bool) {
+	minAmount, minOk := fm.parseAmount(&fm.minAmountEditor)
+	maxAmount, maxOk := fm.parseAmount(&fm.maxAmountEditor)
+	from, fromOk := fm.parseDate(&fm.fromEditor)
+	to, toOk := fm.parseDate(&fm.toEditor)
+	if !minOk || !maxOk || !fromOk || !toOk {
+		return nil, false
+	}
+	if maxAmount > 0 && maxAmount < minAmount {
+		fm.maxAmountEditor.SetError(values.String(values.StrInvalidAmount))
+		return nil, false
+	}
+	if !to.IsZero() {
+		// Include the transactions of the whole end date.
+		to = to.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		fm.toEditor.SetError(values.String(values.StrInvalidDateRange))
+		return nil, false
+	}
+
+	query := &sharedW.TxQuery{
+		MinAmount:    minAmount,
+		MaxAmount:    maxAmount,
+		Address:      strings.TrimSpace(fm.addressEditor.Editor.Text()),
+		Label:        strings.TrimSpace(fm.labelEditor.Editor.Text()),
+		Confirmation: sharedW.ConfirmationState(fm.confirmationDropdown.SelectedIndex()),
+	}
+	if !from.IsZero() {
+		query.From = from.Unix()
+	}
+	if !to.IsZero() {
+		query.To = to.Unix()
+	}
+	if account := fm.accounts[fm.accountDropdown.SelectedIndex()]; account != -1 {
+		query.Accounts = []int32{account}
+	}
+	if index := fm.ticketStatusDropdown.SelectedIndex(); fm.showTicketStatus && index > 0 {
+		query.TicketStatus = sharedW.TicketStatuses[index-1]
+	}
+	return query, true
+}
+
+func (fm *advancedFilterModal) formRow(gtx C, w layout.Widget) D {
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, w)
+}
+
+// dropdownRow draws the dropdown with its title above it.
+func (fm *advancedFilterModal) dropdownRow(gtx C, title string, dropdown *cryptomaterial.DropDown) D {
+	return fm.formRow(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(fm.Theme.Label(values.TextSize14, title).Layout),
+			layout.Rigid(dropdown.Layout),
+		)
+	})
+}
+
+// editorPair draws the editors side by side.
+func (fm *advancedFilterModal) editorPair(gtx C, left, right *cryptomaterial.Editor) D {
+	return fm.formRow(gtx, func(gtx C) D {
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
+			layout.Flexed(0.5, func(gtx C) D {
+				return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, left.Layout)
+			}),
+			layout.Flexed(0.5, func(gtx C) D {
+				return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, right.Layout)
+			}),
+		)
+	})
+}
+
+func (fm *advancedFilterModal) Layout(gtx C) D {
+	w := []layout.Widget{
+		func(gtx C) D {
+			txt := fm.Theme.Label(values.TextSize20, values.String(values.StrAdvancedFilter))
+			txt.Font.Weight = font.SemiBold
+			return txt.Layout(gtx)
+		},
+		func(gtx C) D {
+			body := fm.Theme.Body2(values.String(values.StrAdvancedFilterMsg))
+			body.Color = fm.Theme.Color.GrayText2
+			return fm.formRow(gtx, body.Layout)
+		},
+		func(gtx C) D {
+			return fm.editorPair(gtx, &fm.minAmountEditor, &fm.maxAmountEditor)
+		},
+		func(gtx C) D {
+			return fm.editorPair(gtx, &fm.fromEditor, &fm.toEditor)
+		},
+		func(gtx C) D {
+			return fm.formRow(gtx, fm.addressEditor.Layout)
+		},
+		func(gtx C) D {
+			return fm.formRow(gtx, fm.labelEditor.Layout)
+		},
+		func(gtx C) D {
+			if len(fm.accounts) == 1 {
+				return D{}
+			}
+			return fm.dropdownRow(gtx, values.String(values.StrAccount), fm.accountDropdown)
+		},
+		func(gtx C) D {
+			return fm.dropdownRow(gtx, values.String(values.StrConfirmationStatus), fm.confirmationDropdown)
+		},
+		func(gtx C) D {
+			if !fm.showTicketStatus {
+				return D{}
+			}
+			return fm.dropdownRow(gtx, values.String(values.StrTicketStatus), fm.ticketStatusDropdown)
+		},
+		func(gtx C) D {
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
+				layout.Rigid(fm.clearBtn.Layout),
+				layout.Flexed(1, func(gtx C) D {
+					return layout.E.Layout(gtx, func(gtx C) D {
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
+							layout.Rigid(func(gtx C) D {
+								return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, fm.cancelBtn.Layout)
+							}),
+							layout.Rigid(fm.applyBtn.Layout),
+						)
+					})
+				}),
+			)
+		},
+	}
+
+	return fm.Modal.Layout(gtx, w)
+}
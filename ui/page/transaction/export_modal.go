@@ -0,0 +1,231 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const (
+	txExportModalID  = "tx_export_modal"
+	exportDateFormat = "2006-01-02"
+)
+
+// txExportModal selects the file format, date range and account of the
+// transactions exported from the transactions page.
+type txExportModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	wallets  []sharedW.Asset
+	txFilter int32
+	accounts []int32
+
+	formatDropdown  *cryptomaterial.DropDown
+	accountDropdown *cryptomaterial.DropDown
+	fromEditor      cryptomaterial.Editor
+	toEditor        cryptomaterial.Editor
+	includeFiat     *widget.Bool
+
+	cancelBtn cryptomaterial.Button
+	exportBtn cryptomaterial.Button
+
+	materialLoader material.LoaderStyle
+	isExporting    bool
+}
+
+func newTxExportModal(l *load.Load, wallets []sharedW.Asset, txFilter int32) *txExportModal {
+	em := &txExportModal{
+		Load:           l,
+		Modal:          l.Theme.ModalFloatTitle(txExportModalID, l.IsMobileView(), nil),
+		wallets:        wallets,
+		txFilter:       txFilter,
+		accounts:       []int32{txexport.AllAccounts},
+		includeFiat:    &widget.Bool{Value: l.AssetsManager.ExchangeRateFetchingEnabled()},
+		cancelBtn:      l.Theme.OutlineButton(values.String(values.StrCancel)),
+		exportBtn:      l.Theme.Button(values.String(values.StrExport)),
+		materialLoader: material.Loader(l.Theme.Base),
+	}
+	em.cancelBtn.Font.Weight = font.Medium
+	em.exportBtn.Font.Weight = font.Medium
+
+	formats := make([]cryptomaterial.DropDownItem, 0, len(txexport.Formats))
+	for _, format := range txexport.Formats {
+		formats = append(formats, cryptomaterial.DropDownItem{Text: strings.ToUpper(string(format))})
+	}
+	em.formatDropdown = l.Theme.NewCommonDropDown(formats, nil, cryptomaterial.MatchParent, values.TxExportFormatDropdownGroup, false)
+
+	// Accounts can only be selected when a single wallet is exported.
+	accountItems := []cryptomaterial.DropDownItem{{Text: values.String(values.StrAllAccounts)}}
+	if len(wallets) == 1 {
+		if accounts, err := wallets[0].GetAccountsRaw(); err == nil {
+			for _, account := range accounts.Accounts {
+				em.accounts = append(em.accounts, account.Number)
+				accountItems = append(accountItems, cryptomaterial.DropDownItem{Text: account.Name})
+			}
+		} else {
+			log.Errorf("Error loading accounts: %v", err)
+		}
+	}
+	em.accountDropdown = l.Theme.NewCommonDropDown(accountItems, nil, cryptomaterial.MatchParent, values.TxExportAccountDropdownGroup, false)
+
+	em.fromEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrFromDate))
+	em.fromEditor.Editor.SingleLine = true
+	em.toEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrToDate))
+	em.toEditor.Editor.SingleLine = true
+
+	return em
+}
+
+func (em *txExportModal) OnResume() {}
+
+func (em *txExportModal) OnDismiss() {}
+
+// parseDate parses the editor's date. The second return value is false if the
+// editor has an invalid date, an empty editor returns a zero time.
+func (em *txExportModal) parseDate(editor *cryptomaterial.Editor) (time.Time, bool) {
+	editor.SetError("")
+	text := strings.TrimSpace(editor.Editor.Text())
+	if text == "" {
+		return time.Time{}, true
+	}
+	date, err := time.ParseInLocation(exportDateFormat, text, time.Local)
+	if err != nil {
+		editor.SetError(values.String(values.StrInvalidDate))
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+func (em *txExportModal) Handle(gtx C) {
+	if (em.cancelBtn.Clicked(gtx) || em.Modal.Escaped(gtx)) && !em.isExporting {
+		em.Dismiss()
+	}
+
+	if em.exportBtn.Clicked(gtx) && !em.isExporting {
+		em.export()
+	}
+}
+
+func (em *txExportModal) export() {
+	from, fromOk := em.parseDate(&em.fromEditor)
+	to, toOk := em.parseDate(&em.toEditor)
+	if !fromOk || !toOk {
+		return
+	}
+	if !to.IsZero() {
+		// Include the transactions of the whole end date.
+		to = to.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		em.toEditor.SetError(values.String(values.StrInvalidDateRange))
+		return
+	}
+
+	opts := &txexport.Options{
+		Format:   txexport.Formats[em.formatDropdown.SelectedIndex()],
+		From:     from,
+		To:       to,
+		TxFilter: em.txFilter,
+		Account:  em.accounts[em.accountDropdown.SelectedIndex()],
+	}
+	if em.includeFiat.Value {
+		opts.FiatCurrency = em.AssetsManager.GetFiatCurrency()
+	}
+
+	em.isExporting = true
+	go func() {
+		fileName := filepath.Join(em.AssetsManager.RootDir(), "exports", fmt.Sprintf("transaction_export_%d.%s", time.Now().Unix(), opts.Format))
+		err := em.AssetsManager.ExportTransactions(context.Background(), em.wallets, opts, fileName)
+		em.isExporting = false
+		em.Dismiss()
+		if err != nil {
+			errModal := modal.NewErrorModal(em.Load, fmt.Errorf("error exporting your wallet(s) transactions: %v", err).Error(), modal.DefaultClickFunc())
+			em.ParentWindow().ShowModal(errModal)
+			return
+		}
+
+		infoModal := modal.NewSuccessModal(em.Load, values.StringF(values.StrExportTransactionSuccessMsg, fileName), modal.DefaultClickFunc())
+		em.ParentWindow().ShowModal(infoModal)
+	}()
+}
+
+func (em *txExportModal) formRow(gtx C, w layout.Widget) D {
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, w)
+}
+
+func (em *txExportModal) Layout(gtx C) D {
+	w := []layout.Widget{
+		func(gtx C) D {
+			txt := em.Theme.Label(values.TextSize20, values.String(values.StrExportTransaction))
+			txt.Font.Weight = font.SemiBold
+			return txt.Layout(gtx)
+		},
+		func(gtx C) D {
+			body := em.Theme.Body2(values.String(values.StrExportTransactionsMsg) + " " + values.String(values.StrExportFilterNote))
+			body.Color = em.Theme.Color.GrayText2
+			return body.Layout(gtx)
+		},
+		func(gtx C) D {
+			return em.formRow(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(em.Theme.Label(values.TextSize14, values.String(values.StrFileFormat)).Layout),
+					layout.Rigid(em.formatDropdown.Layout),
+				)
+			})
+		},
+		func(gtx C) D {
+			if len(em.accounts) == 1 {
+				return D{}
+			}
+			return em.formRow(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(em.Theme.Label(values.TextSize14, values.String(values.StrAccount)).Layout),
+					layout.Rigid(em.accountDropdown.Layout),
+				)
+			})
+		},
+		func(gtx C) D {
+			return em.formRow(gtx, em.fromEditor.Layout)
+		},
+		func(gtx C) D {
+			return em.formRow(gtx, em.toEditor.Layout)
+		},
+		func(gtx C) D {
+			if !em.AssetsManager.ExchangeRateFetchingEnabled() {
+				return D{}
+			}
+			label := values.StringF(values.StrIncludeFiatValuesFmt, em.AssetsManager.GetFiatCurrency())
+			return em.formRow(gtx, em.Theme.CheckBox(em.includeFiat, label).Layout)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				if em.isExporting {
+					return em.materialLoader.Layout(gtx)
+				}
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, em.cancelBtn.Layout)
+					}),
+					layout.Rigid(em.exportBtn.Layout),
+				)
+			})
+		},
+	}
+
+	return em.Modal.Layout(gtx, w)
+}
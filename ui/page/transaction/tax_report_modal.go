@@ -0,0 +1,248 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/libwallet/taxlots"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const (
+	taxReportModalID = "tax_report_modal"
+	// taxReportYears is the number of years that can be selected, starting
+	// from the current year.
+	taxReportYears = 10
+)
+
+// taxReportModal shows the realised gains and income of all wallets for a
+// year and saves them to a CSV file.
+type taxReportModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	years          []int
+	yearDropdown   *cryptomaterial.DropDown
+	methodDropdown *cryptomaterial.DropDown
+
+	cancelBtn   cryptomaterial.Button
+	generateBtn cryptomaterial.Button
+	saveBtn     cryptomaterial.Button
+
+	materialLoader material.LoaderStyle
+	isLoading      bool
+	report         *taxlots.YearReport
+	unmatched      int
+}
+
+func newTaxReportModal(l *load.Load) *taxReportModal {
+	rm := &taxReportModal{
+		Load:           l,
+		Modal:          l.Theme.ModalFloatTitle(taxReportModalID, l.IsMobileView(), nil),
+		cancelBtn:      l.Theme.OutlineButton(values.String(values.StrCancel)),
+		generateBtn:    l.Theme.Button(values.String(values.StrGenerate)),
+		saveBtn:        l.Theme.Button(values.String(values.StrSaveCSV)),
+		materialLoader: material.Loader(l.Theme.Base),
+	}
+	rm.cancelBtn.Font.Weight = font.Medium
+	rm.generateBtn.Font.Weight = font.Medium
+	rm.saveBtn.Font.Weight = font.Medium
+
+	currentYear := time.Now().Year()
+	years := make([]cryptomaterial.DropDownItem, 0, taxReportYears)
+	for i := 0; i < taxReportYears; i++ {
+		rm.years = append(rm.years, currentYear-i)
+		years = append(years, cryptomaterial.DropDownItem{Text: strconv.Itoa(currentYear - i)})
+	}
+	rm.yearDropdown = l.Theme.NewCommonDropDown(years, nil, cryptomaterial.MatchParent, values.TaxReportYearDropdownGroup, false)
+	// Default to the last complete year.
+	rm.yearDropdown.SetSelectedValue(strconv.Itoa(currentYear - 1))
+
+	methods := make([]cryptomaterial.DropDownItem, 0, len(taxlots.Methods))
+	for _, method := range taxlots.Methods {
+		methods = append(methods, cryptomaterial.DropDownItem{Text: strings.ToUpper(string(method))})
+	}
+	rm.methodDropdown = l.Theme.NewCommonDropDown(methods, nil, cryptomaterial.MatchParent, values.TaxReportMethodDropdownGroup, false)
+
+	return rm
+}
+
+func (rm *taxReportModal) OnResume() {}
+
+func (rm *taxReportModal) OnDismiss() {}
+
+func (rm *taxReportModal) Handle(gtx C) {
+	if (rm.cancelBtn.Clicked(gtx) || rm.Modal.Escaped(gtx)) && !rm.isLoading {
+		rm.Dismiss()
+	}
+
+	// A new report must be generated when the selection changes.
+	if rm.yearDropdown.Changed(gtx) || rm.methodDropdown.Changed(gtx) {
+		rm.report = nil
+	}
+
+	if rm.generateBtn.Clicked(gtx) && !rm.isLoading {
+		rm.generate()
+	}
+
+	if rm.saveBtn.Clicked(gtx) && !rm.isLoading && rm.report != nil {
+		rm.save()
+	}
+}
+
+func (rm *taxReportModal) generate() {
+	year := rm.years[rm.yearDropdown.SelectedIndex()]
+	method := taxlots.Methods[rm.methodDropdown.SelectedIndex()]
+
+	rm.isLoading = true
+	go func() {
+		report, err := rm.AssetsManager.TaxReport(context.Background(), year, method)
+		rm.isLoading = false
+		if err != nil {
+			errModal := modal.NewErrorModal(rm.Load, fmt.Errorf("error generating tax report: %v", err).Error(), modal.DefaultClickFunc())
+			rm.ParentWindow().ShowModal(errModal)
+			return
+		}
+
+		rm.unmatched = 0
+		for _, gain := range report.Gains {
+			if gain.Unmatched {
+				rm.unmatched++
+			}
+		}
+		rm.report = report
+		rm.ParentWindow().Reload()
+	}()
+}
+
+func (rm *taxReportModal) save() {
+	report := rm.report
+	fileName := filepath.Join(rm.AssetsManager.RootDir(), "exports", fmt.Sprintf("tax_report_%d_%s_%d.csv", report.Year, report.Method, time.Now().Unix()))
+	if err := rm.AssetsManager.SaveTaxReport(report, fileName); err != nil {
+		errModal := modal.NewErrorModal(rm.Load, fmt.Errorf("error saving tax report: %v", err).Error(), modal.DefaultClickFunc())
+		rm.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	rm.Dismiss()
+	infoModal := modal.NewSuccessModal(rm.Load, values.StringF(values.StrTaxReportSaved, fileName), modal.DefaultClickFunc())
+	rm.ParentWindow().ShowModal(infoModal)
+}
+
+func (rm *taxReportModal) formRow(gtx C, label string, w layout.Widget) D {
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(rm.Theme.Label(values.TextSize14, label).Layout),
+			layout.Rigid(w),
+		)
+	})
+}
+
+func (rm *taxReportModal) summaryRow(gtx C, label, value string) D {
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween},
+			layout.Rigid(func(gtx C) D {
+				lbl := rm.Theme.Body1(label)
+				lbl.Color = rm.Theme.Color.GrayText2
+				return lbl.Layout(gtx)
+			}),
+			layout.Rigid(rm.Theme.Body1(value).Layout),
+		)
+	})
+}
+
+func (rm *taxReportModal) summary(gtx C) D {
+	report := rm.report
+	formatFiat := func(amount float64) string {
+		return fmt.Sprintf("%.2f %s", amount, report.Currency)
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return rm.summaryRow(gtx, values.String(values.StrShortTermGains), formatFiat(report.ShortTermGain))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return rm.summaryRow(gtx, values.String(values.StrLongTermGains), formatFiat(report.LongTermGain))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return rm.summaryRow(gtx, values.String(values.StrIncome), formatFiat(report.TotalIncome))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return rm.summaryRow(gtx, values.String(values.StrDisposals), strconv.Itoa(len(report.Gains)))
+		}),
+		layout.Rigid(func(gtx C) D {
+			if report.MissingPrices == 0 {
+				return D{}
+			}
+			lbl := rm.Theme.Body2(values.StringF(values.StrMissingPricesFmt, report.MissingPrices))
+			lbl.Color = rm.Theme.Color.Danger
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if rm.unmatched == 0 {
+				return D{}
+			}
+			lbl := rm.Theme.Body2(values.StringF(values.StrUnmatchedDisposalsFmt, rm.unmatched))
+			lbl.Color = rm.Theme.Color.Danger
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		}),
+	)
+}
+
+func (rm *taxReportModal) Layout(gtx C) D {
+	w := []layout.Widget{
+		func(gtx C) D {
+			txt := rm.Theme.Label(values.TextSize20, values.String(values.StrTaxReport))
+			txt.Font.Weight = font.SemiBold
+			return txt.Layout(gtx)
+		},
+		func(gtx C) D {
+			body := rm.Theme.Body2(values.StringF(values.StrTaxReportMsgFmt, rm.AssetsManager.GetFiatCurrency()))
+			body.Color = rm.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, body.Layout)
+		},
+		func(gtx C) D {
+			return rm.formRow(gtx, values.String(values.StrTaxYear), rm.yearDropdown.Layout)
+		},
+		func(gtx C) D {
+			return rm.formRow(gtx, values.String(values.StrCostBasisMethod), rm.methodDropdown.Layout)
+		},
+		func(gtx C) D {
+			if rm.report == nil {
+				return D{}
+			}
+			return rm.summary(gtx)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				if rm.isLoading {
+					return rm.materialLoader.Layout(gtx)
+				}
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, rm.cancelBtn.Layout)
+					}),
+					layout.Rigid(func(gtx C) D {
+						if rm.report == nil {
+							return rm.generateBtn.Layout(gtx)
+						}
+						return rm.saveBtn.Layout(gtx)
+					}),
+				)
+			})
+		},
+	}
+
+	return rm.Modal.Layout(gtx, w)
+}
@@ -379,7 +379,7 @@ func (pg *TxDetailsPage) txDetailsHeader(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
 							if pg.transaction.Type == dcr.TxTypeTicketPurchase {
-								return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+								return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle},
 									layout.Rigid(pg.Theme.Label(values.TextSize16, values.String(values.StrStatus)+": ").Layout),
 									layout.Rigid(pg.Theme.Label(values.TextSize16, pg.txnWidgets.txStatus.Title).Layout),
 									layout.Rigid(func(gtx C) D {
@@ -462,7 +462,7 @@ func (pg *TxDetailsPage) txDetailsHeader(gtx C) D {
 									return lbl.Layout(gtx)
 
 								case dcr.TicketStatusLive:
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Rigid(func(gtx C) D {
 											lbl := pg.Theme.Label(values.TextSize16, values.String(values.StrLifeSpan)+": ")
 											lbl.Color = col
@@ -483,7 +483,7 @@ func (pg *TxDetailsPage) txDetailsHeader(gtx C) D {
 									}
 
 									// voted or revoked
-									return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 										layout.Rigid(func(gtx C) D {
 											lbl := pg.Theme.Label(values.TextSize16, values.String(values.StrReward)+": ")
 											lbl.Color = col
@@ -558,7 +558,7 @@ func (pg *TxDetailsPage) getTimeToMatureOrExpire() int {
 
 func (pg *TxDetailsPage) keyValue(gtx C, key string, value layout.Widget) D {
 	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Flexed(.4, func(gtx C) D {
 				return layout.Inset{Right: values.MarginPadding35}.Layout(gtx, func(gtx C) D {
 					lbl := pg.Theme.Label(values.TextSize14, key)
@@ -711,7 +711,7 @@ func (pg *TxDetailsPage) txnTypeAndID(gtx C) D {
 		}),
 		layout.Rigid(func(gtx C) D {
 			stat := func(gtx C) D {
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(func(gtx C) D {
 						return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, pg.txnWidgets.confirmationIcons.Layout12dp)
 					}),
@@ -862,7 +862,7 @@ func (pg *TxDetailsPage) txnIORow(gtx C, amount int64, acctNum int32, address st
 				gtx.Constraints.Min.X = gtx.Constraints.Max.X
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						return layout.Flex{}.Layout(gtx,
+						return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 							layout.Rigid(pg.Theme.Label(values.TextSize14, amt).Layout),
 							layout.Rigid(func(gtx C) D {
 								m := values.MarginPadding5
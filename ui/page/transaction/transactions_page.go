@@ -1,15 +1,9 @@
 package transaction
 
 import (
-	"encoding/csv"
 	"fmt"
-	"math"
-	"os"
-	"path/filepath"
-	"runtime"
 	"sort"
 	"strings"
-	"time"
 
 	"gioui.org/font"
 	"gioui.org/layout"
@@ -19,11 +13,9 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
-	"github.com/crypto-power/cryptopower/libwallet/txhelper"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
-	"github.com/crypto-power/cryptopower/ui/modal"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
@@ -69,9 +61,16 @@ type TransactionsPage struct {
 	walletDropDown *cryptomaterial.DropDown
 	filterBtn      *cryptomaterial.Clickable
 	exportBtn      *cryptomaterial.Clickable
+	taxReportBtn   *cryptomaterial.Clickable
 	isFilterOpen   bool
 	searchEditor   cryptomaterial.Editor
 
+	// advancedFilterBtn opens the advancedFilterModal, which sets the
+	// advancedQuery used to fetch the transactions of the wallets if it is
+	// not nil.
+	advancedFilterBtn *cryptomaterial.Clickable
+	advancedQuery     *sharedW.TxQuery
+
 	transactionList *cryptomaterial.ClickableList
 	txFilter,
 	previousTxFilter int32
@@ -115,6 +114,8 @@ func NewTransactionsPage(l *load.Load, wallet sharedW.Asset) *TransactionsPage {
 	pg.scroll = components.NewScroll(l, pageSize, pg.fetchTransactions)
 	pg.filterBtn = l.Theme.NewClickable(false)
 	pg.exportBtn = l.Theme.NewClickable(false)
+	pg.taxReportBtn = l.Theme.NewClickable(false)
+	pg.advancedFilterBtn = l.Theme.NewClickable(false)
 	pg.transactionList.Radius = cryptomaterial.Radius(14)
 	pg.transactionList.IsShadowEnabled = true
 
@@ -249,7 +250,9 @@ func (pg *TransactionsPage) fetchTransactions(offset, pageSize int32) (txs []*mu
 	orderNewest := pg.orderDropDown.Selected() != values.String(values.StrOldest)
 
 	wal := pg.selectedWallet
-	if wal == nil {
+	if pg.advancedQuery != nil {
+		txs, totalTxs, err = pg.queryTransactions(offset, pageSize, orderNewest)
+	} else if wal == nil {
 		txs, totalTxs, err = pg.multiWalletTxns(offset, pageSize, orderNewest)
 	} else {
 		txs, totalTxs, err = pg.loadTransactions(wal, offset, pageSize, orderNewest)
@@ -287,6 +290,44 @@ func (pg *TransactionsPage) multiWalletTxns(offset, pageSize int32, newestFirst
 	return allTxs, len(allTxs), nil
 }
 
+// queryTransactions returns the transactions of the selected wallets that
+// match the advanced query and the selected tx filter.
+func (pg *TransactionsPage) queryTransactions(offset, pageSize int32, newestFirst bool) ([]*multiWalletTx, int, error) {
+	mapInfo, _ := components.TxPageDropDownFields(pg.getAssetType(), pg.selectedTxCategoryTab)
+	selectedVal, _, _ := strings.Cut(pg.statusDropDown.Selected(), " ")
+	txFilter, ok := mapInfo[selectedVal]
+	if !ok {
+		err := fmt.Errorf("unsupported field(%v) for txCategoryTab index(%d) found", selectedVal, pg.selectedTxCategoryTab)
+		return nil, -1, err
+	}
+	pg.txFilter = txFilter
+
+	query := *pg.advancedQuery
+	query.TxFilter = txFilter
+	query.Offset = offset
+	query.Limit = pageSize
+	query.NewestFirst = newestFirst
+
+	walletTxs, err := pg.AssetsManager.QueryTransactions(pg.filteredWallets(), &query)
+	if err != nil {
+		return nil, -1, fmt.Errorf("error querying transactions: %v", err)
+	}
+
+	txs := make([]*multiWalletTx, 0, len(walletTxs))
+	for _, tx := range walletTxs {
+		txs = append(txs, &multiWalletTx{tx.Transaction, tx.WalletID})
+	}
+	return txs, len(txs), nil
+}
+
+// filteredWallets returns the wallets whose transactions are shown.
+func (pg *TransactionsPage) filteredWallets() []sharedW.Asset {
+	if pg.selectedWallet != nil {
+		return []sharedW.Asset{pg.selectedWallet}
+	}
+	return pg.assetWallets
+}
+
 func (pg *TransactionsPage) loadTransactions(wal sharedW.Asset, offset, pageSize int32, newestFirst bool) ([]*multiWalletTx, int, error) {
 	mapInfo, _ := components.TxPageDropDownFields(wal.GetAssetType(), pg.selectedTxCategoryTab)
 	if len(mapInfo) < 1 {
@@ -378,7 +419,7 @@ func (pg *TransactionsPage) dropdownLayout(gtx C) D {
 
 func (pg *TransactionsPage) leftDropdown(gtx C) D {
 	showOverlay := pg.walletNotReady() && pg.multiWalletLayout
-	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{Spacing: layout.SpaceBetween},
 		layout.Rigid(func(gtx C) D {
 			if pg.isShowTitle && pg.IsMobileView() {
 				lbl := pg.Theme.Label(values.TextSize16, values.String(values.StrTransactions))
@@ -400,7 +441,7 @@ func (pg *TransactionsPage) leftDropdown(gtx C) D {
 				icon = pg.Theme.Icons.FilterImgIcon
 			}
 			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
-				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Alignment: layout.Middle},
 					layout.Rigid(func(gtx C) D {
 						margin := values.MarginPadding20
 						if pg.IsMobileView() {
@@ -410,6 +451,19 @@ func (pg *TransactionsPage) leftDropdown(gtx C) D {
 							return pg.buttonWrap(gtx, pg.filterBtn, icon, values.String(values.StrFilter))
 						})
 					}),
+					layout.Rigid(func(gtx C) D {
+						margin := values.MarginPadding20
+						if pg.IsMobileView() {
+							margin = values.MarginPadding12
+						}
+						icon := pg.Theme.Icons.FilterOffImgIcon
+						if pg.advancedQuery != nil {
+							icon = pg.Theme.Icons.FilterImgIcon
+						}
+						return layout.Inset{Right: margin}.Layout(gtx, func(gtx C) D {
+							return pg.buttonWrap(gtx, pg.advancedFilterBtn, icon, values.String(values.StrAdvancedFilter))
+						})
+					}),
 					layout.Rigid(func(gtx C) D {
 						// TODO: Enable on mobile
 						if pg.IsMobileView() {
@@ -417,6 +471,16 @@ func (pg *TransactionsPage) leftDropdown(gtx C) D {
 						}
 						return pg.buttonWrap(gtx, pg.exportBtn, pg.Theme.Icons.ShareIcon, values.String(values.StrExport))
 					}),
+					layout.Rigid(func(gtx C) D {
+						// Tax reports cover all the wallets and need the
+						// historical prices of the exchange API.
+						if pg.IsMobileView() || !pg.multiWalletLayout || !pg.AssetsManager.IsHTTPAPIPrivacyModeOff(utils.ExchangeHTTPAPI) {
+							return D{}
+						}
+						return layout.Inset{Left: values.MarginPadding20}.Layout(gtx, func(gtx C) D {
+							return pg.buttonWrap(gtx, pg.taxReportBtn, pg.Theme.Icons.DocumentationIcon, values.String(values.StrTaxReport))
+						})
+					}),
 				)
 			})
 		}),
@@ -449,7 +513,7 @@ func (pg *TransactionsPage) rightDropdown(gtx C) D {
 		return D{}
 	}
 	return layout.E.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.statusDropDown.Layout),
 			layout.Rigid(pg.orderDropDown.Layout),
 		)
@@ -580,6 +644,10 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 			assetIndex--
 			pg.selectedWallet = pg.assetWallets[assetIndex]
 		}
+		if pg.advancedQuery != nil {
+			// Accounts can only be filtered for a single wallet.
+			pg.advancedQuery.Accounts = nil
+		}
 		pg.refreshAvailableTxType()
 		go pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
 	}
@@ -614,32 +682,19 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 		pg.isFilterOpen = !pg.isFilterOpen
 	}
 
+	if pg.advancedFilterBtn.Clicked(gtx) {
+		pg.ParentWindow().ShowModal(newAdvancedFilterModal(pg.Load, pg.filteredWallets(), pg.advancedQuery, func(query *sharedW.TxQuery) {
+			pg.advancedQuery = query
+			go pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
+		}))
+	}
+
 	if pg.exportBtn.Clicked(gtx) {
-		exportModal := modal.NewCustomModal(pg.Load).
-			Title(values.String(values.StrExportTransaction)).
-			Body(values.String(values.StrExportTransactionsMsg)).
-			SetNegativeButtonText(values.String(values.StrCancel)).
-			SetPositiveButtonText(values.String(values.StrExport)).
-			SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
-				assets := []sharedW.Asset{pg.selectedWallet}
-				if pg.selectedWallet == nil {
-					assets = pg.assetWallets
-				}
-				go func() {
-					fileName := filepath.Join(pg.AssetsManager.RootDir(), "exports", fmt.Sprintf("transaction_export_%d.csv", time.Now().Unix()))
-					err := exportTxs(assets, fileName)
-					if err != nil {
-						errModal := modal.NewErrorModal(pg.Load, fmt.Errorf("error exporting your wallet(s) transactions: %v", err).Error(), modal.DefaultClickFunc())
-						pg.ParentWindow().ShowModal(errModal)
-						return
-					}
+		pg.ParentWindow().ShowModal(newTxExportModal(pg.Load, pg.filteredWallets(), pg.txFilter))
+	}
 
-					infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrExportTransactionSuccessMsg, fileName), modal.DefaultClickFunc())
-					pg.ParentWindow().ShowModal(infoModal)
-				}()
-				return true
-			})
-		pg.ParentWindow().ShowModal(exportModal)
+	if pg.taxReportBtn.Clicked(gtx) {
+		pg.ParentWindow().ShowModal(newTaxReportModal(pg.Load))
 	}
 
 	if pg.orderDropDown.Changed(gtx) {
@@ -661,64 +716,6 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 	}
 }
 
-func exportTxs(assets []sharedW.Asset, fileName string) error {
-	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
-		return fmt.Errorf("os.MkdirAll error: %w", err)
-	}
-
-	var success bool
-	defer func() {
-		if !success {
-			os.Remove(fileName)
-		}
-	}()
-
-	f, err := os.Create(fileName)
-	if err != nil {
-		return fmt.Errorf("os.Create error: %w", err)
-	}
-	defer f.Close()
-
-	headers := []string{values.String(values.StrTime), values.String(values.StrHash), values.String(values.StrType), values.String(values.StrDirection), values.String(values.StrFee), values.String(values.StrAmount)}
-
-	writer := csv.NewWriter(f)
-	writer.UseCRLF = runtime.GOOS == "windows"
-	err = writer.Write(headers)
-	if err != nil {
-		return fmt.Errorf("csv.Writer.Write error: %w", err)
-	}
-
-	for _, a := range assets {
-		txs, err := a.GetTransactionsRaw(0, math.MaxInt32, utils.TxFilterAll, true, "")
-		if err != nil {
-			return fmt.Errorf("wallet.GetTransactionsRaw error: %w", err)
-		}
-
-		// Write txs to file.
-		for _, tx := range txs {
-			err := writer.Write([]string{
-				time.Unix(tx.Timestamp, 0).String(),
-				tx.Hash,
-				tx.Type,
-				txhelper.TxDirectionString(tx.Direction),
-				a.ToAmount(tx.Fee).String(),
-				a.ToAmount(tx.Amount).String(),
-			})
-			if err != nil {
-				return fmt.Errorf("csv.Writer.Write error: %v", err)
-			}
-
-			writer.Flush()
-			if err = writer.Error(); err != nil {
-				return fmt.Errorf("csv.Writer error: %w", err)
-			}
-		}
-	}
-
-	success = true
-	return nil
-}
-
 func (pg *TransactionsPage) listenForTxNotifications() {
 	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
 		OnTransaction: func(walletID int, _ *sharedW.Transaction) {
@@ -728,6 +725,15 @@ func (pg *TransactionsPage) listenForTxNotifications() {
 				return // ignore tx
 			}
 
+			pg.scroll.FetchScrollData(false, pg.ParentWindow(), false)
+		},
+		// Refresh the confirmations of the txs moved to a different block by
+		// a chain reorg.
+		OnTransactionReorged: func(walletID int, _ string, _, _ int32) {
+			if pg.selectedWallet != nil && pg.selectedWallet.GetWalletID() != walletID {
+				return // ignore tx
+			}
+
 			pg.scroll.FetchScrollData(false, pg.ParentWindow(), false)
 		},
 	}
@@ -0,0 +1,709 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	qrcode "github.com/yeqown/go-qrcode"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+const (
+	MultisigPageID = "Multisig"
+
+	// maxQRPSBTLength is the length of the largest base64 PSBT shown as a QR
+	// code, larger PSBTs must be exchanged as files.
+	maxQRPSBTLength = 2000
+)
+
+type multisigPendingTx struct {
+	*sharedW.MultisigPendingTx
+	progress *sharedW.PSBTSignatureProgress
+
+	signBtn, exportBtn, qrBtn cryptomaterial.Button
+	broadcastBtn, deleteBtn   cryptomaterial.Button
+}
+
+// MultisigPage shows the cosigners and the funds of a multisig wallet and
+// the txs awaiting the signatures of the cosigners.
+type MultisigPage struct {
+	*load.Load
+	// GenericPageModal defines methods such as ID() and OnAttachedToNavigator()
+	// that helps this Page satisfy the app.Page interface. It also defines
+	// helper methods for accessing the PageNavigator that displayed this page
+	// and the root WindowNavigator.
+	*app.GenericPageModal
+
+	wallet   sharedW.Asset
+	multisig sharedW.MultisigAsset
+
+	config     *sharedW.MultisigConfig
+	balance    *sharedW.Balance
+	address    string
+	qrImage    *image.Image
+	pendingTxs []*multisigPendingTx
+
+	descriptors          []string
+	descriptorClickables []*cryptomaterial.Clickable
+
+	copyKey, copyAddress *cryptomaterial.Clickable
+	newAddressBtn        cryptomaterial.Button
+
+	destinationEditor, amountEditor cryptomaterial.Editor
+	createTxBtn                     cryptomaterial.Button
+
+	psbtEditor    cryptomaterial.Editor
+	importPSBTBtn cryptomaterial.Button
+
+	pageContainer *widget.List
+
+	backButton cryptomaterial.IconButton
+}
+
+func NewMultisigPage(l *load.Load, wallet sharedW.Asset) *MultisigPage {
+	pg := &MultisigPage{
+		Load:             l,
+		GenericPageModal: app.NewGenericPageModal(MultisigPageID),
+		wallet:           wallet,
+		copyKey:          l.Theme.NewClickable(false),
+		copyAddress:      l.Theme.NewClickable(false),
+		newAddressBtn:    l.Theme.OutlineButton(values.String(values.StrGenerateAddress)),
+		createTxBtn:      l.Theme.Button(values.String(values.StrCreateTransaction)),
+		importPSBTBtn:    l.Theme.Button(values.String(values.StrImportPSBT)),
+		pageContainer: &widget.List{
+			List: layout.List{Axis: layout.Vertical},
+		},
+	}
+	pg.multisig, _ = wallet.(sharedW.MultisigAsset)
+
+	pg.destinationEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrAddress))
+	pg.destinationEditor.Editor.SingleLine = true
+	pg.amountEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrAmount))
+	pg.amountEditor.Editor.SingleLine = true
+	pg.psbtEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrPSBTFileOrBase64))
+	pg.psbtEditor.Editor.SingleLine = false
+
+	pg.backButton = components.GetBackButton(l)
+
+	return pg
+}
+
+// OnNavigatedTo is called when the page is about to be displayed and
+// may be used to initialize page features that are only relevant when
+// the page is displayed.
+// Part of the load.Page interface.
+func (pg *MultisigPage) OnNavigatedTo() {
+	pg.loadMultisigData()
+}
+
+func (pg *MultisigPage) loadMultisigData() {
+	if pg.multisig == nil {
+		return
+	}
+
+	config, err := pg.multisig.MultisigConfig()
+	if err != nil {
+		log.Errorf("Error loading multisig config: %v", err)
+		return
+	}
+	pg.config = config
+
+	balance, err := pg.multisig.MultisigBalance()
+	if err != nil {
+		log.Errorf("Error loading multisig balance: %v", err)
+	} else {
+		pg.balance = balance
+	}
+
+	address, err := pg.multisig.MultisigCurrentAddress()
+	if err != nil {
+		log.Errorf("Error loading multisig address: %v", err)
+	} else {
+		pg.setAddress(address)
+	}
+
+	if descriptorAsset, ok := pg.wallet.(sharedW.DescriptorAsset); ok {
+		descriptors, err := descriptorAsset.MultisigDescriptors()
+		if err != nil {
+			log.Errorf("Error loading multisig descriptors: %v", err)
+		} else {
+			pg.descriptors = descriptors
+			pg.descriptorClickables = make([]*cryptomaterial.Clickable, len(descriptors))
+			for i := range descriptors {
+				pg.descriptorClickables[i] = pg.Theme.NewClickable(true)
+			}
+		}
+	}
+
+	pg.loadPendingTxs()
+}
+
+func (pg *MultisigPage) loadPendingTxs() {
+	txs, err := pg.multisig.MultisigPendingTxs()
+	if err != nil {
+		log.Errorf("Error loading multisig pending txs: %v", err)
+		return
+	}
+
+	pendingTxs := make([]*multisigPendingTx, 0, len(txs))
+	for _, tx := range txs {
+		progress, err := pg.multisig.MultisigSignatureProgress(tx.TxID)
+		if err != nil {
+			log.Errorf("Error loading signatures of tx %s: %v", tx.TxID, err)
+			continue
+		}
+
+		pendingTx := &multisigPendingTx{
+			MultisigPendingTx: tx,
+			progress:          progress,
+			signBtn:           pg.Theme.Button(values.String(values.StrSign)),
+			exportBtn:         pg.Theme.OutlineButton(values.String(values.StrExportPSBT)),
+			qrBtn:             pg.Theme.OutlineButton(values.String(values.StrShowQRCode)),
+			broadcastBtn:      pg.Theme.Button(values.String(values.StrBroadcast)),
+			deleteBtn:         pg.Theme.DangerButton(values.String(values.StrDelete)),
+		}
+		local := pg.config.LocalCosigner()
+		pendingTx.signBtn.SetEnabled(local != nil && !progress.HasSigned(local.Fingerprint) && !progress.IsComplete())
+		pendingTx.broadcastBtn.SetEnabled(progress.IsComplete())
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+	pg.pendingTxs = pendingTxs
+}
+
+func (pg *MultisigPage) setAddress(address string) {
+	pg.address = address
+	pg.qrImage = pg.qrCode(address)
+}
+
+func (pg *MultisigPage) qrCode(text string) *image.Image {
+	qrCode, err := qrcode.New(text)
+	if err != nil {
+		log.Error("Error generating qrCode: " + err.Error())
+		return nil
+	}
+
+	var buff bytes.Buffer
+	if err = qrCode.SaveTo(&buff); err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+
+	imgdec, _, err := image.Decode(bytes.NewReader(buff.Bytes()))
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &imgdec
+}
+
+// Layout draws the page UI components into the provided C
+// to be eventually drawn on screen.
+// Part of the load.Page interface.
+func (pg *MultisigPage) Layout(gtx C) D {
+	body := func(gtx C) D {
+		sp := components.SubPage{
+			Load:       pg.Load,
+			Title:      values.String(values.StrMultisig),
+			BackButton: pg.backButton,
+			Back: func() {
+				pg.ParentNavigator().CloseCurrentPage()
+			},
+			Body: func(gtx C) D {
+				if pg.config == nil {
+					return D{}
+				}
+				sections := []layout.Widget{
+					pg.cosignersSection,
+					pg.receiveSection,
+					pg.sendSection,
+					pg.pendingTxsSection,
+				}
+				if len(pg.descriptors) > 0 {
+					sections = append(sections, pg.descriptorsSection)
+				}
+				return pg.Theme.List(pg.pageContainer).Layout(gtx, len(sections), func(gtx C, i int) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							gtx.Constraints.Min.X = gtx.Constraints.Max.X
+							return layout.UniformInset(values.MarginPadding15).Layout(gtx, sections[i])
+						})
+					})
+				})
+			},
+		}
+		return sp.Layout(pg.ParentWindow(), gtx)
+	}
+
+	if pg.Load.IsMobileView() {
+		return components.UniformMobile(gtx, false, false, body)
+	}
+	return body(gtx)
+}
+
+func (pg *MultisigPage) sectionTitle(gtx C, title string) D {
+	lbl := pg.Theme.Body1(title)
+	lbl.Font.Weight = font.SemiBold
+	return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+}
+
+func (pg *MultisigPage) cosignersSection(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrCosigners))
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.StringF(values.StrMultisigThreshold, pg.config.Threshold, len(pg.config.Cosigners)))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lbl.Layout)
+		}),
+	}
+
+	for _, cosigner := range pg.config.Cosigners {
+		cosigner := cosigner
+		children = append(children, layout.Rigid(func(gtx C) D {
+			name := cosigner.Name
+			if cosigner.IsLocal {
+				name = values.String(values.StrThisWallet)
+			}
+			fingerprint := pg.Theme.Body2(cosigner.Fingerprint)
+			fingerprint.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(name).Layout, fingerprint.Layout)
+			})
+		}))
+	}
+
+	if local := pg.config.LocalCosigner(); local != nil {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			if pg.copyKey.Clicked(gtx) {
+				gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(local.KeyOrigin()))})
+				pg.Toast.Notify(values.String(values.StrCopied))
+			}
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						lbl := pg.Theme.Caption(values.String(values.StrShareCosignerKey))
+						lbl.Color = pg.Theme.Color.GrayText2
+						return lbl.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.copyKey.Layout(gtx, pg.Theme.Body2(local.KeyOrigin()).Layout)
+					}),
+				)
+			})
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *MultisigPage) descriptorsSection(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrOutputDescriptors))
+		}),
+	}
+
+	for i, desc := range pg.descriptors {
+		clickable := pg.descriptorClickables[i]
+		desc := desc
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(desc)
+			lbl.Color = pg.Theme.Color.Primary
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return clickable.Layout(gtx, lbl.Layout)
+			})
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *MultisigPage) receiveSection(gtx C) D {
+	row := func(title, value string) layout.FlexChild {
+		return layout.Rigid(func(gtx C) D {
+			v := pg.Theme.Body2(value)
+			v.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(title).Layout, v.Layout)
+			})
+		})
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrBalance))
+		}),
+	}
+	if pg.balance != nil {
+		children = append(children,
+			row(values.String(values.StrTotal), pg.balance.Total.String()),
+			row(values.String(values.StrLabelSpendable), pg.balance.Spendable.String()),
+		)
+	}
+	children = append(children,
+		layout.Rigid(func(gtx C) D {
+			if pg.qrImage == nil {
+				return D{}
+			}
+			return layout.Center.Layout(gtx, func(gtx C) D {
+				return pg.Theme.ImageIcon(gtx, *pg.qrImage, 150)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Center.Layout(gtx, func(gtx C) D {
+				return pg.copyAddress.Layout(gtx, pg.Theme.Body2(pg.address).Layout)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+				return layout.E.Layout(gtx, pg.newAddressBtn.Layout)
+			})
+		}),
+	)
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *MultisigPage) sendSection(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrCreateTransaction))
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding15}.Layout(gtx, pg.destinationEditor.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding15}.Layout(gtx, pg.amountEditor.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.E.Layout(gtx, pg.createTxBtn.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
+				return pg.sectionTitle(gtx, values.String(values.StrImportPSBT))
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding15}.Layout(gtx, pg.psbtEditor.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.E.Layout(gtx, pg.importPSBTBtn.Layout)
+		}),
+	)
+}
+
+func (pg *MultisigPage) pendingTxsSection(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return pg.sectionTitle(gtx, values.String(values.StrPendingTransactions))
+		}),
+	}
+	if len(pg.pendingTxs) == 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrNoPendingTransactions))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}))
+	}
+
+	for i, tx := range pg.pendingTxs {
+		tx := tx
+		if i > 0 {
+			children = append(children, layout.Rigid(func(gtx C) D {
+				m := values.MarginPadding15
+				return layout.Inset{Top: m, Bottom: m}.Layout(gtx, pg.Theme.Separator().Layout)
+			}))
+		}
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return pg.pendingTxLayout(gtx, tx)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+func (pg *MultisigPage) pendingTxLayout(gtx C, tx *multisigPendingTx) D {
+	gray := func(txt string) layout.Widget {
+		lbl := pg.Theme.Body2(txt)
+		lbl.Color = pg.Theme.Color.GrayText2
+		return lbl.Layout
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, pg.Theme.Body2(utils.SplitSingleString(tx.TxID, 0)).Layout,
+				gray(values.StringF(values.StrSignatureProgress, tx.progress.Signatures(), tx.progress.Threshold)))
+		}),
+		layout.Rigid(func(gtx C) D {
+			amount := fmt.Sprintf("%s (%s %s)", pg.wallet.ToAmount(tx.Amount), values.String(values.StrFee), pg.wallet.ToAmount(tx.Fee))
+			return layout.Inset{Top: values.MarginPadding4, Bottom: values.MarginPadding8}.Layout(gtx, gray(amount))
+		}),
+	}
+
+	for _, cosigner := range pg.config.Cosigners {
+		cosigner := cosigner
+		children = append(children, layout.Rigid(func(gtx C) D {
+			name := cosigner.Name
+			if cosigner.IsLocal {
+				name = values.String(values.StrThisWallet)
+			}
+			status := values.String(values.StrNotSigned)
+			if tx.progress.HasSigned(cosigner.Fingerprint) {
+				status = values.String(values.StrSigned)
+			}
+			return components.EndToEndRow(gtx, gray(fmt.Sprintf("%s (%s)", name, cosigner.Fingerprint)), gray(status))
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				inset := layout.Inset{Left: values.MarginPadding5}
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
+					layout.Rigid(tx.deleteBtn.Layout),
+					layout.Rigid(func(gtx C) D { return inset.Layout(gtx, tx.qrBtn.Layout) }),
+					layout.Rigid(func(gtx C) D { return inset.Layout(gtx, tx.exportBtn.Layout) }),
+					layout.Rigid(func(gtx C) D { return inset.Layout(gtx, tx.signBtn.Layout) }),
+					layout.Rigid(func(gtx C) D { return inset.Layout(gtx, tx.broadcastBtn.Layout) }),
+				)
+			})
+		})
+	}))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// HandleUserInteractions is called just before Layout() to determine
+// if any user interaction recently occurred on the page and may be
+// used to update the page's UI components shortly before they are
+// displayed.
+// Part of the load.Page interface.
+func (pg *MultisigPage) HandleUserInteractions(gtx C) {
+	if pg.multisig == nil {
+		return
+	}
+
+	if pg.copyAddress.Clicked(gtx) {
+		gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(pg.address))})
+		pg.Toast.Notify(values.String(values.StrCopied))
+	}
+
+	for i, clickable := range pg.descriptorClickables {
+		if clickable.Clicked(gtx) {
+			gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(pg.descriptors[i]))})
+			pg.Toast.Notify(values.String(values.StrDescriptorCopied))
+		}
+	}
+
+	if pg.newAddressBtn.Clicked(gtx) {
+		address, err := pg.multisig.MultisigNextAddress()
+		if err != nil {
+			pg.showError(err)
+		} else {
+			pg.setAddress(address)
+		}
+	}
+
+	if pg.createTxBtn.Clicked(gtx) {
+		pg.createTx()
+	}
+
+	if pg.importPSBTBtn.Clicked(gtx) {
+		pg.importPSBT()
+	}
+
+	for _, tx := range pg.pendingTxs {
+		if tx.signBtn.Clicked(gtx) {
+			pg.signTx(tx.TxID)
+		}
+		if tx.exportBtn.Clicked(gtx) {
+			pg.exportPSBT(tx.TxID)
+		}
+		if tx.qrBtn.Clicked(gtx) {
+			pg.showPSBTQRCode(tx.PSBT)
+		}
+		if tx.broadcastBtn.Clicked(gtx) {
+			pg.broadcastTx(tx.TxID)
+		}
+		if tx.deleteBtn.Clicked(gtx) {
+			pg.deletePendingTx(tx.TxID)
+		}
+	}
+}
+
+func (pg *MultisigPage) showError(err error) {
+	errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(errModal)
+}
+
+func (pg *MultisigPage) createTx() {
+	pg.destinationEditor.SetError("")
+	pg.amountEditor.SetError("")
+
+	address := strings.TrimSpace(pg.destinationEditor.Editor.Text())
+	if !pg.wallet.IsAddressValid(address) {
+		pg.destinationEditor.SetError(values.String(values.StrInvalidAddress))
+		return
+	}
+	amount, err := strconv.ParseFloat(pg.amountEditor.Editor.Text(), 64)
+	if err != nil || amount <= 0 {
+		pg.amountEditor.SetError(values.String(values.StrInvalidAmount))
+		return
+	}
+
+	destination := &sharedW.TransactionDestination{
+		Address:    address,
+		UnitAmount: dcr.AmountAtom(amount),
+	}
+	if _, err := pg.multisig.CreateMultisigTx([]*sharedW.TransactionDestination{destination}, 0); err != nil {
+		pg.showError(err)
+		return
+	}
+
+	pg.destinationEditor.Editor.SetText("")
+	pg.amountEditor.Editor.SetText("")
+	pg.loadMultisigData()
+}
+
+func (pg *MultisigPage) importPSBT() {
+	pg.psbtEditor.SetError("")
+	text := strings.TrimSpace(pg.psbtEditor.Editor.Text())
+	if text == "" {
+		return
+	}
+
+	// The editor holds either the path of a PSBT file or the base64 PSBT.
+	data := []byte(text)
+	if fileData, err := os.ReadFile(text); err == nil {
+		data = fileData
+	}
+	if _, err := pg.multisig.ImportMultisigPSBT(data); err != nil {
+		pg.psbtEditor.SetError(err.Error())
+		return
+	}
+
+	pg.psbtEditor.Editor.SetText("")
+	pg.Toast.Notify(values.String(values.StrPSBTImported))
+	pg.loadMultisigData()
+}
+
+func (pg *MultisigPage) signTx(txID string) {
+	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrSign)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := pg.multisig.SignMultisigTx(txID, password); err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			pm.Dismiss()
+			pg.loadPendingTxs()
+			return true
+		})
+	pg.ParentWindow().ShowModal(walletPasswordModal)
+}
+
+func (pg *MultisigPage) exportPSBT(txID string) {
+	data, err := pg.multisig.ExportMultisigPSBT(txID)
+	if err != nil {
+		pg.showError(err)
+		return
+	}
+
+	fileName := filepath.Join(pg.AssetsManager.RootDir(), "exports", fmt.Sprintf("psbt_%s_%d.psbt", txID, time.Now().Unix()))
+	if err := os.MkdirAll(filepath.Dir(fileName), libutils.UserFilePerm); err != nil {
+		pg.showError(err)
+		return
+	}
+	if err := os.WriteFile(fileName, data, 0600); err != nil {
+		pg.showError(err)
+		return
+	}
+
+	infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrPSBTExported, fileName), modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(infoModal)
+}
+
+func (pg *MultisigPage) showPSBTQRCode(psbt string) {
+	if len(psbt) > maxQRPSBTLength {
+		pg.showError(fmt.Errorf("%s", values.String(values.StrPSBTTooLargeForQR)))
+		return
+	}
+
+	qrImage := pg.qrCode(psbt)
+	if qrImage == nil {
+		return
+	}
+	qrModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrExportPSBT)).
+		UseCustomWidget(func(gtx C) D {
+			return layout.Center.Layout(gtx, func(gtx C) D {
+				return pg.Theme.ImageIcon(gtx, *qrImage, 300)
+			})
+		}).
+		SetPositiveButtonText(values.String(values.StrOk))
+	pg.ParentWindow().ShowModal(qrModal)
+}
+
+func (pg *MultisigPage) broadcastTx(txID string) {
+	hash, err := pg.multisig.BroadcastMultisigTx(txID)
+	if err != nil {
+		pg.showError(err)
+		return
+	}
+
+	infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrMultisigTxBroadcast, hash), modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(infoModal)
+	pg.loadMultisigData()
+}
+
+func (pg *MultisigPage) deletePendingTx(txID string) {
+	confirmModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrDeletePendingTx)).
+		Body(values.String(values.StrDeletePendingTxMsg)).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		PositiveButtonStyle(pg.Load.Theme.Color.Surface, pg.Load.Theme.Color.Danger).
+		SetPositiveButtonText(values.String(values.StrDelete)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			if err := pg.multisig.DeleteMultisigPendingTx(txID); err != nil {
+				pg.showError(err)
+				return false
+			}
+			pg.loadMultisigData()
+			return true
+		})
+	pg.ParentWindow().ShowModal(confirmModal)
+}
+
+// OnNavigatedFrom is called when the page is about to be removed from
+// the displayed window. This method should ideally be used to disable
+// features that are irrelevant when the page is NOT displayed.
+// NOTE: The page may be re-displayed on the app's window, in which case
+// OnNavigatedTo() will be called again. This method should not destroy UI
+// components unless they'll be recreated in the OnNavigatedTo() method.
+// Part of the load.Page interface.
+func (pg *MultisigPage) OnNavigatedFrom() {}
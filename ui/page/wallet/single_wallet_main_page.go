@@ -104,6 +104,7 @@ func NewSingleWalletMasterPage(l *load.Load, wallet sharedW.Asset, showNavigatio
 	swmp.activeTab = make(map[string]string)
 	swmp.hideBalanceButton = swmp.Theme.NewClickable(false)
 	swmp.openWalletSelector = swmp.Theme.IconButton(swmp.Theme.Icons.NavigationArrowBack)
+	swmp.openWalletSelector.Description = values.String(values.StrBack)
 	swmp.refreshExchangeRateBtn = swmp.Theme.NewClickable(true)
 
 	swmp.openWalletSelector = components.GetBackButton(l)
@@ -298,7 +299,7 @@ func (swmp *SingleWalletMasterPage) updateBalance() {
 	}
 	swmp.walletBalance = totalBalance.Total
 	balanceInUSD := totalBalance.Total.MulF64(swmp.usdExchangeRate).ToCoin()
-	swmp.totalBalanceUSD = utils.FormatAsUSDString(swmp.Printer, balanceInUSD)
+	swmp.totalBalanceUSD = swmp.FormatFiat(balanceInUSD)
 }
 
 // OnDarkModeChanged is triggered whenever the dark mode setting is changed
@@ -573,11 +574,13 @@ func (swmp *SingleWalletMasterPage) LayoutTopBar(gtx C) D {
 								gtx.Constraints.Min.X = gtx.Constraints.Max.X
 								layoutPosition := layout.E
 								return layoutPosition.Layout(gtx, func(gtx C) D {
-									return layout.Flex{}.Layout(gtx,
+									return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 										layout.Rigid(func(gtx C) D {
 											icon := swmp.Theme.Icons.VisibilityOffIcon
+											swmp.hideBalanceButton.Description = values.String(values.StrHideBalance)
 											if swmp.isBalanceHidden {
 												icon = swmp.Theme.Icons.VisibilityIcon
+												swmp.hideBalanceButton.Description = values.String(values.StrShowBalance)
 											}
 											return layout.Inset{
 												Top:   values.MarginPadding5,
@@ -621,10 +624,10 @@ func (swmp *SingleWalletMasterPage) LayoutTopBar(gtx C) D {
 }
 
 func (swmp *SingleWalletMasterPage) walletDropdownLayout(gtx C) D {
-	return layout.Flex{
+	return cryptomaterial.LayoutFlex(gtx, layout.Flex{
 		Axis:      layout.Horizontal,
 		Alignment: layout.Middle,
-	}.Layout(gtx,
+	},
 		layout.Rigid(func(gtx C) D {
 			return layout.Inset{
 				Left: values.MarginPadding10,
@@ -804,6 +807,10 @@ func (swmp *SingleWalletMasterPage) listenForNotifications() {
 			swmp.updateBalance()
 			swmp.ParentWindow().Reload()
 		},
+		OnTransactionReorged: func(_ int, _ string, _, _ int32) {
+			swmp.updateBalance()
+			swmp.ParentWindow().Reload()
+		},
 	}
 	err = swmp.selectedWallet.AddTxAndBlockNotificationListener(txAndBlockNotificationListener, MainPageID)
 	if err != nil {
@@ -59,6 +59,7 @@ type SettingsPage struct {
 	changeWalletName, addAccount, deleteWallet *cryptomaterial.Clickable
 	verifyMessage, validateAddr, signMessage   *cryptomaterial.Clickable
 	updateConnectToPeer, setGapLimit           *cryptomaterial.Clickable
+	deepRecovery, multisig                     *cryptomaterial.Clickable
 
 	backButton cryptomaterial.IconButton
 	infoButton cryptomaterial.IconButton
@@ -71,6 +72,8 @@ type SettingsPage struct {
 	changeTab          func(string)
 
 	peerAddr string
+
+	deepRecoveryStatus string
 }
 
 func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func(), changeTab func(string)) *SettingsPage {
@@ -82,6 +85,8 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 		viewSeed:            l.Theme.NewClickable(false),
 		rescan:              l.Theme.NewClickable(false),
 		setGapLimit:         l.Theme.NewClickable(false),
+		deepRecovery:        l.Theme.NewClickable(false),
+		multisig:            l.Theme.NewClickable(false),
 		changeAccount:       l.Theme.NewClickable(false),
 		checklog:            l.Theme.NewClickable(false),
 		checkStats:          l.Theme.NewClickable(false),
@@ -104,6 +109,8 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 		changeTab:          changeTab,
 	}
 
+	pg.spendUnconfirmed.Description = values.String(values.StrUnconfirmedFunds)
+	pg.spendUnmixedFunds.Description = values.String(values.StrAllowSpendingFromUnmixedAccount)
 	_, pg.infoButton = components.SubpageHeaderButtons(l)
 	pg.backButton = components.GetBackButton(l)
 
@@ -121,6 +128,8 @@ func (pg *SettingsPage) OnNavigatedTo() {
 	pg.loadPeerAddress()
 
 	pg.loadWalletAccount()
+
+	pg.listenForDeepRecoveryProgress()
 }
 
 func (pg *SettingsPage) readBool(key string) bool {
@@ -184,6 +193,12 @@ func (pg *SettingsPage) generalSection() layout.Widget {
 				return layout.Inset{}.Layout(gtx, pg.sectionContent(pg.changePass, values.String(values.StrSpendingPassword)))
 			}),
 			layout.Rigid(pg.sectionContent(pg.changeWalletName, values.String(values.StrRenameWalletSheetTitle))),
+			layout.Rigid(func(gtx C) D {
+				if !pg.isMultisig() {
+					return D{}
+				}
+				return pg.sectionDimension(gtx, pg.multisig, values.String(values.StrMultisig))
+			}),
 			layout.Rigid(func(gtx C) D {
 				if !pg.wallet.IsWalletBackedUp() || !pg.wallet.HasWalletSeed() {
 					return D{}
@@ -227,6 +242,11 @@ func (pg *SettingsPage) generalSection() layout.Widget {
 	}
 }
 
+func (pg *SettingsPage) isMultisig() bool {
+	multisig, ok := pg.wallet.(sharedW.MultisigAsset)
+	return ok && multisig.IsMultisig()
+}
+
 func (pg *SettingsPage) debug() layout.Widget {
 	dim := func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
@@ -237,6 +257,20 @@ func (pg *SettingsPage) debug() layout.Widget {
 				}
 				return D{}
 			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.wallet.IsWatchingOnlyWallet() {
+					return D{}
+				}
+				return pg.sectionDimension(gtx, pg.deepRecovery, values.String(values.StrDeepRecovery))
+			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.deepRecoveryStatus == "" {
+					return D{}
+				}
+				lbl := pg.Theme.Body2(pg.deepRecoveryStatus)
+				lbl.Color = pg.Theme.Color.GrayText2
+				return layout.Inset{Bottom: values.MarginPadding24}.Layout(gtx, lbl.Layout)
+			}),
 			layout.Rigid(pg.sectionContent(pg.checklog, values.String(values.StrViewLog))),
 			layout.Rigid(pg.sectionContent(pg.checkStats, values.String(values.StrViewStats))),
 		)
@@ -281,7 +315,7 @@ func (pg *SettingsPage) pageSections(gtx C, title string, body layout.Widget) D
 			},
 		}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 					layout.Rigid(func(gtx C) D {
 						txt := pg.Theme.Label(values.TextSizeTransform(pg.Load.IsMobileView(), values.TextSize20), title)
 						txt.Color = pg.Theme.Color.DeepBlue
@@ -334,7 +368,7 @@ func (pg *SettingsPage) sectionDimension(gtx C, clickable *cryptomaterial.Clicka
 		return layout.Inset{
 			Bottom: values.MarginPadding24,
 		}.Layout(gtx, func(gtx C) D {
-			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{Axis: layout.Horizontal},
 				layout.Rigid(textLabel.Layout),
 				layout.Flexed(1, func(gtx C) D {
 					return layout.E.Layout(gtx, func(gtx C) D {
@@ -348,7 +382,7 @@ func (pg *SettingsPage) sectionDimension(gtx C, clickable *cryptomaterial.Clicka
 
 func (pg *SettingsPage) subSection(gtx C, title string, body layout.Widget) D {
 	return layout.Inset{Bottom: values.MarginPadding30}.Layout(gtx, func(gtx C) D {
-		return layout.Flex{}.Layout(gtx,
+		return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 			layout.Rigid(pg.Theme.Label(values.TextSizeTransform(pg.Load.IsMobileView(), values.TextSize16), title).Layout),
 			layout.Flexed(1, func(gtx C) D {
 				switch title {
@@ -366,6 +400,7 @@ func (pg *SettingsPage) subSection(gtx C, title string, body layout.Widget) D {
 }
 
 func (pg *SettingsPage) subSectionSwitch(title string, option *cryptomaterial.Switch) layout.Widget {
+	option.Description = title
 	return func(gtx C) D {
 		return pg.subSection(gtx, title, option.Layout)
 	}
@@ -615,7 +650,7 @@ func (pg *SettingsPage) clickableRow(gtx C, row clickableRowData) D {
 		return pg.subSection(gtx, row.title, func(gtx C) D {
 			lbl := pg.Theme.Label(values.TextSizeTransform(pg.Load.IsMobileView(), values.TextSize16), row.labelText)
 			lbl.Color = pg.Theme.Color.GrayText2
-			return layout.Flex{}.Layout(gtx,
+			return cryptomaterial.LayoutFlex(gtx, layout.Flex{},
 				layout.Rigid(lbl.Layout),
 				layout.Rigid(func(gtx C) D {
 					return layout.Inset{Top: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
@@ -696,6 +731,10 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 		pg.gapLimitModal()
 	}
 
+	if pg.deepRecovery.Clicked(gtx) {
+		pg.deepRecoveryModal()
+	}
+
 	if pg.deleteWallet.Clicked(gtx) {
 		pg.deleteWalletModal()
 	}
@@ -783,6 +822,10 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 		pg.ParentNavigator().Display(s.NewLogPage(pg.Load, pg.wallet.LogFile(), values.String(values.StrWalletLog)))
 	}
 
+	if pg.multisig.Clicked(gtx) {
+		pg.ParentNavigator().Display(NewMultisigPage(pg.Load, pg.wallet))
+	}
+
 	if pg.checkStats.Clicked(gtx) {
 		pg.ParentNavigator().Display(s.NewStatPage(pg.Load, pg.wallet))
 	}
@@ -850,6 +893,125 @@ func (pg *SettingsPage) gapLimitModal() {
 	pg.ParentWindow().ShowModal(textModal)
 }
 
+func (pg *SettingsPage) deepRecoveryModal() {
+	// Start from the gap limit set for the wallet's address discovery if it
+	// reaches further than the deep recovery default.
+	addressGap := sharedW.DefaultDeepRecoveryAddressGap
+	walletGapLimit := pg.wallet.ReadStringConfigValueForKey(load.GapLimitConfigKey, "")
+	if val, err := strconv.ParseUint(walletGapLimit, 10, 32); err == nil && uint32(val) > addressGap {
+		addressGap = uint32(val)
+	}
+	addressGapEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrAddressGapLimit))
+	addressGapEditor.Editor.SingleLine = true
+	addressGapEditor.Editor.SetText(strconv.Itoa(int(addressGap)))
+
+	accountGapEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrAccountGapLimit))
+	accountGapEditor.Editor.SingleLine = true
+	accountGapEditor.Editor.SetText(strconv.Itoa(int(sharedW.DefaultDeepRecoveryAccountGap)))
+
+	keyScopes := pg.wallet.DeepRecoveryKeyScopes()
+	scopeCheckBoxes := make([]cryptomaterial.CheckBoxStyle, len(keyScopes))
+	for i, scope := range keyScopes {
+		scopeCheckBoxes[i] = pg.Theme.CheckBox(new(widget.Bool), scope.String())
+		scopeCheckBoxes[i].CheckBox.Value = true
+	}
+
+	parseGapLimit := func(editor cryptomaterial.Editor) (uint32, bool) {
+		val, err := strconv.ParseUint(strings.TrimSpace(editor.Editor.Text()), 10, 32)
+		if err != nil || val < 1 || val > uint64(sharedW.MaxDeepRecoveryGap) {
+			return 0, false
+		}
+		return uint32(val), true
+	}
+
+	deepRecoveryModal := modal.NewCreatePasswordModal(pg.Load).
+		Title(values.String(values.StrDeepRecovery)).
+		SetDescription(values.String(values.StrDeepRecoveryInfo)).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		PasswordHint(values.String(values.StrSpendingPassword)).
+		UseCustomWidget(func(gtx C) D {
+			widgets := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, addressGapEditor.Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, accountGapEditor.Layout)
+				}),
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.String(values.StrKeyScopes)).Layout),
+			}
+			for i := range scopeCheckBoxes {
+				widgets = append(widgets, layout.Rigid(scopeCheckBoxes[i].Layout))
+			}
+			return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, widgets...)
+			})
+		}).
+		SetPositiveButtonCallback(func(_, password string, m *modal.CreatePasswordModal) bool {
+			addressGap, ok := parseGapLimit(addressGapEditor)
+			if !ok {
+				m.SetError(values.String(values.StrGapLimitInputErr))
+				return false
+			}
+			accountGap, ok := parseGapLimit(accountGapEditor)
+			if !ok {
+				m.SetError(values.String(values.StrGapLimitInputErr))
+				return false
+			}
+
+			params := &sharedW.DeepRecoveryParams{
+				AddressGapLimit: addressGap,
+				AccountGapLimit: accountGap,
+			}
+			for i, scope := range keyScopes {
+				if scopeCheckBoxes[i].CheckBox.Value {
+					params.KeyScopes = append(params.KeyScopes, scope)
+				}
+			}
+			if len(params.KeyScopes) == 0 {
+				m.SetError(values.String(values.StrNoKeyScopeSelected))
+				return false
+			}
+
+			if err := pg.wallet.DeepRecovery(password, params); err != nil {
+				m.SetError(err.Error())
+				return false
+			}
+			m.Dismiss()
+
+			info := modal.NewSuccessModal(pg.Load, values.String(values.StrDeepRecoveryStarted), modal.DefaultClickFunc()).
+				Body(values.String(values.StrDeepRecoveryStartedBody))
+			pg.ParentWindow().ShowModal(info)
+			return true
+		})
+	pg.ParentWindow().ShowModal(deepRecoveryModal)
+}
+
+// listenForDeepRecoveryProgress displays the per key scope progress of a
+// deep recovery running on the wallet.
+func (pg *SettingsPage) listenForDeepRecoveryProgress() {
+	pg.wallet.RemoveSyncProgressListener(WalletSettingsPageID)
+
+	syncProgressListener := &sharedW.SyncProgressListener{
+		OnAddressDiscoveryProgress: func(report *sharedW.AddressDiscoveryProgressReport) {
+			switch {
+			case report.DeepRecoveryFinished:
+				pg.deepRecoveryStatus = values.StringF(values.StrDeepRecoveryFinished, report.DiscoveredAccounts)
+			case report.KeyScope != nil:
+				pg.deepRecoveryStatus = values.StringF(values.StrDeepRecoveryProgress, report.KeyScope.String(),
+					report.KeyScopeIndex+1, report.TotalKeyScopes, report.AddressDiscoveryProgress)
+			default:
+				return
+			}
+			pg.ParentWindow().Reload()
+		},
+	}
+	err := pg.wallet.AddSyncProgressListener(syncProgressListener, WalletSettingsPageID)
+	if err != nil {
+		log.Errorf("Error adding sync progress listener: %v", err)
+	}
+}
+
 // OnNavigatedFrom is called when the page is about to be removed from
 // the displayed window. This method should ideally be used to disable
 // features that are irrelevant when the page is NOT displayed.
@@ -857,4 +1019,6 @@ func (pg *SettingsPage) gapLimitModal() {
 // OnNavigatedTo() will be called again. This method should not destroy UI
 // components unless they'll be recreated in the OnNavigatedTo() method.
 // Part of the load.Page interface.
-func (pg *SettingsPage) OnNavigatedFrom() {}
+func (pg *SettingsPage) OnNavigatedFrom() {
+	pg.wallet.RemoveSyncProgressListener(WalletSettingsPageID)
+}
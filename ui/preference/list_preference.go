@@ -10,6 +10,7 @@ import (
 	"gioui.org/widget"
 
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -45,8 +46,12 @@ var (
 		{Key: values.DefaultExchangeValue, Value: values.StrNone},
 	}
 
-	// LangOptions stores the configurable language options.
-	LangOptions = []ItemPreference{
+	// FiatOptions holds the fiat currencies asset values can be displayed in.
+	FiatOptions = fiatOptions()
+
+	// builtInLangOptions stores the language options of the built in
+	// languages.
+	builtInLangOptions = []ItemPreference{
 		{Key: localizable.ENGLISH, Value: values.StrEnglish},
 		{Key: localizable.FRENCH, Value: values.StrFrench},
 		{Key: localizable.SPANISH, Value: values.StrSpanish},
@@ -64,6 +69,50 @@ var (
 	}
 )
 
+func fiatOptions() []ItemPreference {
+	options := make([]ItemPreference, 0, len(ext.SupportedFiatCurrencies))
+	for _, currency := range ext.SupportedFiatCurrencies {
+		options = append(options, ItemPreference{Key: currency, Value: currency})
+	}
+	return options
+}
+
+// LangOptions returns the configurable language options, including the
+// languages added by translation files.
+func LangOptions() []ItemPreference {
+	options := append([]ItemPreference{}, builtInLangOptions...)
+	isBuiltIn := func(lang string) bool {
+		for _, opt := range builtInLangOptions {
+			if opt.Key == lang {
+				return true
+			}
+		}
+		return false
+	}
+	for _, lang := range values.Languages {
+		if isBuiltIn(lang) {
+			continue
+		}
+		name := values.LanguageName(lang)
+		if name == "" {
+			name = lang
+		}
+		options = append(options, ItemPreference{Key: lang, Value: name})
+	}
+	return options
+}
+
+// ThemeOptions returns the theme palette options, including the palettes
+// added by the user.
+func ThemeOptions() []ItemPreference {
+	palettes := values.Palettes()
+	options := make([]ItemPreference, 0, len(palettes))
+	for _, palette := range palettes {
+		options = append(options, ItemPreference{Key: palette.Name, Value: palette.Title})
+	}
+	return options
+}
+
 type ListPreferenceModal struct {
 	*load.Load
 	*cryptomaterial.Modal
@@ -128,10 +177,14 @@ func (lp *ListPreferenceModal) ReadPreferenceKeyedValue() string {
 	switch lp.preferenceKey {
 	case sharedW.CurrencyConversionConfigKey:
 		return lp.AssetsManager.GetCurrencyConversionExchange()
+	case sharedW.FiatCurrencyConfigKey:
+		return lp.AssetsManager.GetFiatCurrency()
 	case sharedW.LanguagePreferenceKey:
 		return lp.AssetsManager.GetLanguagePreference()
 	case sharedW.LogLevelConfigKey:
 		return lp.AssetsManager.GetLogLevels()
+	case sharedW.ThemeConfigKey:
+		return lp.AssetsManager.GetTheme()
 	default:
 		return ""
 	}
@@ -142,12 +195,18 @@ func (lp *ListPreferenceModal) SavePreferenceKeyedValue() {
 	switch lp.preferenceKey {
 	case sharedW.CurrencyConversionConfigKey:
 		lp.AssetsManager.SetCurrencyConversionExchange(val)
+	case sharedW.FiatCurrencyConfigKey:
+		lp.AssetsManager.SetFiatCurrency(val)
 	case sharedW.LanguagePreferenceKey:
 		// TODO: We should be able to update dex core's language when the user
 		// changes language.
 		lp.AssetsManager.SetLanguagePreference(val)
 	case sharedW.LogLevelConfigKey:
 		lp.AssetsManager.SetLogLevels(val)
+	case sharedW.ThemeConfigKey:
+		// The dark mode is kept for the pages that check it.
+		lp.AssetsManager.SetTheme(val)
+		lp.AssetsManager.SetDarkMode(values.ThemePalette(val, false).Dark)
 	}
 }
 
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"context"
+	"image"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page"
+	"github.com/crypto-power/cryptopower/ui/page/accounts"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/page/dcrdex"
+	"github.com/crypto-power/cryptopower/ui/page/exchange"
+	"github.com/crypto-power/cryptopower/ui/page/governance"
+	"github.com/crypto-power/cryptopower/ui/page/info"
+	"github.com/crypto-power/cryptopower/ui/page/privacy"
+	"github.com/crypto-power/cryptopower/ui/page/receive"
+	"github.com/crypto-power/cryptopower/ui/page/root"
+	"github.com/crypto-power/cryptopower/ui/page/security"
+	"github.com/crypto-power/cryptopower/ui/page/seedbackup"
+	"github.com/crypto-power/cryptopower/ui/page/send"
+	"github.com/crypto-power/cryptopower/ui/page/settings"
+	"github.com/crypto-power/cryptopower/ui/page/staking"
+	"github.com/crypto-power/cryptopower/ui/page/transaction"
+	"github.com/crypto-power/cryptopower/ui/page/wallet"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// TestPageSemantics lays out the pages of a new app with a DCR wallet and
+// fails on the interactive widgets screen readers can't describe.
+func TestPageSemantics(t *testing.T) {
+	homeDir := t.TempDir()
+	appCfg, err := load.AppConfigFromFile(filepath.Join(homeDir, "config.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	initAssetsManager := func(netType libutils.NetworkType) (*libwallet.AssetsManager, error) {
+		return libwallet.NewAssetsManager(homeDir, filepath.Join(homeDir, "logs"), netType, "")
+	}
+	appInfo, err := load.StartApp("test", time.Now(), string(libutils.Testnet), appCfg, initAssetsManager)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer appInfo.AssetsManager.Shutdown()
+
+	wal, err := appInfo.AssetsManager.CreateNewDCRWallet("test", "password", sharedW.PassphraseTypePass, sharedW.WordSeed33)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	win := &Window{navigator: app.NewSimpleWindowNavigator(func() {})}
+	l, err := win.NewLoad(appInfo, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pages := []app.Page{
+		page.NewStartPage(ctx, l),
+		root.NewHomePage(ctx, l),
+		settings.NewAppSettingsPage(l),
+		settings.NewHelpPage(l),
+		settings.NewAboutPage(l),
+		settings.NewLicensePage(l),
+		governance.NewGovernancePage(l, nil),
+		governance.NewProposalsPage(l, nil),
+		governance.NewConsensusPage(l),
+		governance.NewTreasuryPage(l),
+		root.NewPortfolioPage(l),
+		exchange.NewTradePage(l),
+		exchange.NewCreateOrderPage(l),
+		exchange.NewOrderHistoryPage(l),
+		dcrdex.NewDEXPage(l),
+		wallet.NewSingleWalletMasterPage(l, wal, func() {}),
+		wallet.NewSettingsPage(l, wal, func() {}, func(string) {}),
+		send.NewSendPage(l, wal),
+		receive.NewReceivePage(l, wal),
+		transaction.NewTransactionsPage(l, wal),
+		accounts.NewAccountPage(l, wal),
+		staking.NewStakingPage(l, wal.(*dcr.Asset)),
+		privacy.NewSetupPrivacyPage(l, wal.(*dcr.Asset)),
+		privacy.NewAccountMixerPage(l, wal.(*dcr.Asset)),
+		components.NewRestorePage(l, "restored", libutils.DCRWalletAsset, func(sharedW.Asset) {}),
+		seedbackup.NewBackupInstructionsPage(l, wal, func(*load.Load, app.WindowNavigator) {}),
+		info.NewInfoPage(l, wal, func(sharedW.Asset) {}),
+		security.NewValidateAddressPage(l, wal),
+		security.NewSignMessagePage(l, wal),
+		security.NewVerifyMessagePage(l, wal),
+	}
+	for _, pg := range pages {
+		win.navigator.Display(pg)
+		// The pages are laid out in the desktop and mobile sizes.
+		for _, size := range [][2]unit.Dp{{values.AppWidth, values.AppHeight}, {values.MobileAppWidth, values.MobileAppHeight}} {
+			gtx := layout.Context{
+				Constraints: layout.Exact(image.Pt(int(size[0]), int(size[1]))),
+				Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+				Locale:      l.Theme.Locale(),
+			}
+			l.SetCurrentAppWidth(gtx.Constraints.Max.X, gtx.Metric)
+			for _, control := range cryptomaterial.AuditSemantics(gtx, pg.Layout) {
+				t.Errorf("%s: unlabeled %s control at %v", pg.ID(), control.Class, control.Bounds)
+			}
+		}
+		win.navigator.CloseAllPages()
+	}
+}
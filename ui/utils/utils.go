@@ -15,6 +15,7 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -81,8 +82,9 @@ func BreakBalance(p *message.Printer, balance string) (b1, b2 string) {
 	return
 }
 
-func FormatAsUSDString(p *message.Printer, usdAmt float64) string {
-	return p.Sprintf("$%.2f", usdAmt)
+// FormatAsFiatString formats the amount with the symbol of the fiat currency.
+func FormatAsFiatString(p *message.Printer, currency string, amt float64) string {
+	return p.Sprintf("%s%.2f", ext.FiatSymbol(currency), amt)
 }
 
 func CryptoToUSD(exchangeRate, coin float64) float64 {
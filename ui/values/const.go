@@ -13,4 +13,17 @@ const (
 	StartPageDropdownGroup
 	AssetTypeDropdownGroup
 	AccountsDropdownGroup
+	AddressTypeDropdownGroup
+	DEXConditionalOrderTypes
+	DEXBuyIntervalDropdownGroup
+	ScheduleStrategyDropdownGroup
+	PortfolioRouteDropdownGroup
+	PortfolioFrequencyDropdownGroup
+	TxExportFormatDropdownGroup
+	TxExportAccountDropdownGroup
+	TaxReportYearDropdownGroup
+	TaxReportMethodDropdownGroup
+	TxQueryAccountDropdownGroup
+	TxQueryConfirmationDropdownGroup
+	TxQueryTicketStatusDropdownGroup
 )
@@ -7,18 +7,28 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/crypto-power/cryptopower/ui/values"
 	"github.com/crypto-power/cryptopower/ui/values/localizable"
 )
 
 var rex = regexp.MustCompile(`(?m)("(?:\\.|[^"\\])*")\s*=\s*("(?:\\.|[^"\\])*")`) // "key"="value"
 const commentPrefix = "/"
 
+// missingFlag reports the missing keys of each locale, including the locales
+// of the translation files in the optional dir that follows it.
+const missingFlag = "-missing"
+
 func main() {
 	if len(os.Args) < 2 {
 		fmt.Println("Invalid arguments")
 		return
 	}
 
+	if os.Args[1] == missingFlag {
+		reportMissingKeys()
+		return
+	}
+
 	readIntoMap := func(m map[string]string, localizableStrings string) {
 		scanner := bufio.NewScanner(strings.NewReader(localizableStrings))
 		for scanner.Scan() {
@@ -69,6 +79,23 @@ func main() {
 	}
 }
 
+func reportMissingKeys() {
+	if len(os.Args) > 2 {
+		if err := values.LoadTranslations(os.Args[2]); err != nil {
+			fmt.Println("Error loading translation files:", err)
+			return
+		}
+	}
+
+	for _, lang := range values.Languages {
+		missing := values.MissingKeys(lang)
+		fmt.Printf("%s: %d missing keys\n", lang, len(missing))
+		for _, key := range missing {
+			fmt.Println("  " + key)
+		}
+	}
+}
+
 func trimQuotes(s string) string {
 	if len(s) >= 2 {
 		if s[0] == '"' && s[len(s)-1] == '"' {
@@ -0,0 +1,23 @@
+package localizable
+
+import "embed"
+
+// Locales holds the translation files embedded at build time. Each file is a
+// JSON object with the locale, its display name, its direction ("rtl" for the
+// languages written right-to-left) and its strings. A string is either a
+// value or an object of its plural and gender forms, e.g.
+//
+//	{
+//		"locale": "fa",
+//		"name": "فارسی",
+//		"direction": "rtl",
+//		"strings": {
+//			"votes": {"one": "%d رأی", "other": "%d رأی"}
+//		}
+//	}
+//
+// The strings of a file replace the strings of the locale defined in this
+// package.
+//
+//go:embed locales/*.json
+var Locales embed.FS
@@ -960,4 +960,281 @@ const EN = `
 "unexpectedErrorMsgFmt" = "Something unexpected happened: %s"
 "unexpectedError" = "Unexpected Error"
 "chinese" = "Chinese"
+"deepRecovery" = "Deep recovery"
+"deepRecoveryInfo" = "Scan for accounts and addresses beyond the default gap limits, including key scopes this wallet doesn't use. Use this if funds sent to addresses generated by other wallet software are missing."
+"addressGapLimit" = "Address gap limit"
+"accountGapLimit" = "Account gap limit"
+"keyScopes" = "Key scopes"
+"noKeyScopeSelected" = "Select at least one key scope"
+"deepRecoveryStarted" = "Deep recovery started"
+"deepRecoveryStartedBody" = "The progress of each key scope is shown on the wallet settings page. Discovered accounts are added to the wallet once the scan completes."
+"deepRecoveryProgress" = "Scanning %s (%d of %d): %d%%"
+"deepRecoveryFinished" = "Deep recovery finished, %d account(s) found"
+"addressType" = "Address type"
+"defaultAddressType" = "Use as the default address type for this account"
+"legacyAddress" = "Legacy (P2PKH)"
+"nestedSegwitAddress" = "Nested SegWit (P2SH-P2WPKH)"
+"nativeSegwitAddress" = "Native SegWit (P2WPKH)"
+"taprootAddress" = "Taproot (P2TR)"
+"multisig" = "Multisig"
+"multisigWallet" = "Multisig wallet"
+"requiredSignatures" = "Required signatures"
+"enterRequiredSignatures" = "Enter the number of required signatures"
+"cosignerKeys" = "Cosigner keys"
+"cosignerKeysHint" = "Extended public keys of the other cosigners, one per line"
+"multisigThreshold" = "%d of %d signatures required"
+"cosigners" = "Cosigners"
+"thisWallet" = "This wallet"
+"shareCosignerKey" = "Share this key with the other cosigners"
+"createTransaction" = "Create transaction"
+"importPSBT" = "Import PSBT"
+"psbtFileOrBase64" = "PSBT file path or base64 text"
+"pendingTransactions" = "Pending transactions"
+"noPendingTransactions" = "No pending transactions"
+"signatureProgress" = "%d of %d signatures"
+"signed" = "Signed"
+"notSigned" = "Not signed"
+"sign" = "Sign"
+"exportPSBT" = "Export PSBT"
+"showQRCode" = "Show QR code"
+"broadcast" = "Broadcast"
+"psbtExported" = "PSBT exported to %s"
+"psbtTooLargeForQR" = "The PSBT is too large for a QR code, export it to a file instead"
+"psbtImported" = "PSBT imported"
+"multisigTxBroadcast" = "Transaction %s broadcast"
+"deletePendingTx" = "Delete pending transaction"
+"deletePendingTxMsg" = "The signatures collected for this transaction will be lost."
+"extendedPubKeyOrDescriptor" = "Extended public key or output descriptor"
+"outputDescriptors" = "Output descriptors"
+"descriptorCopied" = "Descriptor copied"
+"priceChart" = "Price Chart"
+"volume" = "Volume"
+"noChartData" = "No data yet"
+"swapStepInit" = "Swap (init)"
+"swapStepAudit" = "Counterparty swap (audit)"
+"swapStepRedeem" = "Redeem"
+"swapStepCounterRedeem" = "Counterparty redeem"
+"swapStepRefund" = "Refund"
+"matchRefunded" = "Swap refunded"
+"matchRevokedAwaitingRefund" = "Match revoked, waiting to refund the swap"
+"matchRevoked" = "Match revoked"
+"matchStepSendSwap" = "Waiting to broadcast the swap"
+"matchStepAwaitCounterSwap" = "Waiting for the counterparty's swap"
+"matchStepAuditSendSwap" = "Auditing the counterparty's swap and broadcasting the swap"
+"matchStepAuditRedeem" = "Auditing the counterparty's swap and redeeming"
+"matchStepAwaitCounterRedeem" = "Waiting for the counterparty to redeem"
+"matchStepRedeem" = "Waiting to redeem"
+"matchStepRedeemConfirming" = "Redeemed, waiting for confirmations"
+"matchStepComplete" = "Complete"
+"noMatches" = "This order has no matches yet"
+"orderID" = "Order ID"
+"maker" = "Maker"
+"taker" = "Taker"
+"side" = "Side"
+"matchTitle" = "Match %s"
+"refundLocktime" = "Refund locktime"
+"refundableNow" = "Refundable now"
+"refundableIn" = "Refundable in %s"
+"confirmationsCount" = "Confirmations: %s"
+"bondManager" = "Bond Manager"
+"manageBonds" = "Manage Bonds"
+"tierAndReputation" = "Tier & Reputation"
+"effectiveTier" = "Effective Tier"
+"targetTier" = "Target Tier"
+"bondedTier" = "Bonded Tier"
+"reputationScore" = "Reputation Score"
+"penalties" = "Penalties"
+"bonds" = "Bonds"
+"activeTiers" = "Active Tiers"
+"expiringTiers" = "Expiring Tiers"
+"bondLifetime" = "Bond Lifetime"
+"pendingBonds" = "Pending Bonds"
+"refundableBonds" = "Expired Bonds"
+"noRefundableBonds" = "No expired bonds awaiting refund."
+"bondRefunded" = "Refunded"
+"bondSettings" = "Bond Settings"
+"autoRenewBonds" = "Auto-renew bonds"
+"autoRenewBondsMsg" = "Expiring bonds are renewed from the selected wallet account to maintain the target tier."
+"renewalFundsRequired" = "Required balance: %s (includes a %s fee buffer)"
+"targetTierErrMsg" = "Target tier must be a number greater than zero"
+"saveBondSettings" = "Save Settings"
+"bondSettingsSaved" = "Bond settings saved"
+"postAdditionalBond" = "Post Additional Bond"
+"postAdditionalBondMsg" = "Post a bond from any wallet supported by the server to add tiers to your account."
+"bondPosted" = "Bond posted, it will be active after %d confirmation(s)."
+"bondsExpiringNotif" = "%d bond tier(s) on %s will expire soon and will be renewed automatically."
+"bondsExpiringNoRenewalNotif" = "%d bond tier(s) on %s will expire soon. Enable auto-renewal in the bond manager to keep your tier."
+"bondsExpiredNotif" = "Bonds on %s have expired, your effective tier is now %d."
+"conditionalOrders" = "Conditional Orders"
+"conditionalOrdersMsg" = "Conditional orders are placed once their trigger is met. They are only placed while Cryptopower is running and you are logged in to the DEX."
+"newConditionalOrder" = "New Conditional Order"
+"stopLimit" = "Stop-Limit"
+"takeProfit" = "Take-Profit"
+"recurringBuy" = "Recurring Buy (DCA)"
+"triggerPrice" = "Trigger Price"
+"triggerPriceMsg" = "Stop-limit orders trigger when the mid-gap price moves against the order, take-profit orders when it moves in favour of the order."
+"limitPriceOptional" = "Limit price (empty for a market order)"
+"amountPerBuy" = "Amount per buy (%s)"
+"buyInterval" = "Buy Interval"
+"everyHour" = "Every hour"
+"everyDay" = "Every day"
+"everyWeek" = "Every week"
+"numberOfBuys" = "Number of buys (0 for no limit)"
+"invalidTriggerPrice" = "Enter a valid trigger price"
+"invalidNumberOfBuys" = "Enter a valid number of buys"
+"conditionalOrderCreated" = "Conditional order created"
+"conditionalOrderCanceled" = "Conditional order canceled"
+"noConditionalOrders" = "Conditional orders you create will be shown here."
+"conditionalOrdersPaused" = "Conditional orders are paused. Log in to the DEX to resume them."
+"nextBuy" = "Next Buy"
+"buysPlaced" = "Buys Placed"
+"lastError" = "Last Error"
+"lastEvent" = "Last Event"
+"statusActive" = "Active"
+"statusPlaced" = "Placed"
+"statusCanceled" = "Canceled"
+"dexServers" = "DEX Servers"
+"manageServers" = "Manage Servers"
+"addServerMsg" = "Add a DEX server by its address. Servers with self-signed certificates need their certificate, either the PEM encoded certificate or the path to the certificate file."
+"serverAddress" = "Server Address"
+"certificatePemOrPath" = "PEM certificate or certificate file path"
+"serverAdded" = "Server added. Post a bond to trade on %s."
+"serverAccountRestored" = "Your existing account on %s was restored."
+"serverStatus" = "Server Status"
+"serverConnected" = "Connected"
+"serverDisconnected" = "Disconnected"
+"serverDisabled" = "Disabled"
+"accountStatus" = "Account"
+"viewOnlyAccount" = "View-only (no bonds)"
+"tierFmt" = "Tier %d"
+"enableServer" = "Enable"
+"disableServerMsg" = "Disabling %s disconnects it and hides its markets. Servers with active orders cannot be disabled. Unspent bonds are refunded when they expire."
+"serverStatusUpdated" = "Server status updated"
+"updateCertificate" = "Update Certificate"
+"certificateUpdated" = "Certificate updated"
+"compareMarket" = "Compare %s Across Servers"
+"noOtherServersMsg" = "No other connected server lists this market."
+"midGap" = "Mid-Gap"
+"bestBuy" = "Best Buy"
+"bestSell" = "Best Sell"
+"lotSize" = "Lot Size"
+"routeToBestServer" = "Best server"
+"routeToBestServerInfo" = "Place the order on the server whose order book fills it at the best average price. Only servers where you have trading tier, and whose lot size and rate step allow the order, are considered."
+"orderRoutedTo" = "Order routed to %s"
+"compareProviders" = "Compare Providers"
+"compareProvidersMsg" = "Quotes for swapping %f %s to %s from all enabled exchange servers, best first."
+"bestRate" = "Best rate"
+"limits" = "Limits"
+"marketDeviation" = "Market rate deviation"
+"amountOutsideLimits" = "The amount is outside the server limits."
+"rateDeviationWarning" = "The rate deviates from the market rate by more than %d%%."
+"feeRateOptional" = "Fee rate in %s (optional)"
+"invalidFeeRate" = "Invalid fee rate"
+"fundOrder" = "Fund Order"
+"orderFunded" = "Order deposit sent"
+"copySupportBundle" = "Copy Support Bundle"
+"depositTx" = "Deposit tx"
+"payoutTx" = "Payout tx"
+"refundTx" = "Refund tx"
+"orderOverdueMsg" = "No payout or refund has been received for this order yet. Copy the support bundle and contact the exchange support."
+"orderOverdueNotif" = "Instant swap order %s on %s is overdue"
+"scheduleName" = "Schedule name"
+"strategy" = "Strategy"
+"fixedAmountStrategy" = "Fixed amount"
+"balancePercentageStrategy" = "Percentage of balance"
+"targetWeightStrategy" = "Target portfolio weight"
+"amountToSwap" = "Amount to swap (%s)"
+"balancePercentageHint" = "Percentage of balance to swap (%)"
+"targetWeightHint" = "Target weight of destination account (%)"
+"schedules" = "Schedules"
+"noSchedules" = "No schedules yet"
+"pause" = "Pause"
+"resume" = "Resume"
+"scheduleRunning" = "Running"
+"schedulePaused" = "Paused"
+"scheduleAwaitingPassword" = "Waiting for spending password"
+"nextRun" = "Next run: %s"
+"recentRuns" = "Recent runs"
+"scheduleRunSwapped" = "Swapped %.8f %s"
+"scheduleRunSkipped" = "Skipped: %s"
+"schedulesRunning" = "%d running"
+"portfolio" = "Portfolio"
+"portfolioMsg" = "Set target weights for your assets, track how far they drift and rebalance them with instant swaps or DEX orders."
+"managePortfolio" = "Manage portfolio"
+"targetAllocation" = "Target allocation"
+"targetWeightFmt" = "%s target weight (%%)"
+"driftThreshold" = "Drift threshold (%)"
+"rebalanceWith" = "Rebalance with"
+"instantSwap" = "Instant swap"
+"checkDrift" = "Check drift"
+"hourly" = "Hourly"
+"daily" = "Daily"
+"weekly" = "Weekly"
+"autoExecuteSwaps" = "Execute the proposed swaps on scheduled checks"
+"portfolioSaved" = "Portfolio settings saved"
+"invalidTargetWeights" = "Target weights must add up to 100%"
+"currentAllocation" = "Current allocation"
+"allocationRowFmt" = "%.2f%% of %.2f%% (%+.2f)"
+"proposedSwaps" = "Proposed swaps"
+"portfolioBalanced" = "The portfolio is within its drift threshold"
+"rebalanceNeededFmt" = "Portfolio drifted %.2f%% from its target allocation"
+"estimatedReceive" = "Estimated receive"
+"estimatedSlippage" = "Estimated slippage"
+"execute" = "Execute"
+"swapOrderPlaced" = "Swap order %s placed"
+"startMonitoring" = "Start monitoring"
+"stopMonitoring" = "Stop monitoring"
+"lastCheckFmt" = "Last check: %s"
+"fiatCurrency" = "Fiat currency"
+"fileFormat" = "File format"
+"fromDate" = "From (YYYY-MM-DD)"
+"toDate" = "To (YYYY-MM-DD)"
+"allAccounts" = "All accounts"
+"includeFiatValues" = "Include %s values at the time of each transaction"
+"invalidDate" = "Enter the date as YYYY-MM-DD"
+"invalidDateRange" = "The end date must be after the start date"
+"exportFilterNote" = "Only the transactions matching the current filter are exported."
+"taxReport" = "Tax report"
+"taxReportMsg" = "Realised gains and income of all your wallets for the year, valued in %s. Transfers between your wallets are not taxed and vote rewards and coinbase are counted as income."
+"taxYear" = "Tax year"
+"costBasisMethod" = "Cost basis method"
+"generate" = "Generate"
+"shortTermGains" = "Short-term gains"
+"longTermGains" = "Long-term gains"
+"income" = "Income"
+"disposals" = "Disposals"
+"missingPrices" = "%d transaction(s) were valued at zero because their historical price is unavailable."
+"unmatchedDisposals" = "%d disposal(s) have no matching acquisition, their cost basis is zero."
+"taxReportSaved" = "Tax report saved to %s"
+"saveCSV" = "Save CSV"
+"balanceHistory" = "Balance history"
+"portfolioValue" = "Portfolio value"
+"advancedFilter" = "Advanced Filter"
+"advancedFilterMsg" = "Show the transactions of the selected wallets that match all the conditions below. Empty fields match every transaction."
+"minAmount" = "Min amount"
+"maxAmount" = "Max amount"
+"labelContains" = "Label contains"
+"unconfirmed" = "Unconfirmed"
+"ticketStatus" = "Ticket status"
+"any" = "Any"
+"apply" = "Apply"
+"recentActivity" = "Recent Activity"
+"noActivity" = "No activity yet"
+"theme" = "Theme"
+"lightTheme" = "Light"
+"darkTheme" = "Dark"
+"highContrastTheme" = "High contrast"
+"colorBlindSafeTheme" = "Colour-blind safe"
+"showPassword" = "Show password"
+"hidePassword" = "Hide password"
+"previous" = "Previous"
+"close" = "Close"
+"swapCurrencies" = "Swap currencies"
+"resumeSchedules" = "Resume swap schedules"
+"resumeSchedulesInfo" = "%d swap schedule(s) of %s were running when the app was closed. Enter the spending password of the wallet to resume them."
+"swapFundedFrom" = "The swap is funded from %s."
+"hideBalance" = "Hide balance"
+"showBalance" = "Show balance"
+"lightMode" = "Light mode"
+"seedWordN" = "Seed word %s"
 `
@@ -0,0 +1,192 @@
+package values
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The names of the built in palettes.
+const (
+	LightPalette          = "light"
+	DarkPalette           = "dark"
+	HighContrastPalette   = "high_contrast"
+	ColorBlindSafePalette = "colorblind_safe"
+)
+
+// palettesExt is the extension of the palette files.
+const palettesExt = ".json"
+
+// builtInPalettes holds the palette files embedded at build time. Each file is
+// a JSON object with the palette name, its title, whether it is dark and its
+// colors, e.g.
+//
+//	{
+//		"name": "high_contrast",
+//		"title": "highContrastTheme",
+//		"dark": true,
+//		"colors": {"Primary": "#4DB8FF", "Surface": "#0A0A0A"}
+//	}
+//
+// The colors are named after the Color fields and written as #RRGGBB or
+// #AARRGGBB. They replace the colors of the light or dark theme.
+//
+//go:embed palettes/*.json
+var builtInPalettes embed.FS
+
+// Palette is a set of theme colors.
+type Palette struct {
+	Name string
+	// Title is the key of the string displayed for the palette, or the
+	// title itself for the palettes of the user.
+	Title string
+	// Dark selects the dark theme colors and icons the palette colors
+	// replace.
+	Dark   bool
+	colors map[string]color.NRGBA
+}
+
+// paletteFile is a palette file, see builtInPalettes for its format.
+type paletteFile struct {
+	Name   string            `json:"name"`
+	Title  string            `json:"title"`
+	Dark   bool              `json:"dark"`
+	Colors map[string]string `json:"colors"`
+}
+
+var (
+	palettes     = make(map[string]*Palette)
+	paletteNames []string
+)
+
+func init() {
+	if err := loadPalettes(builtInPalettes, "palettes"); err != nil {
+		panic(err)
+	}
+	// The built in palettes are listed first, from the default one.
+	paletteNames = []string{LightPalette, DarkPalette, HighContrastPalette, ColorBlindSafePalette}
+}
+
+// LoadPalettes loads the palette files in dir. A palette with the name of
+// another palette replaces it. It is not an error if dir doesn't exist.
+func LoadPalettes(dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	return loadPalettes(os.DirFS(dir), ".")
+}
+
+// loadPalettes loads the palette files in the dir of fsys.
+func loadPalettes(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*"+palettesExt))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		if err := addPalette(content); err != nil {
+			return fmt.Errorf("invalid palette file %s: %v", file, err)
+		}
+	}
+	return nil
+}
+
+func addPalette(content []byte) error {
+	var file paletteFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return err
+	}
+	if file.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	palette := &Palette{
+		Name:   file.Name,
+		Title:  file.Title,
+		Dark:   file.Dark,
+		colors: make(map[string]color.NRGBA, len(file.Colors)),
+	}
+	if palette.Title == "" {
+		palette.Title = file.Name
+	}
+
+	colorType := reflect.TypeOf(Color{})
+	for name, hex := range file.Colors {
+		if _, ok := colorType.FieldByName(name); !ok {
+			return fmt.Errorf("unknown color %q", name)
+		}
+		c, err := parseHexColor(hex)
+		if err != nil {
+			return fmt.Errorf("invalid color %q: %v", name, err)
+		}
+		palette.colors[name] = c
+	}
+
+	if _, ok := palettes[palette.Name]; !ok {
+		paletteNames = append(paletteNames, palette.Name)
+	}
+	palettes[palette.Name] = palette
+	return nil
+}
+
+// parseHexColor parses a #RRGGBB or #AARRGGBB color.
+func parseHexColor(hex string) (color.NRGBA, error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return color.NRGBA{}, fmt.Errorf("expected #RRGGBB or #AARRGGBB")
+	}
+	c, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, err
+	}
+	if len(hex) == 6 {
+		return rgb(uint32(c)), nil
+	}
+	return argb(uint32(c)), nil
+}
+
+// Palettes returns the palettes that can be selected, the built in ones
+// first.
+func Palettes() []*Palette {
+	list := make([]*Palette, 0, len(paletteNames))
+	for _, name := range paletteNames {
+		list = append(list, palettes[name])
+	}
+	return list
+}
+
+// ThemePalette returns the palette with the name. The light or dark palette
+// is returned if there is none, such as before a palette is selected.
+func ThemePalette(name string, isDarkModeOn bool) *Palette {
+	if palette, ok := palettes[name]; ok {
+		return palette
+	}
+	if isDarkModeOn {
+		return palettes[DarkPalette]
+	}
+	return palettes[LightPalette]
+}
+
+// Colors returns the theme colors of the palette.
+func (p *Palette) Colors() *Color {
+	c := (&Color{}).DefaultThemeColors()
+	if p.Dark {
+		c.DarkThemeColors()
+	}
+
+	fields := reflect.ValueOf(c).Elem()
+	for name, value := range p.colors {
+		fields.FieldByName(name).Set(reflect.ValueOf(value))
+	}
+	return c
+}
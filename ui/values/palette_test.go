@@ -0,0 +1,145 @@
+package values
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    color.NRGBA
+		wantErr bool
+	}{
+		{"#4DB8FF", color.NRGBA{R: 0x4D, G: 0xB8, B: 0xFF, A: 0xFF}, false},
+		{"4db8ff", color.NRGBA{R: 0x4D, G: 0xB8, B: 0xFF, A: 0xFF}, false},
+		{"#99FFFFFF", color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0x99}, false},
+		{"#00000000", color.NRGBA{}, false},
+		{"#FFF", color.NRGBA{}, true},
+		{"#4DB8FF0", color.NRGBA{}, true},
+		{"#GGGGGG", color.NRGBA{}, true},
+		{"", color.NRGBA{}, true},
+	}
+	for _, test := range tests {
+		got, err := parseHexColor(test.hex)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: expected error %v, got %v", test.hex, test.wantErr, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q: expected %v, got %v", test.hex, test.want, got)
+		}
+	}
+}
+
+// restorePalettes restores the loaded palettes once the test is done.
+func restorePalettes(t *testing.T) {
+	savedPalettes := make(map[string]*Palette, len(palettes))
+	for name, palette := range palettes {
+		savedPalettes[name] = palette
+	}
+	savedNames := append([]string(nil), paletteNames...)
+	t.Cleanup(func() {
+		palettes = savedPalettes
+		paletteNames = savedNames
+	})
+}
+
+func TestAddPalette(t *testing.T) {
+	restorePalettes(t)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"unknown color", `{"name": "p", "colors": {"Primry": "#4DB8FF"}}`, true},
+		{"invalid color", `{"name": "p", "colors": {"Primary": "#4DB8F"}}`, true},
+		{"missing name", `{"title": "Mine", "colors": {"Primary": "#4DB8FF"}}`, true},
+		{"invalid json", `{"name": "p",`, true},
+		{"valid", `{"name": "p", "colors": {"Primary": "#4DB8FF", "Surface": "#CC0A0A0A"}}`, false},
+	}
+	for _, test := range tests {
+		err := addPalette([]byte(test.content))
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+	}
+
+	palette := palettes["p"]
+	if palette == nil {
+		t.Fatal("the valid palette was not added")
+	}
+	if palette.Title != "p" {
+		t.Errorf("expected the name as title, got %q", palette.Title)
+	}
+	if palette.colors["Surface"] != (color.NRGBA{R: 0x0A, G: 0x0A, B: 0x0A, A: 0xCC}) {
+		t.Errorf("unexpected Surface color %v", palette.colors["Surface"])
+	}
+}
+
+func TestUserPaletteReplacesBuiltIn(t *testing.T) {
+	restorePalettes(t)
+
+	count := len(Palettes())
+	err := addPalette([]byte(`{"name": "high_contrast", "title": "Mine", "colors": {"Primary": "#112233"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := Palettes()
+	if len(list) != count {
+		t.Fatalf("expected %d palettes, got %d", count, len(list))
+	}
+	if list[2].Name != HighContrastPalette || list[2].Title != "Mine" {
+		t.Errorf("expected the user palette in place of the built in one, got %s %q", list[2].Name, list[2].Title)
+	}
+	if list[2].Dark {
+		t.Error("expected the user palette to replace the dark setting of the built in one")
+	}
+	if got := ThemePalette(HighContrastPalette, true).Colors().Primary; got != rgb(0x112233) {
+		t.Errorf("expected the user palette Primary color, got %v", got)
+	}
+}
+
+func TestPaletteColors(t *testing.T) {
+	light := (&Color{}).DefaultThemeColors()
+	dark := (&Color{}).DefaultThemeColors()
+	dark.DarkThemeColors()
+
+	tests := []struct {
+		name        string
+		palette     *Palette
+		wantPrimary color.NRGBA
+		wantText    color.NRGBA
+	}{{
+		name:        "light palette",
+		palette:     &Palette{},
+		wantPrimary: light.Primary,
+		wantText:    light.Text,
+	}, {
+		name:        "dark palette",
+		palette:     &Palette{Dark: true},
+		wantPrimary: dark.Primary,
+		wantText:    dark.Text,
+	}, {
+		name:        "light palette colors",
+		palette:     &Palette{colors: map[string]color.NRGBA{"Primary": rgb(0x112233)}},
+		wantPrimary: rgb(0x112233),
+		wantText:    light.Text,
+	}, {
+		name:        "dark palette colors",
+		palette:     &Palette{Dark: true, colors: map[string]color.NRGBA{"Primary": rgb(0x112233)}},
+		wantPrimary: rgb(0x112233),
+		wantText:    dark.Text,
+	}}
+	for _, test := range tests {
+		c := test.palette.Colors()
+		if c.Primary != test.wantPrimary {
+			t.Errorf("%s: expected Primary %v, got %v", test.name, test.wantPrimary, c.Primary)
+		}
+		if c.Text != test.wantText {
+			t.Errorf("%s: expected Text %v, got %v", test.name, test.wantText, c.Text)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package values
+
+// The CLDR plural categories of the string forms.
+const (
+	pluralZero  = "zero"
+	pluralOne   = "one"
+	pluralTwo   = "two"
+	pluralFew   = "few"
+	pluralMany  = "many"
+	pluralOther = "other"
+)
+
+// pluralCategory returns the CLDR plural category of the count in the
+// language. Languages without a rule use the English rule.
+func pluralCategory(lang string, n int64) string {
+	if n < 0 {
+		n = -n
+	}
+	mod10, mod100 := n%10, n%100
+
+	switch baseLanguage(lang) {
+	case "zh", "ja", "ko", "vi", "th", "id", "ms", "tr":
+		return pluralOther
+
+	case "fr", "pt", "fa", "hi":
+		if n == 0 || n == 1 {
+			return pluralOne
+		}
+		return pluralOther
+
+	case "ar":
+		switch {
+		case n == 0:
+			return pluralZero
+		case n == 1:
+			return pluralOne
+		case n == 2:
+			return pluralTwo
+		case mod100 >= 3 && mod100 <= 10:
+			return pluralFew
+		case mod100 >= 11:
+			return pluralMany
+		}
+		return pluralOther
+
+	case "ru", "uk", "be":
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return pluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return pluralFew
+		}
+		return pluralMany
+
+	case "pl":
+		switch {
+		case n == 1:
+			return pluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return pluralFew
+		}
+		return pluralMany
+	}
+
+	if n == 1 {
+		return pluralOne
+	}
+	return pluralOther
+}
@@ -0,0 +1,70 @@
+package values
+
+import "testing"
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		lang  string
+		count int64
+		want  string
+	}{
+		// English and the languages without a rule.
+		{"en", 0, pluralOther},
+		{"en", 1, pluralOne},
+		{"en", 2, pluralOther},
+		{"en", -1, pluralOne},
+		{"es", 1, pluralOne},
+		{"es", 21, pluralOther},
+		{"de-DE", 1, pluralOne},
+
+		{"zh", 0, pluralOther},
+		{"zh", 1, pluralOther},
+		{"zh_CN", 2, pluralOther},
+		{"ja", 1, pluralOther},
+		{"tr", 1, pluralOther},
+
+		{"fr", 0, pluralOne},
+		{"fr", 1, pluralOne},
+		{"fr", 2, pluralOther},
+		{"pt-BR", 0, pluralOne},
+		{"fa", 1, pluralOne},
+		{"fa", 5, pluralOther},
+		{"hi", 0, pluralOne},
+
+		{"ar", 0, pluralZero},
+		{"ar", 1, pluralOne},
+		{"ar", 2, pluralTwo},
+		{"ar", 3, pluralFew},
+		{"ar", 10, pluralFew},
+		{"ar", 103, pluralFew},
+		{"ar", 11, pluralMany},
+		{"ar", 99, pluralMany},
+		{"ar", 100, pluralOther},
+		{"ar", 102, pluralOther},
+
+		{"ru", 1, pluralOne},
+		{"ru", 21, pluralOne},
+		{"ru", 11, pluralMany},
+		{"ru", 2, pluralFew},
+		{"ru", 24, pluralFew},
+		{"ru", 12, pluralMany},
+		{"ru", 14, pluralMany},
+		{"ru", 0, pluralMany},
+		{"ru", 5, pluralMany},
+		{"uk", 101, pluralOne},
+		{"be", 22, pluralFew},
+
+		{"pl", 1, pluralOne},
+		{"pl", 21, pluralMany},
+		{"pl", 2, pluralFew},
+		{"pl", 22, pluralFew},
+		{"pl", 12, pluralMany},
+		{"pl", 0, pluralMany},
+		{"pl", 5, pluralMany},
+	}
+	for _, test := range tests {
+		if got := pluralCategory(test.lang, test.count); got != test.want {
+			t.Errorf("%s %d: expected %q, got %q", test.lang, test.count, test.want, got)
+		}
+	}
+}
@@ -62,6 +62,10 @@ func init() {
 
 	readIntoMap(es, localizable.ES)
 	languageStrings[localizable.SPANISH] = es
+
+	if err := loadTranslations(localizable.Locales, "locales"); err != nil {
+		panic(err)
+	}
 }
 
 func hasLanguage(language string) bool {
@@ -106,13 +110,19 @@ func String(key string) string {
 	return key
 }
 
+// StringF formats the string with the arguments. The first integer argument
+// selects the plural form of the string and a Gender argument selects its
+// gender form, if the string has forms in the user language.
 func StringF(key string, a ...interface{}) string {
-	str := String(key)
+	str, args, ok := stringForm(key, a)
+	if !ok {
+		str = String(key)
+	}
 	if str == "" {
 		return str
 	}
 
-	return fmt.Sprintf(str, a...)
+	return fmt.Sprintf(str, args...)
 }
 
 const (
@@ -1069,4 +1079,281 @@ const (
 	StrUnexpectedErrorMsgFmt                 = "unexpectedErrorMsgFmt"
 	StrUnexpectedError                       = "unexpectedError"
 	StrChinese                               = "chinese"
+	StrDeepRecovery                          = "deepRecovery"
+	StrDeepRecoveryInfo                      = "deepRecoveryInfo"
+	StrAddressGapLimit                       = "addressGapLimit"
+	StrAccountGapLimit                       = "accountGapLimit"
+	StrKeyScopes                             = "keyScopes"
+	StrNoKeyScopeSelected                    = "noKeyScopeSelected"
+	StrDeepRecoveryStarted                   = "deepRecoveryStarted"
+	StrDeepRecoveryStartedBody               = "deepRecoveryStartedBody"
+	StrDeepRecoveryProgress                  = "deepRecoveryProgress"
+	StrDeepRecoveryFinished                  = "deepRecoveryFinished"
+	StrAddressType                           = "addressType"
+	StrDefaultAddressType                    = "defaultAddressType"
+	StrLegacyAddress                         = "legacyAddress"
+	StrNestedSegwitAddress                   = "nestedSegwitAddress"
+	StrNativeSegwitAddress                   = "nativeSegwitAddress"
+	StrTaprootAddress                        = "taprootAddress"
+	StrMultisig                              = "multisig"
+	StrMultisigWallet                        = "multisigWallet"
+	StrRequiredSignatures                    = "requiredSignatures"
+	StrEnterRequiredSignatures               = "enterRequiredSignatures"
+	StrCosignerKeys                          = "cosignerKeys"
+	StrCosignerKeysHint                      = "cosignerKeysHint"
+	StrMultisigThreshold                     = "multisigThreshold"
+	StrCosigners                             = "cosigners"
+	StrThisWallet                            = "thisWallet"
+	StrShareCosignerKey                      = "shareCosignerKey"
+	StrCreateTransaction                     = "createTransaction"
+	StrImportPSBT                            = "importPSBT"
+	StrPSBTFileOrBase64                      = "psbtFileOrBase64"
+	StrPendingTransactions                   = "pendingTransactions"
+	StrNoPendingTransactions                 = "noPendingTransactions"
+	StrSignatureProgress                     = "signatureProgress"
+	StrSigned                                = "signed"
+	StrNotSigned                             = "notSigned"
+	StrSign                                  = "sign"
+	StrExportPSBT                            = "exportPSBT"
+	StrShowQRCode                            = "showQRCode"
+	StrBroadcast                             = "broadcast"
+	StrPSBTExported                          = "psbtExported"
+	StrPSBTTooLargeForQR                     = "psbtTooLargeForQR"
+	StrPSBTImported                          = "psbtImported"
+	StrMultisigTxBroadcast                   = "multisigTxBroadcast"
+	StrDeletePendingTx                       = "deletePendingTx"
+	StrDeletePendingTxMsg                    = "deletePendingTxMsg"
+	StrExtendedPubKeyOrDescriptor            = "extendedPubKeyOrDescriptor"
+	StrOutputDescriptors                     = "outputDescriptors"
+	StrDescriptorCopied                      = "descriptorCopied"
+	StrPriceChart                            = "priceChart"
+	StrVolume                                = "volume"
+	StrNoChartData                           = "noChartData"
+	StrSwapStepInit                          = "swapStepInit"
+	StrSwapStepAudit                         = "swapStepAudit"
+	StrSwapStepRedeem                        = "swapStepRedeem"
+	StrSwapStepCounterRedeem                 = "swapStepCounterRedeem"
+	StrSwapStepRefund                        = "swapStepRefund"
+	StrMatchRefunded                         = "matchRefunded"
+	StrMatchRevokedAwaitingRefund            = "matchRevokedAwaitingRefund"
+	StrMatchRevoked                          = "matchRevoked"
+	StrMatchStepSendSwap                     = "matchStepSendSwap"
+	StrMatchStepAwaitCounterSwap             = "matchStepAwaitCounterSwap"
+	StrMatchStepAuditSendSwap                = "matchStepAuditSendSwap"
+	StrMatchStepAuditRedeem                  = "matchStepAuditRedeem"
+	StrMatchStepAwaitCounterRedeem           = "matchStepAwaitCounterRedeem"
+	StrMatchStepRedeem                       = "matchStepRedeem"
+	StrMatchStepRedeemConfirming             = "matchStepRedeemConfirming"
+	StrMatchStepComplete                     = "matchStepComplete"
+	StrNoMatches                             = "noMatches"
+	StrOrderID                               = "orderID"
+	StrMaker                                 = "maker"
+	StrTaker                                 = "taker"
+	StrSide                                  = "side"
+	StrMatchTitle                            = "matchTitle"
+	StrRefundLocktime                        = "refundLocktime"
+	StrRefundableNow                         = "refundableNow"
+	StrRefundableIn                          = "refundableIn"
+	StrConfirmationsCount                    = "confirmationsCount"
+	StrBondManager                           = "bondManager"
+	StrManageBonds                           = "manageBonds"
+	StrTierAndReputation                     = "tierAndReputation"
+	StrEffectiveTier                         = "effectiveTier"
+	StrTargetTier                            = "targetTier"
+	StrBondedTier                            = "bondedTier"
+	StrReputationScore                       = "reputationScore"
+	StrPenalties                             = "penalties"
+	StrBonds                                 = "bonds"
+	StrActiveTiers                           = "activeTiers"
+	StrExpiringTiers                         = "expiringTiers"
+	StrBondLifetime                          = "bondLifetime"
+	StrPendingBonds                          = "pendingBonds"
+	StrRefundableBonds                       = "refundableBonds"
+	StrNoRefundableBonds                     = "noRefundableBonds"
+	StrBondRefunded                          = "bondRefunded"
+	StrBondSettings                          = "bondSettings"
+	StrAutoRenewBonds                        = "autoRenewBonds"
+	StrAutoRenewBondsMsg                     = "autoRenewBondsMsg"
+	StrRenewalFundsRequired                  = "renewalFundsRequired"
+	StrTargetTierErrMsg                      = "targetTierErrMsg"
+	StrSaveBondSettings                      = "saveBondSettings"
+	StrBondSettingsSaved                     = "bondSettingsSaved"
+	StrPostAdditionalBond                    = "postAdditionalBond"
+	StrPostAdditionalBondMsg                 = "postAdditionalBondMsg"
+	StrBondPosted                            = "bondPosted"
+	StrBondsExpiringNotif                    = "bondsExpiringNotif"
+	StrBondsExpiringNoRenewalNotif           = "bondsExpiringNoRenewalNotif"
+	StrBondsExpiredNotif                     = "bondsExpiredNotif"
+	StrConditionalOrders                     = "conditionalOrders"
+	StrConditionalOrdersMsg                  = "conditionalOrdersMsg"
+	StrNewConditionalOrder                   = "newConditionalOrder"
+	StrStopLimit                             = "stopLimit"
+	StrTakeProfit                            = "takeProfit"
+	StrRecurringBuy                          = "recurringBuy"
+	StrTriggerPrice                          = "triggerPrice"
+	StrTriggerPriceMsg                       = "triggerPriceMsg"
+	StrLimitPriceOptional                    = "limitPriceOptional"
+	StrAmountPerBuy                          = "amountPerBuy"
+	StrBuyInterval                           = "buyInterval"
+	StrEveryHour                             = "everyHour"
+	StrEveryDay                              = "everyDay"
+	StrEveryWeek                             = "everyWeek"
+	StrNumberOfBuys                          = "numberOfBuys"
+	StrInvalidTriggerPrice                   = "invalidTriggerPrice"
+	StrInvalidNumberOfBuys                   = "invalidNumberOfBuys"
+	StrConditionalOrderCreated               = "conditionalOrderCreated"
+	StrConditionalOrderCanceled              = "conditionalOrderCanceled"
+	StrNoConditionalOrders                   = "noConditionalOrders"
+	StrConditionalOrdersPaused               = "conditionalOrdersPaused"
+	StrNextBuy                               = "nextBuy"
+	StrBuysPlaced                            = "buysPlaced"
+	StrLastError                             = "lastError"
+	StrLastEvent                             = "lastEvent"
+	StrStatusActive                          = "statusActive"
+	StrStatusPlaced                          = "statusPlaced"
+	StrStatusCanceled                        = "statusCanceled"
+	StrDEXServers                            = "dexServers"
+	StrManageServers                         = "manageServers"
+	StrAddServerMsg                          = "addServerMsg"
+	StrServerAddress                         = "serverAddress"
+	StrCertificatePemOrPath                  = "certificatePemOrPath"
+	StrServerAdded                           = "serverAdded"
+	StrServerAccountRestored                 = "serverAccountRestored"
+	StrServerStatus                          = "serverStatus"
+	StrServerConnected                       = "serverConnected"
+	StrServerDisconnected                    = "serverDisconnected"
+	StrServerDisabled                        = "serverDisabled"
+	StrAccountStatus                         = "accountStatus"
+	StrViewOnlyAccount                       = "viewOnlyAccount"
+	StrTierFmt                               = "tierFmt"
+	StrEnableServer                          = "enableServer"
+	StrDisableServerMsg                      = "disableServerMsg"
+	StrServerStatusUpdated                   = "serverStatusUpdated"
+	StrUpdateCertificate                     = "updateCertificate"
+	StrCertificateUpdated                    = "certificateUpdated"
+	StrCompareMarket                         = "compareMarket"
+	StrNoOtherServersMsg                     = "noOtherServersMsg"
+	StrMidGap                                = "midGap"
+	StrBestBuy                               = "bestBuy"
+	StrBestSell                              = "bestSell"
+	StrLotSize                               = "lotSize"
+	StrRouteToBestServer                     = "routeToBestServer"
+	StrRouteToBestServerInfo                 = "routeToBestServerInfo"
+	StrOrderRoutedTo                         = "orderRoutedTo"
+	StrCompareProviders                      = "compareProviders"
+	StrCompareProvidersMsg                   = "compareProvidersMsg"
+	StrBestRate                              = "bestRate"
+	StrLimits                                = "limits"
+	StrMarketDeviation                       = "marketDeviation"
+	StrAmountOutsideLimits                   = "amountOutsideLimits"
+	StrRateDeviationWarning                  = "rateDeviationWarning"
+	StrFeeRateOptional                       = "feeRateOptional"
+	StrInvalidFeeRate                        = "invalidFeeRate"
+	StrFundOrder                             = "fundOrder"
+	StrOrderFunded                           = "orderFunded"
+	StrCopySupportBundle                     = "copySupportBundle"
+	StrDepositTx                             = "depositTx"
+	StrPayoutTx                              = "payoutTx"
+	StrRefundTx                              = "refundTx"
+	StrOrderOverdueMsg                       = "orderOverdueMsg"
+	StrOrderOverdueNotif                     = "orderOverdueNotif"
+	StrScheduleName                          = "scheduleName"
+	StrStrategy                              = "strategy"
+	StrFixedAmountStrategy                   = "fixedAmountStrategy"
+	StrBalancePercentageStrategy             = "balancePercentageStrategy"
+	StrTargetWeightStrategy                  = "targetWeightStrategy"
+	StrAmountToSwap                          = "amountToSwap"
+	StrBalancePercentageHint                 = "balancePercentageHint"
+	StrTargetWeightHint                      = "targetWeightHint"
+	StrSchedules                             = "schedules"
+	StrNoSchedules                           = "noSchedules"
+	StrPause                                 = "pause"
+	StrResume                                = "resume"
+	StrScheduleRunning                       = "scheduleRunning"
+	StrSchedulePaused                        = "schedulePaused"
+	StrScheduleAwaitingPassword              = "scheduleAwaitingPassword"
+	StrNextRun                               = "nextRun"
+	StrRecentRuns                            = "recentRuns"
+	StrScheduleRunSwapped                    = "scheduleRunSwapped"
+	StrScheduleRunSkipped                    = "scheduleRunSkipped"
+	StrSchedulesRunning                      = "schedulesRunning"
+	StrPortfolio                             = "portfolio"
+	StrPortfolioMsg                          = "portfolioMsg"
+	StrManagePortfolio                       = "managePortfolio"
+	StrTargetAllocation                      = "targetAllocation"
+	StrTargetWeightFmt                       = "targetWeightFmt"
+	StrDriftThreshold                        = "driftThreshold"
+	StrRebalanceWith                         = "rebalanceWith"
+	StrInstantSwap                           = "instantSwap"
+	StrCheckDrift                            = "checkDrift"
+	StrHourly                                = "hourly"
+	StrDaily                                 = "daily"
+	StrWeekly                                = "weekly"
+	StrAutoExecuteSwaps                      = "autoExecuteSwaps"
+	StrPortfolioSaved                        = "portfolioSaved"
+	StrInvalidTargetWeights                  = "invalidTargetWeights"
+	StrCurrentAllocation                     = "currentAllocation"
+	StrAllocationRowFmt                      = "allocationRowFmt"
+	StrProposedSwaps                         = "proposedSwaps"
+	StrPortfolioBalanced                     = "portfolioBalanced"
+	StrRebalanceNeededFmt                    = "rebalanceNeededFmt"
+	StrEstimatedReceive                      = "estimatedReceive"
+	StrEstimatedSlippage                     = "estimatedSlippage"
+	StrExecute                               = "execute"
+	StrSwapOrderPlaced                       = "swapOrderPlaced"
+	StrStartMonitoring                       = "startMonitoring"
+	StrStopMonitoring                        = "stopMonitoring"
+	StrLastCheckFmt                          = "lastCheckFmt"
+	StrFiatCurrency                          = "fiatCurrency"
+	StrFileFormat                            = "fileFormat"
+	StrFromDate                              = "fromDate"
+	StrToDate                                = "toDate"
+	StrAllAccounts                           = "allAccounts"
+	StrIncludeFiatValuesFmt                  = "includeFiatValues"
+	StrInvalidDate                           = "invalidDate"
+	StrInvalidDateRange                      = "invalidDateRange"
+	StrExportFilterNote                      = "exportFilterNote"
+	StrTaxReport                             = "taxReport"
+	StrTaxReportMsgFmt                       = "taxReportMsg"
+	StrTaxYear                               = "taxYear"
+	StrCostBasisMethod                       = "costBasisMethod"
+	StrGenerate                              = "generate"
+	StrShortTermGains                        = "shortTermGains"
+	StrLongTermGains                         = "longTermGains"
+	StrIncome                                = "income"
+	StrDisposals                             = "disposals"
+	StrMissingPricesFmt                      = "missingPrices"
+	StrUnmatchedDisposalsFmt                 = "unmatchedDisposals"
+	StrTaxReportSaved                        = "taxReportSaved"
+	StrSaveCSV                               = "saveCSV"
+	StrBalanceHistory                        = "balanceHistory"
+	StrPortfolioValue                        = "portfolioValue"
+	StrAdvancedFilter                        = "advancedFilter"
+	StrAdvancedFilterMsg                     = "advancedFilterMsg"
+	StrMinAmount                             = "minAmount"
+	StrMaxAmount                             = "maxAmount"
+	StrLabelContains                         = "labelContains"
+	StrUnconfirmed                           = "unconfirmed"
+	StrTicketStatus                          = "ticketStatus"
+	StrAny                                   = "any"
+	StrApply                                 = "apply"
+	StrRecentActivity                        = "recentActivity"
+	StrNoActivity                            = "noActivity"
+	StrTheme                                 = "theme"
+	StrLightTheme                            = "lightTheme"
+	StrDarkTheme                             = "darkTheme"
+	StrHighContrastTheme                     = "highContrastTheme"
+	StrColorBlindSafeTheme                   = "colorBlindSafeTheme"
+	StrShowPassword                          = "showPassword"
+	StrHidePassword                          = "hidePassword"
+	StrPrevious                              = "previous"
+	StrClose                                 = "close"
+	StrSwapCurrencies                        = "swapCurrencies"
+	StrResumeSchedules                       = "resumeSchedules"
+	StrResumeSchedulesInfo                   = "resumeSchedulesInfo"
+	StrSwapFundedFrom                        = "swapFundedFrom"
+	StrHideBalance                           = "hideBalance"
+	StrShowBalance                           = "showBalance"
+	StrLightMode                             = "lightMode"
+	StrSeedWordN                             = "seedWordN"
 )
@@ -0,0 +1,222 @@
+package values
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// translationsExt is the extension of the translation files.
+	translationsExt = ".json"
+	// directionRTL is the direction of the right-to-left languages in their
+	// translation files.
+	directionRTL = "rtl"
+)
+
+// Gender selects the gender form of a string formatted by StringF. It is not
+// passed to the format of the string.
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+	GenderOther  Gender = "other"
+)
+
+// translationFile is a translation file, see localizable.Locales for its
+// format.
+type translationFile struct {
+	Locale    string                     `json:"locale"`
+	Name      string                     `json:"name"`
+	Direction string                     `json:"direction"`
+	Strings   map[string]json.RawMessage `json:"strings"`
+}
+
+// languageForms are the plural and gender forms of the strings of each
+// language, keyed by the plural category, the gender or both as in
+// "female.one".
+var languageForms = make(map[string]map[string]map[string]string)
+
+// languageNames are the display names of the languages added by the
+// translation files.
+var languageNames = make(map[string]string)
+
+// rtlLanguages are the languages written right-to-left.
+var rtlLanguages = make(map[string]bool)
+
+// LoadTranslations loads the translation files in dir over the strings
+// embedded at build time. Languages that are not built in are added to the
+// Languages. It is not an error if dir doesn't exist.
+func LoadTranslations(dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	return loadTranslations(os.DirFS(dir), ".")
+}
+
+// loadTranslations loads the translation files in the dir of fsys.
+func loadTranslations(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*"+translationsExt))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		if err := addTranslation(content); err != nil {
+			return fmt.Errorf("invalid translation file %s: %v", file, err)
+		}
+	}
+	return nil
+}
+
+func addTranslation(content []byte) error {
+	var file translationFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return err
+	}
+	lang := file.Locale
+	if lang == "" {
+		return fmt.Errorf("missing locale")
+	}
+
+	if _, ok := languageStrings[lang]; !ok {
+		languageStrings[lang] = make(map[string]string)
+	}
+	if _, ok := languageForms[lang]; !ok {
+		languageForms[lang] = make(map[string]map[string]string)
+	}
+	if file.Name != "" {
+		languageNames[lang] = file.Name
+	}
+	if file.Direction != "" {
+		rtlLanguages[lang] = file.Direction == directionRTL
+	}
+	if !hasLanguage(lang) {
+		Languages = append(Languages, lang)
+	}
+
+	for key, raw := range file.Strings {
+		var value string
+		if err := json.Unmarshal(raw, &value); err == nil {
+			languageStrings[lang][key] = value
+			delete(languageForms[lang], key)
+			continue
+		}
+
+		var forms map[string]string
+		if err := json.Unmarshal(raw, &forms); err != nil {
+			return fmt.Errorf("invalid value of %q", key)
+		}
+		other, ok := forms[pluralOther]
+		if !ok {
+			return fmt.Errorf("missing %q form of %q", pluralOther, key)
+		}
+		// String returns the other form of the string.
+		languageStrings[lang][key] = other
+		languageForms[lang][key] = forms
+	}
+	return nil
+}
+
+// LanguageName returns the display name of the language from its
+// translation file, or an empty string if it has none.
+func LanguageName(lang string) string {
+	return languageNames[lang]
+}
+
+// IsRTL returns true if the language is written right-to-left.
+func IsRTL(lang string) bool {
+	return rtlLanguages[lang]
+}
+
+// MissingKeys returns the keys of the default language strings that are not
+// translated in the language, sorted.
+func MissingKeys(lang string) []string {
+	strs := languageStrings[lang]
+	var missing []string
+	for key := range languageStrings[DefaultLanguage] {
+		if _, ok := strs[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// stringForm returns the form of the string that matches the count and the
+// gender of the StringF arguments, and the arguments to format it with.
+func stringForm(key string, a []interface{}) (string, []interface{}, bool) {
+	var gender Gender
+	args := make([]interface{}, 0, len(a))
+	for _, arg := range a {
+		if g, ok := arg.(Gender); ok {
+			gender = g
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	for _, lang := range UserLanguages {
+		if _, ok := languageStrings[lang][key]; !ok {
+			continue
+		}
+		forms, ok := languageForms[lang][key]
+		if !ok {
+			return "", args, false
+		}
+
+		count, hasCount := firstCount(args)
+		var candidates []string
+		if hasCount {
+			exact := fmt.Sprintf("=%d", count)
+			category := pluralCategory(lang, count)
+			if gender != "" {
+				candidates = append(candidates, string(gender)+"."+exact, string(gender)+"."+category)
+			}
+			candidates = append(candidates, exact, category)
+		}
+		if gender != "" {
+			candidates = append(candidates, string(gender)+"."+pluralOther, string(gender))
+		}
+		candidates = append(candidates, pluralOther)
+
+		for _, form := range candidates {
+			if str, ok := forms[form]; ok {
+				return str, args, true
+			}
+		}
+	}
+	return "", args, false
+}
+
+// firstCount returns the first integer argument, which selects the plural
+// form of the string.
+func firstCount(a []interface{}) (int64, bool) {
+	for _, arg := range a {
+		v := reflect.ValueOf(arg)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return v.Int(), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return int64(v.Uint()), true
+		}
+	}
+	return 0, false
+}
+
+// baseLanguage returns the language of a locale such as "pt-BR".
+func baseLanguage(lang string) string {
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return strings.ToLower(lang)
+}
@@ -0,0 +1,117 @@
+package values
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/ui/values/localizable"
+)
+
+func TestAddTranslation(t *testing.T) {
+	const lang = "ru"
+	defer func(languages, userLanguages []string) {
+		Languages, UserLanguages = languages, userLanguages
+		delete(languageStrings, lang)
+		delete(languageForms, lang)
+		delete(languageNames, lang)
+		delete(rtlLanguages, lang)
+	}(Languages, UserLanguages)
+
+	content := `{
+		"locale": "ru",
+		"name": "Русский",
+		"direction": "ltr",
+		"strings": {
+			"about": "О программе",
+			"votes": {"one": "%d голос", "few": "%d голоса", "many": "%d голосов", "other": "%d голоса"},
+			"acctCreated": {"male": "Создан %s", "female": "Создана %s", "other": "Создано %s"}
+		}
+	}`
+	if err := addTranslation([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if !hasLanguage(lang) || LanguageName(lang) != "Русский" || IsRTL(lang) {
+		t.Fatalf("expected %s to be added as a left-to-right language", lang)
+	}
+
+	SetUserLanguage(lang)
+	tests := []struct {
+		key  string
+		args []interface{}
+		want string
+	}{
+		{"about", nil, "О программе"},
+		{"votes", []interface{}{1}, "1 голос"},
+		{"votes", []interface{}{3}, "3 голоса"},
+		{"votes", []interface{}{11}, "11 голосов"},
+		{"votes", []interface{}{uint32(21)}, "21 голос"},
+		{"acctCreated", []interface{}{GenderFemale, "Сберегательная"}, "Создана Сберегательная"},
+		{"acctCreated", []interface{}{"Основной"}, "Создано Основной"},
+		// Untranslated strings fall back to English.
+		{"nConfirmations", []interface{}{2}, "2 Confirmations"},
+	}
+	for _, test := range tests {
+		if got := StringF(test.key, test.args...); got != test.want {
+			t.Errorf("%s %v: expected %q, got %q", test.key, test.args, test.want, got)
+		}
+	}
+	if got := String("votes"); got != "%d голоса" {
+		t.Errorf("expected the other form of votes, got %q", got)
+	}
+
+	missing := MissingKeys(lang)
+	if len(missing) != len(languageStrings[DefaultLanguage])-3 {
+		t.Errorf("expected all but 3 keys to be missing, got %d missing", len(missing))
+	}
+
+	invalid := []string{
+		`{"strings": {}}`,
+		`{"locale": "ru", "strings": {"votes": {"one": "%d голос"}}}`,
+		`{"locale": "ru", "strings": {"votes": 1}}`,
+		`{"locale": "ru"`,
+	}
+	for _, content := range invalid {
+		if err := addTranslation([]byte(content)); err == nil {
+			t.Errorf("expected %s to be rejected", content)
+		}
+	}
+}
+
+func TestEmbeddedTranslations(t *testing.T) {
+	defer func(userLanguages []string) { UserLanguages = userLanguages }(UserLanguages)
+
+	// Each embedded file is loaded over the strings of its locale.
+	files := map[string]string{"en": "English", "es": "Español", "fa": "فارسی", "fr": "Français", "zh": "中文"}
+	for lang, name := range files {
+		if LanguageName(lang) != name {
+			t.Errorf("expected %s to be named %s, got %q", lang, name, LanguageName(lang))
+		}
+	}
+	if !IsRTL("fa") || IsRTL(localizable.ENGLISH) {
+		t.Error("expected only fa to be right-to-left")
+	}
+
+	tests := []struct {
+		lang  string
+		count int
+		want  string
+	}{
+		{localizable.ENGLISH, 1, "1 vote"},
+		{localizable.ENGLISH, 2, "2 votes"},
+		{localizable.FRENCH, 0, "0 vote"},
+		{localizable.FRENCH, 2, "2 votes"},
+		{localizable.SPANISH, 1, "1 voto"},
+		{localizable.CHINESE, 1, "1 票"},
+		{localizable.CHINESE, 2, "2 票"},
+	}
+	for _, test := range tests {
+		SetUserLanguage(test.lang)
+		if got := StringF(StrVotes, test.count); got != test.want {
+			t.Errorf("%s %d: expected %q, got %q", test.lang, test.count, test.want, got)
+		}
+	}
+
+	SetUserLanguage(localizable.CHINESE)
+	if got, want := StringF(StrBlocksLeft, 5), "剩余 5 个区块"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
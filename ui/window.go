@@ -52,6 +52,11 @@ type Window struct {
 	drag       gesture.Drag
 	isClick    bool
 	isDragging bool
+
+	// auditSemantics logs the unlabeled controls of each displayed page and
+	// modal, auditedID is the ID of the last one audited.
+	auditSemantics bool
+	auditedID      string
 }
 
 type (
@@ -115,12 +120,13 @@ func (win *Window) NewLoad(appInfo *load.AppInfo, window *giouiApp.Window) (*loa
 	go libutils.IsOnline()
 
 	// Set the user-configured theme colors on app load.
-	var isDarkModeOn bool
+	palette := values.ThemePalette(values.LightPalette, false)
 	if appInfo.AssetsManager.LoadedWalletsCount() > 0 {
 		// A valid DB interface must have been set. Otherwise no valid wallet exists.
-		isDarkModeOn = appInfo.AssetsManager.IsDarkModeOn()
+		palette = values.ThemePalette(appInfo.AssetsManager.GetTheme(), appInfo.AssetsManager.IsDarkModeOn())
 	}
-	th.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
+	th.SetLanguage(appInfo.AssetsManager.GetLanguagePreference())
+	th.SwitchPalette(palette, assets.DecredIcons)
 
 	l.Theme = th
 	// NB: Toasts implementation is maintained here for the cases where its
@@ -268,6 +274,8 @@ func (win *Window) handleFrameEvent(evt giouiApp.FrameEvent) *op.Ops {
 	win.load.SetCurrentAppWidth(evt.Size.X, evt.Metric)
 	ops := &op.Ops{}
 	gtx := giouiApp.NewContext(ops, evt)
+	// Lay out in the direction of the app language rather than the system's.
+	gtx.Locale = win.load.Theme.Locale()
 
 	switch {
 	case win.navigator.CurrentPage() == nil:
@@ -290,9 +298,48 @@ func (win *Window) handleFrameEvent(evt giouiApp.FrameEvent) *op.Ops {
 	// components onto the screen. Use the generated ops to request key events.
 	win.prepareToDisplayUI(gtx)
 	win.addListenKeyEvent(gtx)
+	if win.auditSemantics {
+		win.auditDisplayedSemantics(gtx)
+	}
 	return ops
 }
 
+// EnableSemanticsAudit logs the controls screen readers can't describe on
+// each page and modal when it is first displayed.
+func (win *Window) EnableSemanticsAudit() {
+	win.auditSemantics = true
+}
+
+// auditDisplayedSemantics logs the unlabeled controls of the top modal, or of
+// the current page if no modal is displayed, if it was not audited already.
+func (win *Window) auditDisplayedSemantics(gtx C) {
+	var id string
+	var w layout.Widget
+	if modal := win.navigator.TopModal(); modal != nil {
+		id, w = modal.ID(), modal.Layout
+	} else if pg := win.navigator.CurrentPage(); pg != nil {
+		w = pg.Layout
+		// Master pages display a sub page, it is part of the ID so that
+		// each sub page is audited.
+		for pg != nil {
+			id += "/" + pg.ID()
+			masterPage, ok := pg.(interface{ CurrentPage() app.Page })
+			if !ok {
+				break
+			}
+			pg = masterPage.CurrentPage()
+		}
+	}
+	if w == nil || id == win.auditedID {
+		return
+	}
+	win.auditedID = id
+
+	for _, control := range cryptomaterial.AuditSemantics(gtx, w) {
+		log.Warnf("Unlabeled %s control at %v on %s", control.Class, control.Bounds, id)
+	}
+}
+
 // prepareToDisplayUI creates an operation list and writes the layout of all the
 // window UI components into it. The created ops is returned and may be used to
 // record further operations before finally being rendered on screen via